@@ -559,7 +559,20 @@ func (a *AddrManager) DeserializeNetAddress(addr string,
 		return nil, err
 	}
 
-	return a.HostToNetAddress(host, uint16(port), services)
+	na, err := a.HostToNetAddress(host, uint16(port), services)
+	if err != nil {
+		return nil, err
+	}
+
+	// CJDNS addresses are stored as IPv6 addresses in fc00::/8, which are
+	// otherwise unroutable and therefore never stored.
+	if ip := na.IP.To16(); !na.NeedsAddrV2() && na.IP.To4() == nil &&
+		ip != nil && ip[0] == 0xfc {
+
+		na = wire.NewNetAddressNetwork(wire.NetworkCJDNS, ip, na.Port,
+			na.Services)
+	}
+	return na, nil
 }
 
 // Start begins the core address handler which manages a pool of known
@@ -718,9 +731,29 @@ func (a *AddrManager) reset() {
 }
 
 // HostToNetAddress returns a netaddress given a host address.  If the address
-// is a Tor .onion address this will be taken care of.  Else if the host is
-// not an IP address it will be resolved (via Tor if required).
+// is a Tor .onion or I2P .b32.i2p address this will be taken care of.  Else if
+// the host is not an IP address it will be resolved (via Tor if required).
 func (a *AddrManager) HostToNetAddress(host string, port uint16, services wire.ServiceFlag) (*wire.NetAddress, error) {
+	// Tor v3 address is 56 char base32 + ".onion"
+	if len(host) == torV3HostLen && strings.HasSuffix(host, ".onion") {
+		pubKey, err := parseTorV3Host(host)
+		if err != nil {
+			return nil, err
+		}
+		return wire.NewNetAddressNetwork(wire.NetworkTorV3, pubKey,
+			port, services), nil
+	}
+
+	// I2P address is 52 char base32 + ".b32.i2p"
+	if len(host) == i2pHostLen && strings.HasSuffix(host, i2pHostSuffix) {
+		hash, err := parseI2PHost(host)
+		if err != nil {
+			return nil, err
+		}
+		return wire.NewNetAddressNetwork(wire.NetworkI2P, hash, port,
+			services), nil
+	}
+
 	// Tor address is 16 char base32 + ".onion"
 	var ip net.IP
 	if len(host) == 22 && host[16:] == ".onion" {
@@ -750,8 +783,18 @@ func (a *AddrManager) HostToNetAddress(host string, port uint16, services wire.S
 
 // ipString returns a string for the ip from the provided NetAddress. If the
 // ip is in the range used for Tor addresses then it will be transformed into
-// the relevant .onion address.
+// the relevant .onion address.  Tor v3 and I2P addresses are returned as their
+// .onion and .b32.i2p hosts.
 func ipString(na *wire.NetAddress) string {
+	switch {
+	case IsTorV3(na):
+		return torV3Host(na.Addr)
+	case IsI2P(na):
+		return i2pHost(na.Addr)
+	case IsCJDNS(na):
+		return net.IP(na.Addr).String()
+	}
+
 	if IsOnionCatTor(na) {
 		// We know now that na.IP is long enough.
 		base32str := base32.StdEncoding.EncodeToString(na.IP[6:])
@@ -996,7 +1039,7 @@ func (a *AddrManager) SetServices(addr *wire.NetAddress, services wire.ServiceFl
 // with the given priority.
 func (a *AddrManager) AddLocalAddress(na *wire.NetAddress, priority AddressPriority) error {
 	if !IsRoutable(na) {
-		return fmt.Errorf("address %s is not routable", na.IP)
+		return fmt.Errorf("address %s is not routable", ipString(na))
 	}
 
 	a.lamtx.Lock()
@@ -1034,8 +1077,22 @@ func getReachabilityFrom(localAddr, remoteAddr *wire.NetAddress) int {
 		return Unreachable
 	}
 
-	if IsOnionCatTor(remoteAddr) {
-		if IsOnionCatTor(localAddr) {
+	if IsI2P(remoteAddr) {
+		if IsI2P(localAddr) {
+			return Private
+		}
+		return Unreachable
+	}
+
+	if IsCJDNS(remoteAddr) {
+		if IsCJDNS(localAddr) {
+			return Private
+		}
+		return Unreachable
+	}
+
+	if IsOnion(remoteAddr) {
+		if IsOnion(localAddr) {
 			return Private
 		}
 
@@ -1070,6 +1127,10 @@ func getReachabilityFrom(localAddr, remoteAddr *wire.NetAddress) int {
 	}
 
 	/* ipv6 */
+	if localAddr.NeedsAddrV2() {
+		return Default
+	}
+
 	var tunnelled bool
 	// Is our v6 is tunnelled?
 	if IsRFC3964(localAddr) || IsRFC6052(localAddr) || IsRFC6145(localAddr) {
@@ -1115,15 +1176,14 @@ func (a *AddrManager) GetBestLocalAddress(remoteAddr *wire.NetAddress) *wire.Net
 		}
 	}
 	if bestAddress != nil {
-		log.Debugf("Suggesting address %s:%d for %s:%d", bestAddress.IP,
-			bestAddress.Port, remoteAddr.IP, remoteAddr.Port)
+		log.Debugf("Suggesting address %s for %s",
+			NetAddressKey(bestAddress), NetAddressKey(remoteAddr))
 	} else {
-		log.Debugf("No worthy address for %s:%d", remoteAddr.IP,
-			remoteAddr.Port)
+		log.Debugf("No worthy address for %s", NetAddressKey(remoteAddr))
 
 		// Send something unroutable if nothing suitable.
 		var ip net.IP
-		if !IsIPv4(remoteAddr) && !IsOnionCatTor(remoteAddr) {
+		if !IsIPv4(remoteAddr) && !IsOnion(remoteAddr) {
 			ip = net.IPv6zero
 		} else {
 			ip = net.IPv4zero
@@ -192,3 +192,73 @@ func TestAddrManagerV1ToV2(t *testing.T) {
 	addrMgr.loadPeers()
 	assertAddrs(t, addrMgr, expectedAddrs)
 }
+
+// TestAddrManagerSerializationAddrV2 ensures that Tor v3, I2P and CJDNS
+// addresses survive a round trip through the peers file.
+func TestAddrManagerSerializationAddrV2(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "addrmgr")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	addrMgr := New(tempDir, nil)
+
+	torV3, err := addrMgr.HostToNetAddress("2gzyxa5ihm7nsggfxnu52rck2vv4"+
+		"rvmdlkiu3zzui5du4xyclen53wid.onion", 8333, wire.SFNodeNetwork)
+	if err != nil {
+		t.Fatalf("unable to parse tor v3 address: %v", err)
+	}
+	i2p, err := addrMgr.HostToNetAddress("ukeu3k5oycgaauneqgtnvselmt4yemvo"+
+		"ilkln7jpvamvfx7dnkdq.b32.i2p", 8333, wire.SFNodeNetwork)
+	if err != nil {
+		t.Fatalf("unable to parse i2p address: %v", err)
+	}
+	cjdns := wire.NewNetAddressNetwork(wire.NetworkCJDNS,
+		net.ParseIP("fc32:17ea:e415:c3bf:9808:149d:b5a2:c9aa"), 8333,
+		wire.SFNodeNetwork)
+
+	expectedAddrs := make(map[string]*wire.NetAddress)
+	for _, addr := range []*wire.NetAddress{torV3, i2p, cjdns} {
+		expectedAddrs[NetAddressKey(addr)] = addr
+		addrMgr.AddAddress(addr, randAddr(t))
+	}
+	assertAddrs(t, addrMgr, expectedAddrs)
+
+	addrMgr.savePeers()
+	addrMgr = New(tempDir, nil)
+	addrMgr.loadPeers()
+	assertAddrs(t, addrMgr, expectedAddrs)
+
+	for _, addr := range addrMgr.getAddresses() {
+		expectedAddr := expectedAddrs[NetAddressKey(addr)]
+		if addr.Network != expectedAddr.Network {
+			t.Fatalf("expected network %v for %v, got %v",
+				expectedAddr.Network, NetAddressKey(addr),
+				addr.Network)
+		}
+	}
+}
+
+// TestTorV3Host ensures Tor v3 hosts with an invalid checksum or version are
+// rejected.
+func TestTorV3Host(t *testing.T) {
+	host := "2gzyxa5ihm7nsggfxnu52rck2vv4rvmdlkiu3zzui5du4xyclen53wid.onion"
+	pubKey, err := parseTorV3Host(host)
+	if err != nil {
+		t.Fatalf("unable to parse tor v3 host: %v", err)
+	}
+	if got := torV3Host(pubKey); got != host {
+		t.Fatalf("unexpected tor v3 host - got %s, want %s", got, host)
+	}
+
+	// Change the last character of the checksum and the version.
+	if _, err := parseTorV3Host(host[:52] + "a" + host[53:]); err == nil {
+		t.Fatal("parsed tor v3 host with invalid checksum")
+	}
+	if _, err := parseTorV3Host(host[:55] + "a" + host[56:]); err == nil {
+		t.Fatal("parsed tor v3 host with invalid version")
+	}
+}
@@ -0,0 +1,90 @@
+package addrmgr
+
+import (
+	"bytes"
+	"encoding/base32"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// torV3Version is the version byte of Tor v3 hidden service addresses.
+	torV3Version = 0x03
+
+	// torV3HostLen is the length of a Tor v3 hidden service host, which is
+	// the base32 encoding of the 32 byte public key, a 2 byte checksum and
+	// the version byte followed by ".onion".
+	torV3HostLen = 56 + len(".onion")
+
+	// i2pHostSuffix is the suffix of I2P hosts.
+	i2pHostSuffix = ".b32.i2p"
+
+	// i2pHostLen is the length of an I2P host, which is the unpadded base32
+	// encoding of the 32 byte destination hash followed by ".b32.i2p".
+	i2pHostLen = 52 + len(i2pHostSuffix)
+)
+
+// addrEncoding is the lowercase base32 encoding without padding used by Tor
+// and I2P hosts.
+var addrEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").
+	WithPadding(base32.NoPadding)
+
+// torV3Checksum returns the checksum of a Tor v3 hidden service address for
+// the passed public key.
+func torV3Checksum(pubKey []byte) []byte {
+	h := sha3.New256()
+	h.Write([]byte(".onion checksum"))
+	h.Write(pubKey)
+	h.Write([]byte{torV3Version})
+	return h.Sum(nil)[:2]
+}
+
+// torV3Host returns the .onion host of the Tor v3 hidden service with the
+// passed public key.
+func torV3Host(pubKey []byte) string {
+	data := make([]byte, 0, len(pubKey)+3)
+	data = append(data, pubKey...)
+	data = append(data, torV3Checksum(pubKey)...)
+	data = append(data, torV3Version)
+	return addrEncoding.EncodeToString(data) + ".onion"
+}
+
+// parseTorV3Host returns the public key of the passed Tor v3 .onion host.
+func parseTorV3Host(host string) ([]byte, error) {
+	data, err := addrEncoding.DecodeString(
+		strings.ToLower(strings.TrimSuffix(host, ".onion")))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 35 {
+		return nil, errors.New("invalid tor v3 address length")
+	}
+	pubKey, checksum, version := data[:32], data[32:34], data[34]
+	if version != torV3Version {
+		return nil, errors.New("unknown tor address version")
+	}
+	if !bytes.Equal(checksum, torV3Checksum(pubKey)) {
+		return nil, errors.New("invalid tor v3 address checksum")
+	}
+	return pubKey, nil
+}
+
+// i2pHost returns the .b32.i2p host of the passed I2P destination hash.
+func i2pHost(hash []byte) string {
+	return addrEncoding.EncodeToString(hash) + i2pHostSuffix
+}
+
+// parseI2PHost returns the destination hash of the passed .b32.i2p host.
+func parseI2PHost(host string) ([]byte, error) {
+	hash, err := addrEncoding.DecodeString(
+		strings.ToLower(strings.TrimSuffix(host, i2pHostSuffix)))
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != 32 {
+		return nil, errors.New("invalid i2p address length")
+	}
+	return hash, nil
+}
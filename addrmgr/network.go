@@ -117,6 +117,28 @@ func IsOnionCatTor(na *wire.NetAddress) bool {
 	return onionCatNet.Contains(na.IP)
 }
 
+// IsTorV3 returns whether or not the passed address is a Tor v3 hidden service
+// address.
+func IsTorV3(na *wire.NetAddress) bool {
+	return na.Network == wire.NetworkTorV3
+}
+
+// IsOnion returns whether or not the passed address is a Tor v2 or Tor v3
+// hidden service address.
+func IsOnion(na *wire.NetAddress) bool {
+	return IsOnionCatTor(na) || IsTorV3(na)
+}
+
+// IsI2P returns whether or not the passed address is an I2P address.
+func IsI2P(na *wire.NetAddress) bool {
+	return na.Network == wire.NetworkI2P
+}
+
+// IsCJDNS returns whether or not the passed address is a CJDNS address.
+func IsCJDNS(na *wire.NetAddress) bool {
+	return na.Network == wire.NetworkCJDNS
+}
+
 // IsRFC1918 returns whether or not the passed address is part of the IPv4
 // private network address space as defined by RFC1918 (10.0.0.0/8,
 // 172.16.0.0/12, or 192.168.0.0/16).
@@ -213,6 +235,18 @@ func IsRFC6598(na *wire.NetAddress) bool {
 // IPv4: It is either a zero or all bits set address.
 // IPv6: It is either a zero or RFC3849 documentation address.
 func IsValid(na *wire.NetAddress) bool {
+	// Addresses which are not held in an IP must have the size of their
+	// network.  CJDNS addresses are always in fc00::/8.
+	switch na.Network {
+	case 0:
+	case wire.NetworkTorV3, wire.NetworkI2P:
+		return len(na.Addr) == 32
+	case wire.NetworkCJDNS:
+		return len(na.Addr) == 16 && na.Addr[0] == 0xfc
+	default:
+		return false
+	}
+
 	// IsUnspecified returns if address is 0, so only all bits set, and
 	// RFC3849 need to be explicitly checked.
 	return na.IP != nil && !(na.IP.IsUnspecified() ||
@@ -223,6 +257,9 @@ func IsValid(na *wire.NetAddress) bool {
 // the public internet.  This is true as long as the address is valid and is not
 // in any reserved ranges.
 func IsRoutable(na *wire.NetAddress) bool {
+	if na.NeedsAddrV2() {
+		return IsValid(na)
+	}
 	return IsValid(na) && !(IsRFC1918(na) || IsRFC2544(na) ||
 		IsRFC3927(na) || IsRFC4862(na) || IsRFC3849(na) ||
 		IsRFC4843(na) || IsRFC5737(na) || IsRFC6598(na) ||
@@ -232,8 +269,9 @@ func IsRoutable(na *wire.NetAddress) bool {
 // GroupKey returns a string representing the network group an address is part
 // of.  This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, the string
 // "local" for a local address, the string "tor:key" where key is the /4 of the
-// onion address for Tor address, and the string "unroutable" for an unroutable
-// address.
+// onion address for Tor address, the string "network:key" where key is the /4
+// of the public key for Tor v3, I2P and CJDNS addresses, and the string
+// "unroutable" for an unroutable address.
 func GroupKey(na *wire.NetAddress) string {
 	if IsLocal(na) {
 		return "local"
@@ -268,6 +306,16 @@ func GroupKey(na *wire.NetAddress) string {
 		// group is keyed off the first 4 bits of the actual onion key.
 		return fmt.Sprintf("tor:%d", na.IP[6]&((1<<4)-1))
 	}
+	if na.NeedsAddrV2() {
+		// group is keyed off the first 4 bits of the public key the
+		// address is derived from, which follows the constant 0xfc
+		// prefix of CJDNS addresses.
+		key := na.Addr
+		if IsCJDNS(na) {
+			key = key[1:]
+		}
+		return fmt.Sprintf("%v:%d", na.Network, key[0]&((1<<4)-1))
+	}
 
 	// OK, so now we know ourselves to be a IPv6 address.
 	// bitcoind uses /32 for everything, except for Hurricane Electric's
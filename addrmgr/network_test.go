@@ -200,3 +200,61 @@ func TestGroupKey(t *testing.T) {
 		}
 	}
 }
+
+// TestGroupKeyAddrV2 tests the GroupKey function to ensure it properly groups
+// Tor v3, I2P and CJDNS addresses by their network.
+func TestGroupKeyAddrV2(t *testing.T) {
+	tests := []struct {
+		name     string
+		network  wire.NetworkID
+		addr     []byte
+		expected string
+	}{
+		{
+			name:     "tor v3",
+			network:  wire.NetworkTorV3,
+			addr:     append([]byte{0x53}, make([]byte, 31)...),
+			expected: "torv3:3",
+		},
+		{
+			name:     "i2p",
+			network:  wire.NetworkI2P,
+			addr:     append([]byte{0xa7}, make([]byte, 31)...),
+			expected: "i2p:7",
+		},
+		{
+			name:     "cjdns",
+			network:  wire.NetworkCJDNS,
+			addr:     net.ParseIP("fc0a:1:2:3:4:5:6:7"),
+			expected: "cjdns:10",
+		},
+		{
+			name:     "cjdns outside fc00::/8",
+			network:  wire.NetworkCJDNS,
+			addr:     net.ParseIP("fd00::1"),
+			expected: "unroutable",
+		},
+		{
+			name:     "tor v3 wrong size",
+			network:  wire.NetworkTorV3,
+			addr:     make([]byte, 16),
+			expected: "unroutable",
+		},
+		{
+			name:     "unknown network",
+			network:  wire.NetworkID(0xaa),
+			addr:     make([]byte, 32),
+			expected: "unroutable",
+		},
+	}
+
+	for i, test := range tests {
+		na := wire.NewNetAddressNetwork(test.network, test.addr, 8333,
+			wire.SFNodeNetwork)
+		if key := addrmgr.GroupKey(na); key != test.expected {
+			t.Errorf("TestGroupKeyAddrV2 #%d (%s): unexpected group "+
+				"key - got '%s', want '%s'", i, test.name,
+				key, test.expected)
+		}
+	}
+}
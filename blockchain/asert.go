@@ -0,0 +1,123 @@
+package blockchain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+)
+
+// CalcAsertTarget returns the target difficulty of the block after the block
+// at the passed height and timestamp according to the aserti3-2d absolutely
+// scheduled exponentially rising targets algorithm.
+//
+// The schedule starts from the anchor block, whose target, height and parent
+// timestamp are passed.  Each block is expected targetSpacing seconds after
+// its parent, and the target doubles for every halfLife seconds the chain
+// is behind that schedule and halves for every halfLife seconds it is ahead.
+// The exponential is approximated by a cubic polynomial in fixed point
+// arithmetic so that every node computes the exact same target.
+//
+// The target is the base target of the block.  Coinbase addresses with a
+// stake mine against it multiplied by cross.ComputeDiff, so staked blocks
+// arrive faster than the schedule implies and raise the base target of
+// everyone accordingly.
+func CalcAsertTarget(anchorTarget *big.Int, anchorHeight int32,
+	anchorParentTime int64, height int32, timestamp int64,
+	targetSpacing, halfLife int64, powLimit *big.Int) *big.Int {
+
+	timeDiff := timestamp - anchorParentTime
+	heightDiff := int64(height - anchorHeight)
+
+	// The exponent of two the anchor target is multiplied with, in fixed
+	// point with 16 fractional bits.  The division truncates towards zero
+	// as in the reference implementation.
+	exponent := ((timeDiff - targetSpacing*(heightDiff+1)) * 65536) / halfLife
+
+	// Split the exponent into its integer and fractional parts.  The
+	// shift rounds downwards, so the fractional part is never negative.
+	shifts := exponent >> 16
+	frac := uint64(uint16(exponent))
+
+	// 65536 * 2^(frac/65536) approximated by
+	// 1 + 0.695502049*x + 0.2262698*x^2 + 0.0782318*x^3 for 0 <= x < 1,
+	// which is off by less than 0.013%.  The sum can't overflow.
+	factor := 65536 + ((195766423245049*frac +
+		971821376*frac*frac +
+		5127*frac*frac*frac +
+		1<<47) >> 48)
+
+	// Multiply the anchor target by the factor and 2^shifts, and divide
+	// it by the 65536 the factor is scaled by.
+	target := new(big.Int).Mul(anchorTarget, new(big.Int).SetUint64(factor))
+	shifts -= 16
+	if shifts <= 0 {
+		target.Rsh(target, uint(-shifts))
+	} else {
+		// Anything shifted this far exceeds the proof of work limit,
+		// so don't bother creating huge numbers.
+		if shifts > 256 {
+			shifts = 256
+		}
+		target.Lsh(target, uint(shifts))
+	}
+
+	// Zero is not a valid target, but one is.
+	if target.Sign() == 0 {
+		return target.SetInt64(1)
+	}
+	if target.Cmp(powLimit) > 0 {
+		return target.Set(powLimit)
+	}
+	return target
+}
+
+// asertAnchor returns the anchor of the ASERT schedule of the chain ending
+// with the passed block node, which must be at or after the anchor.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) asertAnchor(lastNode *blockNode) (*chaincfg.AsertAnchor, error) {
+	if b.chainParams.AsertAnchor != nil {
+		return b.chainParams.AsertAnchor, nil
+	}
+
+	// The anchor is the last block using the previous algorithm.  Look it
+	// up in the main chain when the passed node forks after it to avoid
+	// walking back to it.
+	anchorHeight := b.chainParams.UpgradeHeight(chaincfg.UpgradeAsert) - 1
+	var anchor *blockNode
+	if fork := b.bestChain.FindFork(lastNode); fork != nil &&
+		fork.height >= anchorHeight {
+
+		anchor = b.bestChain.NodeByHeight(anchorHeight)
+	} else {
+		anchor = lastNode.Ancestor(anchorHeight)
+	}
+	if anchor == nil || anchor.parent == nil {
+		return nil, AssertError("unable to obtain the ASERT anchor block")
+	}
+
+	return &chaincfg.AsertAnchor{
+		Height:     anchor.height,
+		Bits:       anchor.bits,
+		ParentTime: anchor.parent.timestamp,
+	}, nil
+}
+
+// calcAsertRequiredDifficulty calculates the required difficulty for the
+// block after the passed previous block node using the ASERT algorithm.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) calcAsertRequiredDifficulty(lastNode *blockNode) (uint32, error) {
+	anchor, err := b.asertAnchor(lastNode)
+	if err != nil {
+		return 0, err
+	}
+
+	target := CalcAsertTarget(CompactToBig(anchor.Bits), anchor.Height,
+		anchor.ParentTime, lastNode.height, lastNode.timestamp,
+		int64(b.chainParams.TargetTimePerBlock/time.Second),
+		int64(b.chainParams.AsertHalfLife/time.Second),
+		b.chainParams.PowLimit)
+	return BigToCompact(target), nil
+}
@@ -0,0 +1,339 @@
+package blockchain
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// TestCalcAsertTarget ensures CalcAsertTarget matches vectors of the
+// reference aserti3-2d implementation.
+func TestCalcAsertTarget(t *testing.T) {
+	// The anchor is block 1000 with bits 0x1c2a1115 whose parent was mined
+	// at 1600000000.  Blocks are due every 30 seconds and the half-life is
+	// two hours.
+	powLimit := new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+	anchorTarget := CompactToBig(0x1c2a1115)
+	tests := []struct {
+		name      string
+		height    int32
+		timestamp int64
+		bits      uint32
+	}{
+		{"anchor on schedule", 1000, 1600000030, 0x1c2a1115},
+		{"one block ahead", 1001, 1600000030, 0x1c29f21b},
+		{"spacing ahead", 1100, 1600003000, 0x1c29f21b},
+		{"half-life behind", 1100, 1600010200, 0x1c53e40c},
+		{"half-life ahead", 1100, 1599995800, 0x1c14f90d},
+		{"half a half-life behind", 1100, 1600006600, 0x1c3b5071},
+		{"second behind", 1100, 1600003001, 0x1c29f341},
+		{"second ahead", 1100, 1600002999, 0x1c29f11f},
+		{"three half-lives behind", 2000, 1600051600, 0x1d014f90},
+		{"three half-lives ahead", 2000, 1600008400, 0x1c053e43},
+		{"no time passed", 2000, 1600000000, 0x1c0255f8},
+		{"long run behind", 6000, 1600250000, 0x1e09b5c7},
+		{"timestamp before anchor", 1010, 1599999330, 0x1c2635d4},
+		{"day behind", 3880, 1600172800, 0x1e029f20},
+		{"pow limit", 1001, 2600000000, 0x1e0fffff},
+		{"minimum target", 201000, 1600000000, 0x01010000},
+	}
+
+	for _, test := range tests {
+		target := CalcAsertTarget(anchorTarget, 1000, 1600000000,
+			test.height, test.timestamp, 30, 7200, powLimit)
+		if bits := BigToCompact(target); bits != test.bits {
+			t.Errorf("%s: got bits %08x, want %08x", test.name, bits,
+				test.bits)
+		}
+	}
+}
+
+// asertTestParams returns regression test parameters with ASERT activating
+// at the passed height.
+func asertTestParams(activation int32) *chaincfg.Params {
+	params := chaincfg.RegressionNetParams
+	params.Upgrades = map[chaincfg.UpgradeID]int32{
+		chaincfg.UpgradeAsert: activation,
+	}
+	return &params
+}
+
+// TestAsertRequiredDifficulty ensures the required difficulty switches to
+// ASERT at its activation height and is scheduled from the anchor of the
+// chain being extended.
+func TestAsertRequiredDifficulty(t *testing.T) {
+	params := asertTestParams(10)
+	bc := newFakeChain(params)
+	spacing := int64(params.TargetTimePerBlock / time.Second)
+	halfLife := int64(params.AsertHalfLife / time.Second)
+
+	// Build a main chain up to height 12 and a side chain forking from
+	// block 5 up to height 10 with different difficulties.
+	mainTip := bc.bestChain.Tip()
+	sideTip := mainTip
+	blockTime := time.Unix(mainTip.timestamp, 0)
+	for i := int32(1); i <= 12; i++ {
+		blockTime = blockTime.Add(params.TargetTimePerBlock)
+		mainTip = newFakeNode(mainTip, 1, 0x2000ffff, blockTime)
+		bc.index.AddNode(mainTip)
+		if i <= 5 {
+			sideTip = mainTip
+		} else if i <= 10 {
+			sideTip = newFakeNode(sideTip, 1, 0x2000aaaa,
+				blockTime.Add(time.Minute))
+			bc.index.AddNode(sideTip)
+		}
+	}
+	bc.bestChain.SetTip(mainTip)
+
+	if algo := bc.SelectDifficultyAdjustmentAlgorithm(9); algo != DifficultyLegacy {
+		t.Fatalf("algorithm of block 9 is %d, want legacy", algo)
+	}
+	if algo := bc.SelectDifficultyAdjustmentAlgorithm(10); algo != DifficultyAsert {
+		t.Fatalf("algorithm of block 10 is %d, want ASERT", algo)
+	}
+
+	// Block 9 still uses the legacy algorithm.
+	node8 := mainTip.Ancestor(8)
+	nextTime := time.Unix(node8.timestamp, 0).Add(time.Hour)
+	bits, err := bc.calcNextRequiredDifficulty(node8, nextTime)
+	if err != nil {
+		t.Fatalf("calcNextRequiredDifficulty: %v", err)
+	}
+	legacyBits, _ := bc.calcLegacyRequiredDifficulty(node8, nextTime,
+		difficultyBoundDivisor)
+	if bits != legacyBits {
+		t.Errorf("bits of block 9 are %08x, want legacy %08x", bits,
+			legacyBits)
+	}
+
+	// Blocks from 10 on are scheduled from block 9 of their chain.
+	tests := []struct {
+		name string
+		last *blockNode
+	}{
+		{"activation", mainTip.Ancestor(9)},
+		{"main chain", mainTip},
+		{"side chain", sideTip},
+	}
+	for _, test := range tests {
+		anchor := test.last.Ancestor(9)
+		want := BigToCompact(CalcAsertTarget(CompactToBig(anchor.bits),
+			9, anchor.parent.timestamp, test.last.height,
+			test.last.timestamp, spacing, halfLife, params.PowLimit))
+
+		// The time of the new block doesn't matter.
+		bits, err := bc.calcNextRequiredDifficulty(test.last, time.Time{})
+		if err != nil {
+			t.Fatalf("%s: calcNextRequiredDifficulty: %v", test.name,
+				err)
+		}
+		if bits != want {
+			t.Errorf("%s: got bits %08x, want %08x", test.name, bits,
+				want)
+		}
+	}
+
+	// A configured anchor replaces the one in the chain.
+	params.AsertAnchor = &chaincfg.AsertAnchor{
+		Height:     9,
+		Bits:       0x2000bbbb,
+		ParentTime: mainTip.Ancestor(8).timestamp,
+	}
+	want := BigToCompact(CalcAsertTarget(CompactToBig(0x2000bbbb), 9,
+		params.AsertAnchor.ParentTime, mainTip.height, mainTip.timestamp,
+		spacing, halfLife, params.PowLimit))
+	bits, err = bc.calcNextRequiredDifficulty(mainTip, time.Time{})
+	if err != nil {
+		t.Fatalf("calcNextRequiredDifficulty: %v", err)
+	}
+	if bits != want {
+		t.Errorf("configured anchor: got bits %08x, want %08x", bits, want)
+	}
+}
+
+// simMiner is a miner of the ASERT simulation.
+type simMiner struct {
+	addr     czzutil.Address
+	hashRate float64
+	blocks   int
+}
+
+// simulateAsert mines the passed number of blocks on the passed chain with
+// the passed miners, which mine against the required difficulty boosted by
+// their stake in the passed entangle state.  It returns the average block
+// time.
+func simulateAsert(bc *BlockChain, rng *rand.Rand, miners []*simMiner,
+	state *cross.EntangleState, blocks int) (float64, error) {
+
+	tip := bc.bestChain.Tip()
+	start := tip.timestamp
+	for i := 0; i < blocks; i++ {
+		bits, err := bc.calcNextRequiredDifficulty(tip, time.Time{})
+		if err != nil {
+			return 0, err
+		}
+
+		// Every miner finds a block after an exponentially distributed
+		// time depending on its hash rate and effective target, and
+		// the fastest one wins.
+		var winner *simMiner
+		best := math.Inf(1)
+		for _, miner := range miners {
+			target := cross.ComputeDiff(bc.chainParams,
+				CompactToBig(bits), miner.addr, state)
+			work, _ := new(big.Float).Quo(new(big.Float).SetInt(oneLsh256),
+				new(big.Float).SetInt(target)).Float64()
+			if d := rng.ExpFloat64() * work / miner.hashRate; d < best {
+				best, winner = d, miner
+			}
+		}
+		winner.blocks++
+
+		blockTime := time.Unix(tip.timestamp+int64(math.Round(best)), 0)
+		tip = newFakeNode(tip, 1, bits, blockTime)
+		bc.index.AddNode(tip)
+		bc.bestChain.SetTip(tip)
+	}
+	return float64(tip.timestamp-start) / float64(blocks), nil
+}
+
+// TestAsertSimulation mines simulated blocks through hash rate swings and
+// staked miners to ensure ASERT keeps the block time on target.
+func TestAsertSimulation(t *testing.T) {
+	params := asertTestParams(2)
+	params.PowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
+	bc := newFakeChain(params)
+	rng := rand.New(rand.NewSource(1))
+
+	addrs := make([]czzutil.Address, 2)
+	for i := range addrs {
+		addr, err := czzutil.NewAddressPubKeyHash([]byte{19: byte(i)}, params)
+		if err != nil {
+			t.Fatalf("NewAddressPubKeyHash: %v", err)
+		}
+		addrs[i] = addr
+	}
+
+	// Anchor the schedule at a difficulty matching 1000 hashes per second.
+	genesis := bc.bestChain.Tip()
+	anchorTarget := new(big.Int).Div(oneLsh256, big.NewInt(1000*30))
+	anchor := newFakeNode(genesis, 1, BigToCompact(anchorTarget),
+		time.Unix(genesis.timestamp+30, 0))
+	bc.index.AddNode(anchor)
+	bc.bestChain.SetTip(anchor)
+
+	miner := &simMiner{addr: addrs[0], hashRate: 1000}
+	staker := &simMiner{addr: addrs[1], hashRate: 1000}
+	noStake := &cross.EntangleState{}
+	phases := []struct {
+		name   string
+		miners []*simMiner
+		state  *cross.EntangleState
+	}{
+		{"steady", []*simMiner{miner}, noStake},
+		{"hash rate up tenfold", []*simMiner{{addr: addrs[0], hashRate: 10000}}, noStake},
+		{"hash rate down", []*simMiner{miner}, noStake},
+		{"staked miner", []*simMiner{miner, staker}, &cross.EntangleState{
+			EnInfos: map[string]*cross.BeaconAddressInfo{
+				"beacon": {
+					StakingAmount: new(big.Int).Mul(
+						params.MinStakingAmount, big.NewInt(2)),
+					CoinBaseAddress: []string{addrs[1].String()},
+				},
+			},
+		}},
+	}
+	for _, phase := range phases {
+		// Let the difficulty settle before measuring the block time.
+		if _, err := simulateAsert(bc, rng, phase.miners, phase.state, 2000); err != nil {
+			t.Fatalf("%s: %v", phase.name, err)
+		}
+		staker.blocks, miner.blocks = 0, 0
+		avg, err := simulateAsert(bc, rng, phase.miners, phase.state, 2000)
+		if err != nil {
+			t.Fatalf("%s: %v", phase.name, err)
+		}
+		if avg < 27 || avg > 33 {
+			t.Errorf("%s: average block time %.1fs, want 30s",
+				phase.name, avg)
+		}
+	}
+
+	// Staking twice the minimum amount boosts the target eightfold, so the
+	// staker finds eight of nine blocks with the same hash rate.
+	share := float64(staker.blocks) / float64(staker.blocks+miner.blocks)
+	if share < 0.85 || share > 0.93 {
+		t.Errorf("staked miner found %.2f of the blocks, want 0.89", share)
+	}
+}
+
+// TestDifficultyCalculator ensures the difficulty calculator requires the same
+// difficulty as the block chain for chains starting at the genesis block and
+// after the ASERT anchor.
+func TestDifficultyCalculator(t *testing.T) {
+	params := asertTestParams(20)
+	bc := newFakeChain(params)
+	genesis := bc.bestChain.Tip()
+	calc := NewDifficultyCalculator(params, &params.GenesisBlock.Header, 0, 0)
+
+	// Mine blocks with varying times across the activation.
+	tip := genesis
+	for i := int32(1); i <= 40; i++ {
+		blockTime := time.Unix(tip.timestamp+int64(i%7)*10, 0)
+		want, err := bc.calcNextRequiredDifficulty(tip, blockTime)
+		if err != nil {
+			t.Fatalf("calcNextRequiredDifficulty: %v", err)
+		}
+		bits, err := calc.NextRequiredDifficulty(blockTime)
+		if err != nil {
+			t.Fatalf("NextRequiredDifficulty: %v", err)
+		}
+		if bits != want {
+			t.Fatalf("block %d: got bits %08x, want %08x", i, bits, want)
+		}
+
+		tip = newFakeNode(tip, 1, want, blockTime)
+		bc.index.AddNode(tip)
+		bc.bestChain.SetTip(tip)
+		header := tip.Header()
+		calc.AddHeader(&header)
+	}
+	if calc.Height() != tip.height {
+		t.Fatalf("calculator height is %d, want %d", calc.Height(),
+			tip.height)
+	}
+
+	// A calculator starting after the anchor needs it configured.
+	late := NewDifficultyCalculator(params, &wire.BlockHeader{
+		Bits:      tip.bits,
+		Timestamp: time.Unix(tip.timestamp, 0),
+	}, tip.height, 0)
+	if _, err := late.NextRequiredDifficulty(time.Time{}); err == nil {
+		t.Fatal("calculator without the anchor returned no error")
+	}
+	anchor := tip.Ancestor(19)
+	params.AsertAnchor = &chaincfg.AsertAnchor{
+		Height:     19,
+		Bits:       anchor.bits,
+		ParentTime: anchor.parent.timestamp,
+	}
+	want, err := bc.calcNextRequiredDifficulty(tip, time.Time{})
+	if err != nil {
+		t.Fatalf("calcNextRequiredDifficulty: %v", err)
+	}
+	bits, err := late.NextRequiredDifficulty(time.Time{})
+	if err != nil {
+		t.Fatalf("NextRequiredDifficulty: %v", err)
+	}
+	if bits != want {
+		t.Errorf("configured anchor: got bits %08x, want %08x", bits, want)
+	}
+}
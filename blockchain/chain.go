@@ -154,6 +154,13 @@ type BlockChain struct {
 	// to help prevent logic races when blocks are being processed.
 	utxoCache *utxoCache
 
+	// utxoSetState is the rolling commitment to the utxo set at the end of
+	// the main chain.  It is nil when the commitment is not known, such as
+	// while the utxo set is downloaded in fast sync mode.
+	//
+	// It is protected by the chain lock.
+	utxoSetState *utxoSetState
+
 	// orphanLock protects the fields related to handling of orphan blocks.
 	// They are protected by a combination of the chain lock and the orphan lock.
 	orphanLock   sync.RWMutex
@@ -229,6 +236,10 @@ type BlockChain struct {
 	// finished.
 	fastSyncDone chan struct{}
 
+	// headersOnly is set if the blockchain only maintains the block index
+	// from validated headers and keeps no UTXO set.
+	headersOnly bool
+
 	//
 	committeeVerify *cross.CommitteeVerify
 
@@ -720,6 +731,12 @@ func (b *BlockChain) connectBlock(node *blockNode, block *czzutil.Block,
 	state := newBestState(node, blockSize, numTxns,
 		curTotalTxns+numTxns, node.CalcPastMedianTime())
 
+	// Roll the utxo set commitment forward when it is known.
+	var utxoSetState *utxoSetState
+	if b.utxoSetState != nil {
+		utxoSetState = b.utxoSetState.connectBlock(block, stxos)
+	}
+
 	// Atomically insert info into the database.
 	err = b.db.Update(func(dbTx database.Tx) error {
 		// Update best block state.
@@ -728,6 +745,14 @@ func (b *BlockChain) connectBlock(node *blockNode, block *czzutil.Block,
 			return err
 		}
 
+		// Update the utxo set commitment along with the best state.
+		if utxoSetState != nil {
+			err = dbPutUtxoSetState(dbTx, utxoSetState)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Add the block hash and height to the block index which tracks
 		// the main chain.
 		err = dbPutBlockIndex(dbTx, block.Hash(), node.height)
@@ -768,6 +793,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block *czzutil.Block,
 
 	// This node is now the end of the best chain.
 	b.bestChain.SetTip(node)
+	b.utxoSetState = utxoSetState
 
 	// Update the state for the best block.  Notice how this replaces the
 	// entire struct instead of updating the existing one.  This effectively
@@ -849,6 +875,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *czzutil.Block, view
 	state := newBestState(prevNode, blockSize, numTxns,
 		newTotalTxns, prevNode.CalcPastMedianTime())
 
+	var utxoSetState *utxoSetState
 	err = b.db.Update(func(dbTx database.Tx) error {
 		// Update best block state.
 		err := dbPutBestState(dbTx, state, node.workSum)
@@ -870,6 +897,19 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *czzutil.Block, view
 			return err
 		}
 
+		// Roll the utxo set commitment back when it is known.
+		if b.utxoSetState != nil {
+			if len(stxos) != countSpentOutputs(block) {
+				return AssertError("disconnectBlock called with " +
+					"inconsistent spent transaction out information")
+			}
+			utxoSetState = b.utxoSetState.disconnectBlock(block, stxos)
+			err = dbPutUtxoSetState(dbTx, utxoSetState)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Update the transaction spend journal by removing the record
 		// that contains all txos spent by the block.
 		err = dbRemoveSpendJournalEntry(dbTx, block.Hash())
@@ -903,6 +943,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *czzutil.Block, view
 
 	// This node's parent is now the end of the best chain.
 	b.bestChain.SetTip(node.parent)
+	b.utxoSetState = utxoSetState
 
 	// Update the state for the best block.  Notice how this replaces the
 	// entire struct instead of updating the existing one.  This effectively
@@ -1232,14 +1273,14 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *czzutil.Block, fla
 
 	err := b.db.Update(func(dbTx database.Tx) error {
 
-		if b.chainParams.MauiHeight <= block.Height() {
+		if b.chainParams.IsActive(chaincfg.UpgradeMaui, block.Height()) {
 			err := dbStateTx(b, dbTx, block)
 			if err != nil {
 				return err
 			}
 		}
 
-		if b.chainParams.BeaconHeight <= block.Height() && b.chainParams.MauiHeight > block.Height() {
+		if b.chainParams.IsActive(chaincfg.UpgradeBeacon, block.Height()) && !b.chainParams.IsActive(chaincfg.UpgradeMaui, block.Height()) {
 			err := dbBeaconTx(b.chainParams, dbTx, block)
 			if err != nil {
 				return err
@@ -1905,6 +1946,44 @@ func (b *BlockChain) reconsiderBlock(hash *chainhash.Hash) error {
 	return nil
 }
 
+// PreciousBlock treats the block with the passed hash as if it were received
+// before the other blocks with the same amount of work, making it the tip of
+// the main chain when its branch has as much work as the main chain.  Nothing
+// is done for blocks with less work, which can not become the tip.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) PreciousBlock(hash *chainhash.Hash) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	node := b.index.LookupNode(hash)
+	if node == nil {
+		return fmt.Errorf("block %s is not known", hash)
+	}
+	if b.bestChain.Contains(node) {
+		return nil
+	}
+	if node.workSum.Cmp(b.bestChain.Tip().workSum) < 0 {
+		return nil
+	}
+	if b.index.NodeStatus(node).KnownInvalid() {
+		return fmt.Errorf("block %s is invalid", hash)
+	}
+
+	detachNodes, attachNodes := b.getReorganizeNodes(node)
+	log.Infof("REORGANIZE: Block %v is precious and is causing a "+
+		"reorganize.", node.hash)
+	err := b.reorganizeChain(detachNodes, attachNodes)
+
+	// Either getReorganizeNodes or reorganizeChain could have made unsaved
+	// changes to the block index, so flush regardless of whether there was
+	// an error.
+	if writeErr := b.index.flushToDB(); writeErr != nil {
+		log.Warnf("Error flushing block index changes to disk: %v", writeErr)
+	}
+	return err
+}
+
 // Prune deletes the block data and spend journals for all blocks deeper than
 // the set prune depth.
 //
@@ -2229,6 +2308,12 @@ type Config struct {
 	// the UTXO set in fast sync mode.
 	Proxy string
 
+	// HeadersOnly will only maintain the block index from validated
+	// headers without keeping a UTXO set.  Blocks must be added through
+	// ProcessBlockHeader rather than ProcessBlock.  A database that was
+	// used in headers-only mode can not be used by a full node.
+	HeadersOnly bool
+
 	// Eth
 	EthRPC []string
 
@@ -2372,6 +2457,7 @@ func New(config *Config) (*BlockChain, error) {
 		pruneDepth:          config.PruneDepth,
 		fastSyncDataDir:     config.FastSyncDataDir,
 		fastSyncDone:        make(chan struct{}),
+		headersOnly:         config.HeadersOnly,
 		committeeVerify:     committeeVerify,
 		ConvertTx:           make(map[string]*cross.ConvertTxTemp),
 	}
@@ -2385,6 +2471,39 @@ func New(config *Config) (*BlockChain, error) {
 	}
 
 	bestNode := b.bestChain.Tip()
+
+	// A headers-only database lacks the block data and UTXO set that a
+	// full node needs and a full database would be left with an UTXO set
+	// behind its tip by a headers-only node, so the two can't be mixed.
+	var chainType []byte
+	b.db.View(func(tx database.Tx) error {
+		chainType = dbFetchBlockchainType(tx)
+		return nil
+	})
+	isHeadersOnlyDB := bytes.Equal(chainType, headersOnlyBlockchainEntryValue)
+	if isHeadersOnlyDB && !config.HeadersOnly {
+		return nil, AssertError("blockchain.New database was created " +
+			"in headers-only mode")
+	}
+	if config.HeadersOnly {
+		if !isHeadersOnlyDB && bestNode.height > 0 {
+			return nil, AssertError("blockchain.New headers-only mode " +
+				"requires a new or headers-only database")
+		}
+		err := b.db.Update(func(tx database.Tx) error {
+			return dbPutBlockchainType(tx, headersOnlyBlockchainEntryValue)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Chain state (height %d, hash %v, work %v) in "+
+			"headers-only mode", bestNode.height, bestNode.hash,
+			bestNode.workSum)
+
+		return &b, nil
+	}
+
 	lastCheckpoint := b.LatestCheckpoint()
 	config.FastSync = config.FastSync && lastCheckpoint != nil && bestNode.height <= lastCheckpoint.Height
 
@@ -2448,6 +2567,15 @@ func New(config *Config) (*BlockChain, error) {
 		log.Info("Re-indexing complete")
 	}
 
+	// Load the utxo set commitment, unless the utxo set is about to be
+	// replaced by the one downloaded in fast sync mode, in which case the
+	// commitment is set up once the download is verified.
+	if !config.FastSync {
+		if err := b.initUtxoSetState(config.Interrupt); err != nil {
+			return nil, err
+		}
+	}
+
 	if config.FastSync {
 		if lastCheckpoint.UtxoSetHash == nil || len(lastCheckpoint.UtxoSetSources) == 0 || lastCheckpoint.UtxoSetSize == 0 {
 			errStr := fmt.Sprintf("chain with %s params does not support fastsync mode", b.chainParams.Name)
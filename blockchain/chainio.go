@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/czzec"
 	"github.com/classzz/classzz/database"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
@@ -66,6 +67,10 @@ var (
 	// consistency status of the utxo state.
 	utxoStateConsistencyKeyName = []byte("utxostateconsistency")
 
+	// utxoSetStateKeyName is the name of the db key used to store the
+	// rolling commitment to the utxo set and its statistics.
+	utxoSetStateKeyName = []byte("utxosetstate")
+
 	// blockchainTypeKeyName is the name of the db key used to store the
 	// prune status of the blockchain. If it was ever run in prune mode
 	// or fastsync mode then it should be treated as a pruned chain.
@@ -75,6 +80,10 @@ var (
 	// pruned blockchain.
 	prunedBlockchainEntryValue = []byte("prunedblockchain")
 
+	// headersOnlyBlockchainEntryValue is the value the corresponds to a
+	// headers-only blockchain.
+	headersOnlyBlockchainEntryValue = []byte("headersonlyblockchain")
+
 	// utxoSetVersionKeyName is the name of the db key used to store the
 	// version of the utxo set currently in the database.
 	utxoSetVersionKeyName = []byte("utxosetversion")
@@ -521,126 +530,24 @@ func dbStateTx(b *BlockChain, dbTx database.Tx, block *czzutil.Block) error {
 	pHeight := block.Height() - 1
 	pHash := block.MsgBlock().Header.PrevBlock
 	cState := dbFetchCommitteeState(dbTx, pHeight, pHash)
-	if block.Height() == b.chainParams.MauiHeight {
-		eState := dbFetchEntangleState(dbTx, pHeight, pHash)
-		cState = cross.NewCommitteeState()
-		for _, v := range eState.EnInfos {
-			pi := &cross.PledgeInfo{
-				ID:              big.NewInt(int64(v.ExchangeID)),
-				Address:         v.Address,
-				ToAddress:       v.ToAddress,
-				StakingAmount:   v.StakingAmount,
-				CoinBaseAddress: v.CoinBaseAddress,
-			}
-			cState.PledgeInfos = append(cState.PledgeInfos, pi)
-		}
-	}
-
-	var MortgageTx *wire.MsgTx
-	CastingTx := make([]*wire.MsgTx, 0, 0)
-	ConvertTx := make([]*cross.ConvertTxTemp, 0, 0)
-	ConvertConfirmsTx := make([]*wire.MsgTx, 0, 0)
-
-	for _, tx := range block.Transactions() {
-
-		// Mortgage
-		if br, _ := cross.IsMortgageTx(tx.MsgTx(), b.chainParams); br != nil && MortgageTx == nil {
-			MortgageTx = tx.MsgTx()
-		}
-
-		// AddMortgage
-		if bp, _ := cross.IsAddMortgageTx(tx.MsgTx(), b.chainParams); bp != nil && MortgageTx == nil {
-			MortgageTx = tx.MsgTx()
-		}
-
-		// IsUpdateCoinbaseAllTx
-		if ubc, _ := cross.IsUpdateCoinbaseAllTx(tx.MsgTx(), b.chainParams); ubc != nil && MortgageTx == nil {
-			MortgageTx = tx.MsgTx()
-		}
-
-		// IsCastingTx
-		if ct, _ := cross.IsCastingTx(tx.MsgTx()); ct != nil {
-			CastingTx = append(CastingTx, tx.MsgTx())
-		}
-
-		// IsConvertTx
-		if cinfo, _ := cross.IsConvertTx(tx.MsgTx()); cinfo != nil {
-			convert := b.ConvertTx[tx.Hash().String()]
-			ctx := &cross.ConvertTxTemp{
-				Infos: convert.Infos,
-				Tx:    tx.MsgTx(),
-			}
-			ConvertTx = append(ConvertTx, ctx)
-		}
-
-		// IsConvertConfirmTx
-		if cinfo, _ := cross.IsConvertConfirmTx(tx.MsgTx()); cinfo != nil {
-			ConvertConfirmsTx = append(ConvertConfirmsTx, tx.MsgTx())
-		}
-	}
-
-	if MortgageTx != nil {
-		// Mortgage
-		if info, _ := cross.IsMortgageTx(MortgageTx, b.chainParams); info != nil {
-			cState.Mortgage(info.Address, info.ToAddress, info.PubKey, info.StakingAmount, info.CoinBaseAddress)
-			cState.PutNoCostUtxos(info.Address, wire.OutPoint{
-				Hash:  MortgageTx.TxHash(),
-				Index: 1,
-			},
-				MortgageTx.TxOut[1].PkScript,
-				MortgageTx.TxOut[1].Value,
-			)
-		}
-
-		// AddMortgage
-		if bp, _ := cross.IsAddMortgageTx(MortgageTx, b.chainParams); bp != nil {
-			cState.AddMortgage(bp.Address, bp.StakingAmount)
-			cState.PutNoCostUtxos(bp.Address, wire.OutPoint{
-				Hash:  MortgageTx.TxHash(),
-				Index: 1,
-			},
-				MortgageTx.TxOut[1].PkScript,
-				MortgageTx.TxOut[1].Value,
-			)
-		}
-
-		// UpdateCoinbaseAll
-		if bp, _ := cross.IsUpdateCoinbaseAllTx(MortgageTx, b.chainParams); bp != nil {
-			cState.UpdateCoinbaseAll(bp.Address, bp.CoinBaseAddress)
+	var eState *cross.EntangleState
+	if block.Height() == b.chainParams.UpgradeHeight(chaincfg.UpgradeMaui) {
+		eState = dbFetchEntangleState(dbTx, pHeight, pHash)
+	}
+
+	// The conversions of Convert transactions were verified against their
+	// external chains when the block was checked.
+	convertInfos := func(tx *czzutil.Tx) ([]*cross.ConvertTxInfo, error) {
+		convert, ok := b.ConvertTx[tx.Hash().String()]
+		if !ok {
+			return nil, fmt.Errorf("conversions of transaction %s "+
+				"were not verified", tx.Hash())
 		}
+		return convert.Infos, nil
 	}
-
-	for _, tx := range CastingTx {
-		if cinfo, _ := cross.IsCastingTx(tx); cinfo != nil {
-			cState.Casting(cinfo, tx.TxHash().String())
-			pool := cross.CoinPools[cinfo.ConvertType]
-			addr, _ := czzutil.NewAddressPubKeyHash(pool, b.chainParams)
-			cState.PutNoCostUtxos(addr.String(), wire.OutPoint{
-				Hash:  tx.TxHash(),
-				Index: 1,
-			},
-				tx.TxOut[1].PkScript,
-				tx.TxOut[1].Value,
-			)
-		}
-	}
-
-	for _, ctx := range ConvertTx {
-		if cinfo, _ := cross.IsConvertTx(ctx.Tx); cinfo != nil {
-			for _, info := range ctx.Infos {
-				fmt.Println("CommitteeState Convert ", info.ExtTxHash)
-				cState.Convert(info, ctx.Tx.TxHash().String())
-			}
-		}
-	}
-
-	for _, tx := range ConvertConfirmsTx {
-		if cinfo, _ := cross.IsConvertConfirmTx(tx); cinfo != nil {
-			cross.ConvertConfirms(cState, cinfo)
-		}
-	}
-
-	if err := cross.MakeCoinbaseTxUtxo(b.chainParams, block.Transactions()[0].MsgTx(), cState, len(ConvertTx) != 0); err != nil {
+	cState, err := ConnectCommitteeState(b.chainParams, cState, eState,
+		block, convertInfos)
+	if err != nil {
 		return err
 	}
 
@@ -661,33 +568,9 @@ func dbBeaconTx(params *chaincfg.Params, dbTx database.Tx, block *czzutil.Block)
 	pHash := block.MsgBlock().Header.PrevBlock
 	eState := dbFetchEntangleState(dbTx, pHeight, pHash)
 
-	if block.Height() == params.BeaconHeight {
-		eState = cross.NewEntangleState()
-	}
-
-	for _, tx := range block.Transactions() {
-		var err error
-		// BeaconRegistration
-		br, _ := cross.IsBeaconRegistrationTx(tx.MsgTx(), params)
-		if br != nil {
-			if eState != nil {
-				err = eState.RegisterBeaconAddress(br.Address, br.ToAddress, br.StakingAmount, br.Fee, br.KeepTime, br.AssetFlag, br.WhiteList, br.CoinBaseAddress)
-			}
-			if err != nil {
-				return err
-			}
-		}
-
-		// AddBeaconPledge
-		bp, _ := cross.IsAddBeaconPledgeTx(tx.MsgTx(), params)
-		if bp != nil {
-			if eState != nil {
-				err = eState.AppendAmountForBeaconAddress(bp.Address, bp.StakingAmount)
-			}
-			if err != nil {
-				return err
-			}
-		}
+	eState, err := ConnectEntangleState(params, eState, block)
+	if err != nil {
+		return err
 	}
 
 	if eState != nil {
@@ -1039,6 +922,37 @@ func DeserializeUtxoEntry(serialized []byte) (*UtxoEntry, error) {
 	return entry, nil
 }
 
+// serializeUtxoCommitmentFormat serializes the passed Utxo into the commitment
+// format, which is the format the Utxo set is downloaded in during fast sync.
+//
+// The serialized format is:
+//
+//   <txid><index><height and coinbase flag><amount><script len><script>
+//
+//   Field                       Type             Size
+//   txid                        chainhash.Hash   chainhash.HashSize
+//   index                       uint32           4 bytes
+//   height and coinbase flag    uint32           4 bytes
+//   amount                      uint64           8 bytes
+//   script len                  uint32           4 bytes
+//   script                      []byte           script len
+//
+// All integers are little endian and the coinbase flag is the least
+// significant bit of the last byte of the height.
+func serializeUtxoCommitmentFormat(outpoint wire.OutPoint, entry *UtxoEntry) []byte {
+	serialized := make([]byte, 52+len(entry.PkScript()))
+	copy(serialized[:32], outpoint.Hash[:])
+	binary.LittleEndian.PutUint32(serialized[32:36], outpoint.Index)
+	binary.LittleEndian.PutUint32(serialized[36:40], uint32(entry.BlockHeight()))
+	if entry.IsCoinBase() {
+		serialized[39] |= 0x01
+	}
+	binary.LittleEndian.PutUint64(serialized[40:48], uint64(entry.Amount()))
+	binary.LittleEndian.PutUint32(serialized[48:52], uint32(len(entry.PkScript())))
+	copy(serialized[52:], entry.PkScript())
+	return serialized
+}
+
 // deserializeUtxoCommitmentFormat takes a Utxo serialized in the commitment format and
 // deserializes it into an OutPoint and UtxoEntry.
 func deserializeUtxoCommitmentFormat(serialized []byte) (*wire.OutPoint, *UtxoEntry, error) {
@@ -1463,6 +1377,87 @@ func dbFetchUtxoStateConsistency(dbTx database.Tx) (byte, *chainhash.Hash, error
 	return deserializeUtxoStateConsistency(serialized)
 }
 
+// -----------------------------------------------------------------------------
+// The utxo set state is stored as the hash of the block it is the state at the
+// end of, the statistics of the utxo set and the point of its rolling ECMH
+// commitment.
+//
+// The serialized format is:
+//
+//   <block hash><num outputs><total amount><size><x><y>
+//
+//   Field             Type             Size
+//   block hash        chainhash.Hash   chainhash.HashSize
+//   num outputs       uint64           8 bytes
+//   total amount      uint64           8 bytes
+//   size              uint64           8 bytes
+//   x                 big.Int          32 bytes
+//   y                 big.Int          32 bytes
+// -----------------------------------------------------------------------------
+
+// utxoSetStateSize is the size of a serialized utxo set state.
+const utxoSetStateSize = chainhash.HashSize + 8 + 8 + 8 + 32 + 32
+
+// serializeUtxoSetState returns the serialization of the passed utxo set state.
+func serializeUtxoSetState(state *utxoSetState) []byte {
+	serialized := make([]byte, utxoSetStateSize)
+	copy(serialized[:chainhash.HashSize], state.hash[:])
+	offset := chainhash.HashSize
+	byteOrder.PutUint64(serialized[offset:], state.numOutputs)
+	offset += 8
+	byteOrder.PutUint64(serialized[offset:], state.totalAmount)
+	offset += 8
+	byteOrder.PutUint64(serialized[offset:], state.size)
+	offset += 8
+	x, y := state.multiset.Point()
+	x.FillBytes(serialized[offset : offset+32])
+	offset += 32
+	y.FillBytes(serialized[offset : offset+32])
+	return serialized
+}
+
+// deserializeUtxoSetState deserializes the passed serialized utxo set state.
+func deserializeUtxoSetState(serialized []byte) (*utxoSetState, error) {
+	if len(serialized) != utxoSetStateSize {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt utxo set state",
+		}
+	}
+
+	state := &utxoSetState{}
+	copy(state.hash[:], serialized[:chainhash.HashSize])
+	offset := chainhash.HashSize
+	state.numOutputs = byteOrder.Uint64(serialized[offset:])
+	offset += 8
+	state.totalAmount = byteOrder.Uint64(serialized[offset:])
+	offset += 8
+	state.size = byteOrder.Uint64(serialized[offset:])
+	offset += 8
+	x := new(big.Int).SetBytes(serialized[offset : offset+32])
+	offset += 32
+	y := new(big.Int).SetBytes(serialized[offset : offset+32])
+	state.multiset = czzec.NewMultisetFromPoint(czzec.S256(), x, y)
+	return state, nil
+}
+
+// dbPutUtxoSetState uses an existing database transaction to store the passed
+// utxo set state.
+func dbPutUtxoSetState(dbTx database.Tx, state *utxoSetState) error {
+	return dbTx.Metadata().Put(utxoSetStateKeyName, serializeUtxoSetState(state))
+}
+
+// dbFetchUtxoSetState uses an existing database transaction to retrieve the
+// utxo set state from the database.  The returned state is nil when none is
+// stored.
+func dbFetchUtxoSetState(dbTx database.Tx) (*utxoSetState, error) {
+	serialized := dbTx.Metadata().Get(utxoSetStateKeyName)
+	if serialized == nil {
+		return nil, nil
+	}
+	return deserializeUtxoSetState(serialized)
+}
+
 // dbPutBlockchainType uses an existing database transaction to
 // update the blockchain type entry with the provided type.
 func dbPutBlockchainType(dbTx database.Tx, chainType []byte) error {
@@ -1593,6 +1588,13 @@ func (b *BlockChain) createChainState() error {
 			return err
 		}
 
+		// Store the commitment to the empty utxo set so it doesn't need
+		// to be calculated on the first start.
+		err = dbPutUtxoSetState(dbTx, newUtxoSetState(node.hash))
+		if err != nil {
+			return err
+		}
+
 		// Store the genesis block into the database.
 		return dbStoreBlock(dbTx, genesisBlock)
 	})
@@ -1716,7 +1718,7 @@ func (b *BlockChain) initChainState(fastSync bool) error {
 		var block wire.MsgBlock
 		var blockBytes []byte
 		lastCheckpoint := b.LatestCheckpoint()
-		if !fastSync || (lastCheckpoint != nil && tip.height > lastCheckpoint.Height) {
+		if !b.headersOnly && (!fastSync || (lastCheckpoint != nil && tip.height > lastCheckpoint.Height)) {
 			blockBytes, err = dbTx.FetchBlock(&state.hash)
 			if err != nil {
 				return err
@@ -1900,6 +1902,156 @@ func (b *BlockChain) GetEstateByHashAndHeight(hash chainhash.Hash, height int32)
 	return eState
 }
 
+// FetchCommitteeState returns the committee state as of the passed block.
+// Unlike GetCstateByHashAndHeight, an error is returned when no state is
+// stored for the block, such as for blocks before the Maui fork.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchCommitteeState(hash *chainhash.Hash, height int32) (*cross.CommitteeState, error) {
+	var cState *cross.CommitteeState
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		cState, err = DBFetchCommitteeState(dbTx, hash, height)
+		return err
+	})
+	return cState, err
+}
+
+// FetchEntangleState returns the entangle state as of the passed block.
+// Unlike GetEstateByHashAndHeight, an error is returned when no state is
+// stored for the block, such as for blocks outside of the range from the
+// beacon fork up to the Maui fork.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchEntangleState(hash *chainhash.Hash, height int32) (*cross.EntangleState, error) {
+	var eState *cross.EntangleState
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		eState, err = DBFetchEntangleState(dbTx, hash, height)
+		return err
+	})
+	return eState, err
+}
+
+// DBFetchCommitteeState uses an existing database transaction to retrieve the
+// committee state as of the passed block.  An error is returned when no state
+// is stored for the block.
+func DBFetchCommitteeState(dbTx database.Tx, hash *chainhash.Hash, height int32) (*cross.CommitteeState, error) {
+	cState := dbFetchCommitteeState(dbTx, height, *hash)
+	if cState == nil {
+		return nil, fmt.Errorf("no committee state for block %s "+
+			"at height %d", hash, height)
+	}
+	return cState, nil
+}
+
+// DBFetchEntangleState uses an existing database transaction to retrieve the
+// entangle state as of the passed block.  An error is returned when no state
+// is stored for the block.  Unlike dbFetchEntangleState, it never creates the
+// entangle state bucket, so it is safe for read-only transactions.
+func DBFetchEntangleState(dbTx database.Tx, hash *chainhash.Hash, height int32) (*cross.EntangleState, error) {
+	var eState *cross.EntangleState
+	if dbTx.Metadata().Bucket(cross.EntangleStateKey) != nil {
+		eState = dbFetchEntangleState(dbTx, height, *hash)
+	}
+	if eState == nil {
+		return nil, fmt.Errorf("no entangle state for block %s "+
+			"at height %d", hash, height)
+	}
+	return eState, nil
+}
+
+// DBFetchBestHeight uses an existing database transaction to retrieve the
+// height of the best block of the main chain.
+func DBFetchBestHeight(dbTx database.Tx) (int32, error) {
+	serializedData := dbTx.Metadata().Get(chainStateKeyName)
+	if serializedData == nil {
+		return 0, fmt.Errorf("the database has no chain state")
+	}
+	state, err := deserializeBestChainState(serializedData)
+	if err != nil {
+		return 0, err
+	}
+	return int32(state.height), nil
+}
+
+// DBFetchBlockByHeight uses an existing database transaction to retrieve the
+// block of the main chain at the passed height with the height set.
+func DBFetchBlockByHeight(dbTx database.Tx, height int32) (*czzutil.Block, error) {
+	hash, err := dbFetchHashByHeight(dbTx, height)
+	if err != nil {
+		return nil, err
+	}
+	blockBytes, err := dbTx.FetchBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := czzutil.NewBlockFromBytes(blockBytes)
+	if err != nil {
+		return nil, err
+	}
+	block.SetHeight(height)
+	return block, nil
+}
+
+// FetchStakingState returns the stakes the targets of blocks extending the
+// passed block are boosted with by cross.ComputeDiff.  The stakes come from
+// the entangle state between the beacon and Maui forks and from the pledges
+// of the committee state after.  Nil is returned for blocks before the beacon
+// fork, whose children are not boosted.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) FetchStakingState(hash *chainhash.Hash, height int32) (*cross.EntangleState, error) {
+	switch {
+	case b.chainParams.IsActive(chaincfg.UpgradeMaui, height):
+		cState, err := b.FetchCommitteeState(hash, height)
+		if err != nil {
+			return nil, err
+		}
+		return cState.StakingState(), nil
+
+	case b.chainParams.IsActive(chaincfg.UpgradeBeacon, height):
+		return b.FetchEntangleState(hash, height)
+	}
+	return nil, nil
+}
+
+// CommitteeEvents returns the changes the passed block made to the committee
+// state of its parent.  The state of every processed block is kept, so the
+// events can also be computed for blocks which were disconnected from the main
+// chain.  No events are returned for blocks before the Maui fork.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) CommitteeEvents(block *czzutil.Block) ([]*cross.CommitteeEvent, error) {
+	height := block.Height()
+	if !b.chainParams.IsActive(chaincfg.UpgradeMaui, height) {
+		return nil, nil
+	}
+
+	var prev, cur *cross.CommitteeState
+	err := b.db.View(func(dbTx database.Tx) error {
+		cur = dbFetchCommitteeState(dbTx, height, *block.Hash())
+		if b.chainParams.IsActive(chaincfg.UpgradeMaui, height-1) {
+			prev = dbFetchCommitteeState(dbTx, height-1,
+				block.MsgBlock().Header.PrevBlock)
+			if prev == nil {
+				return fmt.Errorf("no committee state for block %s "+
+					"at height %d", block.MsgBlock().Header.PrevBlock,
+					height-1)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cur == nil {
+		return nil, fmt.Errorf("no committee state for block %s "+
+			"at height %d", block.Hash(), height)
+	}
+	return cross.DiffCommitteeState(prev, cur), nil
+}
+
 // BlockByHeight returns the block at the given height in the main chain.
 //
 // This function is safe for concurrent access.
@@ -0,0 +1,131 @@
+package blockchain
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+)
+
+// ChainTipStatus describes the state of the branch ending at a chain tip.
+type ChainTipStatus int
+
+const (
+	// ChainTipActive indicates the tip is the tip of the main chain.
+	ChainTipActive ChainTipStatus = iota
+
+	// ChainTipValidFork indicates the branch is fully validated but is not
+	// part of the main chain.
+	ChainTipValidFork
+
+	// ChainTipValidHeaders indicates all blocks of the branch are available
+	// but they have never been fully validated.
+	ChainTipValidHeaders
+
+	// ChainTipHeadersOnly indicates some blocks of the branch are not
+	// available, only their headers are known.
+	ChainTipHeadersOnly
+
+	// ChainTipInvalid indicates the branch holds at least one invalid block.
+	ChainTipInvalid
+)
+
+// chainTipStatusStrings is a map of chain tip statuses back to their constant
+// names for pretty printing.
+var chainTipStatusStrings = map[ChainTipStatus]string{
+	ChainTipActive:       "active",
+	ChainTipValidFork:    "valid-fork",
+	ChainTipValidHeaders: "valid-headers",
+	ChainTipHeadersOnly:  "headers-only",
+	ChainTipInvalid:      "invalid",
+}
+
+// String returns the ChainTipStatus as the string used by the getchaintips
+// RPC.
+func (s ChainTipStatus) String() string {
+	if str, ok := chainTipStatusStrings[s]; ok {
+		return str
+	}
+	return "unknown"
+}
+
+// ChainTip describes a block of the block index which has no children, or the
+// tip of the main chain.
+type ChainTip struct {
+	Height int32
+	Hash   chainhash.Hash
+
+	// BranchLen is the number of blocks between the tip and the main chain,
+	// which is zero for the tip of the main chain.
+	BranchLen int32
+
+	Status ChainTipStatus
+}
+
+// ChainTips returns the tips of all branches of the block tree, sorted by
+// descending height.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ChainTips() []ChainTip {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	tip := b.bestChain.Tip()
+
+	b.index.RLock()
+	hasChildren := make(map[*blockNode]struct{})
+	for _, node := range b.index.index {
+		if node.parent != nil {
+			hasChildren[node.parent] = struct{}{}
+		}
+	}
+
+	var tips []ChainTip
+	for _, node := range b.index.index {
+		if _, ok := hasChildren[node]; ok && node != tip {
+			continue
+		}
+
+		fork := b.bestChain.FindFork(node)
+		chainTip := ChainTip{
+			Height:    node.height,
+			Hash:      node.hash,
+			BranchLen: node.height - fork.height,
+		}
+		switch {
+		case node == tip:
+			chainTip.Status = ChainTipActive
+		case node.status.KnownInvalid():
+			chainTip.Status = ChainTipInvalid
+		case !branchHasData(node, fork):
+			chainTip.Status = ChainTipHeadersOnly
+		case node.status.KnownValid():
+			chainTip.Status = ChainTipValidFork
+		default:
+			chainTip.Status = ChainTipValidHeaders
+		}
+		tips = append(tips, chainTip)
+	}
+	b.index.RUnlock()
+
+	sort.Slice(tips, func(i, j int) bool {
+		if tips[i].Height != tips[j].Height {
+			return tips[i].Height > tips[j].Height
+		}
+		return bytes.Compare(tips[i].Hash[:], tips[j].Hash[:]) < 0
+	})
+	return tips
+}
+
+// branchHasData returns whether or not the data of all blocks from the passed
+// node back to, but excluding, the fork node is stored.
+//
+// This function MUST be called with the block index lock held (for reads).
+func branchHasData(node, fork *blockNode) bool {
+	for n := node; n != nil && n != fork; n = n.parent {
+		if !n.status.HaveData() {
+			return false
+		}
+	}
+	return true
+}
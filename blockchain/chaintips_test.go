@@ -0,0 +1,157 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+)
+
+// fakeChainParams returns a copy of the main network parameters with the
+// retarget parameters newFakeChain divides by set.
+func fakeChainParams() *chaincfg.Params {
+	params := chaincfg.MainNetParams
+	params.TargetTimespan = time.Hour * 24 * 14
+	params.TargetTimePerBlock = time.Second * 30
+	params.RetargetAdjustmentFactor = 4
+	return &params
+}
+
+// setWork gives each of the passed chained nodes one unit of work more than
+// its parent, since the nodes created by chainedNodes have none.
+func setWork(nodes []*blockNode) {
+	for _, node := range nodes {
+		node.workSum = new(big.Int).Add(node.parent.workSum, big.NewInt(1))
+	}
+}
+
+// TestChainTips ensures the tips of competing branches are reported with the
+// length and status of their branch.
+func TestChainTips(t *testing.T) {
+	// Construct a synthetic block chain with a block index consisting of
+	// the following structure.
+	// 	genesis -> 1 -> 2 -> ... -> 8 -> 9 -> 10
+	// 	                   |         |    \-> 10a (invalid)
+	// 	                   |         \-> 9b (unvalidated)
+	// 	                   \-> 6c -> 7c (header only)
+	// 	                   \-> 6d -> 7d -> 8d -> 9d (reorganized out)
+	tip := tstTip
+	chain := newFakeChain(fakeChainParams())
+	mainNodes := chainedNodes(chain.bestChain.Genesis(), 10)
+	invalidNodes := chainedNodes(mainNodes[8], 1)
+	unvalidatedNodes := chainedNodes(mainNodes[7], 1)
+	headerNodes := chainedNodes(mainNodes[4], 2)
+	staleNodes := chainedNodes(mainNodes[4], 4)
+	for _, node := range append(mainNodes, staleNodes...) {
+		chain.index.SetStatusFlags(node, statusDataStored|statusValid)
+		chain.index.AddNode(node)
+	}
+	chain.index.SetStatusFlags(invalidNodes[0], statusDataStored|statusValidateFailed)
+	chain.index.AddNode(invalidNodes[0])
+	chain.index.SetStatusFlags(unvalidatedNodes[0], statusDataStored)
+	chain.index.AddNode(unvalidatedNodes[0])
+	chain.index.SetStatusFlags(headerNodes[0], statusDataStored)
+	chain.index.AddNode(headerNodes[0])
+	chain.index.AddNode(headerNodes[1])
+	chain.bestChain.SetTip(tip(mainNodes))
+
+	want := map[chainhash.Hash]ChainTip{
+		tip(mainNodes).hash: {
+			Height: 10, Hash: tip(mainNodes).hash, BranchLen: 0,
+			Status: ChainTipActive,
+		},
+		invalidNodes[0].hash: {
+			Height: 10, Hash: invalidNodes[0].hash, BranchLen: 1,
+			Status: ChainTipInvalid,
+		},
+		unvalidatedNodes[0].hash: {
+			Height: 9, Hash: unvalidatedNodes[0].hash, BranchLen: 1,
+			Status: ChainTipValidHeaders,
+		},
+		tip(headerNodes).hash: {
+			Height: 7, Hash: tip(headerNodes).hash, BranchLen: 2,
+			Status: ChainTipHeadersOnly,
+		},
+		tip(staleNodes).hash: {
+			Height: 9, Hash: tip(staleNodes).hash, BranchLen: 4,
+			Status: ChainTipValidFork,
+		},
+	}
+
+	tips := chain.ChainTips()
+	if len(tips) != len(want) {
+		t.Fatalf("got %d tips, want %d", len(tips), len(want))
+	}
+	for i, chainTip := range tips {
+		if i > 0 && chainTip.Height > tips[i-1].Height {
+			t.Fatalf("tips are not sorted by height: %v", tips)
+		}
+		if chainTip != want[chainTip.Hash] {
+			t.Errorf("unexpected tip %+v, want %+v", chainTip,
+				want[chainTip.Hash])
+		}
+	}
+
+	// The tip of the main chain is reported even when it has children.
+	chain.bestChain.SetTip(mainNodes[8])
+	tips = chain.ChainTips()
+	if tips[0].Hash != tip(mainNodes).hash || tips[0].Status != ChainTipValidFork {
+		t.Fatalf("unexpected highest tip %+v", tips[0])
+	}
+	var found bool
+	for _, chainTip := range tips {
+		if chainTip.Hash == mainNodes[8].hash {
+			found = chainTip.Status == ChainTipActive
+		}
+	}
+	if !found {
+		t.Fatal("active tip with children not reported")
+	}
+}
+
+// TestPreciousBlock ensures blocks which can not become the tip of the main
+// chain are left alone.
+func TestPreciousBlock(t *testing.T) {
+	// Construct a synthetic block chain with a block index consisting of
+	// the following structure.
+	// 	genesis -> 1 -> 2 -> 3 -> 4
+	// 	                   \-> 3a -> 4a (invalid)
+	tip := tstTip
+	chain := newFakeChain(fakeChainParams())
+	mainNodes := chainedNodes(chain.bestChain.Genesis(), 4)
+	forkNodes := chainedNodes(mainNodes[1], 2)
+	setWork(mainNodes)
+	setWork(forkNodes)
+	for _, node := range mainNodes {
+		chain.index.SetStatusFlags(node, statusDataStored|statusValid)
+		chain.index.AddNode(node)
+	}
+	chain.index.SetStatusFlags(forkNodes[0], statusDataStored|statusValid)
+	chain.index.AddNode(forkNodes[0])
+	chain.index.SetStatusFlags(forkNodes[1], statusDataStored|statusValidateFailed)
+	chain.index.AddNode(forkNodes[1])
+	chain.bestChain.SetTip(tip(mainNodes))
+
+	tests := []struct {
+		name    string
+		hash    chainhash.Hash
+		wantErr bool
+	}{
+		{name: "main chain block", hash: mainNodes[2].hash},
+		{name: "fork with less work", hash: forkNodes[0].hash},
+		{name: "invalid fork", hash: forkNodes[1].hash, wantErr: true},
+		{name: "unknown block", hash: chainhash.Hash{0x01}, wantErr: true},
+	}
+	for _, test := range tests {
+		err := chain.PreciousBlock(&test.hash)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+		if chain.bestChain.Tip() != tip(mainNodes) {
+			t.Fatalf("%s: tip changed to %v", test.name,
+				chain.bestChain.Tip().hash)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// ConvertInfosFunc returns the conversions of the passed Convert transaction
+// as verified against their external chains, in the order they are added to
+// the committee state.
+type ConvertInfosFunc func(tx *czzutil.Tx) ([]*cross.ConvertTxInfo, error)
+
+// ConnectCommitteeState returns the committee state after the passed block
+// given the state after its parent.  At the Maui upgrade the state is created
+// from the pledges of the passed entangle state of the parent instead.  The
+// parent state is modified, so callers must pass a copy they own.
+//
+// The conversions of Convert transactions are looked up with convertInfos,
+// which lets tools replaying the chain stub out their external verification.
+func ConnectCommitteeState(params *chaincfg.Params, cState *cross.CommitteeState,
+	eState *cross.EntangleState, block *czzutil.Block,
+	convertInfos ConvertInfosFunc) (*cross.CommitteeState, error) {
+
+	if block.Height() == params.UpgradeHeight(chaincfg.UpgradeMaui) {
+		if eState == nil {
+			return nil, fmt.Errorf("no entangle state before the Maui "+
+				"upgrade at block %s", block.Hash())
+		}
+		cState = cross.NewCommitteeState()
+		for _, v := range eState.EnInfos {
+			pi := &cross.PledgeInfo{
+				ID:              big.NewInt(int64(v.ExchangeID)),
+				Address:         v.Address,
+				ToAddress:       v.ToAddress,
+				StakingAmount:   v.StakingAmount,
+				CoinBaseAddress: v.CoinBaseAddress,
+			}
+			cState.PledgeInfos = append(cState.PledgeInfos, pi)
+		}
+	}
+	if cState == nil {
+		return nil, fmt.Errorf("no committee state before block %s",
+			block.Hash())
+	}
+	cState.RotateCommittee(params, block.Height())
+	cState.InitPools(params, block.Height())
+
+	var MortgageTx *wire.MsgTx
+	CastingTx := make([]*wire.MsgTx, 0, 0)
+	ConvertTx := make([]*cross.ConvertTxTemp, 0, 0)
+	ConvertConfirmsTx := make([]*wire.MsgTx, 0, 0)
+	PoolKeyRotations := make([]*cross.PoolKeyRotationTxInfo, 0, 0)
+
+	for _, tx := range block.Transactions() {
+
+		// Mortgage
+		if br, _ := cross.IsMortgageTx(tx.MsgTx(), params); br != nil && MortgageTx == nil {
+			MortgageTx = tx.MsgTx()
+		}
+
+		// AddMortgage
+		if bp, _ := cross.IsAddMortgageTx(tx.MsgTx(), params); bp != nil && MortgageTx == nil {
+			MortgageTx = tx.MsgTx()
+		}
+
+		// IsUpdateCoinbaseAllTx
+		if ubc, _ := cross.IsUpdateCoinbaseAllTx(tx.MsgTx(), params); ubc != nil && MortgageTx == nil {
+			MortgageTx = tx.MsgTx()
+		}
+
+		// IsCastingTx
+		if ct, _ := cross.IsCastingTx(tx.MsgTx()); ct != nil {
+			CastingTx = append(CastingTx, tx.MsgTx())
+		}
+
+		// IsConvertTx
+		if cinfo, _ := cross.IsConvertTx(tx.MsgTx()); cinfo != nil {
+			infos, err := convertInfos(tx)
+			if err != nil {
+				return nil, err
+			}
+			ctx := &cross.ConvertTxTemp{
+				Infos: infos,
+				Tx:    tx.MsgTx(),
+			}
+			ConvertTx = append(ConvertTx, ctx)
+		}
+
+		// IsConvertConfirmTx
+		if cinfo, _ := cross.IsConvertConfirmTx(tx.MsgTx()); cinfo != nil {
+			ConvertConfirmsTx = append(ConvertConfirmsTx, tx.MsgTx())
+		}
+
+		// PoolKeyRotation
+		if info, _ := cross.IsPoolKeyRotationTx(tx.MsgTx()); info != nil {
+			PoolKeyRotations = append(PoolKeyRotations, info)
+		}
+	}
+
+	if MortgageTx != nil {
+		// Mortgage
+		if info, _ := cross.IsMortgageTx(MortgageTx, params); info != nil {
+			cState.Mortgage(info.Address, info.ToAddress, info.PubKey, info.StakingAmount, info.CoinBaseAddress)
+			cState.PutNoCostUtxos(info.Address, wire.OutPoint{
+				Hash:  MortgageTx.TxHash(),
+				Index: 1,
+			},
+				MortgageTx.TxOut[1].PkScript,
+				MortgageTx.TxOut[1].Value,
+			)
+		}
+
+		// AddMortgage
+		if bp, _ := cross.IsAddMortgageTx(MortgageTx, params); bp != nil {
+			cState.AddMortgage(bp.Address, bp.StakingAmount)
+			cState.PutNoCostUtxos(bp.Address, wire.OutPoint{
+				Hash:  MortgageTx.TxHash(),
+				Index: 1,
+			},
+				MortgageTx.TxOut[1].PkScript,
+				MortgageTx.TxOut[1].Value,
+			)
+		}
+
+		// UpdateCoinbaseAll
+		if bp, _ := cross.IsUpdateCoinbaseAllTx(MortgageTx, params); bp != nil {
+			cState.UpdateCoinbaseAll(bp.Address, bp.CoinBaseAddress)
+		}
+	}
+
+	for _, tx := range CastingTx {
+		if cinfo, _ := cross.IsCastingTx(tx); cinfo != nil {
+			cState.Casting(cinfo, tx.TxHash().String())
+			pool := cross.CoinPools[cinfo.ConvertType]
+			addr, _ := czzutil.NewAddressPubKeyHash(pool, params)
+			cState.PutNoCostUtxos(addr.String(), wire.OutPoint{
+				Hash:  tx.TxHash(),
+				Index: 1,
+			},
+				tx.TxOut[1].PkScript,
+				tx.TxOut[1].Value,
+			)
+		}
+	}
+
+	for _, ctx := range ConvertTx {
+		for _, info := range ctx.Infos {
+			cState.Convert(info, ctx.Tx.TxHash().String())
+		}
+	}
+
+	for _, tx := range ConvertConfirmsTx {
+		if cinfo, _ := cross.IsConvertConfirmTx(tx); cinfo != nil {
+			cross.ConvertConfirms(cState, cinfo)
+		}
+	}
+
+	for _, info := range PoolKeyRotations {
+		cState.RotatePoolKeys(info)
+	}
+
+	if err := cross.MakeCoinbaseTxUtxo(params, block.Transactions()[0].MsgTx(), cState, len(ConvertTx) != 0); err != nil {
+		return nil, err
+	}
+	return cState, nil
+}
+
+// ConnectEntangleState returns the entangle state after the passed block given
+// the state after its parent, which is modified.  The state starts out empty
+// at the beacon upgrade and nil is returned while there is none.
+func ConnectEntangleState(params *chaincfg.Params, eState *cross.EntangleState,
+	block *czzutil.Block) (*cross.EntangleState, error) {
+
+	if block.Height() == params.UpgradeHeight(chaincfg.UpgradeBeacon) {
+		eState = cross.NewEntangleState()
+	}
+	if eState == nil {
+		return nil, nil
+	}
+
+	for _, tx := range block.Transactions() {
+		// BeaconRegistration
+		br, _ := cross.IsBeaconRegistrationTx(tx.MsgTx(), params)
+		if br != nil {
+			err := eState.RegisterBeaconAddress(br.Address, br.ToAddress, br.StakingAmount, br.Fee, br.KeepTime, br.AssetFlag, br.WhiteList, br.CoinBaseAddress)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// AddBeaconPledge
+		bp, _ := cross.IsAddBeaconPledgeTx(tx.MsgTx(), params)
+		if bp != nil {
+			err := eState.AppendAmountForBeaconAddress(bp.Address, bp.StakingAmount)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return eState, nil
+}
@@ -0,0 +1,148 @@
+package blockchain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// testCrossBlock returns a block at the passed height with a coinbase and the
+// passed transactions.
+func testCrossBlock(height int32, txs ...*wire.MsgTx) *czzutil.Block {
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		[]byte{byte(height)}))
+	coinbase.AddTxOut(wire.NewTxOut(1, []byte{txscript.OP_TRUE}))
+	msgBlock := wire.NewMsgBlock(&wire.BlockHeader{})
+	msgBlock.AddTransaction(coinbase)
+	for _, tx := range txs {
+		msgBlock.AddTransaction(tx)
+	}
+	block := czzutil.NewBlock(msgBlock)
+	block.SetHeight(height)
+	return block
+}
+
+// TestConnectCommitteeState ensures the committee state is created from the
+// entangle state at the Maui upgrade and takes the conversions of Convert
+// transactions from the passed lookup.
+func TestConnectCommitteeState(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	maui := params.UpgradeHeight(chaincfg.UpgradeMaui)
+
+	eState := cross.NewEntangleState()
+	eState.EnInfos["beacon"] = &cross.BeaconAddressInfo{
+		ExchangeID:    7,
+		Address:       "beacon",
+		StakingAmount: big.NewInt(1000),
+	}
+	noConverts := func(tx *czzutil.Tx) ([]*cross.ConvertTxInfo, error) {
+		t.Fatalf("conversions of %s looked up", tx.Hash())
+		return nil, nil
+	}
+	cState, err := ConnectCommitteeState(params, nil, eState,
+		testCrossBlock(maui), noConverts)
+	if err != nil {
+		t.Fatalf("ConnectCommitteeState: %v", err)
+	}
+	if len(cState.PledgeInfos) != 1 || cState.PledgeInfos[0].ID.Int64() != 7 {
+		t.Fatalf("pledges %v not taken from the entangle state",
+			cState.PledgeInfos)
+	}
+	if _, err := ConnectCommitteeState(params, nil, nil,
+		testCrossBlock(maui+1), noConverts); err == nil {
+		t.Error("block connected without a parent state")
+	}
+
+	// The conversions of a Convert transaction are added in the order of
+	// the lookup.
+	data, err := rlp.EncodeToBytes(&cross.ConvertTxInfo{
+		AssetType:   cross.ExpandedTxConvert_ECzz,
+		ConvertType: cross.ExpandedTxConvert_Czz,
+		ExtTxHash:   "ext",
+		Amount:      big.NewInt(100),
+		FeeAmount:   big.NewInt(0),
+	})
+	if err != nil {
+		t.Fatalf("EncodeToBytes: %v", err)
+	}
+	script, err := txscript.ConvertScript(data)
+	if err != nil {
+		t.Fatalf("ConvertScript: %v", err)
+	}
+	convertTx := wire.NewMsgTx(wire.TxVersion)
+	convertTx.AddTxOut(wire.NewTxOut(0, script))
+	block := testCrossBlock(maui+1, convertTx)
+
+	verified := []*cross.ConvertTxInfo{
+		{
+			AssetType:   cross.ExpandedTxConvert_ECzz,
+			ConvertType: cross.ExpandedTxConvert_Czz,
+			ExtTxHash:   "ext-a",
+			Amount:      big.NewInt(60),
+			FeeAmount:   big.NewInt(1),
+		},
+		{
+			AssetType:   cross.ExpandedTxConvert_ECzz,
+			ConvertType: cross.ExpandedTxConvert_Czz,
+			ExtTxHash:   "ext-b",
+			Amount:      big.NewInt(40),
+			FeeAmount:   big.NewInt(1),
+		},
+	}
+	converts := func(tx *czzutil.Tx) ([]*cross.ConvertTxInfo, error) {
+		if tx.MsgTx().TxHash() != convertTx.TxHash() {
+			t.Fatalf("conversions of %s looked up", tx.Hash())
+		}
+		return verified, nil
+	}
+	cState, err = ConnectCommitteeState(params, cState, nil, block, converts)
+	if err != nil {
+		t.Fatalf("ConnectCommitteeState: %v", err)
+	}
+	items := cState.ConvertConfirmItems[cross.ExpandedTxConvert_ECzz][cross.ExpandedTxConvert_Czz]
+	if len(items) != 2 || items[0].ExtTxHash != "ext-a" ||
+		items[1].ExtTxHash != "ext-b" || cState.MaxItemID.Int64() != 2 {
+
+		t.Fatalf("unexpected convert items %v", items)
+	}
+	if items[0].TxHash != convertTx.TxHash().String() {
+		t.Errorf("item of transaction %s, want %s", items[0].TxHash,
+			convertTx.TxHash())
+	}
+
+	errLookup := errors.New("not verified")
+	failing := func(*czzutil.Tx) ([]*cross.ConvertTxInfo, error) {
+		return nil, errLookup
+	}
+	if _, err := ConnectCommitteeState(params, cState, nil, block,
+		failing); err != errLookup {
+
+		t.Errorf("got error %v, want %v", err, errLookup)
+	}
+}
+
+// TestConnectEntangleState ensures the entangle state starts empty at the
+// beacon upgrade and is not created before.
+func TestConnectEntangleState(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	beacon := params.UpgradeHeight(chaincfg.UpgradeBeacon)
+
+	eState, err := ConnectEntangleState(params, nil, testCrossBlock(beacon-1))
+	if err != nil || eState != nil {
+		t.Fatalf("state %v and error %v before the beacon upgrade",
+			eState, err)
+	}
+	eState, err = ConnectEntangleState(params, nil, testCrossBlock(beacon))
+	if err != nil || eState == nil || len(eState.EnInfos) != 0 {
+		t.Fatalf("state %v and error %v at the beacon upgrade", eState,
+			err)
+	}
+}
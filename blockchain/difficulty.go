@@ -8,7 +8,9 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
 )
 
 var (
@@ -23,12 +25,12 @@ var (
 	// oneLsh256 is 1 shifted left 256 bits.  It is defined here to avoid
 	// the overhead of creating it multiple times.
 	oneLsh256 = new(big.Int).Lsh(bigOne, 256)
-
-	DifficultyBoundDivisor = big.NewInt(128)
-	//DifficultyBoundDivisor = big.NewInt(1024) // The bound divisor of the difficulty, used in the update calculations.
-
 )
 
+// difficultyBoundDivisor is the bound divisor of the difficulty, used in the
+// update calculations of the legacy algorithm.
+const difficultyBoundDivisor = 128
+
 // DifficultyAdjustmentWindow is the size of the window used by the DAA adjustment
 // algorithm when calculating the current difficulty. The algorithm requires fetching
 // a 'suitable' block out of blocks n-144, n-145, and n-146. We set this value equal
@@ -47,11 +49,18 @@ type DifficultyAlgorithm uint32
 const (
 	// DifficultyLegacy was in effect from genesis through August 1st, 2017.
 	DifficultyLegacy DifficultyAlgorithm = 0
+
+	// DifficultyAsert adjusts the difficulty towards an absolute schedule
+	// from the UpgradeAsert activation height on.  See CalcAsertTarget.
+	DifficultyAsert DifficultyAlgorithm = 1
 )
 
 // SelectDifficultyAdjustmentAlgorithm returns the difficulty adjustment algorithm that
 // should be used when validating a block at the given height.
 func (b *BlockChain) SelectDifficultyAdjustmentAlgorithm(height int32) DifficultyAlgorithm {
+	if b.chainParams.IsActive(chaincfg.UpgradeAsert, height) {
+		return DifficultyAsert
+	}
 	return DifficultyLegacy
 }
 
@@ -239,7 +248,13 @@ func (b *BlockChain) getSuitableBlock(node0 *blockNode) (*blockNode, error) {
 // the exported version uses the current best chain as the previous block node
 // while this function accepts any block node.
 func (b *BlockChain) calcNextRequiredDifficulty(lastNode *blockNode, newBlockTime time.Time) (uint32, error) {
+	return b.nextRequiredDifficulty(lastNode, newBlockTime,
+		difficultyBoundDivisor)
+}
 
+// nextRequiredDifficulty is calcNextRequiredDifficulty with the bound divisor
+// of the legacy algorithm passed.
+func (b *BlockChain) nextRequiredDifficulty(lastNode *blockNode, newBlockTime time.Time, boundDivisor int64) (uint32, error) {
 	// Genesis block.
 	if lastNode == nil {
 		return b.chainParams.PowLimitBits, nil
@@ -250,6 +265,20 @@ func (b *BlockChain) calcNextRequiredDifficulty(lastNode *blockNode, newBlockTim
 		return lastNode.bits, nil
 	}
 
+	switch b.SelectDifficultyAdjustmentAlgorithm(lastNode.height + 1) {
+	case DifficultyAsert:
+		return b.calcAsertRequiredDifficulty(lastNode)
+	default:
+		return b.calcLegacyRequiredDifficulty(lastNode, newBlockTime,
+			boundDivisor)
+	}
+}
+
+// calcLegacyRequiredDifficulty calculates the required difficulty for the
+// block after the passed previous block node by adjusting the difficulty of
+// the previous block by up to 1/boundDivisor depending on its distance to the
+// new block.
+func (b *BlockChain) calcLegacyRequiredDifficulty(lastNode *blockNode, newBlockTime time.Time, boundDivisor int64) (uint32, error) {
 	bigTime := new(big.Int).SetInt64(newBlockTime.Unix())
 	bigParentTime := new(big.Int).SetInt64(lastNode.timestamp)
 
@@ -276,7 +305,7 @@ func (b *BlockChain) calcNextRequiredDifficulty(lastNode *blockNode, newBlockTim
 
 	// parent_diff + (parent_diff * max( 1 - ((timestamp - parent.timestamp) // 30), -99) // 1024 )
 	y.Mul(difficulty, x)
-	x.Div(y, DifficultyBoundDivisor)
+	x.Div(y, big.NewInt(boundDivisor))
 	newDifficulty := new(big.Int).Add(difficulty, x)
 	//log.Info("Difficulty ", "number", lastNode.height, "difficulty", lastNode.workSum, "newDifficulty", newDifficulty)
 
@@ -303,3 +332,50 @@ func (b *BlockChain) CalcNextRequiredDifficulty(timestamp time.Time) (uint32, er
 	b.chainLock.Unlock()
 	return difficulty, err
 }
+
+// DifficultyCalculator calculates the required difficulty of blocks extending
+// a chain of headers which is not backed by a database, such as a simulated
+// chain, with the same code the block chain validates blocks with.
+//
+// The chain may start at any height.  When it starts after the anchor of the
+// ASERT algorithm, the AsertAnchor field of the parameters must be set.
+type DifficultyCalculator struct {
+	chain        *BlockChain
+	boundDivisor int64
+}
+
+// NewDifficultyCalculator returns a difficulty calculator for a chain starting
+// with the passed header at the passed height.  A non-zero bound divisor
+// replaces the one of the legacy algorithm, which only simulations do.
+func NewDifficultyCalculator(params *chaincfg.Params, header *wire.BlockHeader, height int32, boundDivisor int64) *DifficultyCalculator {
+	node := newBlockNode(header, nil)
+	node.height = height
+	if boundDivisor == 0 {
+		boundDivisor = difficultyBoundDivisor
+	}
+	return &DifficultyCalculator{
+		chain: &BlockChain{
+			chainParams: params,
+			bestChain:   newChainView(node),
+		},
+		boundDivisor: boundDivisor,
+	}
+}
+
+// AddHeader extends the chain with the passed header.  The header isn't
+// validated, so its difficulty may differ from the required one.
+func (c *DifficultyCalculator) AddHeader(header *wire.BlockHeader) {
+	c.chain.bestChain.SetTip(newBlockNode(header, c.chain.bestChain.Tip()))
+}
+
+// Height returns the height of the last header of the chain.
+func (c *DifficultyCalculator) Height() int32 {
+	return c.chain.bestChain.Height()
+}
+
+// NextRequiredDifficulty calculates the required difficulty for the block
+// after the last header of the chain with the passed timestamp.
+func (c *DifficultyCalculator) NextRequiredDifficulty(timestamp time.Time) (uint32, error) {
+	return c.chain.nextRequiredDifficulty(c.chain.bestChain.Tip(), timestamp,
+		c.boundDivisor)
+}
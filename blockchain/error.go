@@ -223,6 +223,10 @@ const (
 	// ErrInvalidTxOrder indicates the order of the transactions in the block
 	// does not follow the active transaction ordering consensus rule.
 	ErrInvalidTxOrder
+
+	// ErrCommitteeApproval indicates a ConvertConfirm transaction is not
+	// signed by enough members of the committee serving at its height.
+	ErrCommitteeApproval
 )
 
 // Map of ErrorCode values back to their constant names for pretty printing.
@@ -270,6 +274,7 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrInvalidAncestorBlock:  "ErrInvalidAncestorBlock",
 	ErrPrevBlockNotBest:      "ErrPrevBlockNotBest",
 	ErrInvalidTxOrder:        "ErrInvalidTxOrder",
+	ErrCommitteeApproval:     "ErrCommitteeApproval",
 }
 
 // String returns the ErrorCode as a human-readable name.
@@ -55,6 +55,7 @@ func TestErrorCodeStringer(t *testing.T) {
 		{ErrPreviousBlockUnknown, "ErrPreviousBlockUnknown"},
 		{ErrInvalidAncestorBlock, "ErrInvalidAncestorBlock"},
 		{ErrPrevBlockNotBest, "ErrPrevBlockNotBest"},
+		{ErrCommitteeApproval, "ErrCommitteeApproval"},
 		{0xffff, "Unknown ErrorCode (65535)"},
 	}
 
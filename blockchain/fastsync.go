@@ -13,6 +13,7 @@ import (
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/database"
 	"github.com/classzz/classzz/wire"
 
 	"github.com/btcsuite/go-socks/socks"
@@ -29,6 +30,18 @@ func (b *BlockChain) fastSyncUtxoSet(checkpoint *chaincfg.Checkpoint, proxyAddr
 	// If the UTXO set is already caught up with the last checkpoint then
 	// we can just close the done chan and exit.
 	if b.utxoCache.lastFlushHash.IsEqual(checkpoint.Hash) {
+		state, err := b.fetchUtxoSetState(checkpoint.Hash)
+		if err != nil {
+			return err
+		}
+		if state != nil {
+			b.chainLock.Lock()
+			b.utxoSetState = state
+			b.chainLock.Unlock()
+		} else {
+			log.Warnf("The UTXO set commitment is not available " +
+				"until the next start without fast sync")
+		}
 		close(b.fastSyncDone)
 		return nil
 	}
@@ -128,9 +141,10 @@ func (b *BlockChain) fastSyncUtxoSet(checkpoint *chaincfg.Checkpoint, proxyAddr
 	}
 	close(jobsChan)
 
-	// Read each result and add the returned hash to the
-	// existing multiset.
+	// Read each result and add the returned hash and statistics to the
+	// existing ones.
 	m := czzec.NewMultiset(czzec.S256())
+	state := &utxoSetState{hash: *checkpoint.Hash, multiset: m}
 	for i := 0; i < numWorkers; i++ {
 		result := <-resultsChan
 		if result.err != nil {
@@ -138,6 +152,9 @@ func (b *BlockChain) fastSyncUtxoSet(checkpoint *chaincfg.Checkpoint, proxyAddr
 			return err
 		}
 		m.Merge(result.m)
+		state.numOutputs += result.numOutputs
+		state.totalAmount += result.totalAmount
+		state.size += result.size
 	}
 	close(resultsChan)
 
@@ -162,6 +179,19 @@ func (b *BlockChain) fastSyncUtxoSet(checkpoint *chaincfg.Checkpoint, proxyAddr
 
 	log.Infof("Verification complete. UTXO hash %s.", m.Hash().String())
 
+	// The verified UTXO set seeds the commitment which is rolled forward
+	// as the blocks after the checkpoint are connected.
+	err = b.db.Update(func(dbTx database.Tx) error {
+		return dbPutUtxoSetState(dbTx, state)
+	})
+	if err != nil {
+		log.Errorf("Error processing UTXO set: %s", err.Error())
+		return err
+	}
+	b.chainLock.Lock()
+	b.utxoSetState = state
+	b.chainLock.Unlock()
+
 	// Signal fastsync complete
 	close(b.fastSyncDone)
 
@@ -169,10 +199,14 @@ func (b *BlockChain) fastSyncUtxoSet(checkpoint *chaincfg.Checkpoint, proxyAddr
 }
 
 // result holds a multiset with a hash of all the UTXOs read by
-// this worker and a possible error.
+// this worker, their count, total amount and serialized size, and
+// a possible error.
 type result struct {
-	m   *czzec.Multiset
-	err error
+	m           *czzec.Multiset
+	numOutputs  uint64
+	totalAmount uint64
+	size        uint64
+	err         error
 }
 
 // worker handles the work of deserializing the UTXO, calculating the ECMH hash of
@@ -181,14 +215,18 @@ type result struct {
 // chan is closed.
 func worker(cache *utxoCache, jobs <-chan []byte, results chan<- *result) {
 	var (
-		err      error
-		m        = czzec.NewMultiset(czzec.S256())
-		entry    *UtxoEntry
-		outpoint *wire.OutPoint
-		state    = &BestState{Hash: chainhash.Hash{}}
+		err         error
+		m           = czzec.NewMultiset(czzec.S256())
+		numOutputs  uint64
+		totalAmount uint64
+		size        uint64
+		entry       *UtxoEntry
+		outpoint    *wire.OutPoint
+		state       = &BestState{Hash: chainhash.Hash{}}
 	)
 	for serializedUtxo := range jobs {
 		m.Add(serializedUtxo)
+		size += uint64(len(serializedUtxo))
 
 		outpoint, entry, err = deserializeUtxoCommitmentFormat(serializedUtxo)
 		if err != nil {
@@ -196,6 +234,8 @@ func worker(cache *utxoCache, jobs <-chan []byte, results chan<- *result) {
 			results <- &result{err: err}
 			return
 		}
+		numOutputs++
+		totalAmount += uint64(entry.Amount())
 
 		if err = cache.AddEntry(*outpoint, entry, true); err != nil {
 			results <- &result{err: err}
@@ -207,7 +247,12 @@ func worker(cache *utxoCache, jobs <-chan []byte, results chan<- *result) {
 			return
 		}
 	}
-	results <- &result{m: m}
+	results <- &result{
+		m:           m,
+		numOutputs:  numOutputs,
+		totalAmount: totalAmount,
+		size:        size,
+	}
 }
 
 // downloadUtxoSet will attempt to connect to make an HTTP GET request to the
@@ -501,3 +501,111 @@ func TestGreatWallActivation(t *testing.T) {
 		}
 	}
 }
+
+// TestPostSubsidy ensures the coinbase of the blocks after the end of the
+// subsidy may pay the tail emission of the network but no more.
+func TestPostSubsidy(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.Upgrades = map[chaincfg.UpgradeID]int32{
+		chaincfg.UpgradeSubsidyEnd:  20,
+		chaincfg.UpgradePostSubsidy: 20,
+	}
+	params.PostSubsidyPolicy = chaincfg.PostSubsidyTailEmission
+	params.TailEmission = 5e8
+	params.NoDifficultyAdjustment = true
+
+	tests, err := fullblocktests.GeneratePostSubsidy(&params)
+	if err != nil {
+		t.Fatalf("failed to generate tests: %v", err)
+	}
+	runPostSubsidyTests(t, &params, tests)
+}
+
+// TestPostSubsidyConvertFees ensures the coinbase of a block with a Convert
+// transaction after the end of the subsidy may pay the miner its share of the
+// conversion fees from the fee pool but no more.
+func TestPostSubsidyConvertFees(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.Upgrades = map[chaincfg.UpgradeID]int32{
+		chaincfg.UpgradeSubsidyEnd:  20,
+		chaincfg.UpgradePostSubsidy: 20,
+	}
+	params.PostSubsidyPolicy = chaincfg.PostSubsidyConvertFees
+	params.ConvertFeeShare = 40
+	params.NoDifficultyAdjustment = true
+
+	tests, err := fullblocktests.GeneratePostSubsidyConvertFees(&params)
+	if err != nil {
+		t.Fatalf("failed to generate tests: %v", err)
+	}
+	runPostSubsidyTests(t, &params, tests)
+}
+
+// runPostSubsidyTests processes the blocks of the passed post-subsidy tests
+// with a new chain instance on the passed network.
+func runPostSubsidyTests(t *testing.T, params *chaincfg.Params, tests [][]fullblocktests.TestInstance) {
+	// Create a new database and chain instance to run tests against.
+	chain, teardownFunc, err := chainSetup("fullblocktest", params)
+	if err != nil {
+		t.Errorf("Failed to setup chain instance: %v", err)
+		return
+	}
+	defer teardownFunc()
+
+	// The blocks are solved with the double sha256 hash instead of the
+	// proof of work algorithm of the chain.
+	flags := blockchain.BFNoPoWCheck
+	for testNum, test := range tests {
+		for itemNum, item := range test {
+			switch item := item.(type) {
+			case fullblocktests.AcceptedBlock:
+				block := czzutil.NewBlock(item.Block)
+				block.SetHeight(item.Height)
+				isMainChain, _, err := chain.ProcessBlock(block,
+					flags)
+				if err != nil {
+					t.Fatalf("block %q (hash %s, height %d) "+
+						"should have been accepted: %v",
+						item.Name, block.Hash(), item.Height,
+						err)
+				}
+				if isMainChain != item.IsMainChain {
+					t.Fatalf("block %q (hash %s, height %d) "+
+						"unexpected main chain flag -- got "+
+						"%v, want %v", item.Name, block.Hash(),
+						item.Height, isMainChain,
+						item.IsMainChain)
+				}
+
+			case fullblocktests.RejectedBlock:
+				block := czzutil.NewBlock(item.Block)
+				block.SetHeight(item.Height)
+				_, _, err := chain.ProcessBlock(block,
+					flags)
+				rerr, ok := err.(blockchain.RuleError)
+				if !ok || rerr.ErrorCode != item.RejectCode {
+					t.Fatalf("block %q (hash %s, height %d) "+
+						"returned error %v, want reject "+
+						"code %v", item.Name, block.Hash(),
+						item.Height, err, item.RejectCode)
+				}
+
+			case fullblocktests.ExpectedTip:
+				best := chain.BestSnapshot()
+				if best.Hash != item.Block.BlockHash() ||
+					best.Height != item.Height {
+
+					t.Fatalf("block %q (height %d) should be "+
+						"the current tip -- got (hash %s, "+
+						"height %d)", item.Name, item.Height,
+						best.Hash, best.Height)
+				}
+
+			default:
+				t.Fatalf("test #%d, item #%d is not one of "+
+					"the supported test instance types -- "+
+					"got type: %T", testNum, itemNum, item)
+			}
+		}
+	}
+}
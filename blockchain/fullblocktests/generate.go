@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"runtime"
 	"time"
 
@@ -24,8 +25,10 @@ import (
 	"github.com/classzz/classzz/blockchain"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/cross"
 	"github.com/classzz/classzz/czzec"
 	"github.com/classzz/classzz/mining"
+	"github.com/classzz/classzz/rlp"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
@@ -197,6 +200,10 @@ type testGenerator struct {
 
 	// Common key for any tests which require signed transactions.
 	privKey *czzec.PrivateKey
+
+	// Public key script the coinbase pays to, an OP_TRUE script when
+	// nil.
+	coinbasePkScript []byte
 }
 
 // makeTestGenerator returns a test generator instance initialized with the
@@ -295,9 +302,14 @@ func (g *testGenerator) createCoinbaseTx(blockHeight int32) *wire.MsgTx {
 		Sequence:        wire.MaxTxInSequenceNum,
 		SignatureScript: coinbaseScript,
 	})
+	pkScript := g.coinbasePkScript
+	if pkScript == nil {
+		pkScript = opTrueScript
+	}
 	tx.AddTxOut(&wire.TxOut{
-		Value:    blockchain.CalcBlockSubsidy(blockHeight, g.params),
-		PkScript: opTrueScript,
+		Value: blockchain.CalcBlockSubsidy(blockHeight, g.params) +
+			blockchain.CalcPostSubsidyReward(blockHeight, 0, g.params),
+		PkScript: pkScript,
 	})
 	if tx.SerializeSize() < blockchain.MinTransactionSize {
 		padLen := blockchain.MinTransactionSize - tx.SerializeSize()
@@ -2469,3 +2481,291 @@ func GenerateBlocksWithSchnorrSigs() (tests [][]TestInstance, err error) {
 
 	return tests, nil
 }
+
+// GeneratePostSubsidy generates a test chain across the end of the block
+// subsidy on the passed network, which must pay a tail emission from the same
+// height on.  The coinbase transactions pay to a public key hash since the
+// chain requires their outputs to have an address.
+func GeneratePostSubsidy(params *chaincfg.Params) (tests [][]TestInstance, err error) {
+	// In order to simplify the generation code which really should never
+	// fail unless the test code itself is broken, panics are used
+	// internally.  This deferred func ensures any panics don't escape the
+	// generator by replacing the named error return with the underlying
+	// panic error.
+	defer func() {
+		if r := recover(); r != nil {
+			tests = nil
+
+			switch rt := r.(type) {
+			case string:
+				err = errors.New(rt)
+			case error:
+				err = rt
+			default:
+				err = errors.New("Unknown panic")
+			}
+		}
+	}()
+
+	// Create a test generator instance initialized with the genesis block
+	// as the tip.
+	g, err := makeTestGenerator(params)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := czzutil.NewAddressPubKeyHash(czzutil.Hash160(
+		g.privKey.PubKey().SerializeCompressed()), params)
+	if err != nil {
+		return nil, err
+	}
+	g.coinbasePkScript, err = txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptBlock := func(blockName string, block *wire.MsgBlock, isMainChain, isOrphan bool) TestInstance {
+		blockHeight := g.blockHeights[blockName]
+		return AcceptedBlock{blockName, block, blockHeight, isMainChain,
+			isOrphan}
+	}
+	rejectBlock := func(blockName string, block *wire.MsgBlock, code blockchain.ErrorCode) TestInstance {
+		blockHeight := g.blockHeights[blockName]
+		return RejectedBlock{blockName, block, blockHeight, code}
+	}
+	expectTipBlock := func(blockName string, block *wire.MsgBlock) TestInstance {
+		blockHeight := g.blockHeights[blockName]
+		return ExpectedTip{blockName, block, blockHeight}
+	}
+
+	// Generate the blocks paying the subsidy up to the end of it.  The
+	// chain starts in the past since blocks are a second apart and must
+	// not be ahead of the current time.
+	//
+	//   genesis -> bs1 -> ... -> bs(n-1)
+	subsidyEnd := params.UpgradeHeight(chaincfg.UpgradeSubsidyEnd)
+	start := time.Unix(time.Now().Unix()-int64(subsidyEnd)-2, 0)
+	var testInstances []TestInstance
+	for g.tipHeight < subsidyEnd-1 {
+		blockName := fmt.Sprintf("bs%d", g.tipHeight+1)
+		g.nextBlock(blockName, nil, func(b *wire.MsgBlock) {
+			if g.tipHeight == 0 {
+				b.Header.Timestamp = start
+			}
+		})
+		testInstances = append(testInstances, acceptBlock(g.tipName,
+			g.tip, true, false))
+	}
+	tests = append(tests, testInstances)
+
+	// Create a block which pays the subsidy at the first height without
+	// one.
+	//
+	//   ... -> bs(n-1)
+	//                 \-> bp0(0)
+	g.nextBlock("bp0", nil, func(b *wire.MsgBlock) {
+		b.Transactions[0].TxOut[0].Value = blockchain.CalcBlockSubsidy(
+			subsidyEnd-1, params)
+	})
+	tests = append(tests, []TestInstance{
+		rejectBlock(g.tipName, g.tip, blockchain.ErrBadCoinbaseValue),
+	})
+
+	// Create a block which pays one more than the tail emission.
+	//
+	//   ... -> bs(n-1)
+	//                 \-> bp1(0)
+	g.setTip(fmt.Sprintf("bs%d", subsidyEnd-1))
+	g.nextBlock("bp1", nil, additionalCoinbase(1))
+	tests = append(tests, []TestInstance{
+		rejectBlock(g.tipName, g.tip, blockchain.ErrBadCoinbaseValue),
+	})
+
+	// Create blocks which pay the tail emission.
+	//
+	//   ... -> bs(n-1) -> bt0 -> bt1
+	g.setTip(fmt.Sprintf("bs%d", subsidyEnd-1))
+	g.nextBlock("bt0", nil)
+	if g.tip.Transactions[0].TxOut[0].Value != params.TailEmission {
+		panic(fmt.Sprintf("coinbase of block bt0 pays %d instead of "+
+			"the tail emission %d", g.tip.Transactions[0].TxOut[0].Value,
+			params.TailEmission))
+	}
+	testInstances = []TestInstance{acceptBlock(g.tipName, g.tip, true,
+		false)}
+	g.nextBlock("bt1", nil)
+	testInstances = append(testInstances, acceptBlock(g.tipName, g.tip,
+		true, false), expectTipBlock(g.tipName, g.tip))
+	tests = append(tests, testInstances)
+
+	return tests, nil
+}
+
+// GeneratePostSubsidyConvertFees generates a test chain with a Convert
+// transaction after the end of the block subsidy on the passed network, which
+// must pay the miners a share of the conversion fees from the same height on.
+// The coinbase transactions pay to a public key hash since the chain requires
+// their outputs to have an address.
+func GeneratePostSubsidyConvertFees(params *chaincfg.Params) (tests [][]TestInstance, err error) {
+	// In order to simplify the generation code which really should never
+	// fail unless the test code itself is broken, panics are used
+	// internally.  This deferred func ensures any panics don't escape the
+	// generator by replacing the named error return with the underlying
+	// panic error.
+	defer func() {
+		if r := recover(); r != nil {
+			tests = nil
+
+			switch rt := r.(type) {
+			case string:
+				err = errors.New(rt)
+			case error:
+				err = rt
+			default:
+				err = errors.New("Unknown panic")
+			}
+		}
+	}()
+
+	// Create a test generator instance initialized with the genesis block
+	// as the tip.
+	g, err := makeTestGenerator(params)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := czzutil.NewAddressPubKeyHash(czzutil.Hash160(
+		g.privKey.PubKey().SerializeCompressed()), params)
+	if err != nil {
+		return nil, err
+	}
+	g.coinbasePkScript, err = txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	feePoolScript, err := txscript.PayToPubKeyHashScript(cross.FeePool)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptBlock := func(blockName string, block *wire.MsgBlock, isMainChain, isOrphan bool) TestInstance {
+		blockHeight := g.blockHeights[blockName]
+		return AcceptedBlock{blockName, block, blockHeight, isMainChain,
+			isOrphan}
+	}
+	rejectBlock := func(blockName string, block *wire.MsgBlock, code blockchain.ErrorCode) TestInstance {
+		blockHeight := g.blockHeights[blockName]
+		return RejectedBlock{blockName, block, blockHeight, code}
+	}
+	expectTipBlock := func(blockName string, block *wire.MsgBlock) TestInstance {
+		blockHeight := g.blockHeights[blockName]
+		return ExpectedTip{blockName, block, blockHeight}
+	}
+
+	// Generate the blocks paying the subsidy up to the end of it and keep
+	// the coinbase of the first one to spend.  The chain starts in the
+	// past since blocks are a second apart and must not be ahead of the
+	// current time.
+	//
+	//   genesis -> bs1 -> ... -> bs(n-1)
+	subsidyEnd := params.UpgradeHeight(chaincfg.UpgradeSubsidyEnd)
+	if subsidyEnd <= int32(params.CoinbaseMaturity)+1 {
+		panic("the coinbase of the first block is not mature at the " +
+			"end of the subsidy")
+	}
+	start := time.Unix(time.Now().Unix()-int64(subsidyEnd)-2, 0)
+	var testInstances []TestInstance
+	for g.tipHeight < subsidyEnd-1 {
+		blockName := fmt.Sprintf("bs%d", g.tipHeight+1)
+		g.nextBlock(blockName, nil, func(b *wire.MsgBlock) {
+			if g.tipHeight == 0 {
+				b.Header.Timestamp = start
+			}
+		})
+		if g.tipHeight == 1 {
+			g.saveTipCoinbaseOut()
+		}
+		testInstances = append(testInstances, acceptBlock(g.tipName,
+			g.tip, true, false))
+	}
+	tests = append(tests, testInstances)
+
+	// Create a Convert transaction spending the coinbase of the first
+	// block with a fee of 1 atom for the miner.
+	data, err := rlp.EncodeToBytes(&cross.ConvertTxInfo{
+		AssetType:   cross.ExpandedTxConvert_Czz,
+		ConvertType: cross.ExpandedTxConvert_ECzz,
+		ExtTxHash:   "convert",
+		Amount:      big.NewInt(1e9),
+	})
+	if err != nil {
+		return nil, err
+	}
+	convertScript, err := txscript.ConvertScript(data)
+	if err != nil {
+		return nil, err
+	}
+	spend := g.oldestCoinbaseOut()
+	convertTx := wire.NewMsgTx(1)
+	convertTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: spend.prevOut,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	convertTx.AddTxOut(wire.NewTxOut(0, convertScript))
+	convertTx.AddTxOut(wire.NewTxOut(int64(spend.amount)-1, opTrueScript))
+	convertTx.TxIn[0].SignatureScript, err = txscript.SignatureScript(
+		convertTx, 0, int64(spend.amount), g.coinbasePkScript,
+		txscript.SigHashAll, g.privKey, true)
+	if err != nil {
+		return nil, err
+	}
+	convertFees := cross.ConvertFees(convertTx).Int64()
+	share := blockchain.CalcPostSubsidyReward(subsidyEnd, convertFees,
+		params)
+	if share <= 0 || share >= convertFees {
+		panic(fmt.Sprintf("miner share %d of the conversion fees %d "+
+			"is not a part of them", share, convertFees))
+	}
+
+	// payConvertFees returns a function that adds the Convert transaction
+	// to a block whose coinbase pays the passed amounts of the conversion
+	// fees to the miner, along with the transaction fee, and the fee pool.
+	payConvertFees := func(minerShare, feePool int64) func(*wire.MsgBlock) {
+		return func(b *wire.MsgBlock) {
+			coinbaseTx := b.Transactions[0]
+			coinbaseTx.TxOut[0].Value += 1 + minerShare
+			coinbaseTx.AddTxOut(wire.NewTxOut(feePool, feePoolScript))
+			b.AddTransaction(convertTx)
+		}
+	}
+
+	// Create a block which pays the miner one more than its share.
+	//
+	//   ... -> bs(n-1)
+	//                 \-> bf0(0)
+	g.nextBlock("bf0", nil, payConvertFees(share+1, convertFees-share-1))
+	tests = append(tests, []TestInstance{
+		rejectBlock(g.tipName, g.tip, blockchain.ErrBadCoinbaseValue),
+	})
+
+	// Create a block which pays the share to the miner without taking it
+	// from the fee pool.
+	//
+	//   ... -> bs(n-1)
+	//                 \-> bf1(0)
+	g.setTip(fmt.Sprintf("bs%d", subsidyEnd-1))
+	g.nextBlock("bf1", nil, payConvertFees(share, convertFees))
+	tests = append(tests, []TestInstance{
+		rejectBlock(g.tipName, g.tip, blockchain.ErrBadCoinbaseValue),
+	})
+
+	// Create a block which pays the share to the miner from the fee pool.
+	//
+	//   ... -> bs(n-1) -> bf2
+	g.setTip(fmt.Sprintf("bs%d", subsidyEnd-1))
+	g.nextBlock("bf2", nil, payConvertFees(share, convertFees-share))
+	tests = append(tests, []TestInstance{
+		acceptBlock(g.tipName, g.tip, true, false),
+		expectTipBlock(g.tipName, g.tip),
+	})
+
+	return tests, nil
+}
@@ -0,0 +1,227 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/consensus"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/database"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// HeadersOnly returns whether or not the blockchain is running in headers-only
+// mode.  In this mode the block index is maintained from headers alone and no
+// UTXO set is kept.
+func (b *BlockChain) HeadersOnly() bool {
+	return b.headersOnly
+}
+
+// maxStakedTarget returns the largest target cross.ComputeDiff can raise the
+// passed target to.  The staking multiplier grows with the stake of the
+// coinbase address, which can never exceed every coin that will ever exist.
+func maxStakedTarget(params *chaincfg.Params, target *big.Int) *big.Int {
+	maxStake := big.NewInt(czzutil.MaxSatoshi)
+	maxTarget := new(big.Int).Mul(target,
+		cross.StakingMultiplier(params, maxStake, 0))
+	if maxTarget.Cmp(params.PowLimit) > 0 {
+		maxTarget.Set(params.PowLimit)
+	}
+	return maxTarget
+}
+
+// checkHeaderProofOfWork ensures the CZZ seal of the passed header is valid
+// without access to the block's coinbase.  The bits of the header must already
+// match the ones calcNextRequiredDifficulty expects, which
+// checkBlockHeaderContext ensures.
+//
+// Below the beacon height a block must satisfy the target claimed by its bits.
+// From the beacon height on, cross.ComputeDiff raises the target of a block
+// depending on the stake of its coinbase address.  Neither the coinbase nor
+// the staking state are known to a headers-only node, so the seal is checked
+// against the largest target any stake could raise the expected target to.
+// This bounds the work a forged header must carry by the retargeted difficulty
+// instead of the proof of work limit, while the stake of the actual miner is
+// only enforced by full nodes.
+func (b *BlockChain) checkHeaderProofOfWork(header *wire.BlockHeader, blockHeight int32) error {
+	// Ensure the bits are in the allowed range.
+	err := checkProofOfWork(b.chainParams, header, b.chainParams.PowLimit,
+		BFNoPoWCheck, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	target := CompactToBig(header.Bits)
+	if b.chainParams.IsActive(chaincfg.UpgradeBeacon, blockHeight-1) {
+		target = maxStakedTarget(b.chainParams, target)
+	}
+
+	param := &consensus.CzzConsensusParam{
+		HeadHash: header.BlockHashNoNonce(),
+		Target:   target,
+	}
+	if err := consensus.VerifyBlockSeal(param, header.Nonce); err != nil {
+		str := fmt.Sprintf("block hash of %s is higher than "+
+			"expected max of %064x", header.BlockHash(), target)
+		return ruleError(ErrHighHash, str)
+	}
+	return nil
+}
+
+// ProcessBlockHeader validates the passed block header and adds it to the
+// block index.  The header must connect to a known block, carry a valid seal
+// and satisfy the difficulty, timestamp and checkpoint rules.  When the header
+// results in a chain with more work than the current best chain it becomes the
+// new tip.
+//
+// This is the block processing entry point for headers-only nodes and must not
+// be used by nodes that maintain a UTXO set.
+//
+// The returned bool indicates whether or not the header became the new tip of
+// the main chain.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ProcessBlockHeader(header *wire.BlockHeader) (bool, error) {
+	if !b.headersOnly {
+		return false, AssertError("ProcessBlockHeader called on a chain " +
+			"that is not in headers-only mode")
+	}
+
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	blockHash := header.BlockHash()
+	if b.index.HaveBlock(&blockHash) {
+		str := fmt.Sprintf("already have block header %v", blockHash)
+		return false, ruleError(ErrDuplicateBlock, str)
+	}
+
+	prevNode := b.index.LookupNode(&header.PrevBlock)
+	if prevNode == nil {
+		str := fmt.Sprintf("previous block %s is unknown", header.PrevBlock)
+		return false, ruleError(ErrPreviousBlockUnknown, str)
+	}
+	if b.index.NodeStatus(prevNode).KnownInvalid() {
+		str := fmt.Sprintf("previous block %s is known to be invalid",
+			header.PrevBlock)
+		return false, ruleError(ErrInvalidAncestorBlock, str)
+	}
+
+	// The seal is verified separately below, so only the remaining context
+	// free checks are performed here.  The context checks ensure the bits
+	// match the difficulty retarget rules, which the seal check relies on.
+	prevHeader := prevNode.Header()
+	err := checkBlockHeaderSanity(b.chainParams, &prevHeader, header,
+		b.chainParams.PowLimit, b.timeSource, BFNoPoWCheck, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	if err := b.checkBlockHeaderContext(header, prevNode, BFNone); err != nil {
+		return false, err
+	}
+
+	blockHeight := prevNode.height + 1
+	if err := b.checkHeaderProofOfWork(header, blockHeight); err != nil {
+		return false, err
+	}
+
+	node := newBlockNode(header, prevNode)
+	node.status = statusValid
+	b.index.AddNode(node)
+
+	// Nothing more to do when the header does not extend the chain with the
+	// most work.
+	tip := b.bestChain.Tip()
+	if node.workSum.Cmp(tip.workSum) <= 0 {
+		return false, b.index.flushToDB()
+	}
+
+	// Update the main chain height index for the nodes that are detached
+	// from and attached to the main chain along with the best state.
+	fork := b.bestChain.FindFork(node)
+	var attachNodes []*blockNode
+	for n := node; n != fork; n = n.parent {
+		attachNodes = append(attachNodes, n)
+	}
+	state := newBestState(node, 0, 0, 0, node.CalcPastMedianTime())
+	err = b.db.Update(func(dbTx database.Tx) error {
+		for n := tip; n != fork; n = n.parent {
+			err := dbRemoveBlockIndex(dbTx, &n.hash, n.height)
+			if err != nil {
+				return err
+			}
+		}
+		for i := len(attachNodes) - 1; i >= 0; i-- {
+			n := attachNodes[i]
+			if err := dbPutBlockIndex(dbTx, &n.hash, n.height); err != nil {
+				return err
+			}
+		}
+		return dbPutBestState(dbTx, state, node.workSum)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if fork != tip {
+		log.Infof("REORGANIZE: header chain forks at %v (height %d), "+
+			"new tip %v (height %d)", fork.hash, fork.height,
+			node.hash, node.height)
+	}
+
+	b.bestChain.SetTip(node)
+	b.stateLock.Lock()
+	b.stateSnapshot = state
+	b.stateLock.Unlock()
+
+	return true, b.index.flushToDB()
+}
+
+// StoreBlock stores the full data of a block whose header is already part of
+// the block index.  It is used by headers-only nodes to keep the blocks that
+// match their watched scripts so they can be served over RPC.  The block is
+// not connected and its transactions are not validated beyond ensuring they
+// commit to the merkle root of the indexed header.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) StoreBlock(block *czzutil.Block) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	node := b.index.LookupNode(block.Hash())
+	if node == nil {
+		str := fmt.Sprintf("block %s has no known header", block.Hash())
+		return ruleError(ErrPreviousBlockUnknown, str)
+	}
+
+	merkles := BuildMerkleTreeStore(block.Transactions())
+	calculatedMerkleRoot := merkles[len(merkles)-1]
+	if !node.merkleRoot.IsEqual(calculatedMerkleRoot) {
+		str := fmt.Sprintf("block merkle root is invalid - block "+
+			"header indicates %v, but calculated value is %v",
+			node.merkleRoot, calculatedMerkleRoot)
+		return ruleError(ErrBadMerkleRoot, str)
+	}
+
+	err := b.db.Update(func(dbTx database.Tx) error {
+		return dbStoreBlock(dbTx, block)
+	})
+	if err != nil {
+		return err
+	}
+
+	b.index.SetStatusFlags(node, statusDataStored)
+	return b.index.flushToDB()
+}
+
+// HaveBlockData returns whether or not the full data of the block with the
+// passed hash is stored.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) HaveBlockData(hash *chainhash.Hash) bool {
+	node := b.index.LookupNode(hash)
+	return node != nil && b.index.NodeStatus(node).HaveData()
+}
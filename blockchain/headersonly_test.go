@@ -0,0 +1,49 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+)
+
+// TestMaxStakedTarget ensures the largest target a stake can raise a target to
+// is the target boosted by a stake of every coin, capped by the proof of work
+// limit.
+func TestMaxStakedTarget(t *testing.T) {
+	// A stake of 21e9 coins is 21000 times the minimum stake of 1e6 coins
+	// on the main network, which multiplies the target by 21000^3.
+	multiplier := new(big.Int).Exp(big.NewInt(21000), big.NewInt(3), nil)
+	params := &chaincfg.MainNetParams
+
+	tests := []struct {
+		name   string
+		target *big.Int
+		want   *big.Int
+	}{{
+		name:   "boosted",
+		target: new(big.Int).Lsh(bigOne, 100),
+		want: new(big.Int).Mul(new(big.Int).Lsh(bigOne, 100),
+			multiplier),
+	}, {
+		name:   "capped",
+		target: new(big.Int).Lsh(bigOne, 200),
+		want:   params.PowLimit,
+	}, {
+		name:   "pow limit",
+		target: params.PowLimit,
+		want:   params.PowLimit,
+	}}
+
+	for _, test := range tests {
+		target := new(big.Int).Set(test.target)
+		got := maxStakedTarget(params, target)
+		if got.Cmp(test.want) != 0 {
+			t.Errorf("%s: got %064x, want %064x", test.name, got,
+				test.want)
+		}
+		if target.Cmp(test.target) != 0 {
+			t.Errorf("%s: modified the passed target", test.name)
+		}
+	}
+}
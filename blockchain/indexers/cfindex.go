@@ -211,7 +211,7 @@ func (idx *CfIndex) Create(dbTx database.Tx) error {
 
 // storeFilter stores a given filter, and performs the steps needed to
 // generate the filter's header.
-func storeFilter(dbTx database.Tx, block *czzutil.Block, f *gcs.Filter,
+func storeFilter(dbTx database.Tx, h, ph *chainhash.Hash, f *gcs.Filter,
 	filterType wire.FilterType) error {
 	if uint8(filterType) > maxFilterType {
 		return errors.New("unsupported filter type")
@@ -223,7 +223,6 @@ func storeFilter(dbTx database.Tx, block *czzutil.Block, f *gcs.Filter,
 	hashkey := cfHashKeys[filterType]
 
 	// Start by storing the filter.
-	h := block.Hash()
 	filterBytes, err := f.NBytes()
 	if err != nil {
 		return err
@@ -245,7 +244,6 @@ func storeFilter(dbTx database.Tx, block *czzutil.Block, f *gcs.Filter,
 
 	// Then fetch the previous block's filter header.
 	var prevHeader *chainhash.Hash
-	ph := &block.MsgBlock().Header.PrevBlock
 	if ph.IsEqual(&zeroHash) {
 		prevHeader = &zeroHash
 	} else {
@@ -279,7 +277,39 @@ func (idx *CfIndex) ConnectBlock(dbTx database.Tx, block *czzutil.Block,
 		return err
 	}
 
-	return storeFilter(dbTx, block, f, wire.GCSFilterRegular)
+	return storeFilter(dbTx, block.Hash(), &block.MsgBlock().Header.PrevBlock,
+		f, wire.GCSFilterRegular)
+}
+
+// InitHeadersOnly creates the buckets for the index when they don't exist yet
+// without involving the index manager.  Headers-only nodes can't build filters
+// from blocks, so they populate the index with the filters received from peers
+// through AddFilter instead.
+func (idx *CfIndex) InitHeadersOnly() error {
+	return idx.db.Update(func(dbTx database.Tx) error {
+		if dbTx.Metadata().Bucket(cfIndexParentBucketKey) != nil {
+			return nil
+		}
+		return idx.Create(dbTx)
+	})
+}
+
+// AddFilter stores a serialized filter received from a peer for the block with
+// the passed header and extends the filter header chain with it.  The filter
+// of the previous block must already be stored unless the block is the
+// genesis block.
+func (idx *CfIndex) AddFilter(header *wire.BlockHeader,
+	filterType wire.FilterType, filterBytes []byte) error {
+
+	f, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM, filterBytes)
+	if err != nil {
+		return err
+	}
+
+	h := header.BlockHash()
+	return idx.db.Update(func(dbTx database.Tx) error {
+		return storeFilter(dbTx, &h, &header.PrevBlock, f, filterType)
+	})
 }
 
 // DisconnectBlock is invoked by the index manager when a block has been
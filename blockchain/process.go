@@ -6,6 +6,7 @@ package blockchain
 
 import (
 	"fmt"
+	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/cross"
 	"github.com/classzz/classzz/database"
@@ -194,23 +195,11 @@ func (b *BlockChain) ProcessBlock(block *czzutil.Block, flags BehaviorFlags) (bo
 	}
 
 	var eState *cross.EntangleState
-	if b.chainParams.BeaconHeight <= prevHeight && b.chainParams.MauiHeight > prevHeight {
+	if b.chainParams.IsActive(chaincfg.UpgradeBeacon, prevHeight) && !b.chainParams.IsActive(chaincfg.UpgradeMaui, prevHeight) {
 		eState = b.GetEstateByHashAndHeight(*prevHash, prevHeight)
-	} else if b.chainParams.MauiHeight <= prevHeight {
+	} else if b.chainParams.IsActive(chaincfg.UpgradeMaui, prevHeight) {
 		cState := b.GetCstateByHashAndHeight(*prevHash, prevHeight)
-		bai2s := make(map[string]*cross.BeaconAddressInfo)
-		for _, v := range cState.PledgeInfos {
-			bai2 := &cross.BeaconAddressInfo{
-				ExchangeID:      v.ID.Uint64(),
-				StakingAmount:   v.StakingAmount,
-				CoinBaseAddress: v.CoinBaseAddress,
-			}
-			bai2s[v.Address] = bai2
-		}
-
-		eState = &cross.EntangleState{
-			EnInfos: bai2s,
-		}
+		eState = cState.StakingState()
 	}
 
 	script := block.MsgBlock().Transactions[0].TxOut[0].PkScript
@@ -229,14 +218,14 @@ func (b *BlockChain) ProcessBlock(block *czzutil.Block, flags BehaviorFlags) (bo
 		return false, true, nil
 	}
 
-	if b.chainParams.BeaconHeight < blockHeight && b.chainParams.MauiHeight > blockHeight {
+	if b.chainParams.IsActive(chaincfg.UpgradeBeacon, blockHeight-1) && !b.chainParams.IsActive(chaincfg.UpgradeMaui, blockHeight) {
 		if err := b.CheckBeacon(block, prevHeight); err != nil {
 			return false, false, err
 		}
 	}
 
 	// cross Verify
-	if b.chainParams.MauiHeight <= blockHeight {
+	if b.chainParams.IsActive(chaincfg.UpgradeMaui, blockHeight) {
 		if err := b.CheckBlockCrossTx(block, prevHeight); err != nil {
 			return false, false, err
 		}
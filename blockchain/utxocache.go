@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/database"
 	"github.com/classzz/classzz/txscript"
@@ -880,7 +881,7 @@ func (b *BlockChain) FetchPoolUtxoView(hash *chainhash.Hash, height int32) (*Utx
 		return nil, err
 	}
 
-	if b.chainParams.EntangleHeight > height+1 || (len(tx.MsgTx().TxIn) != 3 && b.chainParams.EntangleHeight > height+1) {
+	if !b.chainParams.IsActive(chaincfg.UpgradeEntangle, height+1) || (len(tx.MsgTx().TxIn) != 3 && !b.chainParams.IsActive(chaincfg.UpgradeEntangle, height+1)) {
 		return nil, nil
 	}
 	//tx.MsgTx().TxOut[1].PkScript
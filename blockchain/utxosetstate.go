@@ -0,0 +1,248 @@
+package blockchain
+
+import (
+	"errors"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/database"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// utxoSetState is a rolling commitment to the utxo set as of the end of a
+// block along with statistics about the set.  The commitment is the ECMH of
+// all unspent outputs serialized in the commitment format, so it matches the
+// UtxoSetHash of a fast sync checkpoint at the same block.
+type utxoSetState struct {
+	hash        chainhash.Hash
+	multiset    *czzec.Multiset
+	numOutputs  uint64
+	totalAmount uint64
+	size        uint64
+}
+
+// newUtxoSetState returns the state of an empty utxo set at the block with the
+// passed hash.
+func newUtxoSetState(hash chainhash.Hash) *utxoSetState {
+	return &utxoSetState{
+		hash:     hash,
+		multiset: czzec.NewMultiset(czzec.S256()),
+	}
+}
+
+// clone returns a deep copy of the state.
+func (s *utxoSetState) clone() *utxoSetState {
+	x, y := s.multiset.Point()
+	return &utxoSetState{
+		hash:        s.hash,
+		multiset:    czzec.NewMultisetFromPoint(czzec.S256(), x, y),
+		numOutputs:  s.numOutputs,
+		totalAmount: s.totalAmount,
+		size:        s.size,
+	}
+}
+
+// addEntry adds the passed unspent output to the state.
+func (s *utxoSetState) addEntry(outpoint wire.OutPoint, entry *UtxoEntry) {
+	serialized := serializeUtxoCommitmentFormat(outpoint, entry)
+	s.multiset.Add(serialized)
+	s.numOutputs++
+	s.totalAmount += uint64(entry.Amount())
+	s.size += uint64(len(serialized))
+}
+
+// removeEntry removes the passed output, which must be part of the state, from
+// the state.
+func (s *utxoSetState) removeEntry(outpoint wire.OutPoint, entry *UtxoEntry) {
+	serialized := serializeUtxoCommitmentFormat(outpoint, entry)
+	s.multiset.Remove(serialized)
+	s.numOutputs--
+	s.totalAmount -= uint64(entry.Amount())
+	s.size -= uint64(len(serialized))
+}
+
+// connectBlock returns the state after the passed block, which spends the
+// outputs described by stxos, is connected.  The receiver is not modified.
+//
+// The outputs created by the block are added before the spent ones are removed
+// since the multiset ignores removals while it is empty.  Outputs which are
+// created and spent by the same block cancel out.
+func (s *utxoSetState) connectBlock(block *czzutil.Block, stxos []SpentTxOut) *utxoSetState {
+	state := s.clone()
+	state.hash = *block.Hash()
+	forEachBlockOutput(block, state.addEntry)
+	forEachSpentOutput(block, stxos, state.removeEntry)
+	return state
+}
+
+// disconnectBlock returns the state after the passed block, which spends the
+// outputs described by stxos, is disconnected.  The receiver is not modified.
+func (s *utxoSetState) disconnectBlock(block *czzutil.Block, stxos []SpentTxOut) *utxoSetState {
+	state := s.clone()
+	state.hash = block.MsgBlock().Header.PrevBlock
+	forEachSpentOutput(block, stxos, state.addEntry)
+	forEachBlockOutput(block, state.removeEntry)
+	return state
+}
+
+// forEachBlockOutput calls fn with every output the passed block adds to the
+// utxo set, in the same way connectTransactions adds them.
+func forEachBlockOutput(block *czzutil.Block, fn func(wire.OutPoint, *UtxoEntry)) {
+	for _, tx := range block.Transactions() {
+		isCoinBase := IsCoinBase(tx)
+		prevOut := wire.OutPoint{Hash: *tx.Hash()}
+		for txOutIdx, txOut := range tx.MsgTx().TxOut {
+			if !isUtxoSetOutput(txOut.PkScript) {
+				continue
+			}
+			prevOut.Index = uint32(txOutIdx)
+			entry := &UtxoEntry{
+				amount:      txOut.Value,
+				pkScript:    txOut.PkScript,
+				blockHeight: block.Height(),
+			}
+			if isCoinBase {
+				entry.packedFlags |= tfCoinBase
+			}
+			fn(prevOut, entry)
+		}
+	}
+}
+
+// forEachSpentOutput calls fn with every output spent by the passed block,
+// using the spent txout details for the data of the outputs.
+func forEachSpentOutput(block *czzutil.Block, stxos []SpentTxOut, fn func(wire.OutPoint, *UtxoEntry)) {
+	var stxoIdx int
+	for _, tx := range block.Transactions()[1:] {
+		for _, txIn := range tx.MsgTx().TxIn {
+			stxo := &stxos[stxoIdx]
+			stxoIdx++
+
+			entry := &UtxoEntry{
+				amount:      stxo.Amount,
+				pkScript:    stxo.PkScript,
+				blockHeight: stxo.Height,
+			}
+			if stxo.IsCoinBase {
+				entry.packedFlags |= tfCoinBase
+			}
+			fn(txIn.PreviousOutPoint, entry)
+		}
+	}
+}
+
+// fetchUtxoSetState returns the utxo set state stored in the database when it
+// is for the block with the passed hash and nil otherwise.
+func (b *BlockChain) fetchUtxoSetState(hash *chainhash.Hash) (*utxoSetState, error) {
+	var state *utxoSetState
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		state, err = dbFetchUtxoSetState(dbTx)
+		return err
+	})
+	if err != nil || state == nil || state.hash != *hash {
+		return nil, err
+	}
+	return state, nil
+}
+
+// initUtxoSetState loads the utxo set state from the database.  When the
+// stored state is missing or is not for the tip of the main chain, such as for
+// databases created before the state was introduced, it is calculated from the
+// utxo set, which can take a while.
+//
+// This function MUST be called with the utxo state consistent with the tip of
+// the main chain.
+func (b *BlockChain) initUtxoSetState(interrupt <-chan struct{}) error {
+	tip := b.bestChain.Tip()
+	state, err := b.fetchUtxoSetState(&tip.hash)
+	if err != nil {
+		return err
+	}
+	if state != nil {
+		b.utxoSetState = state
+		return nil
+	}
+
+	// Flush the utxo cache so the database holds the entire utxo set.
+	log.Info("Calculating the UTXO set commitment.  This might take a while...")
+	if err := b.utxoCache.Flush(FlushRequired, b.BestSnapshot()); err != nil {
+		return err
+	}
+
+	state = newUtxoSetState(tip.hash)
+	err = b.db.View(func(dbTx database.Tx) error {
+		utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+		return utxoBucket.ForEach(func(k, v []byte) error {
+			if interruptRequested(interrupt) {
+				return errInterruptRequested
+			}
+			entry, err := DeserializeUtxoEntry(v)
+			if err != nil {
+				return err
+			}
+			state.addEntry(*DeserializeOutpointKey(k), entry)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	err = b.db.Update(func(dbTx database.Tx) error {
+		return dbPutUtxoSetState(dbTx, state)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("UTXO set commitment %v (%d outputs)", state.multiset.Hash(),
+		state.numOutputs)
+	b.utxoSetState = state
+	return nil
+}
+
+// UtxoSetInfo houses information about the utxo set at the end of the main
+// chain.
+type UtxoSetInfo struct {
+	// Height and Hash identify the block the information is for.
+	Height int32
+	Hash   chainhash.Hash
+
+	// TotalTxns is the total number of transactions in the main chain.
+	TotalTxns uint64
+
+	// NumOutputs is the number of unspent outputs and TotalAmount the sum of
+	// their amounts.
+	NumOutputs  uint64
+	TotalAmount int64
+
+	// Size is the size of the utxo set serialized in the commitment format
+	// and UtxoSetHash its ECMH, as used by fast sync checkpoints.
+	Size        uint64
+	UtxoSetHash chainhash.Hash
+}
+
+// UtxoSetInfo returns information about the utxo set at the end of the main
+// chain.  The information is maintained as blocks are connected and
+// disconnected, so this is cheap.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) UtxoSetInfo() (*UtxoSetInfo, error) {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	state := b.utxoSetState
+	if state == nil {
+		return nil, errors.New("the utxo set commitment is not available")
+	}
+	return &UtxoSetInfo{
+		Height:      b.bestChain.Tip().height,
+		Hash:        state.hash,
+		TotalTxns:   b.BestSnapshot().TotalTxns,
+		NumOutputs:  state.numOutputs,
+		TotalAmount: int64(state.totalAmount),
+		Size:        state.size,
+		UtxoSetHash: state.multiset.Hash(),
+	}, nil
+}
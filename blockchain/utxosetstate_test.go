@@ -0,0 +1,156 @@
+package blockchain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// checkUtxoSetState ensures the passed utxo set states are equal.
+func checkUtxoSetState(t *testing.T, name string, got, want *utxoSetState) {
+	t.Helper()
+	if got.hash != want.hash {
+		t.Errorf("%s: block hash %v, want %v", name, got.hash, want.hash)
+	}
+	if got.multiset.Hash() != want.multiset.Hash() {
+		t.Errorf("%s: utxo set hash %v, want %v", name,
+			got.multiset.Hash(), want.multiset.Hash())
+	}
+	if got.numOutputs != want.numOutputs || got.totalAmount != want.totalAmount ||
+		got.size != want.size {
+		t.Errorf("%s: %d outputs of %d (%d bytes), want %d of %d (%d bytes)",
+			name, got.numOutputs, got.totalAmount, got.size,
+			want.numOutputs, want.totalAmount, want.size)
+	}
+}
+
+// TestUtxoSetState ensures the utxo set state rolled forward and back over a
+// block matches the state of the resulting utxo set.
+func TestUtxoSetState(t *testing.T) {
+	pkScript := []byte{txscript.OP_TRUE}
+	prevOut := wire.OutPoint{Hash: chainhash.Hash{0x01}}
+	prevEntry := &UtxoEntry{
+		amount:      5e8,
+		pkScript:    pkScript,
+		blockHeight: 1,
+		packedFlags: tfCoinBase,
+	}
+
+	// The block spends the existing output and an output created by an
+	// earlier transaction of the block.  The unspendable output of the
+	// coinbase is not part of the utxo set.
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{},
+		math.MaxUint32), nil))
+	coinbase.AddTxOut(wire.NewTxOut(5e8, pkScript))
+	coinbase.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_RETURN}))
+	tx1 := wire.NewMsgTx(wire.TxVersion)
+	tx1.AddTxIn(wire.NewTxIn(&prevOut, nil))
+	tx1.AddTxOut(wire.NewTxOut(3e8, pkScript))
+	tx1.AddTxOut(wire.NewTxOut(2e8, pkScript))
+	tx2 := wire.NewMsgTx(wire.TxVersion)
+	tx2.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: tx1.TxHash()}, nil))
+	tx2.AddTxOut(wire.NewTxOut(3e8, pkScript))
+	msgBlock := &wire.MsgBlock{
+		Header:       wire.BlockHeader{PrevBlock: chainhash.Hash{0x02}},
+		Transactions: []*wire.MsgTx{coinbase, tx1, tx2},
+	}
+	block := czzutil.NewBlock(msgBlock)
+	block.SetHeight(2)
+	stxos := []SpentTxOut{
+		{Amount: 5e8, PkScript: pkScript, Height: 1, IsCoinBase: true},
+		{Amount: 3e8, PkScript: pkScript, Height: 2},
+	}
+
+	start := newUtxoSetState(msgBlock.Header.PrevBlock)
+	start.addEntry(prevOut, prevEntry)
+	startHash := start.multiset.Hash()
+
+	want := newUtxoSetState(*block.Hash())
+	want.addEntry(wire.OutPoint{Hash: coinbase.TxHash()}, &UtxoEntry{
+		amount: 5e8, pkScript: pkScript, blockHeight: 2,
+		packedFlags: tfCoinBase,
+	})
+	want.addEntry(wire.OutPoint{Hash: tx1.TxHash(), Index: 1}, &UtxoEntry{
+		amount: 2e8, pkScript: pkScript, blockHeight: 2,
+	})
+	want.addEntry(wire.OutPoint{Hash: tx2.TxHash()}, &UtxoEntry{
+		amount: 3e8, pkScript: pkScript, blockHeight: 2,
+	})
+
+	connected := start.connectBlock(block, stxos)
+	checkUtxoSetState(t, "connect", connected, want)
+	if start.multiset.Hash() != startHash {
+		t.Fatal("connectBlock modified the original state")
+	}
+
+	disconnected := connected.disconnectBlock(block, stxos)
+	checkUtxoSetState(t, "disconnect", disconnected, start)
+
+	deserialized, err := deserializeUtxoSetState(serializeUtxoSetState(connected))
+	if err != nil {
+		t.Fatalf("deserializeUtxoSetState: %v", err)
+	}
+	checkUtxoSetState(t, "deserialize", deserialized, connected)
+	if _, err := deserializeUtxoSetState([]byte{0x00}); err == nil {
+		t.Fatal("deserializeUtxoSetState accepted a truncated state")
+	}
+}
+
+// TestUtxoSetStateGenesis ensures a new chain stores the commitment to the
+// empty utxo set at the genesis block, so it isn't calculated on startup.
+func TestUtxoSetStateGenesis(t *testing.T) {
+	chain, teardownFunc, err := chainSetup("utxosetstategenesis",
+		&chaincfg.RegressionNetParams)
+	if err != nil {
+		t.Fatalf("Failed to setup chain instance: %v", err)
+	}
+	defer teardownFunc()
+
+	genesisHash := chain.chainParams.GenesisHash
+	stored, err := chain.fetchUtxoSetState(genesisHash)
+	if err != nil {
+		t.Fatalf("fetchUtxoSetState: %v", err)
+	}
+	if stored == nil {
+		t.Fatal("no utxo set state stored for the genesis block")
+	}
+	want := newUtxoSetState(*genesisHash)
+	checkUtxoSetState(t, "stored", stored, want)
+	checkUtxoSetState(t, "loaded", chain.utxoSetState, want)
+
+	stored, err = chain.fetchUtxoSetState(&chainhash.Hash{0x01})
+	if err != nil {
+		t.Fatalf("fetchUtxoSetState: %v", err)
+	}
+	if stored != nil {
+		t.Fatal("fetchUtxoSetState returned the state of another block")
+	}
+}
+
+// TestUtxoCommitmentFormatSerialization ensures unspent outputs serialized in
+// the commitment format deserialize to the same outputs.
+func TestUtxoCommitmentFormatSerialization(t *testing.T) {
+	outpoint := wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 7}
+	entry := &UtxoEntry{
+		amount:      12345,
+		pkScript:    []byte{txscript.OP_TRUE},
+		blockHeight: 100000,
+		packedFlags: tfCoinBase,
+	}
+	gotOutpoint, gotEntry, err := deserializeUtxoCommitmentFormat(
+		serializeUtxoCommitmentFormat(outpoint, entry))
+	if err != nil {
+		t.Fatalf("deserializeUtxoCommitmentFormat: %v", err)
+	}
+	if *gotOutpoint != outpoint || gotEntry.Amount() != entry.Amount() ||
+		gotEntry.BlockHeight() != entry.BlockHeight() ||
+		!gotEntry.IsCoinBase() || string(gotEntry.PkScript()) != string(entry.PkScript()) {
+		t.Fatalf("unexpected output %v %+v", gotOutpoint, gotEntry)
+	}
+}
@@ -174,6 +174,27 @@ func (view *UtxoViewpoint) addInputUtxos(source utxoView, block *czzutil.Block)
 	return nil
 }
 
+// isUtxoSetOutput returns whether or not an output with the passed public key
+// script is added to the utxo set.  Provably unspendable outputs and the
+// outputs of the beacon, mortgage, convert, casting and pool key rotation
+// transactions are not.
+func isUtxoSetOutput(pkScript []byte) bool {
+	switch {
+	case txscript.IsUnspendable(pkScript),
+		txscript.IsBeaconRegistrationTy(pkScript),
+		txscript.IsAddBeaconPledgeTy(pkScript),
+		txscript.IsMortgageTy(pkScript),
+		txscript.IsAddMortgageTy(pkScript),
+		txscript.IsUpdateCoinbaseAllTy(pkScript),
+		txscript.IsConvertTy(pkScript),
+		txscript.IsConvertConfirmTy(pkScript),
+		txscript.IsCastingTy(pkScript),
+		txscript.IsPoolKeyRotationTy(pkScript):
+		return false
+	}
+	return true
+}
+
 func addTxOuts(view utxoView, tx *czzutil.Tx, blockHeight int32, overwrite bool) error {
 	// Add the transaction's outputs as available utxos.
 	isCoinBase := IsCoinBase(tx)
@@ -181,32 +202,9 @@ func addTxOuts(view utxoView, tx *czzutil.Tx, blockHeight int32, overwrite bool)
 	for txOutIdx, txOut := range tx.MsgTx().TxOut {
 		prevOut.Index = uint32(txOutIdx)
 
-		// Don't add provably unspendable outputs.
-		if txscript.IsUnspendable(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsBeaconRegistrationTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsAddBeaconPledgeTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsMortgageTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsAddMortgageTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsUpdateCoinbaseAllTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsConvertTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsConvertConfirmTy(txOut.PkScript) {
-			continue
-		}
-		if txscript.IsCastingTy(txOut.PkScript) {
+		// Don't add provably unspendable outputs and the outputs of
+		// the classzz transaction types.
+		if !isUtxoSetOutput(txOut.PkScript) {
 			continue
 		}
 
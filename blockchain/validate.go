@@ -5,6 +5,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -142,7 +143,7 @@ func isCoinBaseInParam(tx *czzutil.Tx, chainParams *chaincfg.Params) bool {
 		return false
 	}
 
-	if height >= chainParams.EntangleHeight {
+	if chainParams.IsActive(chaincfg.UpgradeEntangle, height) {
 		if len(msgTx.TxIn) < 3 {
 			return false
 		}
@@ -238,7 +239,7 @@ func IsFinalizedTransaction(tx *czzutil.Tx, blockHeight int32, blockTime time.Ti
 // approximately every 4 years.
 func CalcBlockSubsidy(height int32, chainParams *chaincfg.Params) int64 {
 
-	if height >= 1500000 {
+	if chainParams.IsActive(chaincfg.UpgradeSubsidyEnd, height) {
 		return 0
 	}
 	if chainParams.SubsidyReductionInterval == 0 {
@@ -249,6 +250,59 @@ func CalcBlockSubsidy(height int32, chainParams *chaincfg.Params) int64 {
 	return baseSubsidy >> uint(height/chainParams.SubsidyReductionInterval)
 }
 
+// CalcPostSubsidyReward returns the amount the coinbase of a block at the
+// provided height may pay its miner on top of the subsidy and the transaction
+// fees according to the post-subsidy policy of the network.  convertFees is
+// the total fee of the conversions of the block as returned by
+// CalcConvertFees.
+func CalcPostSubsidyReward(height int32, convertFees int64, chainParams *chaincfg.Params) int64 {
+	if !chainParams.IsActive(chaincfg.UpgradePostSubsidy, height) {
+		return 0
+	}
+
+	switch chainParams.PostSubsidyPolicy {
+	case chaincfg.PostSubsidyTailEmission:
+		return chainParams.TailEmission
+	case chaincfg.PostSubsidyConvertFees:
+		return convertFees * chainParams.ConvertFeeShare / 100
+	}
+	return 0
+}
+
+// CalcConvertFees returns the total fee of the conversions of the passed
+// transactions which the coinbase pays to the fee pool.
+func CalcConvertFees(txns []*czzutil.Tx) int64 {
+	var fees int64
+	for _, tx := range txns {
+		fees += cross.ConvertFees(tx.MsgTx()).Int64()
+	}
+	return fees
+}
+
+// checkFeePool ensures the fee pool output of the passed coinbase transaction
+// does not pay more than the conversion fees of the block left after the share
+// paid to the miner.
+func checkFeePool(coinbase *wire.MsgTx, convertFees, minerShare int64) error {
+	pkScript, err := txscript.PayToPubKeyHashScript(cross.FeePool)
+	if err != nil {
+		return err
+	}
+	var feePool int64
+	for _, txOut := range coinbase.TxOut[1:] {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			feePool += txOut.Value
+		}
+	}
+	if feePool > convertFees-minerShare {
+		str := fmt.Sprintf("coinbase transaction for block pays %v to "+
+			"the fee pool which is more than the %v left of the "+
+			"conversion fees after the miner share of %v", feePool,
+			convertFees-minerShare, minerShare)
+		return ruleError(ErrBadCoinbaseValue, str)
+	}
+	return nil
+}
+
 // CheckTransactionSanity performs some preliminary checks on a transaction to
 // ensure it is sane.  These checks are context free.
 func CheckTransactionSanity(tx *czzutil.Tx, magneticAnomalyActive bool, scriptFlags txscript.ScriptFlags) error {
@@ -418,7 +472,7 @@ func (b *BlockChain) CheckBeacon(block *czzutil.Block, prevHeight int32) error {
 func (b *BlockChain) CheckBlockCrossTx(block *czzutil.Block, prevHeight int32) error {
 	hash := block.MsgBlock().Header.PrevBlock
 	cState := b.GetCstateByHashAndHeight(hash, prevHeight)
-	if b.chainParams.MauiHeight == prevHeight+1 {
+	if b.chainParams.UpgradeHeight(chaincfg.UpgradeMaui) == prevHeight+1 {
 		eState := b.CurrentEstate()
 		cState = cross.NewCommitteeState()
 		for _, v := range eState.EnInfos {
@@ -432,11 +486,14 @@ func (b *BlockChain) CheckBlockCrossTx(block *czzutil.Block, prevHeight int32) e
 			cState.PledgeInfos = append(cState.PledgeInfos, pi)
 		}
 	}
+	cState.RotateCommittee(b.chainParams, prevHeight+1)
+	cState.InitPools(b.chainParams, prevHeight+1)
 
 	var MortgageTx *wire.MsgTx
 	CastingTx := make([]*wire.MsgTx, 0, 0)
 	ConvertTx := make([]*cross.ConvertTxTemp, 0, 0)
 	ConvertConfirmsTx := make([]*wire.MsgTx, 0, 0)
+	PoolKeyRotations := make(map[uint8]*cross.PoolKeyRotationTxInfo)
 
 	for _, tx := range block.Transactions() {
 
@@ -495,10 +552,23 @@ func (b *BlockChain) CheckBlockCrossTx(block *czzutil.Block, prevHeight int32) e
 					if err = cState.ConvertConfirmVerify(v); err != nil {
 						return err
 					}
+					if err = cState.VerifyReleaseSignatures(b.chainParams, v, prevHeight+1); err != nil {
+						return err
+					}
 				}
 			}
 			ConvertConfirmsTx = append(ConvertConfirmsTx, tx.MsgTx())
 		}
+
+		// PoolKeyRotation
+		if info, err := b.GetCommitteeVerify().VerifyPoolKeyRotationTx(tx.MsgTx(), cState, prevHeight+1); err != nil && err != cross.NoPoolKeyRotation {
+			return err
+		} else if info != nil {
+			if _, ok := PoolKeyRotations[info.AssetType]; ok {
+				return fmt.Errorf("pool %d rotated twice in block", info.AssetType)
+			}
+			PoolKeyRotations[info.AssetType] = info
+		}
 	}
 
 	if MortgageTx != nil {
@@ -561,6 +631,11 @@ func (b *BlockChain) CheckBlockCrossTx(block *czzutil.Block, prevHeight int32) e
 		}
 	}
 
+	// The keys of the pools are rotated after the conversions they released.
+	for _, info := range PoolKeyRotations {
+		cState.RotatePoolKeys(info)
+	}
+
 	if err := cross.MakeCoinbaseTxUtxo(b.chainParams, block.Transactions()[0].MsgTx(), cState, len(ConvertTx) != 0); err != nil {
 		return err
 	}
@@ -571,6 +646,37 @@ func (b *BlockChain) CheckBlockCrossTx(block *czzutil.Block, prevHeight int32) e
 	return nil
 }
 
+// CheckConvertConfirmSigners ensures a ConvertConfirm transaction spends
+// outputs controlled by enough members of the committee serving at the passed
+// height, which is elected from the passed committee state of the parent
+// block.  Transactions of other types and those before the committee upgrade
+// always pass.
+//
+// The passed view must contain the outputs spent by the transaction.
+func CheckConvertConfirmSigners(params *chaincfg.Params, tx *czzutil.Tx, view *UtxoViewpoint, cState *cross.CommitteeState, height int32) error {
+	if !params.IsActive(chaincfg.UpgradeCommittee, height) {
+		return nil
+	}
+	if cinfo, _ := cross.IsConvertConfirmTx(tx.MsgTx()); cinfo == nil {
+		return nil
+	}
+
+	pkScripts := make([][]byte, 0, len(tx.MsgTx().TxIn))
+	for _, txIn := range tx.MsgTx().TxIn {
+		entry := view.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil || entry.IsSpent() {
+			continue
+		}
+		pkScripts = append(pkScripts, entry.PkScript())
+	}
+	committee := cState.CurrentCommittee(params, height)
+	if err := cross.VerifyCommitteeApproval(params, committee, pkScripts); err != nil {
+		str := fmt.Sprintf("transaction %v: %v", tx.Hash(), err)
+		return ruleError(ErrCommitteeApproval, str)
+	}
+	return nil
+}
+
 // checkProofOfWork ensures the block header bits which indicate the target
 // difficulty is in min/max range and that the block hash is less than the
 // target difficulty as claimed.
@@ -941,7 +1047,7 @@ func (b *BlockChain) CheckBlockHeaderContext(header *wire.BlockHeader, addr czzu
 
 	//eState := b.CurrentEstate()
 	var eState3 *cross.EntangleState
-	if b.chainParams.BeaconHeight <= tip.height && b.chainParams.MauiHeight > tip.height {
+	if b.chainParams.IsActive(chaincfg.UpgradeBeacon, tip.height) && !b.chainParams.IsActive(chaincfg.UpgradeMaui, tip.height) {
 		eState4 := b.CurrentEstate()
 		bai2s := make(map[string]*cross.BeaconAddressInfo)
 		for k, v := range eState4.EnInfos {
@@ -1121,7 +1227,7 @@ func (b *BlockChain) checkBlockContext(block *czzutil.Block, prevNode *blockNode
 	return nil
 }
 func checkMergeTxInCoinbase(tx *czzutil.Tx, txHeight int32, utxoView *UtxoViewpoint, chainParams *chaincfg.Params) (bool, error) {
-	if chainParams.EntangleHeight >= txHeight {
+	if !chainParams.IsActive(chaincfg.UpgradeEntangle, txHeight-1) {
 		if isCoinBaseInParam(tx, chainParams) {
 			return true, nil
 		}
@@ -1145,10 +1251,10 @@ func checkMergeTxInCoinbase(tx *czzutil.Tx, txHeight int32, utxoView *UtxoViewpo
 					return true, ruleError(ErrSpentTxOut, str)
 				}
 				uxtoHeight := utxo.BlockHeight()
-				if uxtoHeight < chainParams.EntangleHeight-1 {
+				if !chainParams.IsActive(chaincfg.UpgradeEntangle, uxtoHeight+1) {
 					str := fmt.Sprintf("output %v referenced from "+
 						"the wrong height[%d,%d]", txIn.PreviousOutPoint,
-						uxtoHeight, chainParams.EntangleHeight-1)
+						uxtoHeight, chainParams.UpgradeHeight(chaincfg.UpgradeEntangle)-1)
 					return true, ruleError(ErrBadTxOutValue, str)
 				}
 				if txInIndex <= 2 {
@@ -1164,18 +1270,18 @@ func checkMergeTxInCoinbase(tx *czzutil.Tx, txHeight int32, utxoView *UtxoViewpo
 }
 
 func checkBlockSubsidy(chainParams *chaincfg.Params, block, preBlock *czzutil.Block, txHeight int32, utxoView *UtxoViewpoint, amountSubsidy int64) error {
-	if txHeight <= chainParams.EntangleHeight {
+	if !chainParams.IsActive(chaincfg.UpgradeEntangle, txHeight-1) {
 		return nil
 	}
 	fork := false
-	if txHeight >= chainParams.BeaconHeight {
+	if chainParams.IsActive(chaincfg.UpgradeBeacon, txHeight) {
 		fork = true
 	}
 	originIncome1, originIncome2 := amountSubsidy*19/100, amountSubsidy/100
 	originIncome3 := amountSubsidy - originIncome1 - originIncome2
-	if txHeight == chainParams.EntangleHeight {
-		originIncome1 = originIncome1 * int64(chainParams.EntangleHeight-1)
-		originIncome2 = originIncome2 * int64(chainParams.EntangleHeight-1)
+	if txHeight == chainParams.UpgradeHeight(chaincfg.UpgradeEntangle) {
+		originIncome1 = originIncome1 * int64(chainParams.UpgradeHeight(chaincfg.UpgradeEntangle)-1)
+		originIncome2 = originIncome2 * int64(chainParams.UpgradeHeight(chaincfg.UpgradeEntangle)-1)
 	}
 	reward1, reward2, reward3 := originIncome1, originIncome2, originIncome3
 	// check sum reward
@@ -1418,6 +1524,18 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *czzutil.Block, vi
 		}
 	}
 
+	// ConvertConfirm transactions must be signed by the committee, which
+	// is elected from the committee state of the parent.
+	var cState *cross.CommitteeState
+	if b.chainParams.IsActive(chaincfg.UpgradeCommittee, node.height) {
+		var err error
+		cState, err = b.FetchCommitteeState(&node.parent.hash,
+			node.parent.height)
+		if err != nil {
+			return ruleError(ErrCommitteeApproval, err.Error())
+		}
+	}
+
 	// Perform several checks on the inputs for each transaction.  Also
 	// accumulate the total fees.  This could technically be combined with
 	// the loop above instead of running another loop over the transactions,
@@ -1431,6 +1549,13 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *czzutil.Block, vi
 		if err != nil {
 			return err
 		}
+		if cState != nil {
+			err := CheckConvertConfirmSigners(b.chainParams, tx, view,
+				cState, node.height)
+			if err != nil {
+				return err
+			}
+		}
 
 		// Sum the total fees and ensure we don't overflow the
 		// accumulator.
@@ -1478,13 +1603,28 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *czzutil.Block, vi
 		break
 	}
 	amountSubsidy := CalcBlockSubsidy(node.height, b.chainParams)
-	expectedSatoshiOut := amountSubsidy + totalFees
+	convertFees := CalcConvertFees(transactions)
+	postSubsidy := CalcPostSubsidyReward(node.height, convertFees,
+		b.chainParams)
+	expectedSatoshiOut := amountSubsidy + totalFees + postSubsidy
 	if totalSatoshiOut > expectedSatoshiOut {
 		str := fmt.Sprintf("coinbase transaction for block pays %v "+
 			"which is more than expected value of %v",
 			totalSatoshiOut, expectedSatoshiOut)
 		return ruleError(ErrBadCoinbaseValue, str)
 	}
+
+	// The share of the conversion fees paid to the miner is taken from
+	// the fee pool.
+	if b.chainParams.PostSubsidyPolicy == chaincfg.PostSubsidyConvertFees &&
+		postSubsidy > 0 {
+
+		err := checkFeePool(transactions[0].MsgTx(), convertFees,
+			postSubsidy)
+		if err != nil {
+			return err
+		}
+	}
 	preHash := block.MsgBlock().Header.PrevBlock
 	preHeight := node.height - 1
 	if preHeight > 0 {
@@ -1595,7 +1735,7 @@ func (b *BlockChain) CheckConnectBlockTemplate(block *czzutil.Block) error {
 	prevHeader, _ := b.HeaderByHash(&block.MsgBlock().Header.PrevBlock)
 
 	var eState3 *cross.EntangleState
-	if b.chainParams.BeaconHeight <= tip.height && b.chainParams.MauiHeight > tip.height {
+	if b.chainParams.IsActive(chaincfg.UpgradeBeacon, tip.height) && !b.chainParams.IsActive(chaincfg.UpgradeMaui, tip.height) {
 		eState4 := b.CurrentEstate()
 
 		bai2s := make(map[string]*cross.BeaconAddressInfo)
@@ -1613,7 +1753,7 @@ func (b *BlockChain) CheckConnectBlockTemplate(block *czzutil.Block) error {
 			EnInfos: bai2s,
 		}
 
-	} else if b.chainParams.MauiHeight <= tip.height {
+	} else if b.chainParams.IsActive(chaincfg.UpgradeMaui, tip.height) {
 		eState3 = b.CurrentEstate()
 	}
 
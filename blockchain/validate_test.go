@@ -12,6 +12,7 @@ import (
 
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/cross"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
@@ -452,10 +453,12 @@ func repeatScript(n int, newBytes ...byte) []byte {
 // TestCheckBlockSanity tests the CheckBlockSanity function to ensure it works
 // as expected.
 func TestCheckBlockSanity(t *testing.T) {
-	powLimit := chaincfg.MainNetParams.PowLimit
+	params := &chaincfg.MainNetParams
+	powLimit := params.PowLimit
 	block := czzutil.NewBlock(&Block100000)
 	timeSource := NewMedianTime()
-	err := CheckBlockSanity(block, powLimit, timeSource, false)
+	err := CheckBlockSanity(params, nil, block, powLimit, timeSource, false,
+		nil, nil)
 	if err != nil {
 		t.Errorf("CheckBlockSanity: %v", err)
 	}
@@ -464,7 +467,8 @@ func TestCheckBlockSanity(t *testing.T) {
 	// second fails.
 	timestamp := block.MsgBlock().Header.Timestamp
 	block.MsgBlock().Header.Timestamp = timestamp.Add(time.Nanosecond)
-	err = CheckBlockSanity(block, powLimit, timeSource, true)
+	err = CheckBlockSanity(params, nil, block, powLimit, timeSource, true,
+		nil, nil)
 	if err == nil {
 		t.Errorf("CheckBlockSanity: error is nil when it shouldn't be")
 	}
@@ -535,6 +539,64 @@ func TestCheckSerializedHeight(t *testing.T) {
 	}
 }
 
+// TestCalcPostSubsidyReward ensures the post-subsidy reward follows the policy
+// of the network once it activates.
+func TestCalcPostSubsidyReward(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.Upgrades = map[chaincfg.UpgradeID]int32{
+		chaincfg.UpgradeSubsidyEnd:  100,
+		chaincfg.UpgradePostSubsidy: 110,
+	}
+	params.TailEmission = 5e8
+	params.ConvertFeeShare = 40
+
+	tests := []struct {
+		policy      chaincfg.PostSubsidyPolicy
+		height      int32
+		convertFees int64
+		reward      int64
+	}{
+		{chaincfg.PostSubsidyNone, 110, 1000, 0},
+		{chaincfg.PostSubsidyTailEmission, 109, 1000, 0},
+		{chaincfg.PostSubsidyTailEmission, 110, 1000, 5e8},
+		{chaincfg.PostSubsidyConvertFees, 109, 1000, 0},
+		{chaincfg.PostSubsidyConvertFees, 110, 1000, 400},
+		{chaincfg.PostSubsidyConvertFees, 110, 9, 3},
+	}
+	for i, test := range tests {
+		params.PostSubsidyPolicy = test.policy
+		reward := CalcPostSubsidyReward(test.height, test.convertFees,
+			&params)
+		if reward != test.reward {
+			t.Errorf("#%d %v at height %d: got reward %d, want %d",
+				i, test.policy, test.height, reward, test.reward)
+		}
+	}
+}
+
+// TestCheckFeePool ensures the fee pool output of a coinbase is limited to the
+// conversion fees left after the share of the miner.
+func TestCheckFeePool(t *testing.T) {
+	feePool, err := txscript.PayToPubKeyHashScript(cross.FeePool)
+	if err != nil {
+		t.Fatalf("PayToPubKeyHashScript: %v", err)
+	}
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxOut(wire.NewTxOut(400, []byte{txscript.OP_TRUE}))
+	coinbase.AddTxOut(wire.NewTxOut(600, feePool))
+
+	if err := checkFeePool(coinbase, 1000, 400); err != nil {
+		t.Errorf("checkFeePool: %v", err)
+	}
+	err = checkFeePool(coinbase, 1000, 401)
+	if rerr, ok := err.(RuleError); !ok ||
+		rerr.ErrorCode != ErrBadCoinbaseValue {
+
+		t.Errorf("checkFeePool of an overpaid fee pool: got %v, want "+
+			"%v", err, ErrBadCoinbaseValue)
+	}
+}
+
 // Block100000 defines block 100,000 of the block chain.  It is used to
 // test Block operations.
 var Block100000 = wire.MsgBlock{
@@ -120,6 +120,18 @@ type ConvertConfirmOut struct {
 	ExtTxHash   string
 	Index       uint32
 	Amount      float64
+	Signatures  []string `json:",omitempty"`
+}
+
+// PoolKeyRotationOut describes a pool key rotation.  The keys and signatures
+// are hex encoded, and the signatures of the keys not signing are empty.
+type PoolKeyRotationOut struct {
+	AssetType    uint8
+	ExtAddresses []string
+	PubKeys      []string
+	Threshold    uint32
+	Nonce        uint64
+	Signatures   []string
 }
 
 // CreatePledgeRegistrationCmd defines JSON-RPC command.
@@ -186,11 +198,19 @@ type ConvertConfirmCmd struct {
 	LockTime       *int64
 }
 
-// AddBeaconPledge defines JSON-RPC command.
+// ConversionAddresseCmd defines the conversionaddress JSON-RPC command.
 type ConversionAddresseCmd struct {
 	ClasszzAddress string `json:"classzz_address"`
 }
 
+// NewConversionAddressCmd returns a new instance which can be used to issue a
+// conversionaddress JSON-RPC command.
+func NewConversionAddressCmd(classzzAddress string) *ConversionAddresseCmd {
+	return &ConversionAddresseCmd{
+		ClasszzAddress: classzzAddress,
+	}
+}
+
 func (w *WhiteUnit) toAddress() string {
 	// pk to czz address
 	return ""
@@ -239,6 +259,27 @@ func NewCreateRawTransactionCmd(inputs []TransactionInput, amounts map[string]fl
 	}
 }
 
+// CreatePsbtCmd defines the createpsbt JSON-RPC command.
+type CreatePsbtCmd struct {
+	Inputs   []TransactionInput
+	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"`
+	LockTime *int64
+}
+
+// NewCreatePsbtCmd returns a new instance which can be used to issue a
+// createpsbt JSON-RPC command.
+//
+// Amounts are in BTC.
+func NewCreatePsbtCmd(inputs []TransactionInput, amounts map[string]float64,
+	lockTime *int64) *CreatePsbtCmd {
+
+	return &CreatePsbtCmd{
+		Inputs:   inputs,
+		Amounts:  amounts,
+		LockTime: lockTime,
+	}
+}
+
 // NewCreateRawTransactionCmd returns a new instance which can be used to issue
 // a createrawtransaction JSON-RPC command.
 //
@@ -344,6 +385,19 @@ func NewDecodeRawTransactionCmd(hexTx string) *DecodeRawTransactionCmd {
 	}
 }
 
+// DecodePsbtCmd defines the decodepsbt JSON-RPC command.
+type DecodePsbtCmd struct {
+	Psbt string
+}
+
+// NewDecodePsbtCmd returns a new instance which can be used to issue a
+// decodepsbt JSON-RPC command.
+func NewDecodePsbtCmd(psbt string) *DecodePsbtCmd {
+	return &DecodePsbtCmd{
+		Psbt: psbt,
+	}
+}
+
 // DecodeScriptCmd defines the decodescript JSON-RPC command.
 type DecodeScriptCmd struct {
 	HexScript string
@@ -357,6 +411,24 @@ func NewDecodeScriptCmd(hexScript string) *DecodeScriptCmd {
 	}
 }
 
+// FinalizePsbtCmd defines the finalizepsbt JSON-RPC command.
+type FinalizePsbtCmd struct {
+	Psbt    string
+	Extract *bool `jsonrpcdefault:"true"`
+}
+
+// NewFinalizePsbtCmd returns a new instance which can be used to issue a
+// finalizepsbt JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewFinalizePsbtCmd(psbt string, extract *bool) *FinalizePsbtCmd {
+	return &FinalizePsbtCmd{
+		Psbt:    psbt,
+		Extract: extract,
+	}
+}
+
 // GetAddedNodeInfoCmd defines the getaddednodeinfo JSON-RPC command.
 type GetAddedNodeInfoCmd struct {
 	DNS  bool
@@ -685,6 +757,15 @@ func NewGetChainTipsCmd() *GetChainTipsCmd {
 	return &GetChainTipsCmd{}
 }
 
+// GetCommitteeCmd defines the getcommittee JSON-RPC command.
+type GetCommitteeCmd struct{}
+
+// NewGetCommitteeCmd returns a new instance which can be used to issue a
+// getcommittee JSON-RPC command.
+func NewGetCommitteeCmd() *GetCommitteeCmd {
+	return &GetCommitteeCmd{}
+}
+
 // GetConnectionCountCmd defines the getconnectioncount JSON-RPC command.
 type GetConnectionCountCmd struct{}
 
@@ -739,6 +820,42 @@ func NewGetWorkTemplateCmd() *GetWorkTemplateCmd {
 	return &GetWorkTemplateCmd{}
 }
 
+// GetMempoolAncestorsCmd defines the getmempoolancestors JSON-RPC command.
+type GetMempoolAncestorsCmd struct {
+	TxID    string
+	Verbose *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetMempoolAncestorsCmd returns a new instance which can be used to issue
+// a getmempoolancestors JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMempoolAncestorsCmd(txHash string, verbose *bool) *GetMempoolAncestorsCmd {
+	return &GetMempoolAncestorsCmd{
+		TxID:    txHash,
+		Verbose: verbose,
+	}
+}
+
+// GetMempoolDescendantsCmd defines the getmempooldescendants JSON-RPC command.
+type GetMempoolDescendantsCmd struct {
+	TxID    string
+	Verbose *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetMempoolDescendantsCmd returns a new instance which can be used to
+// issue a getmempooldescendants JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMempoolDescendantsCmd(txHash string, verbose *bool) *GetMempoolDescendantsCmd {
+	return &GetMempoolDescendantsCmd{
+		TxID:    txHash,
+		Verbose: verbose,
+	}
+}
+
 // GetMempoolEntryCmd defines the getmempoolentry JSON-RPC command.
 type GetMempoolEntryCmd struct {
 	TxID string
@@ -788,6 +905,22 @@ func NewGetNetTotalsCmd() *GetNetTotalsCmd {
 	return &GetNetTotalsCmd{}
 }
 
+// GetNetMsgStatsCmd defines the getnetmsgstats JSON-RPC command.
+type GetNetMsgStatsCmd struct {
+	PeerID *int32
+}
+
+// NewGetNetMsgStatsCmd returns a new instance which can be used to issue a
+// getnetmsgstats JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetNetMsgStatsCmd(peerID *int32) *GetNetMsgStatsCmd {
+	return &GetNetMsgStatsCmd{
+		PeerID: peerID,
+	}
+}
+
 // GetNetworkHashPSCmd defines the getnetworkhashps JSON-RPC command.
 type GetNetworkHashPSCmd struct {
 	Blocks *int `jsonrpcdefault:"120"`
@@ -831,6 +964,33 @@ func NewGetPeerInfoCmd() *GetPeerInfoCmd {
 	return &GetPeerInfoCmd{}
 }
 
+// GetNextCommitteeCmd defines the getnextcommittee JSON-RPC command.
+type GetNextCommitteeCmd struct{}
+
+// NewGetNextCommitteeCmd returns a new instance which can be used to issue a
+// getnextcommittee JSON-RPC command.
+func NewGetNextCommitteeCmd() *GetNextCommitteeCmd {
+	return &GetNextCommitteeCmd{}
+}
+
+// GetPoolAccountingCmd defines the getpoolaccounting JSON-RPC command.
+type GetPoolAccountingCmd struct {
+	StartHeight *int32
+	EndHeight   *int32
+}
+
+// NewGetPoolAccountingCmd returns a new instance which can be used to issue a
+// getpoolaccounting JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetPoolAccountingCmd(startHeight, endHeight *int32) *GetPoolAccountingCmd {
+	return &GetPoolAccountingCmd{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
 // GetPeerInfoCmd defines the getpeerinfo JSON-RPC command.
 type GetStateInfoCmd struct {
 	ID *uint64 `json:"id"`
@@ -1233,6 +1393,7 @@ func init() {
 	flags := UsageFlag(0)
 
 	MustRegisterCmd("addnode", (*AddNodeCmd)(nil), flags)
+	MustRegisterCmd("createpsbt", (*CreatePsbtCmd)(nil), flags)
 	MustRegisterCmd("createrawtransaction", (*CreateRawTransactionCmd)(nil), flags)
 	MustRegisterCmd("beaconregistration", (*BeaconRegistrationCmd)(nil), flags)
 	MustRegisterCmd("addbeaconpledge", (*AddBeaconPledgeCmd)(nil), flags)
@@ -1243,8 +1404,10 @@ func init() {
 	MustRegisterCmd("casting", (*CastingCmd)(nil), flags)
 	MustRegisterCmd("convertconfirm", (*ConvertConfirmCmd)(nil), flags)
 	MustRegisterCmd("conversionaddress", (*ConversionAddresseCmd)(nil), flags)
+	MustRegisterCmd("decodepsbt", (*DecodePsbtCmd)(nil), flags)
 	MustRegisterCmd("decoderawtransaction", (*DecodeRawTransactionCmd)(nil), flags)
 	MustRegisterCmd("decodescript", (*DecodeScriptCmd)(nil), flags)
+	MustRegisterCmd("finalizepsbt", (*FinalizePsbtCmd)(nil), flags)
 	MustRegisterCmd("getaddednodeinfo", (*GetAddedNodeInfoCmd)(nil), flags)
 	MustRegisterCmd("getbestblockhash", (*GetBestBlockHashCmd)(nil), flags)
 	MustRegisterCmd("getblock", (*GetBlockCmd)(nil), flags)
@@ -1257,6 +1420,7 @@ func init() {
 	MustRegisterCmd("getcfilter", (*GetCFilterCmd)(nil), flags)
 	MustRegisterCmd("getcfilterheader", (*GetCFilterHeaderCmd)(nil), flags)
 	MustRegisterCmd("getchaintips", (*GetChainTipsCmd)(nil), flags)
+	MustRegisterCmd("getcommittee", (*GetCommitteeCmd)(nil), flags)
 	MustRegisterCmd("getconnectioncount", (*GetConnectionCountCmd)(nil), flags)
 	MustRegisterCmd("getdifficulty", (*GetDifficultyCmd)(nil), flags)
 	MustRegisterCmd("getgenerate", (*GetGenerateCmd)(nil), flags)
@@ -1265,13 +1429,18 @@ func init() {
 	MustRegisterCmd("getstateinfo", (*GetStateInfoCmd)(nil), flags)
 	MustRegisterCmd("getconvertitems", (*GetConvertItemsCmd)(nil), flags)
 	MustRegisterCmd("getconvertconfirmitems", (*GetConvertConfirmItemsCmd)(nil), flags)
+	MustRegisterCmd("getmempoolancestors", (*GetMempoolAncestorsCmd)(nil), flags)
+	MustRegisterCmd("getmempooldescendants", (*GetMempoolDescendantsCmd)(nil), flags)
 	MustRegisterCmd("getmempoolentry", (*GetMempoolEntryCmd)(nil), flags)
 	MustRegisterCmd("getmempoolinfo", (*GetMempoolInfoCmd)(nil), flags)
 	MustRegisterCmd("getmininginfo", (*GetMiningInfoCmd)(nil), flags)
+	MustRegisterCmd("getnextcommittee", (*GetNextCommitteeCmd)(nil), flags)
 	MustRegisterCmd("getnetworkinfo", (*GetNetworkInfoCmd)(nil), flags)
+	MustRegisterCmd("getnetmsgstats", (*GetNetMsgStatsCmd)(nil), flags)
 	MustRegisterCmd("getnettotals", (*GetNetTotalsCmd)(nil), flags)
 	MustRegisterCmd("getnetworkhashps", (*GetNetworkHashPSCmd)(nil), flags)
 	MustRegisterCmd("getpeerinfo", (*GetPeerInfoCmd)(nil), flags)
+	MustRegisterCmd("getpoolaccounting", (*GetPoolAccountingCmd)(nil), flags)
 	MustRegisterCmd("getrawmempool", (*GetRawMempoolCmd)(nil), flags)
 	MustRegisterCmd("getrawtransaction", (*GetRawTransactionCmd)(nil), flags)
 	MustRegisterCmd("omni_gettransaction", (*OmniGetTransactionCmd)(nil), flags)
@@ -41,6 +41,19 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"addnode","params":["127.0.0.1","remove"],"id":1}`,
 			unmarshalled: &btcjson.AddNodeCmd{Addr: "127.0.0.1", SubCmd: btcjson.ANRemove},
 		},
+		{
+			name: "conversionaddress",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("conversionaddress", "czp5g27p3lz02astuyrnzd0sm90gh4280g3hgr2l0t")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewConversionAddressCmd("czp5g27p3lz02astuyrnzd0sm90gh4280g3hgr2l0t")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"conversionaddress","params":["czp5g27p3lz02astuyrnzd0sm90gh4280g3hgr2l0t"],"id":1}`,
+			unmarshalled: &btcjson.ConversionAddresseCmd{
+				ClasszzAddress: "czp5g27p3lz02astuyrnzd0sm90gh4280g3hgr2l0t",
+			},
+		},
 		{
 			name: "createrawtransaction",
 			newCmd: func() (interface{}, error) {
@@ -80,6 +93,36 @@ func TestChainSvrCmds(t *testing.T) {
 				LockTime: btcjson.Int64(12312333333),
 			},
 		},
+		{
+			name: "createpsbt",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("createpsbt", `[{"txid":"123","vout":1}]`,
+					`{"456":0.0123}`)
+			},
+			staticCmd: func() interface{} {
+				txInputs := []btcjson.TransactionInput{
+					{Txid: "123", Vout: 1},
+				}
+				amounts := map[string]float64{"456": .0123}
+				return btcjson.NewCreatePsbtCmd(txInputs, amounts, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createpsbt","params":[[{"txid":"123","vout":1}],{"456":0.0123}],"id":1}`,
+			unmarshalled: &btcjson.CreatePsbtCmd{
+				Inputs:  []btcjson.TransactionInput{{Txid: "123", Vout: 1}},
+				Amounts: map[string]float64{"456": .0123},
+			},
+		},
+		{
+			name: "decodepsbt",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("decodepsbt", "cHNidP8=")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDecodePsbtCmd("cHNidP8=")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"decodepsbt","params":["cHNidP8="],"id":1}`,
+			unmarshalled: &btcjson.DecodePsbtCmd{Psbt: "cHNidP8="},
+		},
 
 		{
 			name: "decoderawtransaction",
@@ -103,6 +146,34 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"decodescript","params":["00"],"id":1}`,
 			unmarshalled: &btcjson.DecodeScriptCmd{HexScript: "00"},
 		},
+		{
+			name: "finalizepsbt",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("finalizepsbt", "cHNidP8=")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFinalizePsbtCmd("cHNidP8=", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"finalizepsbt","params":["cHNidP8="],"id":1}`,
+			unmarshalled: &btcjson.FinalizePsbtCmd{
+				Psbt:    "cHNidP8=",
+				Extract: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "finalizepsbt optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("finalizepsbt", "cHNidP8=", false)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFinalizePsbtCmd("cHNidP8=", btcjson.Bool(false))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"finalizepsbt","params":["cHNidP8=",false],"id":1}`,
+			unmarshalled: &btcjson.FinalizePsbtCmd{
+				Psbt:    "cHNidP8=",
+				Extract: btcjson.Bool(false),
+			},
+		},
 		{
 			name: "getaddednodeinfo",
 			newCmd: func() (interface{}, error) {
@@ -427,6 +498,34 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"getinfo","params":[],"id":1}`,
 			unmarshalled: &btcjson.GetInfoCmd{},
 		},
+		{
+			name: "getmempoolancestors",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempoolancestors", "txhash")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolAncestorsCmd("txhash", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolancestors","params":["txhash"],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolAncestorsCmd{
+				TxID:    "txhash",
+				Verbose: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "getmempooldescendants optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempooldescendants", "txhash", true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolDescendantsCmd("txhash", btcjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempooldescendants","params":["txhash",true],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolDescendantsCmd{
+				TxID:    "txhash",
+				Verbose: btcjson.Bool(true),
+			},
+		},
 		{
 			name: "getmempoolentry",
 			newCmd: func() (interface{}, error) {
@@ -473,6 +572,32 @@ func TestChainSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"getnetworkinfo","params":[],"id":1}`,
 			unmarshalled: &btcjson.GetNetworkInfoCmd{},
 		},
+		{
+			name: "getnetmsgstats",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getnetmsgstats")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetNetMsgStatsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnetmsgstats","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetNetMsgStatsCmd{
+				PeerID: nil,
+			},
+		},
+		{
+			name: "getnetmsgstats optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getnetmsgstats", 3)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetNetMsgStatsCmd(btcjson.Int32(3))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnetmsgstats","params":[3],"id":1}`,
+			unmarshalled: &btcjson.GetNetMsgStatsCmd{
+				PeerID: btcjson.Int32(3),
+			},
+		},
 		{
 			name: "getnettotals",
 			newCmd: func() (interface{}, error) {
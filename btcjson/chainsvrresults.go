@@ -99,6 +99,45 @@ type DecodeScriptResult struct {
 	P2sh      string   `json:"p2sh,omitempty"`
 }
 
+// PsbtUtxoResult models the spent output of a partially signed transaction
+// input.
+type PsbtUtxoResult struct {
+	Amount       float64            `json:"amount"`
+	ScriptPubKey ScriptPubKeyResult `json:"scriptPubKey"`
+}
+
+// PsbtInputResult models the data of an input of the decodepsbt command.
+type PsbtInputResult struct {
+	Utxo              *PsbtUtxoResult     `json:"utxo,omitempty"`
+	PartialSignatures map[string]string   `json:"partial_signatures,omitempty"`
+	SighashType       string              `json:"sighash,omitempty"`
+	RedeemScript      *DecodeScriptResult `json:"redeem_script,omitempty"`
+	FinalScriptSig    *ScriptSig          `json:"final_scriptSig,omitempty"`
+	Unknown           map[string]string   `json:"unknown,omitempty"`
+}
+
+// PsbtOutputResult models the data of an output of the decodepsbt command.
+type PsbtOutputResult struct {
+	RedeemScript *DecodeScriptResult `json:"redeem_script,omitempty"`
+	Unknown      map[string]string   `json:"unknown,omitempty"`
+}
+
+// DecodePsbtResult models the data returned from the decodepsbt command.
+type DecodePsbtResult struct {
+	Tx      TxRawDecodeResult  `json:"tx"`
+	Unknown map[string]string  `json:"unknown"`
+	Inputs  []PsbtInputResult  `json:"inputs"`
+	Outputs []PsbtOutputResult `json:"outputs"`
+	Fee     *float64           `json:"fee,omitempty"`
+}
+
+// FinalizePsbtResult models the data returned from the finalizepsbt command.
+type FinalizePsbtResult struct {
+	Psbt     string `json:"psbt,omitempty"`
+	Hex      string `json:"hex,omitempty"`
+	Complete bool   `json:"complete"`
+}
+
 // GetAddedNodeInfoResultAddr models the data of the addresses portion of the
 // getaddednodeinfo command.
 type GetAddedNodeInfoResultAddr struct {
@@ -151,6 +190,73 @@ type GetBlockChainInfoResult struct {
 	Bip9SoftForks        map[string]*Bip9SoftForkDescription `json:"bip9_softforks"`
 }
 
+// GetChainTipsResult models the data returned from the getchaintips command.
+type GetChainTipsResult struct {
+	Height    int32  `json:"height"`
+	Hash      string `json:"hash"`
+	BranchLen int32  `json:"branchlen"`
+	Status    string `json:"status"`
+}
+
+// CommitteeMemberResult models a member of a committee returned by the
+// getcommittee and getnextcommittee commands.
+type CommitteeMemberResult struct {
+	Address       string `json:"address"`
+	Coinbase      string `json:"coinbase"`
+	PubKey        string `json:"pubkey"`
+	StakingAmount int64  `json:"stakingamount"`
+}
+
+// GetCommitteeResult models the data returned from the getcommittee and
+// getnextcommittee commands.
+type GetCommitteeResult struct {
+	Epoch       int64                   `json:"epoch"`
+	StartHeight int64                   `json:"startheight"`
+	EndHeight   int64                   `json:"endheight"`
+	Threshold   int32                   `json:"threshold"`
+	Members     []CommitteeMemberResult `json:"members"`
+	BackMembers []CommitteeMemberResult `json:"backmembers"`
+}
+
+// PoolAccountingResult models the accounting of a pool returned by the
+// getpoolaccounting command.
+type PoolAccountingResult struct {
+	AssetType       uint8    `json:"assettype"`
+	Address         string   `json:"address"`
+	BurnedIn        int64    `json:"burnedin"`
+	PaidOut         int64    `json:"paidout"`
+	Fees            int64    `json:"fees"`
+	CastIn          int64    `json:"castin"`
+	ConvertedIn     int64    `json:"convertedin"`
+	MintedOut       int64    `json:"mintedout"`
+	PendingItems    int      `json:"pendingitems"`
+	PendingAmount   int64    `json:"pendingamount"`
+	StartBalance    int64    `json:"startbalance"`
+	Balance         int64    `json:"balance"`
+	ExpectedBalance int64    `json:"expectedbalance"`
+	Mismatches      []string `json:"mismatches,omitempty"`
+}
+
+// PledgeAccountingResult models the accounting of a pledge returned by the
+// getpoolaccounting command.
+type PledgeAccountingResult struct {
+	ID                 int64  `json:"id"`
+	Address            string `json:"address"`
+	StartStakingAmount int64  `json:"startstakingamount"`
+	StakingAmount      int64  `json:"stakingamount"`
+	Locked             int64  `json:"locked"`
+}
+
+// GetPoolAccountingResult models the data returned from the getpoolaccounting
+// command.
+type GetPoolAccountingResult struct {
+	StartHeight int32                    `json:"startheight"`
+	EndHeight   int32                    `json:"endheight"`
+	Consistent  bool                     `json:"consistent"`
+	Pools       []PoolAccountingResult   `json:"pools"`
+	Pledges     []PledgeAccountingResult `json:"pledges"`
+}
+
 // GetBlockTemplateResultTx models the transactions field of the
 // getblocktemplate command.
 type GetBlockTemplateResultTx struct {
@@ -223,6 +329,8 @@ type GetMempoolEntryResult struct {
 	AncestorSize     int64    `json:"ancestorsize"`
 	AncestorFees     float64  `json:"ancestorfees"`
 	Depends          []string `json:"depends"`
+	SpentBy          []string `json:"spentby"`
+	Type             string   `json:"type"`
 }
 
 // GetMempoolInfoResult models the data returned from the getmempoolinfo
@@ -292,6 +400,46 @@ type GetPeerInfoResult struct {
 	Whitelisted    bool    `json:"whitelisted"`
 	FeeFilter      int64   `json:"feefilter"`
 	SyncNode       bool    `json:"syncnode"`
+
+	BytesSentPerMsg map[string]uint64 `json:"bytessent_per_msg"`
+	BytesRecvPerMsg map[string]uint64 `json:"bytesrecv_per_msg"`
+}
+
+// NetMsgStatsResult models the counters of the messages of a single command
+// returned from the getnetmsgstats command.
+type NetMsgStatsResult struct {
+	Count uint64 `json:"count"`
+	Bytes uint64 `json:"bytes"`
+}
+
+// LatencyHistogramResult models a relay latency histogram returned from the
+// getnetmsgstats command.  Counts holds one entry per bound in BoundsMillis
+// followed by the number of latencies beyond the last bound.
+type LatencyHistogramResult struct {
+	Count        uint64    `json:"count"`
+	MeanMillis   float64   `json:"meanms"`
+	BoundsMillis []float64 `json:"boundsms"`
+	Counts       []uint64  `json:"counts"`
+}
+
+// PeerNetMsgStatsResult models the message statistics of a single peer
+// returned from the getnetmsgstats command.
+type PeerNetMsgStatsResult struct {
+	ID           int32                        `json:"id"`
+	Addr         string                       `json:"addr"`
+	Inbound      bool                         `json:"inbound"`
+	Sent         map[string]NetMsgStatsResult `json:"sent"`
+	Recv         map[string]NetMsgStatsResult `json:"recv"`
+	BlockLatency LatencyHistogramResult       `json:"blocklatency"`
+	TxLatency    LatencyHistogramResult       `json:"txlatency"`
+}
+
+// GetNetMsgStatsResult models the data returned from the getnetmsgstats
+// command.  The totals are summed over the peers.
+type GetNetMsgStatsResult struct {
+	Sent  map[string]NetMsgStatsResult `json:"sent"`
+	Recv  map[string]NetMsgStatsResult `json:"recv"`
+	Peers []PeerNetMsgStatsResult      `json:"peers"`
 }
 
 // GetRawMempoolVerboseResult models the data returned from the getrawmempool
@@ -326,6 +474,17 @@ type GetTxOutResult struct {
 	Coinbase      bool               `json:"coinbase"`
 }
 
+// GetTxOutSetInfoResult models the data from the gettxoutsetinfo command.
+type GetTxOutSetInfoResult struct {
+	Height       int32   `json:"height"`
+	BestBlock    string  `json:"bestblock"`
+	Transactions uint64  `json:"transactions"`
+	TxOuts       uint64  `json:"txouts"`
+	UtxoSetSize  uint64  `json:"utxosetsize"`
+	UtxoSetHash  string  `json:"utxosethash"`
+	TotalAmount  float64 `json:"total_amount"`
+}
+
 // GetNetTotalsResult models the data returned from the getnettotals command.
 type GetNetTotalsResult struct {
 	TotalBytesRecv uint64 `json:"totalbytesrecv"`
@@ -497,6 +656,18 @@ type StateInfoChainResult struct {
 	CoinBaseAddress []string `json:"CoinBaseAddress"`
 }
 
+// ConversionAddressResult models the data returned by the chain server
+// conversionaddress command.  It holds the addresses of the supported external
+// chains which share the public key hash of a classzz address.
+type ConversionAddressResult struct {
+	DOGE string `json:"DOGE"`
+	LTC  string `json:"LTC"`
+	BTC  string `json:"BTC"`
+	BCH  string `json:"BCH"`
+	BSV  string `json:"BSV"`
+	USDT string `json:"USDT"`
+}
+
 // ConvertItemsResult models the data returned by the chain server getinfo command.
 type ConvertItemsResult struct {
 	MID              *big.Int `json:"mid"`
@@ -78,6 +78,31 @@ func NewStopNotifyNewTransactionsCmd() *StopNotifyNewTransactionsCmd {
 	return &StopNotifyNewTransactionsCmd{}
 }
 
+// NotifyCrossEventsCmd defines the notifycrossevents JSON-RPC command.
+type NotifyCrossEventsCmd struct {
+	EventTypes *[]string
+}
+
+// NewNotifyCrossEventsCmd returns a new instance which can be used to issue a
+// notifycrossevents JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewNotifyCrossEventsCmd(eventTypes *[]string) *NotifyCrossEventsCmd {
+	return &NotifyCrossEventsCmd{
+		EventTypes: eventTypes,
+	}
+}
+
+// StopNotifyCrossEventsCmd defines the stopnotifycrossevents JSON-RPC command.
+type StopNotifyCrossEventsCmd struct{}
+
+// NewStopNotifyCrossEventsCmd returns a new instance which can be used to issue
+// a stopnotifycrossevents JSON-RPC command.
+func NewStopNotifyCrossEventsCmd() *StopNotifyCrossEventsCmd {
+	return &StopNotifyCrossEventsCmd{}
+}
+
 // NotifyReceivedCmd defines the notifyreceived JSON-RPC command.
 //
 // Deprecated: Use LoadTxFilterCmd instead.
@@ -228,11 +253,13 @@ func init() {
 	MustRegisterCmd("authenticate", (*AuthenticateCmd)(nil), flags)
 	MustRegisterCmd("loadtxfilter", (*LoadTxFilterCmd)(nil), flags)
 	MustRegisterCmd("notifyblocks", (*NotifyBlocksCmd)(nil), flags)
+	MustRegisterCmd("notifycrossevents", (*NotifyCrossEventsCmd)(nil), flags)
 	MustRegisterCmd("notifynewtransactions", (*NotifyNewTransactionsCmd)(nil), flags)
 	MustRegisterCmd("notifyreceived", (*NotifyReceivedCmd)(nil), flags)
 	MustRegisterCmd("notifyspent", (*NotifySpentCmd)(nil), flags)
 	MustRegisterCmd("session", (*SessionCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyblocks", (*StopNotifyBlocksCmd)(nil), flags)
+	MustRegisterCmd("stopnotifycrossevents", (*StopNotifyCrossEventsCmd)(nil), flags)
 	MustRegisterCmd("stopnotifynewtransactions", (*StopNotifyNewTransactionsCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyspent", (*StopNotifySpentCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyreceived", (*StopNotifyReceivedCmd)(nil), flags)
@@ -63,6 +63,43 @@ func TestChainSvrWsCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"stopnotifyblocks","params":[],"id":1}`,
 			unmarshalled: &btcjson.StopNotifyBlocksCmd{},
 		},
+		{
+			name: "notifycrossevents",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifycrossevents")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNotifyCrossEventsCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifycrossevents","params":[],"id":1}`,
+			unmarshalled: &btcjson.NotifyCrossEventsCmd{
+				EventTypes: nil,
+			},
+		},
+		{
+			name: "notifycrossevents optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifycrossevents", []string{"ConvertItemCreated"})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNotifyCrossEventsCmd(&[]string{"ConvertItemCreated"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifycrossevents","params":[["ConvertItemCreated"]],"id":1}`,
+			unmarshalled: &btcjson.NotifyCrossEventsCmd{
+				EventTypes: &[]string{"ConvertItemCreated"},
+			},
+		},
+		{
+			name: "stopnotifycrossevents",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("stopnotifycrossevents")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewStopNotifyCrossEventsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"stopnotifycrossevents","params":[],"id":1}`,
+			unmarshalled: &btcjson.StopNotifyCrossEventsCmd{},
+		},
 		{
 			name: "notifynewtransactions",
 			newCmd: func() (interface{}, error) {
@@ -75,6 +75,11 @@ const (
 	// from the chain server that inform a client that a transaction that
 	// matches the loaded filter was accepted by the mempool.
 	RelevantTxAcceptedNtfnMethod = "relevanttxaccepted"
+
+	// CrossEventNtfnMethod is the method used for notifications from the
+	// chain server that a block connected to or disconnected from the main
+	// chain changed the committee state.
+	CrossEventNtfnMethod = "crossevent"
 )
 
 // BlockConnectedNtfn defines the blockconnected JSON-RPC notification.
@@ -285,6 +290,25 @@ func NewRelevantTxAcceptedNtfn(txHex string) *RelevantTxAcceptedNtfn {
 	return &RelevantTxAcceptedNtfn{Transaction: txHex}
 }
 
+// CrossEventNtfn defines the crossevent JSON-RPC notification.
+type CrossEventNtfn struct {
+	Hash    string
+	Height  int32
+	Removed bool
+	Event   CrossEventResult
+}
+
+// NewCrossEventNtfn returns a new instance which can be used to issue a
+// crossevent JSON-RPC notification.
+func NewCrossEventNtfn(hash string, height int32, removed bool, event CrossEventResult) *CrossEventNtfn {
+	return &CrossEventNtfn{
+		Hash:    hash,
+		Height:  height,
+		Removed: removed,
+		Event:   event,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable by websockets and are
 	// notifications.
@@ -301,4 +325,5 @@ func init() {
 	MustRegisterCmd(TxAcceptedNtfnMethod, (*TxAcceptedNtfn)(nil), flags)
 	MustRegisterCmd(TxAcceptedVerboseNtfnMethod, (*TxAcceptedVerboseNtfn)(nil), flags)
 	MustRegisterCmd(RelevantTxAcceptedNtfnMethod, (*RelevantTxAcceptedNtfn)(nil), flags)
+	MustRegisterCmd(CrossEventNtfnMethod, (*CrossEventNtfn)(nil), flags)
 }
@@ -225,6 +225,35 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				Transaction: "001122",
 			},
 		},
+		{
+			name: "crossevent",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("crossevent", "123", 100000, true, `{"type":"PoolBalanceChanged","poolbalance":{"address":"czz","balance":1.5}}`)
+			},
+			staticNtfn: func() interface{} {
+				event := btcjson.CrossEventResult{
+					Type: "PoolBalanceChanged",
+					PoolBalance: &btcjson.CrossPoolBalanceResult{
+						Address: "czz",
+						Balance: 1.5,
+					},
+				}
+				return btcjson.NewCrossEventNtfn("123", 100000, true, event)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"crossevent","params":["123",100000,true,{"type":"PoolBalanceChanged","poolbalance":{"address":"czz","balance":1.5}}],"id":null}`,
+			unmarshalled: &btcjson.CrossEventNtfn{
+				Hash:    "123",
+				Height:  100000,
+				Removed: true,
+				Event: btcjson.CrossEventResult{
+					Type: "PoolBalanceChanged",
+					PoolBalance: &btcjson.CrossPoolBalanceResult{
+						Address: "czz",
+						Balance: 1.5,
+					},
+				},
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
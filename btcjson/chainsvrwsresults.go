@@ -19,3 +19,20 @@ type RescannedBlock struct {
 	Hash         string   `json:"hash"`
 	Transactions []string `json:"transactions"`
 }
+
+// CrossEventResult models a change of the committee state sent with the
+// crossevent notification.  Depending on the type, one of ConvertItem, Pledge
+// and PoolBalance is set.
+type CrossEventResult struct {
+	Type        string                  `json:"type"`
+	ConvertItem *ConvertItemsResult     `json:"convertitem,omitempty"`
+	Pledge      *StateInfoChainResult   `json:"pledge,omitempty"`
+	PoolBalance *CrossPoolBalanceResult `json:"poolbalance,omitempty"`
+}
+
+// CrossPoolBalanceResult models the balance of a pool address in a crossevent
+// notification.
+type CrossPoolBalanceResult struct {
+	Address string  `json:"address"`
+	Balance float64 `json:"balance"`
+}
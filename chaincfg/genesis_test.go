@@ -89,11 +89,11 @@ func TestTestNet3GenesisBlock(t *testing.T) {
 	}
 
 	// Ensure the encoded block matches the expected bytes.
-	if !bytes.Equal(buf.Bytes(), testNet3GenesisBlockBytes) {
+	if !bytes.Equal(buf.Bytes(), testNetGenesisBlockBytes) {
 		t.Fatalf("TestTestNet3GenesisBlock: Genesis block does not "+
 			"appear valid - got %v, want %v",
 			spew.Sdump(buf.Bytes()),
-			spew.Sdump(testNet3GenesisBlockBytes))
+			spew.Sdump(testNetGenesisBlockBytes))
 	}
 
 	// Check hash of the block against expected hash.
@@ -214,9 +214,9 @@ var regTestGenesisBlockBytes = []byte{
 	0xac, 0x00, 0x00, 0x00, 0x00, /* |.....|    */
 }
 
-// testNet3GenesisBlockBytes are the wire encoded bytes for the genesis block of
+// testNetGenesisBlockBytes are the wire encoded bytes for the genesis block of
 // the test network (version 3) as of protocol version 60002.
-var testNet3GenesisBlockBytes = []byte{
+var testNetGenesisBlockBytes = []byte{
 	0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, /* |........| */
 	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, /* |........| */
 	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, /* |........| */
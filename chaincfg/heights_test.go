@@ -0,0 +1,112 @@
+package chaincfg
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// consensusPackages are the directories, relative to this package, of the
+// packages which implement consensus rules.
+var consensusPackages = []string{"../blockchain", "../cross", "../mempool",
+	"../mining"}
+
+// allowedHeightLiterals are the comparisons of heights against literals in
+// the consensus packages which do not schedule rule changes, keyed by file
+// name and literal.  Comparisons against -1, 0 and 1 are always allowed since
+// they deal with the genesis block and unset heights.
+var allowedHeightLiterals = map[string]string{
+	"chainview.go:12":     "dense block locator entries",
+	"chainio.go:64":       "depth of the cached convert transactions",
+	"chain.go:0x7fffffff": "height of unconfirmed utxos",
+}
+
+// intLiteral returns the integer literal the passed expression consists of,
+// possibly parenthesized, negated or converted, and whether there is one.
+func intLiteral(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return e.Value, e.Kind == token.INT
+	case *ast.ParenExpr:
+		return intLiteral(e.X)
+	case *ast.UnaryExpr:
+		return intLiteral(e.X)
+	case *ast.CallExpr:
+		if len(e.Args) == 1 {
+			if _, ok := e.Fun.(*ast.Ident); ok {
+				return intLiteral(e.Args[0])
+			}
+		}
+	}
+	return "", false
+}
+
+// isHeight returns whether the passed expression refers to a block height.
+func isHeight(expr ast.Expr) bool {
+	height := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if strings.HasSuffix(strings.ToLower(ident.Name), "height") {
+				height = true
+			}
+		}
+		return !height
+	})
+	return height
+}
+
+// TestNoMagicHeights ensures the consensus packages gate rule changes on the
+// upgrade schedule of the chain parameters defined in this package rather than
+// on raw heights.
+func TestNoMagicHeights(t *testing.T) {
+	fset := token.NewFileSet()
+	for _, dir := range consensusPackages {
+		pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+			return !strings.HasSuffix(fi.Name(), "_test.go")
+		}, 0)
+		if err != nil {
+			t.Fatalf("ParseDir %s: %v", dir, err)
+		}
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Files {
+				ast.Inspect(file, func(n ast.Node) bool {
+					expr, ok := n.(*ast.BinaryExpr)
+					if !ok {
+						return true
+					}
+					switch expr.Op {
+					case token.EQL, token.NEQ, token.LSS, token.LEQ,
+						token.GTR, token.GEQ:
+					default:
+						return true
+					}
+					lit, ok := intLiteral(expr.Y)
+					other := expr.X
+					if !ok {
+						lit, ok = intLiteral(expr.X)
+						other = expr.Y
+					}
+					if !ok || !isHeight(other) {
+						return true
+					}
+					switch lit {
+					case "0", "1":
+						return true
+					}
+					pos := fset.Position(expr.Pos())
+					key := filepath.Base(pos.Filename) + ":" + lit
+					if _, ok := allowedHeightLiterals[key]; !ok {
+						t.Errorf("%s: height compared against %s, "+
+							"use the upgrade schedule of the chain "+
+							"parameters", pos, lit)
+					}
+					return true
+				})
+			}
+		}
+	}
+}
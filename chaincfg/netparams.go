@@ -0,0 +1,593 @@
+package chaincfg
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+)
+
+// upgradeNames maps the names of the upgrades in network parameter files to
+// their IDs.
+var upgradeNames = map[string]UpgradeID{
+	"entangle":      UpgradeEntangle,
+	"beacon":        UpgradeBeacon,
+	"maui":          UpgradeMaui,
+	"castingamount": UpgradeCastingAmount,
+	"subsidyend":    UpgradeSubsidyEnd,
+	"asert":         UpgradeAsert,
+	"committee":     UpgradeCommittee,
+	"poolmultisig":  UpgradePoolMultisig,
+	"postsubsidy":   UpgradePostSubsidy,
+}
+
+// postSubsidyPolicyNames maps the names of the post-subsidy policies in
+// network parameter files to the policies.
+var postSubsidyPolicyNames = map[string]PostSubsidyPolicy{
+	"none":         PostSubsidyNone,
+	"tailemission": PostSubsidyTailEmission,
+	"convertfees":  PostSubsidyConvertFees,
+}
+
+// deploymentNames maps the names of the deployments in network parameter
+// files to their IDs.
+var deploymentNames = map[string]int{
+	"testdummy": DeploymentTestDummy,
+	"csv":       DeploymentCSV,
+	"seq":       DeploymentSEQ,
+}
+
+// GenesisFile describes the genesis block of a network in a network
+// parameter file.  The block consists of a coinbase transaction without
+// outputs whose signature script is the message.
+type GenesisFile struct {
+	Message   string `json:"message" toml:"message"`
+	Timestamp int64  `json:"timestamp" toml:"timestamp"`
+	Bits      uint32 `json:"bits" toml:"bits"`
+	Nonce     uint64 `json:"nonce" toml:"nonce"`
+
+	// Hash is the expected hash of the genesis block.  It is checked
+	// when set.
+	Hash string `json:"hash,omitempty" toml:"hash,omitempty"`
+}
+
+// DNSSeedFile describes a DNS seed in a network parameter file.
+type DNSSeedFile struct {
+	Host         string `json:"host" toml:"host"`
+	HasFiltering bool   `json:"hasfiltering" toml:"hasfiltering"`
+}
+
+// CheckpointFile describes a checkpoint in a network parameter file.
+type CheckpointFile struct {
+	Height int32  `json:"height" toml:"height"`
+	Hash   string `json:"hash" toml:"hash"`
+}
+
+// AsertAnchorFile describes the anchor block of the ASERT difficulty
+// adjustment algorithm in a network parameter file.
+type AsertAnchorFile struct {
+	Height     int32  `json:"height" toml:"height"`
+	Bits       uint32 `json:"bits" toml:"bits"`
+	ParentTime int64  `json:"parenttime" toml:"parenttime"`
+}
+
+// DeploymentFile describes a rule change deployment in a network parameter
+// file.
+type DeploymentFile struct {
+	BitNumber  uint8  `json:"bitnumber" toml:"bitnumber"`
+	StartTime  uint64 `json:"starttime" toml:"starttime"`
+	ExpireTime uint64 `json:"expiretime" toml:"expiretime"`
+}
+
+// NetParamsFile is the JSON or TOML representation of the parameters of a
+// custom network, such as a private development network.  Durations are
+// strings accepted by time.ParseDuration, amounts are in the smallest unit,
+// the proof of work limit is hex encoded and so are the HD key IDs.
+//
+// Upgrades are keyed by entangle, beacon, maui, castingamount, subsidyend,
+// asert, committee, poolmultisig and postsubsidy and never activate when
+// missing.  The post-subsidy policy is none, tailemission or convertfees.
+// Deployments are keyed by testdummy, csv and seq and are always available for
+// vote when missing.
+type NetParamsFile struct {
+	Name        string        `json:"name" toml:"name"`
+	Net         uint32        `json:"net" toml:"net"`
+	DefaultPort string        `json:"defaultport" toml:"defaultport"`
+	DNSSeeds    []DNSSeedFile `json:"dnsseeds" toml:"dnsseeds"`
+
+	// RPCPort and GRPCPort are the default ports of the RPC servers of
+	// the network.  They are not part of Params.
+	RPCPort  string `json:"rpcport" toml:"rpcport"`
+	GRPCPort string `json:"grpcport" toml:"grpcport"`
+
+	Genesis GenesisFile `json:"genesis" toml:"genesis"`
+
+	PowLimit                 string `json:"powlimit" toml:"powlimit"`
+	PowLimitBits             uint32 `json:"powlimitbits" toml:"powlimitbits"`
+	CoinbaseMaturity         uint16 `json:"coinbasematurity" toml:"coinbasematurity"`
+	SubsidyReductionInterval int32  `json:"subsidyreductioninterval" toml:"subsidyreductioninterval"`
+	TargetTimespan           string `json:"targettimespan" toml:"targettimespan"`
+	TargetTimePerBlock       string `json:"targettimeperblock" toml:"targettimeperblock"`
+	RetargetAdjustmentFactor int64  `json:"retargetadjustmentfactor" toml:"retargetadjustmentfactor"`
+	ReduceMinDifficulty      bool   `json:"reducemindifficulty" toml:"reducemindifficulty"`
+	NoDifficultyAdjustment   bool   `json:"nodifficultyadjustment" toml:"nodifficultyadjustment"`
+	MinDiffReductionTime     string `json:"mindiffreductiontime" toml:"mindiffreductiontime"`
+	GenerateSupported        bool   `json:"generatesupported" toml:"generatesupported"`
+
+	AsertHalfLife string           `json:"aserthalflife" toml:"aserthalflife"`
+	AsertAnchor   *AsertAnchorFile `json:"asertanchor" toml:"asertanchor"`
+
+	MinStakingAmount    int64 `json:"minstakingamount" toml:"minstakingamount"`
+	MinAddStakingAmount int64 `json:"minaddstakingamount" toml:"minaddstakingamount"`
+
+	CommitteeEpochLength int32 `json:"committeeepochlength" toml:"committeeepochlength"`
+	CommitteeSize        int32 `json:"committeesize" toml:"committeesize"`
+	CommitteeBackups     int32 `json:"committeebackups" toml:"committeebackups"`
+	CommitteeThreshold   int32 `json:"committeethreshold" toml:"committeethreshold"`
+
+	PostSubsidyPolicy string `json:"postsubsidypolicy" toml:"postsubsidypolicy"`
+	TailEmission      int64  `json:"tailemission" toml:"tailemission"`
+	ConvertFeeShare   int64  `json:"convertfeeshare" toml:"convertfeeshare"`
+
+	Upgrades    map[string]int32 `json:"upgrades" toml:"upgrades"`
+	Checkpoints []CheckpointFile `json:"checkpoints" toml:"checkpoints"`
+
+	RuleChangeActivationThreshold uint32                    `json:"rulechangeactivationthreshold" toml:"rulechangeactivationthreshold"`
+	MinerConfirmationWindow       uint32                    `json:"minerconfirmationwindow" toml:"minerconfirmationwindow"`
+	Deployments                   map[string]DeploymentFile `json:"deployments" toml:"deployments"`
+
+	RelayNonStdTxs bool `json:"relaynonstdtxs" toml:"relaynonstdtxs"`
+
+	CashAddressPrefix      string `json:"cashaddressprefix" toml:"cashaddressprefix"`
+	LegacyPubKeyHashAddrID byte   `json:"legacypubkeyhashaddrid" toml:"legacypubkeyhashaddrid"`
+	LegacyScriptHashAddrID byte   `json:"legacyscripthashaddrid" toml:"legacyscripthashaddrid"`
+	PrivateKeyID           byte   `json:"privatekeyid" toml:"privatekeyid"`
+	HDPrivateKeyID         string `json:"hdprivatekeyid" toml:"hdprivatekeyid"`
+	HDPublicKeyID          string `json:"hdpublickeyid" toml:"hdpublickeyid"`
+	HDCoinType             uint32 `json:"hdcointype" toml:"hdcointype"`
+}
+
+// IsTOML returns whether the passed network parameter file is TOML rather
+// than JSON, judging by its extension.
+func IsTOML(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".toml")
+}
+
+// LoadNetParamsFile reads a network parameter file.  Files with the .toml
+// extension are decoded as TOML and all others as JSON.  Unknown fields are
+// rejected to catch typos.
+func LoadNetParamsFile(path string) (*NetParamsFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f NetParamsFile
+	if IsTOML(path) {
+		md, err := toml.Decode(string(data), &f)
+		if err != nil {
+			return nil, fmt.Errorf("malformed network parameter file "+
+				"%s: %v", path, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) != 0 {
+			return nil, fmt.Errorf("unknown field %s in network "+
+				"parameter file %s", undecoded[0], path)
+		}
+		return &f, nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("malformed network parameter file %s: %v",
+			path, err)
+	}
+	return &f, nil
+}
+
+// GenesisBlock returns the genesis block described by the file.
+func (f *NetParamsFile) GenesisBlock() *wire.MsgBlock {
+	coinbase := &wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{
+					Hash:  chainhash.Hash{},
+					Index: 0xffffffff,
+				},
+				SignatureScript: []byte(f.Genesis.Message),
+				Sequence:        0xffffffff,
+			},
+		},
+		TxOut:    []*wire.TxOut{},
+		LockTime: 0,
+	}
+
+	// The merkle root of a block with a single transaction is the hash of
+	// that transaction.
+	return &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    1,
+			PrevBlock:  chainhash.Hash{},
+			MerkleRoot: coinbase.TxHash(),
+			CIDRoot:    chainhash.Hash{},
+			Timestamp:  time.Unix(f.Genesis.Timestamp, 0),
+			Bits:       f.Genesis.Bits,
+			Nonce:      f.Genesis.Nonce,
+		},
+		Transactions: []*wire.MsgTx{coinbase},
+	}
+}
+
+// parseDuration parses the passed duration of the named field.
+func parseDuration(field, s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", field, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be positive", field)
+	}
+	return d, nil
+}
+
+// isPort returns whether the passed string is a valid port number.
+func isPort(s string) bool {
+	n, err := strconv.ParseUint(s, 10, 16)
+	return err == nil && n != 0
+}
+
+// parseHDKeyID parses the passed hex encoded HD key ID of the named field.
+func parseHDKeyID(field, s string) ([4]byte, error) {
+	var id [4]byte
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(id) {
+		return id, fmt.Errorf("invalid %s: must be 4 hex encoded bytes",
+			field)
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// Params validates the file and returns the network parameters it describes.
+// The network is not registered.
+func (f *NetParamsFile) Params() (*Params, error) {
+	if f.Name == "" {
+		return nil, fmt.Errorf("missing name")
+	}
+	for _, p := range []*Params{&MainNetParams, &TestNetParams,
+		&RegressionNetParams, &SimNetParams} {
+
+		if f.Name == p.Name {
+			return nil, fmt.Errorf("name %s is taken by a default "+
+				"network", f.Name)
+		}
+	}
+	if f.Net == 0 {
+		return nil, fmt.Errorf("missing net")
+	}
+	if !isPort(f.DefaultPort) {
+		return nil, fmt.Errorf("invalid defaultport %q", f.DefaultPort)
+	}
+	if f.RPCPort != "" && !isPort(f.RPCPort) {
+		return nil, fmt.Errorf("invalid rpcport %q", f.RPCPort)
+	}
+	if f.GRPCPort != "" && !isPort(f.GRPCPort) {
+		return nil, fmt.Errorf("invalid grpcport %q", f.GRPCPort)
+	}
+
+	p := &Params{
+		Name:                          f.Name,
+		Net:                           wire.BitcoinNet(f.Net),
+		DefaultPort:                   f.DefaultPort,
+		PowLimitBits:                  f.PowLimitBits,
+		CoinbaseMaturity:              f.CoinbaseMaturity,
+		SubsidyReductionInterval:      f.SubsidyReductionInterval,
+		RetargetAdjustmentFactor:      f.RetargetAdjustmentFactor,
+		ReduceMinDifficulty:           f.ReduceMinDifficulty,
+		NoDifficultyAdjustment:        f.NoDifficultyAdjustment,
+		GenerateSupported:             f.GenerateSupported,
+		MinStakingAmount:              big.NewInt(f.MinStakingAmount),
+		MinAddStakingAmount:           big.NewInt(f.MinAddStakingAmount),
+		CommitteeEpochLength:          f.CommitteeEpochLength,
+		CommitteeSize:                 f.CommitteeSize,
+		CommitteeBackups:              f.CommitteeBackups,
+		CommitteeThreshold:            f.CommitteeThreshold,
+		TailEmission:                  f.TailEmission,
+		ConvertFeeShare:               f.ConvertFeeShare,
+		Upgrades:                      make(map[UpgradeID]int32),
+		RuleChangeActivationThreshold: f.RuleChangeActivationThreshold,
+		MinerConfirmationWindow:       f.MinerConfirmationWindow,
+		RelayNonStdTxs:                f.RelayNonStdTxs,
+		CashAddressPrefix:             f.CashAddressPrefix,
+		LegacyPubKeyHashAddrID:        f.LegacyPubKeyHashAddrID,
+		LegacyScriptHashAddrID:        f.LegacyScriptHashAddrID,
+		PrivateKeyID:                  f.PrivateKeyID,
+		HDCoinType:                    f.HDCoinType,
+	}
+	for _, seed := range f.DNSSeeds {
+		if seed.Host == "" {
+			return nil, fmt.Errorf("DNS seed without a host")
+		}
+		p.DNSSeeds = append(p.DNSSeeds, DNSSeed(seed))
+	}
+
+	genesis := f.GenesisBlock()
+	genesisHash := genesis.BlockHash()
+	if f.Genesis.Hash != "" {
+		hash, err := chainhash.NewHashFromStr(f.Genesis.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis hash: %v", err)
+		}
+		if *hash != genesisHash {
+			return nil, fmt.Errorf("genesis hash %v does not match the "+
+				"hash %v of the described block", hash, genesisHash)
+		}
+	}
+	p.GenesisBlock = genesis
+	p.GenesisHash = &genesisHash
+
+	powLimit, ok := new(big.Int).SetString(f.PowLimit, 16)
+	if !ok || powLimit.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid powlimit %q", f.PowLimit)
+	}
+	p.PowLimit = powLimit
+	if f.PowLimitBits == 0 {
+		return nil, fmt.Errorf("missing powlimitbits")
+	}
+	if f.Genesis.Bits == 0 {
+		return nil, fmt.Errorf("missing genesis bits")
+	}
+	if f.SubsidyReductionInterval <= 0 {
+		return nil, fmt.Errorf("subsidyreductioninterval must be positive")
+	}
+	if f.RetargetAdjustmentFactor <= 0 {
+		return nil, fmt.Errorf("retargetadjustmentfactor must be positive")
+	}
+
+	var err error
+	if p.TargetTimespan, err = parseDuration("targettimespan", f.TargetTimespan); err != nil {
+		return nil, err
+	}
+	if p.TargetTimePerBlock, err = parseDuration("targettimeperblock", f.TargetTimePerBlock); err != nil {
+		return nil, err
+	}
+	if f.ReduceMinDifficulty {
+		p.MinDiffReductionTime, err = parseDuration("mindiffreductiontime",
+			f.MinDiffReductionTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.MinStakingAmount <= 0 || f.MinAddStakingAmount <= 0 {
+		return nil, fmt.Errorf("minstakingamount and minaddstakingamount " +
+			"must be positive")
+	}
+
+	for name, height := range f.Upgrades {
+		id, ok := upgradeNames[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown upgrade %q", name)
+		}
+		if height < 1 {
+			return nil, fmt.Errorf("upgrade %s must activate after the "+
+				"genesis block", name)
+		}
+		p.Upgrades[id] = height
+	}
+	if p.UpgradeHeight(UpgradeEntangle) > p.UpgradeHeight(UpgradeBeacon) ||
+		p.UpgradeHeight(UpgradeBeacon) > p.UpgradeHeight(UpgradeMaui) {
+
+		return nil, fmt.Errorf("upgrades entangle, beacon and maui must " +
+			"activate in that order")
+	}
+	if err := f.parseAsert(p); err != nil {
+		return nil, err
+	}
+	if err := checkCommittee(p); err != nil {
+		return nil, err
+	}
+	if p.UpgradeHeight(UpgradePoolMultisig) < p.UpgradeHeight(UpgradeCommittee) {
+		return nil, fmt.Errorf("upgrade poolmultisig must not activate " +
+			"before committee")
+	}
+	if err := f.parsePostSubsidy(p); err != nil {
+		return nil, err
+	}
+
+	for i, checkpoint := range f.Checkpoints {
+		hash, err := chainhash.NewHashFromStr(checkpoint.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hash of checkpoint %d: %v",
+				checkpoint.Height, err)
+		}
+		if i > 0 && checkpoint.Height <= p.Checkpoints[i-1].Height {
+			return nil, fmt.Errorf("checkpoints must be ordered by " +
+				"increasing height")
+		}
+		p.Checkpoints = append(p.Checkpoints, Checkpoint{
+			Height: checkpoint.Height,
+			Hash:   hash,
+		})
+	}
+
+	if f.MinerConfirmationWindow == 0 {
+		return nil, fmt.Errorf("missing minerconfirmationwindow")
+	}
+	if f.RuleChangeActivationThreshold == 0 ||
+		f.RuleChangeActivationThreshold > f.MinerConfirmationWindow {
+
+		return nil, fmt.Errorf("rulechangeactivationthreshold must be " +
+			"between 1 and minerconfirmationwindow")
+	}
+	for i := range p.Deployments {
+		p.Deployments[i] = ConsensusDeployment{
+			StartTime:  0,
+			ExpireTime: math.MaxInt64,
+		}
+	}
+	for name, deployment := range f.Deployments {
+		id, ok := deploymentNames[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown deployment %q", name)
+		}
+		p.Deployments[id] = ConsensusDeployment(deployment)
+	}
+
+	if f.CashAddressPrefix == "" ||
+		f.CashAddressPrefix != strings.ToLower(f.CashAddressPrefix) {
+
+		return nil, fmt.Errorf("cashaddressprefix must be a non-empty " +
+			"lower case string")
+	}
+	if IsCashAddressPrefix(f.CashAddressPrefix + ":") {
+		return nil, fmt.Errorf("cashaddressprefix %s is taken by "+
+			"another network", f.CashAddressPrefix)
+	}
+	if p.HDPrivateKeyID, err = parseHDKeyID("hdprivatekeyid", f.HDPrivateKeyID); err != nil {
+		return nil, err
+	}
+	if p.HDPublicKeyID, err = parseHDKeyID("hdpublickeyid", f.HDPublicKeyID); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// parseAsert sets the ASERT difficulty adjustment parameters of the passed
+// network parameters, which must already have their upgrades set.
+func (f *NetParamsFile) parseAsert(p *Params) error {
+	activation, ok := p.Upgrades[UpgradeAsert]
+	if !ok {
+		if f.AsertHalfLife != "" || f.AsertAnchor != nil {
+			return fmt.Errorf("aserthalflife and asertanchor require " +
+				"the asert upgrade")
+		}
+		return nil
+	}
+
+	var err error
+	p.AsertHalfLife, err = parseDuration("aserthalflife", f.AsertHalfLife)
+	if err != nil {
+		return err
+	}
+	if p.AsertHalfLife%time.Second != 0 {
+		return fmt.Errorf("invalid aserthalflife: must be whole seconds")
+	}
+	if p.TargetTimePerBlock%time.Second != 0 {
+		return fmt.Errorf("invalid targettimeperblock: asert requires " +
+			"whole seconds")
+	}
+
+	if f.AsertAnchor == nil {
+		// The anchor is the block before activation, whose parent
+		// timestamp is part of the schedule.
+		if activation < 2 {
+			return fmt.Errorf("upgrade asert must activate after " +
+				"block 1 without an asertanchor")
+		}
+		return nil
+	}
+	anchor := AsertAnchor(*f.AsertAnchor)
+	if anchor.Height < 1 || anchor.Height >= activation {
+		return fmt.Errorf("asertanchor height must be between 1 and " +
+			"the asert activation height")
+	}
+	target := chainhash.CompactToBig(anchor.Bits)
+	if target.Sign() <= 0 || target.Cmp(p.PowLimit) > 0 {
+		return fmt.Errorf("asertanchor bits %08x are not within the "+
+			"powlimit", anchor.Bits)
+	}
+	p.AsertAnchor = &anchor
+	return nil
+}
+
+// checkCommittee validates the committee parameters of the passed network
+// parameters, which must already have their upgrades set.
+func checkCommittee(p *Params) error {
+	activation, ok := p.Upgrades[UpgradeCommittee]
+	if !ok {
+		return nil
+	}
+
+	// Committees are elected from the pledges of the committee state,
+	// which starts with maui.
+	if activation <= p.UpgradeHeight(UpgradeMaui) {
+		return fmt.Errorf("upgrade committee must activate after maui")
+	}
+	if p.CommitteeEpochLength <= 0 || p.CommitteeSize <= 0 {
+		return fmt.Errorf("committeeepochlength and committeesize must " +
+			"be positive")
+	}
+	if p.CommitteeBackups < 0 {
+		return fmt.Errorf("committeebackups must not be negative")
+	}
+	if p.CommitteeThreshold < 1 || p.CommitteeThreshold > p.CommitteeSize {
+		return fmt.Errorf("committeethreshold must be between 1 and " +
+			"committeesize")
+	}
+	return nil
+}
+
+// parsePostSubsidy sets the post-subsidy policy of the passed network
+// parameters, which must already have their upgrades set.
+func (f *NetParamsFile) parsePostSubsidy(p *Params) error {
+	policy := PostSubsidyNone
+	if f.PostSubsidyPolicy != "" {
+		var ok bool
+		policy, ok = postSubsidyPolicyNames[f.PostSubsidyPolicy]
+		if !ok {
+			return fmt.Errorf("unknown postsubsidypolicy %q",
+				f.PostSubsidyPolicy)
+		}
+	}
+	p.PostSubsidyPolicy = policy
+
+	activation, ok := p.Upgrades[UpgradePostSubsidy]
+	if !ok {
+		if policy != PostSubsidyNone {
+			return fmt.Errorf("postsubsidypolicy requires the " +
+				"postsubsidy upgrade")
+		}
+		return nil
+	}
+	if activation < p.UpgradeHeight(UpgradeSubsidyEnd) {
+		return fmt.Errorf("upgrade postsubsidy must not activate " +
+			"before subsidyend")
+	}
+
+	switch policy {
+	case PostSubsidyNone:
+		return fmt.Errorf("upgrade postsubsidy requires a " +
+			"postsubsidypolicy")
+	case PostSubsidyTailEmission:
+		if p.TailEmission <= 0 {
+			return fmt.Errorf("tailemission must be positive")
+		}
+	case PostSubsidyConvertFees:
+		// Conversions are only validated from maui on.
+		if activation < p.UpgradeHeight(UpgradeMaui) {
+			return fmt.Errorf("postsubsidypolicy convertfees " +
+				"requires maui to activate before postsubsidy")
+		}
+
+		// The conversion fees are taken from the fee pool output of
+		// the coinbase, so at most all of them are paid.
+		if p.ConvertFeeShare < 1 || p.ConvertFeeShare > 100 {
+			return fmt.Errorf("convertfeeshare must be between 1 " +
+				"and 100")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package chaincfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// devNetTOML is a valid network parameter file in TOML.
+const devNetTOML = `
+name = "devnet"
+net = 0x44455631
+defaultport = "18887"
+rpcport = "8774"
+
+powlimit = "7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+powlimitbits = 0x207fffff
+coinbasematurity = 14
+subsidyreductioninterval = 1000000
+targettimespan = "336h"
+targettimeperblock = "30s"
+retargetadjustmentfactor = 4
+generatesupported = true
+minstakingamount = 10000000000
+minaddstakingamount = 10000000000
+rulechangeactivationthreshold = 75
+minerconfirmationwindow = 100
+aserthalflife = "1h"
+committeeepochlength = 20
+committeesize = 3
+committeebackups = 1
+committeethreshold = 2
+postsubsidypolicy = "convertfees"
+convertfeeshare = 50
+cashaddressprefix = "czzdev"
+legacypubkeyhashaddrid = 0x3f
+legacyscripthashaddrid = 0x7b
+privatekeyid = 0x64
+hdprivatekeyid = "0420b900"
+hdpublickeyid = "0420bd3a"
+hdcointype = 115
+
+[genesis]
+message = "classzz devnet"
+timestamp = 1792374049
+bits = 0x207fffff
+nonce = 2
+hash = "d190385a0867d398f782698cfa8c42af6befbf4c0c5ae15ed88e026d6b8583e7"
+
+[upgrades]
+entangle = 10
+beacon = 12
+maui = 25
+asert = 30
+committee = 40
+poolmultisig = 40
+subsidyend = 50
+postsubsidy = 60
+
+[deployments.csv]
+bitnumber = 0
+starttime = 0
+expiretime = 1000
+
+[[checkpoints]]
+height = 10
+hash = "0000000000000000000000000000000000000000000000000000000000000001"
+`
+
+// writeTempFile writes the passed data to a file with the passed name in a
+// temporary directory and returns its path.
+func writeTempFile(t *testing.T, name, data string) string {
+	dir, err := ioutil.TempDir("", "netparams")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+// TestNetParamsFile ensures network parameter files are decoded into the
+// parameters they describe.
+func TestNetParamsFile(t *testing.T) {
+	path := writeTempFile(t, "devnet.toml", devNetTOML)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	f, err := LoadNetParamsFile(path)
+	if err != nil {
+		t.Fatalf("LoadNetParamsFile: %v", err)
+	}
+	p, err := f.Params()
+	if err != nil {
+		t.Fatalf("Params: %v", err)
+	}
+
+	if p.Name != "devnet" || p.Net != 0x44455631 || f.RPCPort != "8774" {
+		t.Errorf("unexpected network %s %v rpc port %s", p.Name, p.Net,
+			f.RPCPort)
+	}
+	if p.GenesisHash.String() != f.Genesis.Hash ||
+		p.GenesisBlock.BlockHash() != *p.GenesisHash {
+
+		t.Errorf("unexpected genesis hash %v", p.GenesisHash)
+	}
+	if p.TargetTimePerBlock != 30*time.Second {
+		t.Errorf("unexpected target time per block %v",
+			p.TargetTimePerBlock)
+	}
+	if p.AsertHalfLife != time.Hour || p.AsertAnchor != nil {
+		t.Errorf("unexpected ASERT half-life %v anchor %v",
+			p.AsertHalfLife, p.AsertAnchor)
+	}
+	if p.CommitteeEpochLength != 20 || p.CommitteeSize != 3 ||
+		p.CommitteeBackups != 1 || p.CommitteeThreshold != 2 {
+
+		t.Errorf("unexpected committee epoch length %d size %d backups "+
+			"%d threshold %d", p.CommitteeEpochLength, p.CommitteeSize,
+			p.CommitteeBackups, p.CommitteeThreshold)
+	}
+	if !p.IsActive(UpgradeMaui, 25) || p.IsActive(UpgradeMaui, 24) ||
+		!p.IsActive(UpgradePoolMultisig, 40) ||
+		p.IsActive(UpgradeSubsidyEnd, 49) ||
+		!p.IsActive(UpgradePostSubsidy, 60) {
+
+		t.Errorf("unexpected upgrade schedule %v", p.Upgrades)
+	}
+	if p.PostSubsidyPolicy != PostSubsidyConvertFees ||
+		p.ConvertFeeShare != 50 || p.TailEmission != 0 {
+
+		t.Errorf("unexpected post-subsidy policy %v share %d tail "+
+			"emission %d", p.PostSubsidyPolicy, p.ConvertFeeShare,
+			p.TailEmission)
+	}
+	if p.Deployments[DeploymentCSV].ExpireTime != 1000 ||
+		p.Deployments[DeploymentSEQ].ExpireTime == 0 {
+
+		t.Errorf("unexpected deployments %v", p.Deployments)
+	}
+	if len(p.Checkpoints) != 1 || p.Checkpoints[0].Height != 10 {
+		t.Errorf("unexpected checkpoints %v", p.Checkpoints)
+	}
+	if p.HDPrivateKeyID != [4]byte{0x04, 0x20, 0xb9, 0x00} {
+		t.Errorf("unexpected HD private key ID %x", p.HDPrivateKeyID)
+	}
+
+	// The same parameters in JSON.
+	jsonPath := writeTempFile(t, "devnet.json", `{
+		"name": "devnet", "net": 1145394737, "defaultport": "18887",
+		"genesis": {"message": "classzz devnet", "timestamp": 1792374049,
+			"bits": 545259519, "nonce": 2},
+		"powlimit": "7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"powlimitbits": 545259519, "subsidyreductioninterval": 1000000,
+		"targettimespan": "336h", "targettimeperblock": "30s",
+		"retargetadjustmentfactor": 4, "minstakingamount": 1,
+		"minaddstakingamount": 1, "rulechangeactivationthreshold": 75,
+		"minerconfirmationwindow": 100, "cashaddressprefix": "czzdev",
+		"hdprivatekeyid": "0420b900", "hdpublickeyid": "0420bd3a"
+	}`)
+	defer os.RemoveAll(filepath.Dir(jsonPath))
+	jsonFile, err := LoadNetParamsFile(jsonPath)
+	if err != nil {
+		t.Fatalf("LoadNetParamsFile: %v", err)
+	}
+	jsonParams, err := jsonFile.Params()
+	if err != nil {
+		t.Fatalf("Params: %v", err)
+	}
+	if *jsonParams.GenesisHash != *p.GenesisHash {
+		t.Errorf("JSON genesis hash %v, want %v", jsonParams.GenesisHash,
+			p.GenesisHash)
+	}
+
+	// Unknown fields are rejected.
+	for name, data := range map[string]string{
+		"typo.toml": devNetTOML + "\nbogus = 1\n",
+		"typo.json": `{"name": "devnet", "bogus": 1}`,
+	} {
+		path := writeTempFile(t, name, data)
+		defer os.RemoveAll(filepath.Dir(path))
+		if _, err := LoadNetParamsFile(path); err == nil {
+			t.Errorf("%s: unknown field was accepted", name)
+		}
+	}
+}
+
+// TestNetParamsFileValidation ensures invalid network parameters are rejected.
+func TestNetParamsFileValidation(t *testing.T) {
+	path := writeTempFile(t, "devnet.toml", devNetTOML)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	tests := []struct {
+		name   string
+		modify func(f *NetParamsFile)
+		err    string
+	}{
+		{"default name", func(f *NetParamsFile) { f.Name = "simnet" }, "taken"},
+		{"bad port", func(f *NetParamsFile) { f.DefaultPort = "x" }, "defaultport"},
+		{"genesis mismatch", func(f *NetParamsFile) { f.Genesis.Nonce++ }, "genesis hash"},
+		{"bad powlimit", func(f *NetParamsFile) { f.PowLimit = "zz" }, "powlimit"},
+		{"bad duration", func(f *NetParamsFile) { f.TargetTimespan = "1" }, "targettimespan"},
+		{"unknown upgrade", func(f *NetParamsFile) { f.Upgrades["bogus"] = 1 }, "unknown upgrade"},
+		{"upgrade order", func(f *NetParamsFile) { f.Upgrades["beacon"] = 30 }, "order"},
+		{"asert without half-life", func(f *NetParamsFile) { f.AsertHalfLife = "" }, "aserthalflife"},
+		{"half-life without asert", func(f *NetParamsFile) { delete(f.Upgrades, "asert") }, "require"},
+		{"asert anchor height", func(f *NetParamsFile) {
+			f.AsertAnchor = &AsertAnchorFile{Height: 30, Bits: 0x207fffff}
+		}, "asertanchor"},
+		{"committee before maui", func(f *NetParamsFile) { f.Upgrades["committee"] = 25 }, "after maui"},
+		{"committee without epochs", func(f *NetParamsFile) { f.CommitteeEpochLength = 0 }, "committeeepochlength"},
+		{"committee threshold", func(f *NetParamsFile) { f.CommitteeThreshold = 4 }, "committeethreshold"},
+		{"pool multisig before committee", func(f *NetParamsFile) { f.Upgrades["poolmultisig"] = 39 }, "poolmultisig"},
+		{"pool multisig without committee", func(f *NetParamsFile) { delete(f.Upgrades, "committee") }, "poolmultisig"},
+		{"unknown post-subsidy policy", func(f *NetParamsFile) { f.PostSubsidyPolicy = "bogus" }, "postsubsidypolicy"},
+		{"post-subsidy policy without upgrade", func(f *NetParamsFile) { delete(f.Upgrades, "postsubsidy") }, "requires the postsubsidy"},
+		{"postsubsidy before subsidyend", func(f *NetParamsFile) { f.Upgrades["postsubsidy"] = 49 }, "subsidyend"},
+		{"postsubsidy without policy", func(f *NetParamsFile) { f.PostSubsidyPolicy = "none" }, "requires a postsubsidypolicy"},
+		{"convert fees before maui", func(f *NetParamsFile) {
+			f.Upgrades["maui"] = 70
+			delete(f.Upgrades, "committee")
+			delete(f.Upgrades, "poolmultisig")
+		}, "requires maui"},
+		{"convert fee share", func(f *NetParamsFile) { f.ConvertFeeShare = 101 }, "convertfeeshare"},
+		{"tail emission", func(f *NetParamsFile) { f.PostSubsidyPolicy = "tailemission" }, "tailemission"},
+		{"checkpoint order", func(f *NetParamsFile) {
+			f.Checkpoints = append(f.Checkpoints, f.Checkpoints[0])
+		}, "checkpoints"},
+		{"threshold", func(f *NetParamsFile) { f.RuleChangeActivationThreshold = 101 }, "threshold"},
+		{"unknown deployment", func(f *NetParamsFile) {
+			f.Deployments["bogus"] = DeploymentFile{}
+		}, "unknown deployment"},
+		{"taken prefix", func(f *NetParamsFile) { f.CashAddressPrefix = "czzsim" }, "taken"},
+		{"bad hd key", func(f *NetParamsFile) { f.HDPublicKeyID = "0420" }, "hdpublickeyid"},
+	}
+	for _, test := range tests {
+		f, err := LoadNetParamsFile(path)
+		if err != nil {
+			t.Fatalf("LoadNetParamsFile: %v", err)
+		}
+		test.modify(f)
+		_, err = f.Params()
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v, want one containing %q",
+				test.name, err, test.err)
+		}
+	}
+}
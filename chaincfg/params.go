@@ -55,6 +55,20 @@ type Checkpoint struct {
 	UtxoSetSize    uint32
 }
 
+// AsertAnchor identifies the block the ASERT difficulty adjustment algorithm
+// schedules the following blocks from.
+type AsertAnchor struct {
+	// Height is the height of the anchor block.
+	Height int32
+
+	// Bits is the difficulty target of the anchor block in compact form.
+	Bits uint32
+
+	// ParentTime is the timestamp of the parent of the anchor block in
+	// seconds since the Unix epoch.
+	ParentTime int64
+}
+
 // DNSSeed identifies a DNS seed.
 type DNSSeed struct {
 	// Host defines the hostname of the seed.
@@ -176,6 +190,16 @@ type Params struct {
 	// NOTE: This only applies if ReduceMinDifficulty is true.
 	MinDiffReductionTime time.Duration
 
+	// AsertHalfLife is the time the ASERT difficulty adjustment algorithm
+	// takes to double or halve the difficulty when blocks are that much
+	// ahead of or behind schedule.  The algorithm activates with
+	// UpgradeAsert.
+	AsertHalfLife time.Duration
+
+	// AsertAnchor is the block the ASERT schedule starts from.  When nil,
+	// the last block before UpgradeAsert activates is the anchor.
+	AsertAnchor *AsertAnchor
+
 	// GenerateSupported specifies whether or not CPU mining is allowed.
 	GenerateSupported bool
 
@@ -183,11 +207,33 @@ type Params struct {
 
 	MinAddStakingAmount *big.Int
 
-	EntangleHeight int32
-
-	BeaconHeight int32
-
-	MauiHeight int32
+	// CommitteeEpochLength is the number of blocks a committee serves
+	// for.  From UpgradeCommittee on, the pledges with the largest stakes
+	// are elected as the committee at the first block of every epoch.
+	CommitteeEpochLength int32
+
+	// CommitteeSize is the number of members of a committee and
+	// CommitteeBackups the number of backup members elected after them.
+	CommitteeSize    int32
+	CommitteeBackups int32
+
+	// CommitteeThreshold is the number of members which must sign a
+	// ConvertConfirm transaction, or all members when the committee has
+	// fewer.
+	CommitteeThreshold int32
+
+	// PostSubsidyPolicy is how miners are paid on top of the transaction
+	// fees once the block subsidy has ended, from UpgradePostSubsidy on.
+	// TailEmission is the amount paid every block by PostSubsidyTailEmission
+	// and ConvertFeeShare the percentage of the conversion fees of the block
+	// paid by PostSubsidyConvertFees.
+	PostSubsidyPolicy PostSubsidyPolicy
+	TailEmission      int64
+	ConvertFeeShare   int64
+
+	// Upgrades is the activation height of every height activated
+	// consensus rule change.  See UpgradeID for the rule changes.
+	Upgrades map[UpgradeID]int32
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints []Checkpoint
@@ -245,16 +291,25 @@ var MainNetParams = Params{
 
 	CoinbaseMaturity:         14,
 	SubsidyReductionInterval: 1000000,
-	TargetTimePerBlock:       30, // 30 seconds
+	TargetTimePerBlock:       time.Second * 30, // 30 seconds
+	AsertHalfLife:            time.Hour * 2,    // 240 blocks
 	GenerateSupported:        true,
 
 	MinStakingAmount:    new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e8)),
 	MinAddStakingAmount: new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e8)),
 
-	EntangleHeight: 120000,
-	BeaconHeight:   420000,
-
-	MauiHeight: 1150000,
+	CommitteeEpochLength: 20160, // 1 week
+	CommitteeSize:        7,
+	CommitteeBackups:     3,
+	CommitteeThreshold:   5,
+
+	Upgrades: map[UpgradeID]int32{
+		UpgradeEntangle:      120000,
+		UpgradeBeacon:        420000,
+		UpgradeMaui:          1150000,
+		UpgradeCastingAmount: 1203001,
+		UpgradeSubsidyEnd:    1500000,
+	},
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: []Checkpoint{
 		{Height: 11111, Hash: newHashFromStr("1faf0d2246f07608c6a97a6ca698055a89d07f84c52db4455addad0cc86175aa")},
@@ -379,19 +434,29 @@ var RegressionNetParams = Params{
 	CoinbaseMaturity: 14,
 
 	SubsidyReductionInterval: 1000000,
-	TargetTimePerBlock:       30, // 10 minutes
-	RetargetAdjustmentFactor: 4,  // 25% less, 400% more
+	TargetTimePerBlock:       time.Second * 30, // 30 seconds
+	RetargetAdjustmentFactor: 4,                // 25% less, 400% more
 	ReduceMinDifficulty:      true,
 	NoDifficultyAdjustment:   false,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
+	AsertHalfLife:            time.Hour * 2,    // 240 blocks
 	GenerateSupported:        true,
 
 	MinStakingAmount:    new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e8)),
 	MinAddStakingAmount: new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e8)),
 
-	EntangleHeight: 120000,
-	BeaconHeight:   200000,
-	MauiHeight:     500000,
+	CommitteeEpochLength: 100,
+	CommitteeSize:        4,
+	CommitteeBackups:     2,
+	CommitteeThreshold:   3,
+
+	Upgrades: map[UpgradeID]int32{
+		UpgradeEntangle:      120000,
+		UpgradeBeacon:        200000,
+		UpgradeMaui:          500000,
+		UpgradeCastingAmount: 1203001,
+		UpgradeSubsidyEnd:    1500000,
+	},
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: nil,
 
@@ -457,16 +522,26 @@ var TestNetParams = Params{
 	CoinbaseMaturity:         14,
 	TargetTimespan:           time.Hour * 24 * 14, // 14 days
 	SubsidyReductionInterval: 1000000,
-	TargetTimePerBlock:       30, // 10 minutes
+	TargetTimePerBlock:       time.Second * 30, // 30 seconds
+	AsertHalfLife:            time.Hour,        // 120 blocks
 	GenerateSupported:        true,
 	NoDifficultyAdjustment:   true,
 
 	MinStakingAmount:    new(big.Int).Mul(big.NewInt(100), big.NewInt(1e8)),
 	MinAddStakingAmount: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e8)),
 
-	EntangleHeight: 5,
-	BeaconHeight:   10,
-	MauiHeight:     50,
+	CommitteeEpochLength: 2880, // 1 day
+	CommitteeSize:        4,
+	CommitteeBackups:     2,
+	CommitteeThreshold:   3,
+
+	Upgrades: map[UpgradeID]int32{
+		UpgradeEntangle:      5,
+		UpgradeBeacon:        10,
+		UpgradeMaui:          50,
+		UpgradeCastingAmount: 1203001,
+		UpgradeSubsidyEnd:    1500000,
+	},
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: []Checkpoint{},
 
@@ -535,20 +610,29 @@ var SimNetParams = Params{
 	CoinbaseMaturity:         14,
 	SubsidyReductionInterval: 1000000,
 	TargetTimespan:           time.Hour * 24 * 14, // 14 days
-	TargetTimePerBlock:       30,                  // 10 minutes
+	TargetTimePerBlock:       time.Second * 30,    // 30 seconds
 	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
 	ReduceMinDifficulty:      true,
 	NoDifficultyAdjustment:   false,
 	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
+	AsertHalfLife:            time.Hour,        // 120 blocks
 	GenerateSupported:        true,
 
 	MinStakingAmount:    new(big.Int).Mul(big.NewInt(100), big.NewInt(1e8)),
 	MinAddStakingAmount: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e8)),
 
-	EntangleHeight: 10,
-	BeaconHeight:   12,
-	//ExChangeHeight: 20,
-	MauiHeight: 25,
+	CommitteeEpochLength: 100,
+	CommitteeSize:        4,
+	CommitteeBackups:     2,
+	CommitteeThreshold:   3,
+
+	Upgrades: map[UpgradeID]int32{
+		UpgradeEntangle:      10,
+		UpgradeBeacon:        12,
+		UpgradeMaui:          25,
+		UpgradeCastingAmount: 1203001,
+		UpgradeSubsidyEnd:    1500000,
+	},
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: nil,
 
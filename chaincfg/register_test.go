@@ -66,7 +66,7 @@ func TestRegister(t *testing.T) {
 				},
 				{
 					name:   "duplicate testnet3",
-					params: &TestNet3Params,
+					params: &TestNetParams,
 					err:    ErrDuplicateNet,
 				},
 				{
@@ -81,7 +81,7 @@ func TestRegister(t *testing.T) {
 					valid: true,
 				},
 				{
-					magic: TestNet3Params.LegacyPubKeyHashAddrID,
+					magic: TestNetParams.LegacyPubKeyHashAddrID,
 					valid: true,
 				},
 				{
@@ -107,7 +107,7 @@ func TestRegister(t *testing.T) {
 					valid: true,
 				},
 				{
-					magic: TestNet3Params.LegacyScriptHashAddrID,
+					magic: TestNetParams.LegacyScriptHashAddrID,
 					valid: true,
 				},
 				{
@@ -133,7 +133,7 @@ func TestRegister(t *testing.T) {
 					valid:  true,
 				},
 				{
-					prefix: TestNet3Params.CashAddressPrefix + ":",
+					prefix: TestNetParams.CashAddressPrefix + ":",
 					valid:  true,
 				},
 				{
@@ -172,8 +172,8 @@ func TestRegister(t *testing.T) {
 					err:  nil,
 				},
 				{
-					priv: TestNet3Params.HDPrivateKeyID[:],
-					want: TestNet3Params.HDPublicKeyID[:],
+					priv: TestNetParams.HDPrivateKeyID[:],
+					want: TestNetParams.HDPublicKeyID[:],
 					err:  nil,
 				},
 				{
@@ -215,7 +215,7 @@ func TestRegister(t *testing.T) {
 					valid: true,
 				},
 				{
-					magic: TestNet3Params.LegacyPubKeyHashAddrID,
+					magic: TestNetParams.LegacyPubKeyHashAddrID,
 					valid: true,
 				},
 				{
@@ -241,7 +241,7 @@ func TestRegister(t *testing.T) {
 					valid: true,
 				},
 				{
-					magic: TestNet3Params.LegacyScriptHashAddrID,
+					magic: TestNetParams.LegacyScriptHashAddrID,
 					valid: true,
 				},
 				{
@@ -267,7 +267,7 @@ func TestRegister(t *testing.T) {
 					valid:  true,
 				},
 				{
-					prefix: TestNet3Params.CashAddressPrefix + ":",
+					prefix: TestNetParams.CashAddressPrefix + ":",
 					valid:  true,
 				},
 				{
@@ -322,7 +322,7 @@ func TestRegister(t *testing.T) {
 				},
 				{
 					name:   "duplicate testnet3",
-					params: &TestNet3Params,
+					params: &TestNetParams,
 					err:    ErrDuplicateNet,
 				},
 				{
@@ -342,7 +342,7 @@ func TestRegister(t *testing.T) {
 					valid: true,
 				},
 				{
-					magic: TestNet3Params.LegacyPubKeyHashAddrID,
+					magic: TestNetParams.LegacyPubKeyHashAddrID,
 					valid: true,
 				},
 				{
@@ -368,7 +368,7 @@ func TestRegister(t *testing.T) {
 					valid: true,
 				},
 				{
-					magic: TestNet3Params.LegacyScriptHashAddrID,
+					magic: TestNetParams.LegacyScriptHashAddrID,
 					valid: true,
 				},
 				{
@@ -394,7 +394,7 @@ func TestRegister(t *testing.T) {
 					valid:  true,
 				},
 				{
-					prefix: TestNet3Params.CashAddressPrefix + ":",
+					prefix: TestNetParams.CashAddressPrefix + ":",
 					valid:  true,
 				},
 				{
@@ -433,8 +433,8 @@ func TestRegister(t *testing.T) {
 					err:  nil,
 				},
 				{
-					priv: TestNet3Params.HDPrivateKeyID[:],
-					want: TestNet3Params.HDPublicKeyID[:],
+					priv: TestNetParams.HDPrivateKeyID[:],
+					want: TestNetParams.HDPublicKeyID[:],
 					err:  nil,
 				},
 				{
@@ -0,0 +1,37 @@
+package chaincfg
+
+import "fmt"
+
+// PostSubsidyPolicy identifies how miners are paid once the block subsidy has
+// ended.
+type PostSubsidyPolicy uint8
+
+const (
+	// PostSubsidyNone pays miners the transaction fees only.
+	PostSubsidyNone PostSubsidyPolicy = iota
+
+	// PostSubsidyTailEmission pays miners a fixed amount of new coins
+	// every block.  See TailEmission.
+	PostSubsidyTailEmission
+
+	// PostSubsidyConvertFees pays miners a share of the fees of the
+	// conversions of the block, which otherwise go to the fee pool.  See
+	// ConvertFeeShare.
+	PostSubsidyConvertFees
+)
+
+// postSubsidyPolicyStrings is a map of post-subsidy policies back to their
+// constant names for pretty printing.
+var postSubsidyPolicyStrings = map[PostSubsidyPolicy]string{
+	PostSubsidyNone:         "PostSubsidyNone",
+	PostSubsidyTailEmission: "PostSubsidyTailEmission",
+	PostSubsidyConvertFees:  "PostSubsidyConvertFees",
+}
+
+// String returns the PostSubsidyPolicy in human-readable form.
+func (p PostSubsidyPolicy) String() string {
+	if s, ok := postSubsidyPolicyStrings[p]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown PostSubsidyPolicy (%d)", uint8(p))
+}
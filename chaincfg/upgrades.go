@@ -0,0 +1,91 @@
+package chaincfg
+
+import (
+	"fmt"
+	"math"
+)
+
+// UpgradeID identifies a consensus rule change activated at a fixed height.
+type UpgradeID int
+
+// Constants that identify the height activated consensus rule changes in the
+// Upgrades field of the parameters.
+const (
+	// UpgradeEntangle activates entangle transactions and the split of the
+	// coinbase between the miner and the coin pools.
+	UpgradeEntangle UpgradeID = iota
+
+	// UpgradeBeacon activates the beacon registrations and pledges along
+	// with the entangle state.
+	UpgradeBeacon
+
+	// UpgradeMaui replaces the entangle state with the committee state
+	// along with its convert, casting and pledge transactions.
+	UpgradeMaui
+
+	// UpgradeCastingAmount requires the pool output of casting
+	// transactions to pay exactly the casting amount.
+	UpgradeCastingAmount
+
+	// UpgradeSubsidyEnd ends the block subsidy.
+	UpgradeSubsidyEnd
+
+	// UpgradeAsert replaces the per-block difficulty adjustment with the
+	// ASERT algorithm, which adjusts the difficulty towards an absolute
+	// schedule.  See AsertHalfLife and AsertAnchor.
+	UpgradeAsert
+
+	// UpgradeCommittee elects a committee from the largest pledges every
+	// epoch and requires ConvertConfirm transactions to be signed by its
+	// members.  See CommitteeEpochLength.
+	UpgradeCommittee
+
+	// UpgradePoolMultisig keeps the pools of the external chains in the
+	// committee state, controlled by the keys of the committee serving at
+	// activation, and requires ConvertConfirm transactions to carry the
+	// threshold signatures of the pool keys releasing the funds.  It
+	// requires UpgradeCommittee.
+	UpgradePoolMultisig
+
+	// UpgradePostSubsidy pays miners according to PostSubsidyPolicy in
+	// addition to the transaction fees.  It must not activate before
+	// UpgradeSubsidyEnd.
+	UpgradePostSubsidy
+)
+
+// upgradeIDStrings is a map of upgrade IDs back to their constant names for
+// pretty printing.
+var upgradeIDStrings = map[UpgradeID]string{
+	UpgradeEntangle:      "UpgradeEntangle",
+	UpgradeBeacon:        "UpgradeBeacon",
+	UpgradeMaui:          "UpgradeMaui",
+	UpgradeCastingAmount: "UpgradeCastingAmount",
+	UpgradeSubsidyEnd:    "UpgradeSubsidyEnd",
+	UpgradeAsert:         "UpgradeAsert",
+	UpgradeCommittee:     "UpgradeCommittee",
+	UpgradePoolMultisig:  "UpgradePoolMultisig",
+	UpgradePostSubsidy:   "UpgradePostSubsidy",
+}
+
+// String returns the UpgradeID in human-readable form.
+func (id UpgradeID) String() string {
+	if s, ok := upgradeIDStrings[id]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown UpgradeID (%d)", int(id))
+}
+
+// UpgradeHeight returns the height of the first block the passed upgrade is
+// active for.  Upgrades missing from the schedule never activate.
+func (p *Params) UpgradeHeight(id UpgradeID) int32 {
+	if height, ok := p.Upgrades[id]; ok {
+		return height
+	}
+	return math.MaxInt32
+}
+
+// IsActive returns whether the passed upgrade is active for the block at the
+// passed height.
+func (p *Params) IsActive(id UpgradeID, height int32) bool {
+	return height >= p.UpgradeHeight(id)
+}
@@ -0,0 +1,523 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/classzz/classzz/btcjson"
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/crosstx"
+	"github.com/classzz/classzz/psbt"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	"github.com/jessevdk/go-flags"
+)
+
+type config struct {
+	NetType  string `short:"t" long:"type" description:"mainnet, testnet, regtest, simnet"`
+	KeyFile  string `short:"k" long:"keyfile" description:"File with the WIF private keys of the inputs, one per line"`
+	SigType  string `short:"s" long:"sigtype" description:"Signature algorithm: schnorr or ecdsa"`
+	Unsigned bool   `short:"u" long:"unsigned" description:"Output the transaction without signing it"`
+	Psbt     bool   `short:"p" long:"psbt" description:"Output a base64 partially signed transaction holding the signatures of the available keys"`
+	SignPsbt bool   `short:"P" long:"signpsbt" description:"Read a base64 partially signed transaction instead of a request and add the signatures of the available keys"`
+	Partial  bool   `long:"partial" description:"Only sign the inputs of the available keys and output the transaction even if other inputs still need to be signed"`
+	Tx       string `long:"tx" description:"File with the transaction built from the request, partially signed by the holders of other keys, to add the signatures of the available keys to; it must be complete afterwards unless --partial is set"`
+	Args     struct {
+		Request string `positional-arg-name:"request" description:"JSON file describing the transaction, - for stdin"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// request describes the transaction to build.  The transaction specific
+// fields take the same values as the parameters of the matching RPC, while
+// the inputs also carry the script and amount of the outputs they spend so
+// they can be signed offline.
+type request struct {
+	Type              string                        `json:"type"`
+	Inputs            []btcjson.RawTxInput          `json:"inputs"`
+	Amounts           map[string]float64            `json:"amounts"`
+	LockTime          uint32                        `json:"locktime"`
+	Mortgage          *btcjson.MortgageOut          `json:"mortgage"`
+	AddMortgage       *btcjson.AddMortgageOut       `json:"addmortgage"`
+	UpdateCoinbaseAll *btcjson.UpdateCoinbaseAllOut `json:"updatecoinbaseall"`
+	Convert           []btcjson.ConvertOut          `json:"convert"`
+	Casting           *btcjson.CastingOut           `json:"casting"`
+	ConvertConfirm    []btcjson.ConvertConfirmOut   `json:"convertconfirm"`
+	PoolKeyRotation   *btcjson.PoolKeyRotationOut   `json:"poolkeyrotation"`
+
+	// Committee holds the hex public keys of the members of the committee
+	// approving a convertconfirm transaction.  When set, the inputs must
+	// pay to as many members as the consensus rules require.
+	Committee []string `json:"committee"`
+}
+
+// decodeHexList returns the bytes of the passed hex strings.
+func decodeHexList(list []string) ([][]byte, error) {
+	var decoded [][]byte
+	for _, s := range list {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex %q: %v", s, err)
+		}
+		decoded = append(decoded, b)
+	}
+	return decoded, nil
+}
+
+// template returns the inputs and change outputs of the request.
+func (r *request) template(params *chaincfg.Params) (*crosstx.Template, error) {
+	t := &crosstx.Template{
+		Params:   params,
+		LockTime: r.LockTime,
+	}
+	for _, input := range r.Inputs {
+		hash, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := hex.DecodeString(input.ScriptPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scriptPubKey of %v:%d: %v",
+				input.Txid, input.Vout, err)
+		}
+		amount, err := czzutil.NewAmount(input.Amount)
+		if err != nil {
+			return nil, err
+		}
+		t.Inputs = append(t.Inputs, &crosstx.Input{
+			OutPoint: *wire.NewOutPoint(hash, input.Vout),
+			PkScript: pkScript,
+			Amount:   int64(amount),
+		})
+	}
+
+	// Sort the change addresses so the same request always results in the
+	// same transaction.
+	addrs := make([]string, 0, len(r.Amounts))
+	for addr := range r.Amounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, encodedAddr := range addrs {
+		addr, err := czzutil.DecodeAddress(encodedAddr, params)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := czzutil.NewAmount(r.Amounts[encodedAddr])
+		if err != nil {
+			return nil, err
+		}
+		t.Outputs = append(t.Outputs, &crosstx.Output{
+			Address: addr,
+			Amount:  amount,
+		})
+	}
+	return t, nil
+}
+
+// build returns the unsigned transaction described by the request.
+func (r *request) build(t *crosstx.Template) (*wire.MsgTx, error) {
+	switch r.Type {
+	case "mortgage":
+		if r.Mortgage == nil {
+			break
+		}
+		amount, err := czzutil.NewAmount(r.Mortgage.StakingAmount)
+		if err != nil {
+			return nil, err
+		}
+		return crosstx.NewMortgageTx(t, r.Mortgage.ToAddress, amount,
+			r.Mortgage.CoinBaseAddress)
+
+	case "addmortgage":
+		if r.AddMortgage == nil {
+			break
+		}
+		amount, err := czzutil.NewAmount(r.AddMortgage.StakingAmount)
+		if err != nil {
+			return nil, err
+		}
+		return crosstx.NewAddMortgageTx(t, r.AddMortgage.ToAddress, amount)
+
+	case "updatecoinbaseall":
+		if r.UpdateCoinbaseAll == nil {
+			break
+		}
+		return crosstx.NewUpdateCoinbaseAllTx(t,
+			r.UpdateCoinbaseAll.CoinBaseAddress)
+
+	case "convert":
+		converts := make([]*cross.ConvertTxInfo, 0, len(r.Convert))
+		for _, c := range r.Convert {
+			amount, err := czzutil.NewAmount(c.Amount)
+			if err != nil {
+				return nil, err
+			}
+			converts = append(converts, &cross.ConvertTxInfo{
+				AssetType:   c.AssetType,
+				ConvertType: c.ConvertType,
+				PubKey:      c.PubKey,
+				Height:      c.Height,
+				ExtTxHash:   c.ExtTxHash,
+				Index:       c.Index,
+				Amount:      big.NewInt(int64(amount)),
+				ToToken:     c.ToToken,
+			})
+		}
+		return crosstx.NewConvertTx(t, converts)
+
+	case "casting":
+		if r.Casting == nil {
+			break
+		}
+		amount, err := czzutil.NewAmount(r.Casting.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return crosstx.NewCastingTx(t, r.Casting.ConvertType, amount)
+
+	case "convertconfirm":
+		confirms := make([]*cross.ConvertConfirmTxInfo, 0, len(r.ConvertConfirm))
+		for _, c := range r.ConvertConfirm {
+			amount, err := czzutil.NewAmount(c.Amount)
+			if err != nil {
+				return nil, err
+			}
+			sigs, err := decodeHexList(c.Signatures)
+			if err != nil {
+				return nil, err
+			}
+			confirms = append(confirms, &cross.ConvertConfirmTxInfo{
+				ID:          big.NewInt(c.ID),
+				AssetType:   c.AssetType,
+				ConvertType: c.ConvertType,
+				Height:      c.Height,
+				ExtTxHash:   c.ExtTxHash,
+				Index:       c.Index,
+				Amount:      big.NewInt(int64(amount)),
+				Signatures:  sigs,
+			})
+		}
+		if len(r.Committee) == 0 {
+			return crosstx.NewConvertConfirmTx(t, confirms)
+		}
+		members, err := decodeHexList(r.Committee)
+		if err != nil {
+			return nil, err
+		}
+		return crosstx.NewCommitteeConvertConfirmTx(t, members, confirms)
+
+	case "poolkeyrotation":
+		if r.PoolKeyRotation == nil {
+			break
+		}
+		keys, err := decodeHexList(r.PoolKeyRotation.PubKeys)
+		if err != nil {
+			return nil, err
+		}
+		sigs, err := decodeHexList(r.PoolKeyRotation.Signatures)
+		if err != nil {
+			return nil, err
+		}
+		return crosstx.NewPoolKeyRotationTx(t, &cross.PoolKeyRotationTxInfo{
+			AssetType:    r.PoolKeyRotation.AssetType,
+			ExtAddresses: r.PoolKeyRotation.ExtAddresses,
+			PubKeys:      keys,
+			Threshold:    r.PoolKeyRotation.Threshold,
+			Nonce:        r.PoolKeyRotation.Nonce,
+			Signatures:   sigs,
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown transaction type %q", r.Type)
+	}
+	return nil, fmt.Errorf("missing %q parameters", r.Type)
+}
+
+// readKeys returns the WIF private keys held in the passed file.  Empty lines
+// and lines starting with # are ignored.
+func readKeys(path string, params *chaincfg.Params) ([]*czzutil.WIF, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var wifs []*czzutil.WIF
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		wif, err := czzutil.DecodeWIF(line)
+		if err != nil {
+			return nil, err
+		}
+		if !wif.IsForNet(params) {
+			return nil, errors.New("key file holds a key for the " +
+				"wrong network")
+		}
+		wifs = append(wifs, wif)
+	}
+	return wifs, scanner.Err()
+}
+
+// newPsbt returns a partially signed transaction for the passed transaction
+// built from the request, holding the outputs spent by its inputs and their
+// redeem scripts.
+func (r *request) newPsbt(mtx *wire.MsgTx, t *crosstx.Template) (*psbt.Packet, error) {
+	packet, err := psbt.New(mtx)
+	if err != nil {
+		return nil, err
+	}
+	for i, input := range t.Inputs {
+		packet.Inputs[i].Utxo = wire.NewTxOut(input.Amount, input.PkScript)
+		if r.Inputs[i].RedeemScript == "" {
+			continue
+		}
+		redeemScript, err := hex.DecodeString(r.Inputs[i].RedeemScript)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redeemScript of %v:%d: %v",
+				r.Inputs[i].Txid, r.Inputs[i].Vout, err)
+		}
+		packet.Inputs[i].RedeemScript = redeemScript
+	}
+	return packet, nil
+}
+
+// signPsbt adds the signatures of the passed keys to the inputs of the
+// partially signed transaction whose scripts refer to them.  Inputs which are
+// finalized or lack the data needed to sign them are left alone.
+func signPsbt(packet *psbt.Packet, wifs []*czzutil.WIF) error {
+	for i := range packet.Inputs {
+		if packet.Inputs[i].FinalScriptSig != nil {
+			continue
+		}
+		script, err := packet.SignScript(i)
+		if errors.Is(err, psbt.ErrNotFinalizable) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		pushes, err := txscript.PushedData(script)
+		if err != nil {
+			return err
+		}
+
+		for _, wif := range wifs {
+			pubKey := wif.PrivKey.PubKey().SerializeCompressed()
+			pubKeyHash := czzutil.Hash160(pubKey)
+			for _, data := range pushes {
+				if !bytes.Equal(data, pubKey) && !bytes.Equal(data, pubKeyHash) {
+					continue
+				}
+				if err := packet.SignInput(i, wif.PrivKey); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func run(cfg *config) error {
+	params := &chaincfg.MainNetParams
+	switch cfg.NetType {
+	case "mainnet":
+		params = &chaincfg.MainNetParams
+	case "testnet":
+		params = &chaincfg.TestNetParams
+	case "regtest":
+		params = &chaincfg.RegressionNetParams
+	case "simnet":
+		params = &chaincfg.SimNetParams
+	default:
+		return fmt.Errorf("unknown network %q", cfg.NetType)
+	}
+
+	var sigType crosstx.SignatureType
+	switch cfg.SigType {
+	case "schnorr":
+		sigType = crosstx.SigTypeSchnorr
+	case "ecdsa":
+		sigType = crosstx.SigTypeECDSA
+	default:
+		return fmt.Errorf("unknown signature type %q", cfg.SigType)
+	}
+
+	var data []byte
+	var err error
+	if cfg.Args.Request == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(cfg.Args.Request)
+	}
+	if err != nil {
+		return err
+	}
+
+	var wifs []*czzutil.WIF
+	if !cfg.Unsigned {
+		if cfg.KeyFile == "" {
+			return errors.New("no key file to sign the transaction")
+		}
+		wifs, err = readKeys(cfg.KeyFile, params)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.SignPsbt {
+		packet, err := psbt.NewFromRawBytes(
+			strings.NewReader(strings.TrimSpace(string(data))), true)
+		if err != nil {
+			return err
+		}
+		return printPsbt(packet, wifs)
+	}
+
+	var req request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("invalid request: %v", err)
+	}
+
+	t, err := req.template(params)
+	if err != nil {
+		return err
+	}
+	mtx, err := req.build(t)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Psbt {
+		packet, err := req.newPsbt(mtx, t)
+		if err != nil {
+			return err
+		}
+		return printPsbt(packet, wifs)
+	}
+
+	if cfg.Tx != "" {
+		if err := addSignatures(mtx, cfg.Tx); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case cfg.Unsigned:
+	case cfg.Partial || cfg.Tx != "":
+		signer, err := crosstx.NewKeySigner(wifs)
+		if err != nil {
+			return err
+		}
+		signed, err := crosstx.SignPartial(params, mtx, t.Inputs, signer,
+			sigType, txscript.SigHashAll)
+		if err != nil {
+			return err
+		}
+		if err := crosstx.Verify(mtx, t.Inputs); err != nil {
+			if !cfg.Partial {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Signed %d inputs, the transaction "+
+				"is not complete yet: %v\n", signed, err)
+		}
+	default:
+		signer, err := crosstx.NewKeySigner(wifs)
+		if err != nil {
+			return err
+		}
+		err = crosstx.Sign(params, mtx, t.Inputs, signer, sigType,
+			txscript.SigHashAll)
+		if err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := mtx.Serialize(&buf); err != nil {
+		return err
+	}
+	fmt.Println(hex.EncodeToString(buf.Bytes()))
+	return nil
+}
+
+// addSignatures copies the signature scripts of the partially signed
+// transaction held in hex in the passed file to the passed transaction, which
+// must be the same transaction built from the request.
+func addSignatures(mtx *wire.MsgTx, path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	serialized, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("invalid transaction: %v", err)
+	}
+	var signed wire.MsgTx
+	if err := signed.Deserialize(bytes.NewReader(serialized)); err != nil {
+		return fmt.Errorf("invalid transaction: %v", err)
+	}
+
+	unsigned := signed.Copy()
+	for _, txIn := range unsigned.TxIn {
+		txIn.SignatureScript = nil
+	}
+	if unsigned.TxHash() != mtx.TxHash() {
+		return errors.New("the transaction was not built from the request")
+	}
+	for i, txIn := range signed.TxIn {
+		mtx.TxIn[i].SignatureScript = txIn.SignatureScript
+	}
+	return nil
+}
+
+// printPsbt signs the partially signed transaction with the passed keys and
+// prints it.
+func printPsbt(packet *psbt.Packet, wifs []*czzutil.WIF) error {
+	if err := signPsbt(packet, wifs); err != nil {
+		return err
+	}
+	b64, err := packet.B64Encode()
+	if err != nil {
+		return err
+	}
+	fmt.Println(b64)
+	return nil
+}
+
+func main() {
+	cfg := config{
+		NetType: "mainnet",
+		SigType: "schnorr",
+	}
+
+	parser := flags.NewParser(&cfg, flags.Default)
+	_, err := parser.Parse()
+	if err != nil {
+		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		os.Exit(1)
+	}
+
+	if err := run(&cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/database"
+	_ "github.com/classzz/classzz/database/ffldb"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	defaultDbType     = "ffldb"
+	defaultBucketSize = 10
+	defaultSeed       = 1
+)
+
+var (
+	czzdHomeDir     = czzutil.AppDataDir("classzz", false)
+	defaultDataDir  = filepath.Join(czzdHomeDir, "data")
+	knownDbTypes    = database.SupportedDrivers()
+	activeNetParams = &chaincfg.MainNetParams
+)
+
+// config defines the configuration options for diffsim.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	DataDir        string `short:"b" long:"datadir" description:"Location of the classzz data directory"`
+	DbType         string `long:"dbtype" description:"Database backend to use for the Block Chain"`
+	TestNet3       bool   `long:"testnet" description:"Use the test network"`
+	RegressionTest bool   `long:"regtest" description:"Use the regression test network"`
+	SimNet         bool   `long:"simnet" description:"Use the simulation test network"`
+	NetParams      string `long:"netparams" description:"Use the custom network defined by this JSON or TOML file"`
+
+	Scenario string `short:"s" long:"scenario" description:"Mine the simulated miners of this JSON or TOML scenario instead of replaying the block database"`
+	Start    int32  `long:"start" description:"Height of the first block to replay"`
+	End      int32  `long:"end" description:"Height of the last block to replay, the best block when zero"`
+	Seed     int64  `long:"seed" description:"Seed of the random block times of scenarios"`
+
+	Divisor       int64         `long:"divisor" description:"Replace the difficulty bound divisor of the legacy algorithm"`
+	StakeExponent int64         `long:"stakeexponent" description:"Replace the exponent of the staking multiplier of the target"`
+	Asert         int32         `long:"asert" description:"Activate the ASERT difficulty algorithm at this height"`
+	HalfLife      time.Duration `long:"halflife" description:"Replace the half-life of the ASERT difficulty algorithm"`
+
+	OutDir     string `short:"o" long:"outdir" description:"Directory to write series.csv, blocktimes.csv and stakers.csv to"`
+	BucketSize int64  `long:"bucketsize" description:"Width in seconds of the block time distribution buckets"`
+}
+
+// validDbType returns whether or not dbType is a supported database type.
+func validDbType(dbType string) bool {
+	for _, knownType := range knownDbTypes {
+		if dbType == knownType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// netName returns the name used when referring to a bitcoin network.  At the
+// time of writing, classzz currently places blocks for testnet version 3 in the
+// data and log directory "testnet", which does not match the Name field of the
+// chaincfg parameters.  This function can be used to override this directory name
+// as "testnet" when the passed active network matches wire.TestNet3.
+//
+// A proper upgrade to move the data and log directories for this network to
+// "testnet3" is planned for the future, at which point this function can be
+// removed and the network parameter's name used instead.
+func netName(chainParams *chaincfg.Params) string {
+	switch chainParams.Net {
+	case wire.TestNet:
+		return "testnet"
+	default:
+		return chainParams.Name
+	}
+}
+
+// cleanAndExpandPath expands environment variables and leading ~ in the
+// passed path, cleans the result, and returns it.
+func cleanAndExpandPath(path string) string {
+	// Expand initial ~ to OS specific home directory.
+	if strings.HasPrefix(path, "~") {
+		homeDir := filepath.Dir(czzdHomeDir)
+		path = strings.Replace(path, "~", homeDir, 1)
+	}
+
+	// NOTE: The os.ExpandEnv doesn't work with Windows-style %VARIABLE%,
+	// but they variables can still be expanded via POSIX-style $VARIABLE.
+	return filepath.Clean(os.ExpandEnv(path))
+}
+
+// loadNetParams returns the validated parameters of the custom network
+// defined by the passed file and registers the network.
+func loadNetParams(path string) (*chaincfg.Params, error) {
+	f, err := chaincfg.LoadNetParamsFile(path)
+	if err != nil {
+		return nil, err
+	}
+	params, err := f.Params()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network parameter file %s: %v",
+			path, err)
+	}
+	if err := chaincfg.Register(params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// applyOverrides replaces the difficulty parameters of the active network with
+// those configured.  The parameters are copied so the registered ones are not
+// changed.  The bound divisor and staking exponent are passed to the difficulty
+// calculations instead.
+func applyOverrides(cfg *config) {
+	params := *activeNetParams
+	params.Upgrades = make(map[chaincfg.UpgradeID]int32)
+	for id, height := range activeNetParams.Upgrades {
+		params.Upgrades[id] = height
+	}
+	if cfg.Asert != 0 {
+		params.Upgrades[chaincfg.UpgradeAsert] = cfg.Asert
+		params.AsertAnchor = nil
+	}
+	if cfg.HalfLife != 0 {
+		params.AsertHalfLife = cfg.HalfLife
+	}
+	activeNetParams = &params
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, []string, error) {
+	// Default config.
+	cfg := config{
+		DataDir:    defaultDataDir,
+		DbType:     defaultDbType,
+		Start:      1,
+		Seed:       defaultSeed,
+		OutDir:     ".",
+		BucketSize: defaultBucketSize,
+	}
+
+	// Parse command line options.
+	parser := flags.NewParser(&cfg, flags.Default)
+	remainingArgs, err := parser.Parse()
+	if err != nil {
+		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, nil, err
+	}
+
+	// Multiple networks can't be selected simultaneously.
+	funcName := "loadConfig"
+	numNets := 0
+	// Count number of network flags passed; assign active network params
+	// while we're at it
+	if cfg.TestNet3 {
+		numNets++
+		activeNetParams = &chaincfg.TestNetParams
+	}
+	if cfg.RegressionTest {
+		numNets++
+		activeNetParams = &chaincfg.RegressionNetParams
+	}
+	if cfg.SimNet {
+		numNets++
+		activeNetParams = &chaincfg.SimNetParams
+	}
+	if cfg.NetParams != "" {
+		numNets++
+		params, err := loadNetParams(cleanAndExpandPath(cfg.NetParams))
+		if err != nil {
+			err := fmt.Errorf("%s: %v", funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, nil, err
+		}
+		activeNetParams = params
+	}
+	if numNets > 1 {
+		str := "%s: The testnet, regtest, simnet, and netparams params " +
+			"can't be used together -- choose one of the four"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate database type.
+	if !validDbType(cfg.DbType) {
+		str := "%s: The specified database type [%v] is invalid -- " +
+			"supported types %v"
+		err := fmt.Errorf(str, funcName, cfg.DbType, knownDbTypes)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Append the network type to the data directory so it is "namespaced"
+	// per network.  In addition to the block database, there are other
+	// pieces of data that are saved to disk such as address manager state.
+	// All data is specific to a network, so namespacing the data directory
+	// means each individual piece of serialized data does not have to
+	// worry about changing names per network and such.
+	cfg.DataDir = filepath.Join(cleanAndExpandPath(cfg.DataDir),
+		netName(activeNetParams))
+	cfg.OutDir = cleanAndExpandPath(cfg.OutDir)
+	if cfg.Scenario != "" {
+		cfg.Scenario = cleanAndExpandPath(cfg.Scenario)
+	}
+
+	// Validate the replayed range and the simulation parameters.
+	if cfg.Start < 1 || (cfg.End != 0 && cfg.End < cfg.Start) {
+		str := "%s: The replayed range from %d to %d is invalid"
+		err := fmt.Errorf(str, funcName, cfg.Start, cfg.End)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, nil, err
+	}
+	if cfg.Asert < 0 || cfg.HalfLife < 0 || cfg.Divisor < 0 ||
+		cfg.StakeExponent < 0 || cfg.BucketSize <= 0 {
+
+		str := "%s: The asert, halflife, divisor, stakeexponent and " +
+			"bucketsize options must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, nil, err
+	}
+	if cfg.HalfLife%time.Second != 0 {
+		str := "%s: The halflife must be whole seconds"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, nil, err
+	}
+	applyOverrides(&cfg)
+	if _, ok := activeNetParams.Upgrades[chaincfg.UpgradeAsert]; ok &&
+		activeNetParams.AsertHalfLife <= 0 {
+
+		str := "%s: The ASERT algorithm requires a halflife"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, nil, err
+	}
+
+	return &cfg, remainingArgs, nil
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/classzz/classzz/blockchain"
+)
+
+var (
+	cfg *config
+)
+
+// blockRecord is a replayed or simulated block.
+type blockRecord struct {
+	height       int32
+	timestamp    int64
+	blockTime    int64
+	bits         uint32
+	requiredBits uint32
+	coinbase     string
+
+	// multiplier is the factor the target of the coinbase address was
+	// multiplied with for its stake, nil when it had none.
+	multiplier *big.Int
+}
+
+// minerStats accumulates the blocks of a coinbase address.
+type minerStats struct {
+	address    string
+	name       string
+	stake      *big.Int
+	multiplier *big.Int
+	blocks     int
+
+	// hashWeight is the number of blocks the address would have found
+	// without its stake.  Scenarios add the share of the hash rate of the
+	// miner for every block, replays estimate it from the blocks found.
+	hashWeight float64
+}
+
+// result is the outcome of a replay or scenario.
+type result struct {
+	blocks []*blockRecord
+	miners map[string]*minerStats
+}
+
+// newResult returns an empty result.
+func newResult() *result {
+	return &result{miners: make(map[string]*minerStats)}
+}
+
+// miner returns the statistics of the passed coinbase address, creating them
+// when needed.
+func (r *result) miner(address string) *minerStats {
+	m, ok := r.miners[address]
+	if !ok {
+		m = &minerStats{address: address}
+		r.miners[address] = m
+	}
+	return m
+}
+
+// difficulty returns the difficulty of the passed bits as a multiple of the
+// minimum difficulty.
+func difficulty(bits uint32) string {
+	max := blockchain.CompactToBig(activeNetParams.PowLimitBits)
+	target := blockchain.CompactToBig(bits)
+	if target.Sign() <= 0 {
+		return "0"
+	}
+	return new(big.Rat).SetFrac(max, target).FloatString(8)
+}
+
+// formatMultiplier formats the passed staking multiplier, which is one for
+// addresses without stake.
+func formatMultiplier(multiplier *big.Int) string {
+	if multiplier == nil {
+		return "1"
+	}
+	return multiplier.String()
+}
+
+// writeCSV writes the passed records to the named file in the output
+// directory.
+func writeCSV(name string, records [][]string) error {
+	path := filepath.Join(cfg.OutDir, name)
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(file)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// writeSeries writes the difficulty series of the blocks.
+func writeSeries(r *result) error {
+	records := [][]string{{"height", "timestamp", "blocktime", "bits",
+		"requiredbits", "difficulty", "coinbase", "multiplier"}}
+	for _, b := range r.blocks {
+		records = append(records, []string{
+			strconv.Itoa(int(b.height)),
+			strconv.FormatInt(b.timestamp, 10),
+			strconv.FormatInt(b.blockTime, 10),
+			fmt.Sprintf("%08x", b.bits),
+			fmt.Sprintf("%08x", b.requiredBits),
+			difficulty(b.bits),
+			b.coinbase,
+			formatMultiplier(b.multiplier),
+		})
+	}
+	return writeCSV("series.csv", records)
+}
+
+// writeBlockTimes writes the distribution of the block times in buckets of
+// the configured size.  Negative block times, which the median time rules
+// allow, fall into buckets below zero.
+func writeBlockTimes(r *result) error {
+	counts := make(map[int64]int)
+	for _, b := range r.blocks {
+		bucket := b.blockTime / cfg.BucketSize
+		if b.blockTime < 0 && b.blockTime%cfg.BucketSize != 0 {
+			bucket--
+		}
+		counts[bucket]++
+	}
+	buckets := make([]int64, 0, len(counts))
+	for bucket := range counts {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+
+	records := [][]string{{"from", "to", "blocks", "fraction", "cumulative"}}
+	total := float64(len(r.blocks))
+	cumulative := 0
+	for _, bucket := range buckets {
+		cumulative += counts[bucket]
+		records = append(records, []string{
+			strconv.FormatInt(bucket*cfg.BucketSize, 10),
+			strconv.FormatInt((bucket+1)*cfg.BucketSize, 10),
+			strconv.Itoa(counts[bucket]),
+			strconv.FormatFloat(float64(counts[bucket])/total, 'f', 6, 64),
+			strconv.FormatFloat(float64(cumulative)/total, 'f', 6, 64),
+		})
+	}
+	return writeCSV("blocktimes.csv", records)
+}
+
+// writeStakers writes the blocks found by every coinbase address along with
+// its advantage, the ratio of its share of the blocks to its share of the
+// hash rate.
+func writeStakers(r *result) error {
+	miners := make([]*minerStats, 0, len(r.miners))
+	totalWeight := 0.0
+	for _, m := range r.miners {
+		miners = append(miners, m)
+		totalWeight += m.hashWeight
+	}
+	sort.Slice(miners, func(i, j int) bool {
+		if miners[i].blocks != miners[j].blocks {
+			return miners[i].blocks > miners[j].blocks
+		}
+		return miners[i].address < miners[j].address
+	})
+
+	records := [][]string{{"address", "name", "stake", "multiplier",
+		"blocks", "blockshare", "hashshare", "advantage"}}
+	total := float64(len(r.blocks))
+	for _, m := range miners {
+		stake := "0"
+		if m.stake != nil {
+			stake = m.stake.String()
+		}
+		blockShare := float64(m.blocks) / total
+		hashShare := 0.0
+		if totalWeight > 0 {
+			hashShare = m.hashWeight / totalWeight
+		}
+		advantage := ""
+		if hashShare > 0 {
+			advantage = strconv.FormatFloat(blockShare/hashShare, 'f', 4, 64)
+		}
+		records = append(records, []string{
+			m.address,
+			m.name,
+			stake,
+			formatMultiplier(m.multiplier),
+			strconv.Itoa(m.blocks),
+			strconv.FormatFloat(blockShare, 'f', 6, 64),
+			strconv.FormatFloat(hashShare, 'f', 6, 64),
+			advantage,
+		})
+	}
+	return writeCSV("stakers.csv", records)
+}
+
+// printSummary prints the block time statistics of the result.
+func printSummary(r *result) {
+	times := make([]int64, len(r.blocks))
+	var sum int64
+	for i, b := range r.blocks {
+		times[i] = b.blockTime
+		sum += b.blockTime
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	n := len(times)
+	fmt.Printf("Blocks %d to %d: mean block time %.2fs, median %ds, "+
+		"90th percentile %ds, max %ds\n", r.blocks[0].height,
+		r.blocks[n-1].height, float64(sum)/float64(n), times[n/2],
+		times[n*9/10], times[n-1])
+}
+
+func main() {
+	// Load configuration and parse command line.
+	tcfg, _, err := loadConfig()
+	if err != nil {
+		return
+	}
+	cfg = tcfg
+
+	var r *result
+	if cfg.Scenario != "" {
+		r, err = runScenario()
+	} else {
+		r, err = replay()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if len(r.blocks) == 0 {
+		fmt.Println("No blocks.")
+		return
+	}
+
+	for _, write := range []func(*result) error{writeSeries,
+		writeBlockTimes, writeStakers} {
+
+		if err := write(r); err != nil {
+			fmt.Fprintln(os.Stderr, "failed to write output:", err)
+			os.Exit(1)
+		}
+	}
+	printSummary(r)
+}
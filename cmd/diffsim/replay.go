@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/database"
+	"github.com/classzz/classzz/txscript"
+)
+
+const blockDbNamePrefix = "blocks"
+
+// loadBlockDB opens the block database and returns a handle to it.
+func loadBlockDB() (database.DB, error) {
+	// The database name is based on the database type.
+	dbName := blockDbNamePrefix + "_" + cfg.DbType
+	dbPath := filepath.Join(cfg.DataDir, dbName)
+	fmt.Printf("Loading block database from '%s'\n", dbPath)
+	db, err := database.Open(cfg.DbType, dbPath, activeNetParams.Net)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// pinAsertAnchor sets the ASERT anchor of the active network from the block
+// database when the difficulty calculator, which starts at the passed
+// height, would not contain the anchor along with its parent.
+func pinAsertAnchor(chain *blockchain.BlockChain, height int32) error {
+	activation, ok := activeNetParams.Upgrades[chaincfg.UpgradeAsert]
+	if !ok || activeNetParams.AsertAnchor != nil || activation-1 > height {
+		return nil
+	}
+
+	anchor, err := chain.HeaderByHeight(activation - 1)
+	if err != nil {
+		return err
+	}
+	parent, err := chain.HeaderByHeight(activation - 2)
+	if err != nil {
+		return err
+	}
+	activeNetParams.AsertAnchor = &chaincfg.AsertAnchor{
+		Height:     activation - 1,
+		Bits:       anchor.Bits,
+		ParentTime: parent.Timestamp.Unix(),
+	}
+	return nil
+}
+
+// replay runs the configured range of the main chain in the block database
+// through the difficulty code.  The required bits of every block are those
+// the configured parameters require after the actual previous blocks, and
+// the hash rate of the coinbase addresses is estimated from the blocks they
+// found divided by their staking multiplier.
+func replay() (*result, error) {
+	db, err := loadBlockDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load database: %v", err)
+	}
+	defer db.Close()
+
+	// Setup chain.  Ignore notifications since they aren't needed for this
+	// util.
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:          db,
+		ChainParams: activeNetParams,
+		TimeSource:  blockchain.NewMedianTime(),
+		// No nice way to get the main configuration here.
+		// For now just accept up to the default.
+		ExcessiveBlockSize: 32000000,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize chain: %v", err)
+	}
+	best := chain.BestSnapshot()
+	fmt.Printf("Block database loaded with block height %d\n", best.Height)
+
+	end := cfg.End
+	if end == 0 {
+		end = best.Height
+	}
+	if end > best.Height || cfg.Start > end {
+		return nil, fmt.Errorf("the block database has no blocks from "+
+			"%d to %d", cfg.Start, end)
+	}
+
+	// Start the calculator with the parent of the first replayed block.
+	if err := pinAsertAnchor(chain, cfg.Start-1); err != nil {
+		return nil, err
+	}
+	prevHeader, err := chain.HeaderByHeight(cfg.Start - 1)
+	if err != nil {
+		return nil, err
+	}
+	calc := blockchain.NewDifficultyCalculator(activeNetParams, &prevHeader,
+		cfg.Start-1, cfg.Divisor)
+
+	r := newResult()
+	for height := cfg.Start; height <= end; height++ {
+		block, err := chain.BlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		header := &block.MsgBlock().Header
+		required, err := calc.NextRequiredDifficulty(header.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		record := &blockRecord{
+			height:       height,
+			timestamp:    header.Timestamp.Unix(),
+			blockTime:    header.Timestamp.Unix() - prevHeader.Timestamp.Unix(),
+			bits:         header.Bits,
+			requiredBits: required,
+		}
+
+		// Look up the stake of the coinbase address as of the parent.
+		coinbase := block.MsgBlock().Transactions[0]
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(
+			coinbase.TxOut[0].PkScript, activeNetParams)
+		var stake *big.Int
+		if len(addrs) != 0 {
+			record.coinbase = addrs[0].String()
+			state, err := chain.FetchStakingState(&header.PrevBlock,
+				height-1)
+			if err != nil {
+				return nil, err
+			}
+			if state != nil {
+				amount, found := cross.StakingAmount(addrs[0], state)
+				if found {
+					stake = amount
+					record.multiplier = cross.StakingMultiplier(
+						activeNetParams, amount, cfg.StakeExponent)
+				}
+			}
+		}
+		r.blocks = append(r.blocks, record)
+
+		m := r.miner(record.coinbase)
+		m.blocks++
+		m.stake, m.multiplier = stake, record.multiplier
+		if record.multiplier == nil {
+			m.hashWeight++
+		} else if record.multiplier.Sign() > 0 {
+			weight, _ := new(big.Rat).SetFrac(big.NewInt(1),
+				record.multiplier).Float64()
+			m.hashWeight += weight
+		}
+
+		calc.AddHeader(header)
+		prevHeader = *header
+	}
+	return r, nil
+}
@@ -0,0 +1,343 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// maxBlockWait is the number of seconds after which a simulated block is
+// considered never to be found.
+const maxBlockWait = 1 << 24
+
+// oneLsh256 is 1 shifted left 256 bits.
+var oneLsh256 = new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 256))
+
+// minerFile describes a simulated miner in a scenario file.  The hash rate is
+// the number of hashes tried per second, where a block takes 2^256 / target
+// hashes on average, and the stake is in the smallest unit.
+type minerFile struct {
+	Name     string `json:"name" toml:"name"`
+	HashRate uint64 `json:"hashrate" toml:"hashrate"`
+	Stake    int64  `json:"stake" toml:"stake"`
+}
+
+// phaseFile describes a number of blocks mined with changed hash rates or
+// stakes of the named miners in a scenario file.
+type phaseFile struct {
+	Name      string            `json:"name" toml:"name"`
+	Blocks    int               `json:"blocks" toml:"blocks"`
+	HashRates map[string]uint64 `json:"hashrates" toml:"hashrates"`
+	Stakes    map[string]int64  `json:"stakes" toml:"stakes"`
+}
+
+// scenarioFile is the JSON or TOML representation of a scenario.  The
+// simulated blocks start at the passed height after a block with the passed
+// timestamp and bits.  The timestamp defaults to that of the genesis block
+// and the bits to those matching the initial hash rate.
+type scenarioFile struct {
+	Height    int32       `json:"height" toml:"height"`
+	Timestamp int64       `json:"timestamp" toml:"timestamp"`
+	Bits      uint32      `json:"bits" toml:"bits"`
+	Miners    []minerFile `json:"miners" toml:"miners"`
+	Phases    []phaseFile `json:"phases" toml:"phases"`
+}
+
+// simMiner is a miner of a running scenario.
+type simMiner struct {
+	name     string
+	addr     czzutil.Address
+	hashRate float64
+	stake    int64
+	stats    *minerStats
+}
+
+// loadScenario reads and validates the configured scenario file.
+func loadScenario() (*scenarioFile, error) {
+	data, err := ioutil.ReadFile(cfg.Scenario)
+	if err != nil {
+		return nil, err
+	}
+	var s scenarioFile
+	if chaincfg.IsTOML(cfg.Scenario) {
+		md, err := toml.Decode(string(data), &s)
+		if err != nil {
+			return nil, fmt.Errorf("malformed scenario %s: %v",
+				cfg.Scenario, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) != 0 {
+			return nil, fmt.Errorf("unknown field %s in scenario %s",
+				undecoded[0], cfg.Scenario)
+		}
+	} else {
+		dec := json.NewDecoder(strings.NewReader(string(data)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&s); err != nil {
+			return nil, fmt.Errorf("malformed scenario %s: %v",
+				cfg.Scenario, err)
+		}
+	}
+
+	if s.Height == 0 {
+		s.Height = 1
+	}
+	if s.Height < 1 {
+		return nil, fmt.Errorf("scenario height must be positive")
+	}
+	names := make(map[string]bool)
+	for _, m := range s.Miners {
+		if m.Name == "" || names[m.Name] {
+			return nil, fmt.Errorf("miners need unique names")
+		}
+		if m.Stake < 0 {
+			return nil, fmt.Errorf("miner %s has a negative stake",
+				m.Name)
+		}
+		names[m.Name] = true
+	}
+	if len(s.Phases) == 0 {
+		return nil, fmt.Errorf("scenario without phases")
+	}
+	for i, phase := range s.Phases {
+		if phase.Blocks <= 0 {
+			return nil, fmt.Errorf("phase %d mines no blocks", i)
+		}
+		for name := range phase.HashRates {
+			if !names[name] {
+				return nil, fmt.Errorf("phase %d has a hash rate "+
+					"for unknown miner %q", i, name)
+			}
+		}
+		for name, stake := range phase.Stakes {
+			if !names[name] || stake < 0 {
+				return nil, fmt.Errorf("phase %d has an invalid "+
+					"stake for miner %q", i, name)
+			}
+		}
+	}
+	return &s, nil
+}
+
+// stakingState returns the stakes of the passed miners in the form
+// cross.ComputeDiff expects them.
+func stakingState(miners []*simMiner) *cross.EntangleState {
+	state := &cross.EntangleState{
+		EnInfos: make(map[string]*cross.BeaconAddressInfo),
+	}
+	for _, m := range miners {
+		if m.stake == 0 {
+			continue
+		}
+		state.EnInfos[m.name] = &cross.BeaconAddressInfo{
+			StakingAmount:   big.NewInt(m.stake),
+			CoinBaseAddress: []string{m.addr.String()},
+		}
+	}
+	return state
+}
+
+// hashRates returns the rates at which the passed miners find blocks with the
+// passed bits, which is their hash rate divided by the work of their target
+// boosted by their stake.
+func hashRates(miners []*simMiner, state *cross.EntangleState, bits uint32) []float64 {
+	rates := make([]float64, len(miners))
+	for i, m := range miners {
+		target := cross.ComputeDiffWithExponent(activeNetParams,
+			blockchain.CompactToBig(bits), m.addr, state,
+			cfg.StakeExponent)
+		if target.Sign() <= 0 {
+			continue
+		}
+		work, _ := new(big.Float).Quo(oneLsh256,
+			new(big.Float).SetInt(target)).Float64()
+		rates[i] = m.hashRate / work
+	}
+	return rates
+}
+
+// mineBlock simulates the miners mining the block after the last one of the
+// calculator, whose timestamp is passed, second by second.  The difficulty is
+// recalculated for every timestamp since it may depend on it.  It returns the
+// timestamp and bits of the found block and the miner who found it.
+func mineBlock(calc *blockchain.DifficultyCalculator, rng *rand.Rand,
+	miners []*simMiner, state *cross.EntangleState,
+	parentTime int64) (int64, uint32, *simMiner, error) {
+
+	var bits uint32
+	var rates []float64
+	var total float64
+	for s := int64(1); s <= maxBlockWait; s++ {
+		timestamp := parentTime + s
+		next, err := calc.NextRequiredDifficulty(time.Unix(timestamp, 0))
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		if rates == nil || next != bits {
+			bits = next
+			rates = hashRates(miners, state, bits)
+			total = 0
+			for _, rate := range rates {
+				total += rate
+			}
+		}
+
+		// The block is found within this second with the probability
+		// of the exponential distribution with the total rate, and by
+		// every miner in proportion to its rate.
+		if rng.Float64() >= -math.Expm1(-total) {
+			continue
+		}
+		pick := rng.Float64() * total
+		for i, rate := range rates {
+			if pick < rate || i == len(rates)-1 {
+				return timestamp, bits, miners[i], nil
+			}
+			pick -= rate
+		}
+	}
+	return 0, 0, nil, fmt.Errorf("no block found within %d seconds after "+
+		"block %d", maxBlockWait, calc.Height())
+}
+
+// runScenario mines the configured scenario with the difficulty code.
+func runScenario() (*result, error) {
+	s, err := loadScenario()
+	if err != nil {
+		return nil, err
+	}
+
+	r := newResult()
+	miners := make([]*simMiner, 0, len(s.Miners))
+	byName := make(map[string]*simMiner)
+	for _, mf := range s.Miners {
+		addr, err := czzutil.NewAddressPubKeyHash(
+			czzutil.Hash160([]byte(mf.Name)), activeNetParams)
+		if err != nil {
+			return nil, err
+		}
+		m := &simMiner{
+			name:     mf.Name,
+			addr:     addr,
+			hashRate: float64(mf.HashRate),
+			stake:    mf.Stake,
+			stats:    r.miner(addr.String()),
+		}
+		m.stats.name = mf.Name
+		miners = append(miners, m)
+		byName[m.name] = m
+	}
+
+	// The block before the first simulated one defaults to the genesis
+	// time and to bits matching the initial hash rate.
+	spacing := int64(activeNetParams.TargetTimePerBlock / time.Second)
+	timestamp := s.Timestamp
+	if timestamp == 0 {
+		timestamp = activeNetParams.GenesisBlock.Header.Timestamp.Unix()
+	}
+	bits := s.Bits
+	if bits == 0 {
+		var hashRate float64
+		for _, m := range miners {
+			hashRate += m.hashRate
+		}
+		bits = activeNetParams.PowLimitBits
+		if work := hashRate * float64(spacing); work > 1 {
+			target, _ := new(big.Float).Quo(oneLsh256,
+				big.NewFloat(work)).Int(nil)
+			if target.Cmp(activeNetParams.PowLimit) < 0 {
+				bits = blockchain.BigToCompact(target)
+			}
+		}
+	}
+
+	// Anchor ASERT at the starting block unless it activates later.
+	height := s.Height - 1
+	activation, ok := activeNetParams.Upgrades[chaincfg.UpgradeAsert]
+	if ok && activeNetParams.AsertAnchor == nil && activation-1 <= height {
+		activeNetParams.AsertAnchor = &chaincfg.AsertAnchor{
+			Height:     height,
+			Bits:       bits,
+			ParentTime: timestamp - spacing,
+		}
+	}
+	calc := blockchain.NewDifficultyCalculator(activeNetParams,
+		&wire.BlockHeader{Version: 1, Bits: bits,
+			Timestamp: time.Unix(timestamp, 0)}, height,
+		cfg.Divisor)
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	for _, phase := range s.Phases {
+		for name, hashRate := range phase.HashRates {
+			byName[name].hashRate = float64(hashRate)
+		}
+		for name, stake := range phase.Stakes {
+			byName[name].stake = stake
+		}
+		state := stakingState(miners)
+		var hashRate float64
+		for _, m := range miners {
+			hashRate += m.hashRate
+			m.stats.stake = big.NewInt(m.stake)
+			m.stats.multiplier = nil
+			if m.stake != 0 {
+				m.stats.multiplier = cross.StakingMultiplier(
+					activeNetParams, m.stats.stake,
+					cfg.StakeExponent)
+			}
+		}
+		if hashRate == 0 {
+			return nil, fmt.Errorf("phase %s has no hash rate", phase.Name)
+		}
+
+		for i := 0; i < phase.Blocks; i++ {
+			blockTime, blockBits, winner, err := mineBlock(calc, rng,
+				miners, state, timestamp)
+			if err != nil {
+				return nil, err
+			}
+			height++
+
+			record := &blockRecord{
+				height:       height,
+				timestamp:    blockTime,
+				blockTime:    blockTime - timestamp,
+				bits:         blockBits,
+				requiredBits: blockBits,
+				coinbase:     winner.addr.String(),
+			}
+			if winner.stake != 0 {
+				record.multiplier = cross.StakingMultiplier(
+					activeNetParams, big.NewInt(winner.stake),
+					cfg.StakeExponent)
+			}
+			r.blocks = append(r.blocks, record)
+
+			winner.stats.blocks++
+			for _, m := range miners {
+				m.stats.hashWeight += m.hashRate / hashRate
+			}
+
+			calc.AddHeader(&wire.BlockHeader{
+				Version:   1,
+				Bits:      blockBits,
+				Timestamp: time.Unix(blockTime, 0),
+				Nonce:     uint64(height),
+			})
+			timestamp = blockTime
+		}
+		fmt.Printf("Phase %s mined up to block %d\n", phase.Name, height)
+	}
+	return r, nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/consensus"
+	"github.com/classzz/czzutil"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// csaTableFile is the file the proof of work table is loaded from.  It must
+// be in the working directory.
+const csaTableFile = "csatable.zip"
+
+// nonceBatch is the number of nonces tried between progress reports.
+const nonceBatch = 1 << 16
+
+type config struct {
+	NetParams string `short:"n" long:"netparams" description:"JSON or TOML network parameter file to mine the genesis block of" required:"true"`
+	Begin     uint64 `short:"b" long:"begin" description:"Nonce to start mining from"`
+	Quiet     bool   `short:"q" long:"quiet" description:"Do not report progress"`
+}
+
+func main() {
+	var cfg config
+	parser := flags.NewParser(&cfg, flags.Default)
+	_, err := parser.Parse()
+	if err != nil {
+		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return
+	}
+
+	if err := run(&cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run mines the genesis block of the configured network and prints its
+// genesis section with the found nonce and hash.
+func run(cfg *config) error {
+	path := cleanAndExpandPath(cfg.NetParams)
+	f, err := chaincfg.LoadNetParamsFile(path)
+	if err != nil {
+		return err
+	}
+
+	// The hash changes with the nonce, so validate the file without it.
+	f.Genesis.Hash = ""
+	if f.Genesis.Timestamp == 0 {
+		f.Genesis.Timestamp = time.Now().Unix()
+	}
+	params, err := f.Params()
+	if err != nil {
+		return fmt.Errorf("invalid network parameter file %s: %v", path, err)
+	}
+	target := blockchain.CompactToBig(f.Genesis.Bits)
+	if target.Sign() <= 0 || target.Cmp(params.PowLimit) > 0 {
+		return fmt.Errorf("genesis bits %08x are not within the powlimit",
+			f.Genesis.Bits)
+	}
+	if _, err := os.Stat(csaTableFile); err != nil {
+		return fmt.Errorf("%s must be in the working directory: %v",
+			csaTableFile, err)
+	}
+
+	genesis := f.GenesisBlock()
+	param := consensus.MiningParam{
+		Info: &consensus.CzzConsensusParam{
+			HeadHash: genesis.Header.BlockHashNoNonce(),
+			Target:   target,
+		},
+		Begin: cfg.Begin,
+		Loops: nonceBatch,
+		Abort: make(chan struct{}),
+	}
+	start := time.Now()
+	for {
+		nonce, found := consensus.MineBlock(&param)
+		if found {
+			genesis.Header.Nonce = nonce
+			break
+		}
+		if nonce < param.Begin {
+			return fmt.Errorf("no nonce satisfies the genesis bits")
+		}
+		param.Begin = nonce
+		if !cfg.Quiet {
+			fmt.Fprintf(os.Stderr, "Tried %d nonces in %v\n",
+				param.Begin-cfg.Begin, time.Since(start).Round(time.Second))
+		}
+	}
+
+	f.Genesis.Nonce = genesis.Header.Nonce
+	f.Genesis.Hash = genesis.BlockHash().String()
+	if _, err := f.Params(); err != nil {
+		return fmt.Errorf("mined genesis block is invalid: %v", err)
+	}
+	return printGenesis(f, chaincfg.IsTOML(path))
+}
+
+// printGenesis writes the genesis section of the passed file to stdout in the
+// format of the file.
+func printGenesis(f *chaincfg.NetParamsFile, isTOML bool) error {
+	if isTOML {
+		section := struct {
+			Genesis chaincfg.GenesisFile `toml:"genesis"`
+		}{f.Genesis}
+		return toml.NewEncoder(os.Stdout).Encode(section)
+	}
+
+	section := map[string]chaincfg.GenesisFile{"genesis": f.Genesis}
+	data, err := json.MarshalIndent(section, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// cleanAndExpandPath expands environement variables and leading ~ in the
+// passed path, cleans the result, and returns it.
+func cleanAndExpandPath(path string) string {
+	// Expand initial ~ to OS specific home directory.
+	if strings.HasPrefix(path, "~") {
+		appHomeDir := czzutil.AppDataDir("gengenesis", false)
+		homeDir := filepath.Dir(appHomeDir)
+		path = strings.Replace(path, "~", homeDir, 1)
+	}
+
+	// NOTE: The os.ExpandEnv doesn't work with Windows-style %VARIABLE%,
+	// but they variables can still be expanded via POSIX-style $VARIABLE.
+	return filepath.Clean(os.ExpandEnv(path))
+}
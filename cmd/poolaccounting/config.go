@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/classzz/czzutil"
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+var (
+	czzdHomeDir        = czzutil.AppDataDir("classzz", false)
+	defaultRPCServer   = "localhost"
+	defaultRPCCertFile = filepath.Join(czzdHomeDir, "rpc.cert")
+)
+
+// config defines the configuration options for poolaccounting.
+//
+// See loadConfig for details on the configuration load process.
+type config struct {
+	RPCUser     string `short:"u" long:"rpcuser" description:"RPC username"`
+	RPCPassword string `short:"P" long:"rpcpass" default-mask:"-" description:"RPC password"`
+	RPCServer   string `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	RPCCert     string `short:"c" long:"rpccert" description:"RPC server certificate chain for validation"`
+	NoTLS       bool   `long:"notls" description:"Disable TLS"`
+	TestNet3    bool   `long:"testnet" description:"Connect to testnet"`
+	SimNet      bool   `long:"simnet" description:"Connect to the simulation test network"`
+
+	Start  int32  `long:"start" description:"Height of the first block of the report, the Maui upgrade when zero"`
+	End    int32  `long:"end" description:"Height of the last block of the report, the best block when zero"`
+	Format string `short:"f" long:"format" description:"Output format, csv or json"`
+	OutDir string `short:"o" long:"outdir" description:"Directory to write pools.csv and pledges.csv, or accounting.json, to"`
+}
+
+// normalizeAddress returns addr with the default RPC port of the selected
+// network appended if there is not already a port specified.
+func normalizeAddress(addr string, useTestNet3, useSimNet bool) string {
+	_, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		var defaultPort string
+		switch {
+		case useTestNet3:
+			defaultPort = "18334"
+		case useSimNet:
+			defaultPort = "18556"
+		default:
+			defaultPort = "8334"
+		}
+
+		return net.JoinHostPort(addr, defaultPort)
+	}
+	return addr
+}
+
+// cleanAndExpandPath expands environment variables and leading ~ in the
+// passed path, cleans the result, and returns it.
+func cleanAndExpandPath(path string) string {
+	// Expand initial ~ to OS specific home directory.
+	if strings.HasPrefix(path, "~") {
+		homeDir := filepath.Dir(czzdHomeDir)
+		path = strings.Replace(path, "~", homeDir, 1)
+	}
+
+	// NOTE: The os.ExpandEnv doesn't work with Windows-style %VARIABLE%,
+	// but they variables can still be expanded via POSIX-style $VARIABLE.
+	return filepath.Clean(os.ExpandEnv(path))
+}
+
+// loadConfig initializes and parses the config using command line options.
+func loadConfig() (*config, []string, error) {
+	// Default config.
+	cfg := config{
+		RPCServer: defaultRPCServer,
+		RPCCert:   defaultRPCCertFile,
+		Format:    formatCSV,
+		OutDir:    ".",
+	}
+
+	// Parse command line options.
+	parser := flags.NewParser(&cfg, flags.Default)
+	remainingArgs, err := parser.Parse()
+	if err != nil {
+		if e, ok := err.(*flags.Error); !ok || e.Type != flags.ErrHelp {
+			parser.WriteHelp(os.Stderr)
+		}
+		return nil, nil, err
+	}
+
+	// Multiple networks can't be selected simultaneously.
+	funcName := "loadConfig"
+	if cfg.TestNet3 && cfg.SimNet {
+		str := "%s: The testnet and simnet params can't be used " +
+			"together -- choose one of the two"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+
+	// Validate the output format and the range.
+	cfg.Format = strings.ToLower(cfg.Format)
+	if cfg.Format != formatCSV && cfg.Format != formatJSON {
+		str := "%s: The specified output format [%v] is invalid -- " +
+			"supported formats %v"
+		err := fmt.Errorf(str, funcName, cfg.Format,
+			[]string{formatCSV, formatJSON})
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+	if cfg.Start < 0 || cfg.End < 0 || (cfg.End != 0 && cfg.End < cfg.Start) {
+		str := "%s: The range from %d to %d is invalid"
+		err := fmt.Errorf(str, funcName, cfg.Start, cfg.End)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, nil, err
+	}
+
+	cfg.RPCCert = cleanAndExpandPath(cfg.RPCCert)
+	cfg.OutDir = cleanAndExpandPath(cfg.OutDir)
+
+	// Add default port to RPC server based on --testnet and --simnet flags
+	// if needed.
+	cfg.RPCServer = normalizeAddress(cfg.RPCServer, cfg.TestNet3, cfg.SimNet)
+
+	return &cfg, remainingArgs, nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/classzz/classzz/btcjson"
+	"github.com/classzz/classzz/rpcclient"
+)
+
+var (
+	cfg *config
+)
+
+// fetchAccounting requests the accounting of the configured range from the
+// RPC server.
+func fetchAccounting() (*btcjson.GetPoolAccountingResult, error) {
+	connCfg := &rpcclient.ConnConfig{
+		Host:         cfg.RPCServer,
+		User:         cfg.RPCUser,
+		Pass:         cfg.RPCPassword,
+		DisableTLS:   cfg.NoTLS,
+		HTTPPostMode: true,
+	}
+	if !cfg.NoTLS {
+		pem, err := ioutil.ReadFile(cfg.RPCCert)
+		if err != nil {
+			return nil, err
+		}
+		connCfg.Certificates = pem
+	}
+	client, err := rpcclient.New(connCfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Shutdown()
+
+	var start, end *int32
+	if cfg.Start != 0 {
+		start = &cfg.Start
+	}
+	if cfg.End != 0 {
+		end = &cfg.End
+	}
+	return client.GetPoolAccounting(start, end)
+}
+
+// writeCSV writes the passed records to the named file in the output
+// directory.
+func writeCSV(name string, records [][]string) error {
+	path := filepath.Join(cfg.OutDir, name)
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(file)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// writePools writes the accounting of the pools.
+func writePools(r *btcjson.GetPoolAccountingResult) error {
+	records := [][]string{{"startheight", "endheight", "assettype",
+		"address", "burnedin", "paidout", "fees", "castin",
+		"convertedin", "mintedout", "pendingitems", "pendingamount",
+		"startbalance", "balance", "expectedbalance", "mismatches"}}
+	for _, p := range r.Pools {
+		records = append(records, []string{
+			strconv.Itoa(int(r.StartHeight)),
+			strconv.Itoa(int(r.EndHeight)),
+			strconv.Itoa(int(p.AssetType)),
+			p.Address,
+			strconv.FormatInt(p.BurnedIn, 10),
+			strconv.FormatInt(p.PaidOut, 10),
+			strconv.FormatInt(p.Fees, 10),
+			strconv.FormatInt(p.CastIn, 10),
+			strconv.FormatInt(p.ConvertedIn, 10),
+			strconv.FormatInt(p.MintedOut, 10),
+			strconv.Itoa(p.PendingItems),
+			strconv.FormatInt(p.PendingAmount, 10),
+			strconv.FormatInt(p.StartBalance, 10),
+			strconv.FormatInt(p.Balance, 10),
+			strconv.FormatInt(p.ExpectedBalance, 10),
+			strings.Join(p.Mismatches, "; "),
+		})
+	}
+	return writeCSV("pools.csv", records)
+}
+
+// writePledges writes the accounting of the pledges.
+func writePledges(r *btcjson.GetPoolAccountingResult) error {
+	records := [][]string{{"startheight", "endheight", "id", "address",
+		"startstakingamount", "stakingamount", "locked"}}
+	for _, p := range r.Pledges {
+		records = append(records, []string{
+			strconv.Itoa(int(r.StartHeight)),
+			strconv.Itoa(int(r.EndHeight)),
+			strconv.FormatInt(p.ID, 10),
+			p.Address,
+			strconv.FormatInt(p.StartStakingAmount, 10),
+			strconv.FormatInt(p.StakingAmount, 10),
+			strconv.FormatInt(p.Locked, 10),
+		})
+	}
+	return writeCSV("pledges.csv", records)
+}
+
+// writeJSON writes the whole accounting.
+func writeJSON(r *btcjson.GetPoolAccountingResult) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(cfg.OutDir, "accounting.json"),
+		append(data, '\n'), 0644)
+}
+
+func main() {
+	// Load configuration and parse command line.
+	tcfg, _, err := loadConfig()
+	if err != nil {
+		return
+	}
+	cfg = tcfg
+
+	r, err := fetchAccounting()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to fetch accounting:", err)
+		os.Exit(1)
+	}
+
+	writers := []func(*btcjson.GetPoolAccountingResult) error{writePools,
+		writePledges}
+	if cfg.Format == formatJSON {
+		writers = []func(*btcjson.GetPoolAccountingResult) error{writeJSON}
+	}
+	for _, write := range writers {
+		if err := write(r); err != nil {
+			fmt.Fprintln(os.Stderr, "failed to write output:", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Printf("Accounted blocks %d to %d\n", r.StartHeight, r.EndHeight)
+	if r.Consistent {
+		return
+	}
+	for _, p := range r.Pools {
+		for _, m := range p.Mismatches {
+			fmt.Fprintf(os.Stderr, "pool %d (%s): %s\n", p.AssetType,
+				p.Address, m)
+		}
+	}
+	os.Exit(2)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/czzutil"
+)
+
+// convertRecord is a conversion of a Convert transaction as verified against
+// its external chain, along with the fields the committee state keeps of it.
+type convertRecord struct {
+	AssetType   uint8    `json:"assettype"`
+	ConvertType uint8    `json:"converttype"`
+	ExtTxHash   string   `json:"exttxhash"`
+	PubKey      string   `json:"pubkey"`
+	Amount      *big.Int `json:"amount"`
+	FeeAmount   *big.Int `json:"feeamount"`
+	ToToken     string   `json:"totoken"`
+}
+
+// convertCache stubs out the external verification of Convert transactions
+// with the conversions recorded for them, keyed by transaction hash.  The
+// conversions must come from verifying the transactions against their external
+// chains, never from the audited state.
+type convertCache struct {
+	records map[string][]*convertRecord
+}
+
+// newConvertCache returns an empty cache.
+func newConvertCache() *convertCache {
+	return &convertCache{records: make(map[string][]*convertRecord)}
+}
+
+// loadConvertCache returns the cache recorded to the passed file.
+func loadConvertCache(path string) (*convertCache, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := newConvertCache()
+	if err := json.Unmarshal(data, &c.records); err != nil {
+		return nil, fmt.Errorf("invalid cache %s: %v", path, err)
+	}
+	return c, nil
+}
+
+// unverifiableError identifies a block whose state cannot be replayed since
+// the conversions of one of its Convert transactions are not cached.
+type unverifiableError struct {
+	Height int32
+	Hash   string
+	TxHash string
+}
+
+// Error satisfies the error interface and prints human-readable errors.
+func (e *unverifiableError) Error() string {
+	return fmt.Sprintf("block %d (%s) is unverifiable: the conversions "+
+		"of Convert transaction %s are not cached", e.Height, e.Hash,
+		e.TxHash)
+}
+
+// infos returns the conversions of the passed Convert transaction.  An
+// unverifiableError without the block is returned when they are not cached.
+func (c *convertCache) infos(tx *czzutil.Tx) ([]*cross.ConvertTxInfo, error) {
+	hash := tx.Hash().String()
+	records, ok := c.records[hash]
+	if !ok {
+		return nil, &unverifiableError{TxHash: hash}
+	}
+
+	infos := make([]*cross.ConvertTxInfo, 0, len(records))
+	for _, r := range records {
+		pubKey, err := hex.DecodeString(r.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key of conversion "+
+				"%s of transaction %s: %v", r.ExtTxHash, hash, err)
+		}
+		infos = append(infos, &cross.ConvertTxInfo{
+			AssetType:   r.AssetType,
+			ConvertType: r.ConvertType,
+			ExtTxHash:   r.ExtTxHash,
+			PubKey:      pubKey,
+			Amount:      r.Amount,
+			FeeAmount:   r.FeeAmount,
+			ToToken:     r.ToToken,
+		})
+	}
+	return infos, nil
+}
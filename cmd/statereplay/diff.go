@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/classzz/classzz/cross"
+)
+
+// stateDiff is a difference between an entry of the stored and the replayed
+// state.  The value of an entry missing from one of the states is empty.
+type stateDiff struct {
+	Section  string `json:"section"`
+	Key      string `json:"key"`
+	Stored   string `json:"stored,omitempty"`
+	Replayed string `json:"replayed,omitempty"`
+}
+
+// divergence describes the first block whose replayed state differs from the
+// stored one.
+type divergence struct {
+	Height       int32        `json:"height"`
+	Hash         string       `json:"hash"`
+	State        string       `json:"state"`
+	StoredHash   string       `json:"storedhash"`
+	ReplayedHash string       `json:"replayedhash"`
+	Diffs        []*stateDiff `json:"diffs"`
+}
+
+// stateEntries maps the sections of a flattened state to its entries by key.
+type stateEntries map[string]map[string]string
+
+// put adds the passed value, encoded as JSON unless it is a string, to the
+// entries.
+func (e stateEntries) put(section, key string, value interface{}) {
+	entries, ok := e[section]
+	if !ok {
+		entries = make(map[string]string)
+		e[section] = entries
+	}
+	if s, ok := value.(string); ok {
+		entries[key] = s
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		data = []byte(fmt.Sprintf("%+v", value))
+	}
+	entries[key] = string(data)
+}
+
+// committeeEntries flattens the passed committee state into its pledges,
+// committees, convert items, confirmed convert items, pool utxos and pools.
+func committeeEntries(cState *cross.CommitteeState) stateEntries {
+	e := make(stateEntries)
+	if cState == nil {
+		return e
+	}
+	e.put("maxitemid", "", fmt.Sprint(cState.MaxItemID))
+	for _, pledge := range cState.PledgeInfos {
+		e.put("pledges", fmt.Sprint(pledge.ID), pledge)
+	}
+	for _, committee := range cState.CommitteeInfos {
+		e.put("committees", fmt.Sprint(committee.Id), committee)
+	}
+	for section, itemsByType := range map[string]map[uint8]cross.ConvertItemMap{
+		"convertitems": cState.ConvertItems,
+		"confirmitems": cState.ConvertConfirmItems,
+	} {
+		for assetType, itemMap := range itemsByType {
+			for convertType, list := range itemMap {
+				for _, item := range list {
+					key := fmt.Sprintf("%d/%d/%v", assetType,
+						convertType, item.ID)
+					e.put(section, key, item)
+				}
+			}
+		}
+	}
+	for addr, item := range cState.NoCostUtxos {
+		for i, out := range item.POut {
+			amount := ""
+			if i < len(item.Amount) {
+				amount = fmt.Sprint(item.Amount[i])
+			}
+			e.put("poolutxos", addr+" "+out.String(), amount)
+		}
+	}
+	for _, pool := range cState.Pools {
+		e.put("pools", strconv.Itoa(int(pool.AssetType)), pool)
+	}
+	return e
+}
+
+// entangleEntries flattens the passed entangle state into its beacon
+// addresses, entangle entities and pool amounts.
+func entangleEntries(eState *cross.EntangleState) stateEntries {
+	e := make(stateEntries)
+	if eState == nil {
+		return e
+	}
+	for addr, info := range eState.EnInfos {
+		e.put("beacons", addr, info)
+	}
+	for id, entitys := range eState.EnEntitys {
+		e.put("entitys", strconv.FormatUint(id, 10), entitys)
+	}
+	e.put("poolamounts", "1", fmt.Sprint(eState.PoolAmount1))
+	e.put("poolamounts", "2", fmt.Sprint(eState.PoolAmount2))
+	e.put("curexchangeid", "", strconv.FormatUint(eState.CurExchangeID, 10))
+	return e
+}
+
+// diffEntries returns the entries which differ between the stored and the
+// replayed state, ordered by section and key.
+func diffEntries(stored, replayed stateEntries) []*stateDiff {
+	sections := make(map[string]struct{})
+	for section := range stored {
+		sections[section] = struct{}{}
+	}
+	for section := range replayed {
+		sections[section] = struct{}{}
+	}
+
+	var diffs []*stateDiff
+	for section := range sections {
+		keys := make(map[string]struct{})
+		for key := range stored[section] {
+			keys[key] = struct{}{}
+		}
+		for key := range replayed[section] {
+			keys[key] = struct{}{}
+		}
+		for key := range keys {
+			s, r := stored[section][key], replayed[section][key]
+			if s != r {
+				diffs = append(diffs, &stateDiff{
+					Section:  section,
+					Key:      key,
+					Stored:   s,
+					Replayed: r,
+				})
+			}
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Section != diffs[j].Section {
+			return diffs[i].Section < diffs[j].Section
+		}
+		return diffs[i].Key < diffs[j].Key
+	})
+	return diffs
+}
+
+// printDivergence writes the passed divergence to w as text or, when asJSON
+// is set, as JSON.
+func printDivergence(w io.Writer, d *divergence, asJSON bool) error {
+	if asJSON {
+		data, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	fmt.Fprintf(w, "First divergent block %d (%s)\n", d.Height, d.Hash)
+	fmt.Fprintf(w, "%s state hash: stored %s, replayed %s\n", d.State,
+		d.StoredHash, d.ReplayedHash)
+	if len(d.Diffs) == 0 {
+		fmt.Fprintln(w, "The entries of the states are equal, they only "+
+			"differ in their order")
+	}
+	for _, diff := range d.Diffs {
+		fmt.Fprintf(w, "%s %s:\n", diff.Section, diff.Key)
+		fmt.Fprintf(w, "  stored:   %s\n", orMissing(diff.Stored))
+		fmt.Fprintf(w, "  replayed: %s\n", orMissing(diff.Replayed))
+	}
+	return nil
+}
+
+// orMissing returns the passed value, or a placeholder when it is empty.
+func orMissing(value string) string {
+	if value == "" {
+		return "(missing)"
+	}
+	return value
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/database"
+	"github.com/classzz/czzutil"
+)
+
+const blockDbNamePrefix = "blocks"
+
+var (
+	cfg *config
+)
+
+// loadBlockDB opens the block database read-only and returns a handle to it.
+// The audited database is never written to.
+func loadBlockDB() (database.DB, error) {
+	// The database name is based on the database type.
+	dbName := blockDbNamePrefix + "_" + cfg.DbType
+	dbPath := filepath.Join(cfg.DataDir, dbName)
+	fmt.Printf("Loading block database from '%s'\n", dbPath)
+	db, err := database.OpenReadOnly(cfg.DbType, dbPath, activeNetParams.Net)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// replayer recomputes the cross-chain state of the blocks of the main chain
+// and compares it with the state stored for them.
+type replayer struct {
+	dbTx  database.Tx
+	cache *convertCache
+
+	// cState and eState are the replayed states after the previous block.
+	cState *cross.CommitteeState
+	eState *cross.EntangleState
+}
+
+// loadParentState starts the replay with the stored states of the parent of
+// the block at the passed height.
+func (r *replayer) loadParentState(height int32) error {
+	block, err := blockchain.DBFetchBlockByHeight(r.dbTx, height)
+	if err != nil {
+		return err
+	}
+	prev, hash := height-1, &block.MsgBlock().Header.PrevBlock
+	if activeNetParams.IsActive(chaincfg.UpgradeMaui, prev) {
+		r.cState, err = blockchain.DBFetchCommitteeState(r.dbTx, hash, prev)
+		return err
+	}
+	if activeNetParams.IsActive(chaincfg.UpgradeBeacon, prev) {
+		r.eState, err = blockchain.DBFetchEntangleState(r.dbTx, hash, prev)
+		return err
+	}
+	return nil
+}
+
+// connect replays the passed block and returns where its state diverges from
+// the stored one, or nil when it matches.
+func (r *replayer) connect(block *czzutil.Block) (*divergence, error) {
+	height, hash := block.Height(), block.Hash()
+	if activeNetParams.IsActive(chaincfg.UpgradeMaui, height) {
+		cState, err := blockchain.ConnectCommitteeState(activeNetParams,
+			r.cState, r.eState, block, r.cache.infos)
+		if u, ok := err.(*unverifiableError); ok {
+			u.Height, u.Hash = height, hash.String()
+			return nil, u
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay block %d: %v",
+				height, err)
+		}
+		r.cState, r.eState = cState, nil
+
+		stored, err := blockchain.DBFetchCommitteeState(r.dbTx, hash,
+			height)
+		if err != nil {
+			return nil, err
+		}
+		if stored.Hash() == cState.Hash() {
+			return nil, nil
+		}
+		return &divergence{
+			Height:       height,
+			Hash:         hash.String(),
+			State:        "committee",
+			StoredHash:   stored.Hash().String(),
+			ReplayedHash: cState.Hash().String(),
+			Diffs: diffEntries(committeeEntries(stored),
+				committeeEntries(cState)),
+		}, nil
+	}
+
+	eState, err := blockchain.ConnectEntangleState(activeNetParams,
+		r.eState, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay block %d: %v", height, err)
+	}
+	r.eState = eState
+	if eState == nil {
+		return nil, nil
+	}
+
+	stored, err := blockchain.DBFetchEntangleState(r.dbTx, hash, height)
+	if err != nil {
+		return nil, err
+	}
+	if stored.Hash() == eState.Hash() {
+		return nil, nil
+	}
+	return &divergence{
+		Height:       height,
+		Hash:         hash.String(),
+		State:        "entangle",
+		StoredHash:   stored.Hash().String(),
+		ReplayedHash: eState.Hash().String(),
+		Diffs:        diffEntries(entangleEntries(stored), entangleEntries(eState)),
+	}, nil
+}
+
+// replay replays the configured range of the main chain in the block database
+// and returns the first divergent block, or nil when every replayed state
+// matches the stored one.  The blocks and states are read from a single
+// read-only database transaction, so a running node cannot change them
+// during the replay.
+func replay(cache *convertCache) (*divergence, error) {
+	db, err := loadBlockDB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load database: %v", err)
+	}
+	defer db.Close()
+
+	var d *divergence
+	err = db.View(func(dbTx database.Tx) error {
+		best, err := blockchain.DBFetchBestHeight(dbTx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Block database loaded with block height %d\n", best)
+
+		end := cfg.End
+		if end == 0 {
+			end = best
+		}
+		if end > best || cfg.Start > end {
+			return fmt.Errorf("the block database has no blocks "+
+				"from %d to %d", cfg.Start, end)
+		}
+
+		r := &replayer{dbTx: dbTx, cache: cache}
+		if err := r.loadParentState(cfg.Start); err != nil {
+			return fmt.Errorf("failed to load the state before "+
+				"block %d: %v", cfg.Start, err)
+		}
+		for height := cfg.Start; height <= end; height++ {
+			block, err := blockchain.DBFetchBlockByHeight(dbTx, height)
+			if err != nil {
+				return err
+			}
+			d, err = r.connect(block)
+			if err != nil || d != nil {
+				return err
+			}
+		}
+		fmt.Printf("Replayed the state of blocks %d to %d\n",
+			cfg.Start, end)
+		return nil
+	})
+	return d, err
+}
+
+func main() {
+	// Load configuration and parse command line.
+	tcfg, _, err := loadConfig()
+	if err != nil {
+		return
+	}
+	cfg = tcfg
+
+	cache := newConvertCache()
+	if cfg.Cache != "" {
+		cache, err = loadConvertCache(cfg.Cache)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	d, err := replay(cache)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if _, ok := err.(*unverifiableError); ok {
+			os.Exit(3)
+		}
+		os.Exit(1)
+	}
+	if d != nil {
+		printDivergence(os.Stdout, d, cfg.JSON)
+		os.Exit(2)
+	}
+}
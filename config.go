@@ -29,6 +29,7 @@ import (
 	_ "github.com/classzz/classzz/database/ffldb"
 	"github.com/classzz/classzz/mempool"
 	"github.com/classzz/classzz/peer"
+	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/version"
 	"github.com/classzz/czzutil"
 
@@ -56,6 +57,7 @@ const (
 	defaultBlockMinSize            = 0
 	defaultBlockMaxSize            = 7500000
 	blockMaxSizeMin                = 1000
+	defaultBlockMaxConvertItems    = 100
 	defaultGenerate                = false
 	defaultMaxOrphanTransactions   = 100
 	defaultMaxOrphanTxSize         = 100000
@@ -148,6 +150,7 @@ type config struct {
 	TestNet                 bool          `long:"testnet" description:"Use the test network"`
 	RegressionTest          bool          `long:"regtest" description:"Use the regression test network"`
 	SimNet                  bool          `long:"simnet" description:"Use the simulation test network"`
+	NetParams               string        `long:"netparams" description:"Use the custom network defined by the passed JSON or TOML file"`
 	AddCheckpoints          []string      `long:"addcheckpoint" description:"Add a custom checkpoint.  Format: '<height>:<hash>'"`
 	DisableCheckpoints      bool          `long:"nocheckpoints" description:"Disable built-in checkpoints.  Don't do this unless you know what you're doing."`
 	DbType                  string        `long:"dbtype" description:"Database backend to use for the Block Chain"`
@@ -166,9 +169,13 @@ type config struct {
 	BlockMinSize            uint32        `long:"blockminsize" description:"Mininum block size in bytes to be used when creating a block"`
 	BlockMaxSize            uint32        `long:"blockmaxsize" description:"Maximum block size in bytes to be used when creating a block"`
 	BlockPrioritySize       uint32        `long:"blockprioritysize" description:"Size in bytes for high-priority/low-fee transactions when creating a block"`
+	BlockMaxConvertItems    uint32        `long:"blockmaxconvertitems" description:"Maximum number of conversions of Convert transactions verified when creating a block -- 0 for no limit"`
+	BlockMaxConvertValue    float64       `long:"blockmaxconvertvalue" description:"Maximum value in CZZ of the conversions of Convert transactions when creating a block -- 0 for no limit"`
 	UserAgentComments       []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters      bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
 	NoCFilters              bool          `long:"nocfilters" description:"Disable committed filtering (CF) support"`
+	NoGraphene              bool          `long:"nographene" description:"Disable graphene block relay"`
+	NoTxRecon               bool          `long:"notxrecon" description:"Disable transaction relay by set reconciliation and flood transactions to all peers instead"`
 	DropCfIndex             bool          `long:"dropcfindex" description:"Deletes the index used for committed filtering (CF) support from the database on start up and then exits."`
 	SigCacheMaxSize         uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
 	UtxoCacheMaxSizeMiB     uint          `long:"utxocachemaxsize" description:"The maximum size in MiB of the UTXO cache"`
@@ -184,8 +191,12 @@ type config struct {
 	TargetOutboundPeers     uint32        `long:"targetoutboundpeers" description:"number of outbound connections to maintain"`
 	ReIndexChainState       bool          `long:"reindexchainstate" description:"Rebuild the UTXO database from currently indexed blocks on disk."`
 	FastSync                bool          `long:"fastsync" description:"Sync full blocks from the last checkpoint to the tip rather than from genesis."`
+	HeadersOnly             bool          `long:"headersonly" description:"Only sync and validate block headers and fetch committed filters rather than full blocks. No UTXO set is maintained in this mode."`
+	WatchAddrs              []string      `long:"watchaddr" description:"Add the specified address to the list of addresses whose blocks are fetched in headers-only mode"`
 	GrpcListeners           []string      `long:"grpclisten" description:"Add an interface/port to listen for experimental gRPC connections (default port: 8335, testnet: 18335)"`
 	GrpcAuthToken           string        `long:"grpcauthtoken" description:"An authentication token for the gRPC API to authenticate clients"`
+	GrpcAuthFile            string        `long:"grpcauthfile" description:"JSON file defining the public gRPC methods and named authentication tokens with their allowed methods and rate limits"`
+	GrpcClientCA            string        `long:"grpcclientca" description:"File containing the certificate authorities gRPC clients must present a certificate from (enables mutual TLS)"`
 	DBCacheSize             uint64        `long:"dbcachesize" description:"The maximum size in MiB of the database cache"`
 	DBFlushInterval         uint32        `long:"dbflushinterval" description:"The number of seconds between database flushes"`
 
@@ -200,8 +211,11 @@ type config struct {
 	dial           func(string, string, time.Duration) (net.Conn, error)
 	addCheckpoints []chaincfg.Checkpoint
 	miningAddrs    []czzutil.Address
+	watchedScripts [][]byte
 	minRelayTxFee  czzutil.Amount
 	whitelists     []*net.IPNet
+
+	blockMaxConvertValue czzutil.Amount
 }
 
 // serviceOptions defines the configuration options for the daemon as a service on
@@ -457,6 +471,7 @@ func loadConfig() (*config, []string, error) {
 		BlockMinSize:            defaultBlockMinSize,
 		BlockMaxSize:            defaultBlockMaxSize,
 		BlockPrioritySize:       mempool.DefaultBlockPrioritySize,
+		BlockMaxConvertItems:    defaultBlockMaxConvertItems,
 		MaxOrphanTxs:            defaultMaxOrphanTransactions,
 		SigCacheMaxSize:         defaultSigCacheMaxSize,
 		UtxoCacheMaxSizeMiB:     defaultUtxoCacheMaxSizeMiB,
@@ -509,8 +524,8 @@ func loadConfig() (*config, []string, error) {
 	// Load additional config from file.
 	var configFileError error
 	parser := newConfigParser(&cfg, &serviceOpts, flags.Default)
-	if !(preCfg.RegressionTest || preCfg.SimNet) || preCfg.ConfigFile !=
-		defaultConfigFile {
+	if !(preCfg.RegressionTest || preCfg.SimNet || preCfg.NetParams != "") ||
+		preCfg.ConfigFile != defaultConfigFile {
 
 		if _, err := os.Stat(preCfg.ConfigFile); os.IsNotExist(err) {
 			err := createDefaultConfigFile(preCfg.ConfigFile)
@@ -584,8 +599,20 @@ func loadConfig() (*config, []string, error) {
 		activeNetParams = &simNetParams
 		cfg.DisableDNSSeed = true
 	}
+	if cfg.NetParams != "" {
+		numNets++
+		cfg.NetParams = cleanAndExpandPath(cfg.NetParams)
+		netParams, err := loadNetParams(cfg.NetParams)
+		if err != nil {
+			str := "%s: Failed to load network parameters: %v"
+			err := fmt.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, nil, err
+		}
+		activeNetParams = netParams
+	}
 	if numNets > 1 {
-		str := "%s: The testnet, regtest, segnet, and simnet params " +
+		str := "%s: The testnet, regtest, simnet, and netparams params " +
 			"can't be used together -- choose one of the four"
 		err := fmt.Errorf(str, funcName)
 		fmt.Fprintln(os.Stderr, err)
@@ -621,6 +648,38 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Headers-only mode does not maintain a UTXO set or full blocks, so
+	// none of the options that rely on them can be used with it.
+	if cfg.HeadersOnly && (cfg.Prune || cfg.FastSync || cfg.ReIndexChainState) {
+		str := "%s: prune, fastsync and reindexchainstate can not be used " +
+			"with headers-only mode."
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.HeadersOnly && (cfg.TxIndex || cfg.AddrIndex) {
+		str := "%s: txindex and addrindex can not be used with " +
+			"headers-only mode."
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	if cfg.HeadersOnly && cfg.Generate {
+		str := "%s: generate can not be used with headers-only mode."
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// Headers-only nodes can't validate transactions, so they are never
+	// accepted from remote peers.
+	if cfg.HeadersOnly {
+		cfg.BlocksOnly = true
+	}
+
 	// Checkpoints must not be disabled in fast sync mode
 	if cfg.FastSync && cfg.DisableCheckpoints {
 		str := "%s: disablecheckpoints can not be used with fast sync mode."
@@ -875,6 +934,16 @@ func loadConfig() (*config, []string, error) {
 	cfg.BlockPrioritySize = minUint32(cfg.BlockPrioritySize, cfg.BlockMaxSize)
 	cfg.BlockMinSize = minUint32(cfg.BlockMinSize, cfg.BlockMaxSize)
 
+	// Validate the blockmaxconvertvalue.
+	cfg.blockMaxConvertValue, err = czzutil.NewAmount(cfg.BlockMaxConvertValue)
+	if err != nil || cfg.blockMaxConvertValue < 0 {
+		str := "%s: invalid blockmaxconvertvalue: %v"
+		err := fmt.Errorf(str, funcName, cfg.BlockMaxConvertValue)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Prepend ExcessiveBlockSize signaling to the UserAgentComments
 	cfg.UserAgentComments = append([]string{fmt.Sprintf("EB%.1f", float64(cfg.ExcessiveBlockSize)/1000000)}, cfg.UserAgentComments...)
 
@@ -975,6 +1044,35 @@ func loadConfig() (*config, []string, error) {
 		cfg.miningAddrs = append(cfg.miningAddrs, addr)
 	}
 
+	// Check watch addresses are valid and save their output scripts.
+	cfg.watchedScripts = make([][]byte, 0, len(cfg.WatchAddrs))
+	for _, strAddr := range cfg.WatchAddrs {
+		addr, err := czzutil.DecodeAddress(strAddr, activeNetParams.Params)
+		if err != nil {
+			str := "%s: watch address '%s' failed to decode: %v"
+			err := fmt.Errorf(str, funcName, strAddr, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		if !addr.IsForNet(activeNetParams.Params) {
+			str := "%s: watch address '%s' is on the wrong network"
+			err := fmt.Errorf(str, funcName, strAddr)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			str := "%s: watch address '%s' is not supported: %v"
+			err := fmt.Errorf(str, funcName, strAddr, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
+		cfg.watchedScripts = append(cfg.watchedScripts, pkScript)
+	}
+
 	// Add default port to all listener addresses if needed and remove
 	// duplicate addresses.
 	cfg.Listeners = normalizeAddresses(cfg.Listeners,
@@ -0,0 +1,213 @@
+package cross
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/czzutil"
+)
+
+// PoolAccount is the accounting of the pool of an asset type between two
+// committee states.  Amounts are in the smallest unit of czz.
+type PoolAccount struct {
+	AssetType uint8
+	Address   string
+
+	// BurnedIn is the amount of the conversions from the asset type, whose
+	// tokens were burned on its external chain.  PaidOut is the part of it
+	// converted to czz, and Fees the fees of the conversions.
+	BurnedIn *big.Int
+	PaidOut  *big.Int
+	Fees     *big.Int
+
+	// CastIn is the amount of czz cast into the pool, and ConvertedIn the
+	// amount of conversions from other asset types credited to it after
+	// their fees.
+	CastIn      *big.Int
+	ConvertedIn *big.Int
+
+	// MintedOut is the amount of the conversions to the asset type whose
+	// tokens were confirmed to be minted on its external chain, and the
+	// pending items those still waiting for it.
+	MintedOut     *big.Int
+	PendingItems  int
+	PendingAmount *big.Int
+
+	// StartBalance and Balance are the amounts of the utxos of the pool in
+	// the two states.  ExpectedBalance is the start balance along with the
+	// flows in between.
+	StartBalance    *big.Int
+	Balance         *big.Int
+	ExpectedBalance *big.Int
+}
+
+// Mismatches returns the inconsistencies of the account, which are empty
+// when the balance of the pool matches its flows.
+func (a *PoolAccount) Mismatches() []string {
+	var mismatches []string
+	if a.Balance.Cmp(a.ExpectedBalance) != 0 {
+		mismatches = append(mismatches, fmt.Sprintf("pool balance %v "+
+			"differs from the expected balance %v by %v", a.Balance,
+			a.ExpectedBalance, new(big.Int).Sub(a.Balance, a.ExpectedBalance)))
+	}
+	if a.PaidOut.Cmp(a.BurnedIn) > 0 {
+		mismatches = append(mismatches, fmt.Sprintf("paid out %v exceeds "+
+			"burned in %v", a.PaidOut, a.BurnedIn))
+	}
+	return mismatches
+}
+
+// PledgeAccount is the accounting of a pledge between two committee states.
+type PledgeAccount struct {
+	ID      *big.Int
+	Address string
+
+	// StartStakingAmount and StakingAmount are the staked amounts in the two
+	// states, and Locked the amount of the utxos held for the pledge.
+	StartStakingAmount *big.Int
+	StakingAmount      *big.Int
+	Locked             *big.Int
+}
+
+// poolAddress returns the address of the pool of the passed asset type.
+func poolAddress(params *chaincfg.Params, assetType uint8) (string, error) {
+	addr, err := czzutil.NewAddressPubKeyHash(CoinPools[assetType], params)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// utxoBalance returns the total amount of the no cost utxos of the passed
+// address.
+func utxoBalance(cs *CommitteeState, address string) *big.Int {
+	balance := big.NewInt(0)
+	if item := cs.NoCostUtxos[address]; item != nil {
+		for _, amount := range item.Amount {
+			balance.Add(balance, amount)
+		}
+	}
+	return balance
+}
+
+// PoolAccounting returns the accounting of the pools of all asset types,
+// ordered by asset type, from the prev committee state to cur.  A nil prev is
+// treated as an empty state.  Flows are taken from the items created and
+// confirmed in between, which are never removed from the state.
+func PoolAccounting(params *chaincfg.Params, prev, cur *CommitteeState) ([]*PoolAccount, error) {
+	if prev == nil {
+		prev = NewCommitteeState()
+	}
+
+	accounts := make(map[uint8]*PoolAccount)
+	assetTypes := make([]uint8, 0, len(CoinPools))
+	for assetType := range CoinPools {
+		address, err := poolAddress(params, assetType)
+		if err != nil {
+			return nil, err
+		}
+		accounts[assetType] = &PoolAccount{
+			AssetType:     assetType,
+			Address:       address,
+			BurnedIn:      big.NewInt(0),
+			PaidOut:       big.NewInt(0),
+			Fees:          big.NewInt(0),
+			CastIn:        big.NewInt(0),
+			ConvertedIn:   big.NewInt(0),
+			MintedOut:     big.NewInt(0),
+			PendingAmount: big.NewInt(0),
+			StartBalance:  utxoBalance(prev, address),
+			Balance:       utxoBalance(cur, address),
+		}
+		assetTypes = append(assetTypes, assetType)
+	}
+	sort.Slice(assetTypes, func(i, j int) bool {
+		return assetTypes[i] < assetTypes[j]
+	})
+
+	add := func(sum, amount *big.Int) {
+		if amount != nil {
+			sum.Add(sum, amount)
+		}
+	}
+	for _, event := range DiffCommitteeState(prev, cur) {
+		item := event.Item
+		switch event.Type {
+		case EventCastingCreated:
+			if to, ok := accounts[event.ConvertType]; ok {
+				add(to.CastIn, item.Amount)
+			}
+
+		case EventConvertItemCreated:
+			if from, ok := accounts[event.AssetType]; ok {
+				add(from.BurnedIn, item.Amount)
+				add(from.Fees, item.FeeAmount)
+				if event.ConvertType == ExpandedTxConvert_Czz {
+					add(from.PaidOut, item.Amount)
+				}
+			}
+			if to, ok := accounts[event.ConvertType]; ok {
+				add(to.ConvertedIn, item.Amount)
+				if item.FeeAmount != nil {
+					to.ConvertedIn.Sub(to.ConvertedIn, item.FeeAmount)
+				}
+			}
+
+		case EventConvertItemConfirmed:
+			if to, ok := accounts[event.ConvertType]; ok {
+				add(to.MintedOut, item.Amount)
+			}
+		}
+	}
+
+	forEachConvertItem(cur.ConvertItems, func(_, convertType uint8, item *ConvertItem) {
+		if to, ok := accounts[convertType]; ok {
+			to.PendingItems++
+			add(to.PendingAmount, item.Amount)
+		}
+	})
+
+	result := make([]*PoolAccount, 0, len(assetTypes))
+	for _, assetType := range assetTypes {
+		a := accounts[assetType]
+		a.ExpectedBalance = new(big.Int).Add(a.StartBalance, a.CastIn)
+		a.ExpectedBalance.Add(a.ExpectedBalance, a.ConvertedIn)
+		a.ExpectedBalance.Sub(a.ExpectedBalance, a.BurnedIn)
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+// PledgeAccounting returns the accounting of the pledges of the cur committee
+// state, ordered by ID, along with their staked amounts in the prev state.
+func PledgeAccounting(prev, cur *CommitteeState) []*PledgeAccount {
+	if prev == nil {
+		prev = NewCommitteeState()
+	}
+
+	accounts := make([]*PledgeAccount, 0, len(cur.PledgeInfos))
+	for _, pledge := range cur.PledgeInfos {
+		a := &PledgeAccount{
+			ID:                 pledge.ID,
+			Address:            pledge.Address,
+			StartStakingAmount: big.NewInt(0),
+			StakingAmount:      big.NewInt(0),
+			Locked:             utxoBalance(cur, pledge.Address),
+		}
+		if pledge.StakingAmount != nil {
+			a.StakingAmount.Set(pledge.StakingAmount)
+		}
+		if old := prev.GetPledgeInfoByID(pledge.ID); old != nil &&
+			old.StakingAmount != nil {
+
+			a.StartStakingAmount.Set(old.StakingAmount)
+		}
+		accounts = append(accounts, a)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].ID.Cmp(accounts[j].ID) < 0
+	})
+	return accounts
+}
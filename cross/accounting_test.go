@@ -0,0 +1,143 @@
+package cross
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/wire"
+)
+
+// TestPoolAccounting ensures the flows of the pools between two committee
+// states are taken from the items created and confirmed in between, and pool
+// balances not matching them are flagged.
+func TestPoolAccounting(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	setBalance := func(cs *CommitteeState, assetType uint8, balance int64) {
+		address, err := poolAddress(params, assetType)
+		if err != nil {
+			t.Fatalf("poolAddress: %v", err)
+		}
+		delete(cs.NoCostUtxos, address)
+		cs.PutNoCostUtxos(address, wire.OutPoint{Index: uint32(assetType)},
+			nil, balance)
+	}
+
+	prev := NewCommitteeState()
+	prev.Mortgage("pledge1", []byte{1}, []byte{2}, big.NewInt(100), nil)
+	prev.PutNoCostUtxos("pledge1", wire.OutPoint{Index: 9}, nil, 100)
+	prev.Convert(&ConvertTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_HCzz,
+		ExtTxHash:   "ext1",
+		Amount:      big.NewInt(10),
+		FeeAmount:   big.NewInt(1),
+	}, "tx1")
+	setBalance(prev, ExpandedTxConvert_ECzz, 1000)
+	setBalance(prev, ExpandedTxConvert_HCzz, 500)
+
+	cur := NewCommitteeState()
+	if err := rlp.DecodeBytes(prev.ToBytes(), cur); err != nil {
+		t.Fatalf("DecodeBytes: %v", err)
+	}
+	cur.AddMortgage("pledge1", big.NewInt(50))
+	cur.PutNoCostUtxos("pledge1", wire.OutPoint{Index: 10}, nil, 50)
+	cur.Convert(&ConvertTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_Czz,
+		ExtTxHash:   "ext2",
+		Amount:      big.NewInt(100),
+		FeeAmount:   big.NewInt(0),
+	}, "tx2")
+	cur.Convert(&ConvertTxInfo{
+		AssetType:   ExpandedTxConvert_HCzz,
+		ConvertType: ExpandedTxConvert_ECzz,
+		ExtTxHash:   "ext3",
+		Amount:      big.NewInt(50),
+		FeeAmount:   big.NewInt(5),
+	}, "tx3")
+	cur.Convert(&ConvertTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_BCzz,
+		ExtTxHash:   "ext4",
+		Amount:      big.NewInt(20),
+		FeeAmount:   big.NewInt(2),
+	}, "tx4")
+	cur.Casting(&CastingTxInfo{
+		ConvertType: ExpandedTxConvert_ECzz,
+		Amount:      big.NewInt(30),
+	}, "tx5")
+	cur.ConvertConfirm(&ConvertConfirmTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_HCzz,
+		ID:          big.NewInt(1),
+		ExtTxHash:   "confirm1",
+	})
+	setBalance(cur, ExpandedTxConvert_ECzz, 1000-120+45)
+	setBalance(cur, ExpandedTxConvert_HCzz, 500-50)
+	setBalance(cur, ExpandedTxConvert_BCzz, 25)
+
+	accounts, err := PoolAccounting(params, prev, cur)
+	if err != nil {
+		t.Fatalf("PoolAccounting: %v", err)
+	}
+	want := []struct {
+		assetType                                             uint8
+		burnedIn, paidOut, fees, castIn, convertedIn, minted  int64
+		pendingItems                                          int
+		pendingAmount, startBalance, balance, expectedBalance int64
+		mismatches                                            int
+	}{
+		// The cast czz is missing from the pool.
+		{ExpandedTxConvert_ECzz, 120, 100, 2, 30, 45, 0, 2, 80, 1000, 925, 955, 1},
+		{ExpandedTxConvert_HCzz, 50, 0, 5, 0, 0, 10, 0, 0, 500, 450, 450, 0},
+		// The pool holds more than was converted into it.
+		{ExpandedTxConvert_BCzz, 0, 0, 0, 0, 18, 0, 1, 20, 0, 25, 18, 1},
+	}
+	if len(accounts) != len(want) {
+		t.Fatalf("%d accounts, want %d", len(accounts), len(want))
+	}
+	for i, w := range want {
+		a := accounts[i]
+		got := []int64{a.BurnedIn.Int64(), a.PaidOut.Int64(), a.Fees.Int64(),
+			a.CastIn.Int64(), a.ConvertedIn.Int64(), a.MintedOut.Int64(),
+			int64(a.PendingItems), a.PendingAmount.Int64(),
+			a.StartBalance.Int64(), a.Balance.Int64(),
+			a.ExpectedBalance.Int64(), int64(len(a.Mismatches()))}
+		exp := []int64{w.burnedIn, w.paidOut, w.fees, w.castIn,
+			w.convertedIn, w.minted, int64(w.pendingItems),
+			w.pendingAmount, w.startBalance, w.balance,
+			w.expectedBalance, int64(w.mismatches)}
+		if a.AssetType != w.assetType {
+			t.Errorf("account %d of asset type %d, want %d", i,
+				a.AssetType, w.assetType)
+		}
+		for j := range got {
+			if got[j] != exp[j] {
+				t.Errorf("account of asset type %d: got %v, want %v",
+					a.AssetType, got, exp)
+				break
+			}
+		}
+	}
+
+	// Without changes every pool is consistent.
+	accounts, err = PoolAccounting(params, cur, cur)
+	if err != nil {
+		t.Fatalf("PoolAccounting: %v", err)
+	}
+	for _, a := range accounts {
+		if m := a.Mismatches(); len(m) != 0 {
+			t.Errorf("unchanged pool %d: %v", a.AssetType, m)
+		}
+	}
+
+	pledges := PledgeAccounting(prev, cur)
+	if len(pledges) != 1 || pledges[0].StartStakingAmount.Int64() != 100 ||
+		pledges[0].StakingAmount.Int64() != 150 ||
+		pledges[0].Locked.Int64() != 150 {
+
+		t.Errorf("unexpected pledge accounting %+v", pledges[0])
+	}
+}
@@ -0,0 +1,187 @@
+package cross
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/czzutil"
+)
+
+// The types of committee members.
+const (
+	// MemberTypeMember marks a member signing ConvertConfirm transactions.
+	MemberTypeMember uint32 = iota
+
+	// MemberTypeBackup marks a backup member, which is ranked right after
+	// the members and doesn't sign.
+	MemberTypeBackup
+)
+
+// maxCommitteeHistory is the number of committees kept in the committee
+// state, the serving one and those before it.
+const maxCommitteeHistory = 2
+
+// committeeEpoch returns the committee epoch of the block at the passed height
+// and whether committees are active for it.
+func committeeEpoch(params *chaincfg.Params, height int32) (int32, bool) {
+	activation := params.UpgradeHeight(chaincfg.UpgradeCommittee)
+	if height < activation {
+		return 0, false
+	}
+	return (height - activation) / params.CommitteeEpochLength, true
+}
+
+// ElectCommittee elects the committee serving the passed epoch from the passed
+// pledges.  The pledges are ranked by their stake and, for equal stakes, by
+// their ID.  Pledges without a public key, such as those carried over from the
+// beacon state, or with less than the minimum staking amount are not eligible,
+// and a public key is elected at most once.
+func ElectCommittee(params *chaincfg.Params, pledges []*PledgeInfo, epoch int32) *CommitteeInfo {
+	ranked := make([]*PledgeInfo, 0, len(pledges))
+	for _, v := range pledges {
+		if len(v.PubKey) == 0 || v.StakingAmount == nil ||
+			v.StakingAmount.Cmp(params.MinStakingAmount) < 0 {
+			continue
+		}
+		ranked = append(ranked, v)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if c := ranked[i].StakingAmount.Cmp(ranked[j].StakingAmount); c != 0 {
+			return c > 0
+		}
+		return ranked[i].ID.Cmp(ranked[j].ID) < 0
+	})
+	eligible := make([]*PledgeInfo, 0, len(ranked))
+	elected := make(map[string]bool)
+	for _, v := range ranked {
+		if !elected[string(v.PubKey)] {
+			elected[string(v.PubKey)] = true
+			eligible = append(eligible, v)
+		}
+	}
+
+	start := params.UpgradeHeight(chaincfg.UpgradeCommittee) +
+		epoch*params.CommitteeEpochLength
+	info := &CommitteeInfo{
+		Id:          big.NewInt(int64(epoch)),
+		StartHeight: big.NewInt(int64(start)),
+		EndHeight:   big.NewInt(int64(start + params.CommitteeEpochLength - 1)),
+		Members:     make([]*CommitteeMember, 0, params.CommitteeSize),
+		BackMembers: make([]*CommitteeMember, 0, params.CommitteeBackups),
+	}
+	for i, v := range eligible {
+		member := &CommitteeMember{
+			CommitteeBase: v.Address,
+			Publickey:     v.PubKey,
+			MType:         MemberTypeMember,
+		}
+		if len(v.CoinBaseAddress) != 0 {
+			member.Coinbase = v.CoinBaseAddress[0]
+		}
+		switch {
+		case int32(i) < params.CommitteeSize:
+			info.Members = append(info.Members, member)
+		case int32(i) < params.CommitteeSize+params.CommitteeBackups:
+			member.MType = MemberTypeBackup
+			info.BackMembers = append(info.BackMembers, member)
+		}
+	}
+	return info
+}
+
+// CurrentCommittee returns the committee serving at the passed height, which
+// is elected from the pledges of the state when the height starts an epoch.
+// It returns nil when committees are not active at the height.
+func (cs *CommitteeState) CurrentCommittee(params *chaincfg.Params, height int32) *CommitteeInfo {
+	epoch, ok := committeeEpoch(params, height)
+	if !ok {
+		return nil
+	}
+	if n := len(cs.CommitteeInfos); n != 0 {
+		last := cs.CommitteeInfos[n-1]
+		if last.Id.Cmp(big.NewInt(int64(epoch))) == 0 {
+			return last
+		}
+	}
+	return ElectCommittee(params, cs.PledgeInfos, epoch)
+}
+
+// NextCommittee returns the committee that would serve the epoch after the
+// one of the passed height if it was elected from the current pledges.
+func (cs *CommitteeState) NextCommittee(params *chaincfg.Params, height int32) *CommitteeInfo {
+	epoch, ok := committeeEpoch(params, height)
+	if ok {
+		epoch++
+	}
+	return ElectCommittee(params, cs.PledgeInfos, epoch)
+}
+
+// RotateCommittee elects the committee of the epoch starting at the passed
+// height, if any, from the pledges of the state.  It must be called with the
+// state of the parent block before the transactions of the block are applied.
+func (cs *CommitteeState) RotateCommittee(params *chaincfg.Params, height int32) {
+	epoch, ok := committeeEpoch(params, height)
+	if !ok || height != params.UpgradeHeight(chaincfg.UpgradeCommittee)+
+		epoch*params.CommitteeEpochLength {
+		return
+	}
+
+	cs.CommitteeInfos = append(cs.CommitteeInfos,
+		ElectCommittee(params, cs.PledgeInfos, epoch))
+	if n := len(cs.CommitteeInfos); n > maxCommitteeHistory {
+		cs.CommitteeInfos = cs.CommitteeInfos[n-maxCommitteeHistory:]
+	}
+}
+
+// RequiredApprovals returns the number of members which must sign a
+// ConvertConfirm transaction.
+func (ci *CommitteeInfo) RequiredApprovals(params *chaincfg.Params) int {
+	if int(params.CommitteeThreshold) < len(ci.Members) {
+		return int(params.CommitteeThreshold)
+	}
+	return len(ci.Members)
+}
+
+// Approvals returns the number of members controlling any of the passed
+// scripts, which are those of the outputs spent by a transaction.  A member
+// controls the pay-to-pubkey-hash script of its public key.
+func (ci *CommitteeInfo) Approvals(pkScripts [][]byte) int {
+	approvals := 0
+	for _, member := range ci.Members {
+		script, err := txscript.PayToPubKeyHashScript(
+			czzutil.Hash160(member.Publickey))
+		if err != nil {
+			continue
+		}
+		for _, pkScript := range pkScripts {
+			if bytes.Equal(script, pkScript) {
+				approvals++
+				break
+			}
+		}
+	}
+	return approvals
+}
+
+// VerifyCommitteeApproval ensures enough members of the passed committee
+// control the passed scripts of the outputs spent by a ConvertConfirm
+// transaction.  Since the inputs are signed, this proves the members signed
+// the transaction.
+func VerifyCommitteeApproval(params *chaincfg.Params, ci *CommitteeInfo, pkScripts [][]byte) error {
+	if ci == nil {
+		return nil
+	}
+	if len(ci.Members) == 0 {
+		return fmt.Errorf("committee %d has no members", ci.Id)
+	}
+	approvals, required := ci.Approvals(pkScripts), ci.RequiredApprovals(params)
+	if approvals < required {
+		return fmt.Errorf("ConvertConfirm signed by %d members of "+
+			"committee %d, %d required", approvals, ci.Id, required)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package cross
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/czzutil"
+)
+
+// committeeTestParams returns regression test parameters electing committees
+// of two members and one backup every ten blocks from block 100 on.
+func committeeTestParams() *chaincfg.Params {
+	params := chaincfg.RegressionNetParams
+	params.Upgrades = map[chaincfg.UpgradeID]int32{
+		chaincfg.UpgradeMaui:      50,
+		chaincfg.UpgradeCommittee: 100,
+	}
+	params.MinStakingAmount = big.NewInt(100)
+	params.CommitteeEpochLength = 10
+	params.CommitteeSize = 2
+	params.CommitteeBackups = 1
+	params.CommitteeThreshold = 2
+	return &params
+}
+
+// memberKey returns a public key for committee tests.
+func memberKey(i byte) []byte {
+	key := make([]byte, 33)
+	key[0], key[32] = 0x02, i
+	return key
+}
+
+// memberAddresses returns the addresses of the passed committee members.
+func memberAddresses(members []*CommitteeMember) []string {
+	addrs := make([]string, 0, len(members))
+	for _, m := range members {
+		addrs = append(addrs, m.CommitteeBase)
+	}
+	return addrs
+}
+
+// sameAddresses returns whether the passed address lists are equal.
+func sameAddresses(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestElectCommittee ensures committees are elected from the eligible pledges
+// with the largest stakes.
+func TestElectCommittee(t *testing.T) {
+	params := committeeTestParams()
+	cs := NewCommitteeState()
+	cs.Mortgage("small", nil, memberKey(1), big.NewInt(150), []string{"cb1"})
+	cs.Mortgage("large", nil, memberKey(2), big.NewInt(500), nil)
+	cs.Mortgage("tie", nil, memberKey(3), big.NewInt(150), nil)
+	cs.Mortgage("below", nil, memberKey(4), big.NewInt(99), nil)
+	cs.Mortgage("nokey", nil, nil, big.NewInt(1000), nil)
+	cs.Mortgage("samekey", nil, memberKey(2), big.NewInt(400), nil)
+	cs.Mortgage("third", nil, memberKey(5), big.NewInt(120), nil)
+
+	info := ElectCommittee(params, cs.PledgeInfos, 3)
+	if info.Id.Int64() != 3 || info.StartHeight.Int64() != 130 ||
+		info.EndHeight.Int64() != 139 {
+
+		t.Errorf("epoch %v from %v to %v, want 3 from 130 to 139",
+			info.Id, info.StartHeight, info.EndHeight)
+	}
+	if got := memberAddresses(info.Members); !sameAddresses(got, []string{"large", "small"}) {
+		t.Errorf("members %v, want [large small]", got)
+	}
+	if got := memberAddresses(info.BackMembers); !sameAddresses(got, []string{"tie"}) {
+		t.Errorf("backup members %v, want [tie]", got)
+	}
+	if info.Members[1].Coinbase != "cb1" || info.Members[1].MType != MemberTypeMember ||
+		info.BackMembers[0].MType != MemberTypeBackup {
+
+		t.Errorf("unexpected members %+v %+v", info.Members[1],
+			info.BackMembers[0])
+	}
+}
+
+// TestRotateCommittee ensures committees rotate at epoch boundaries and the
+// serving committee is found between them.
+func TestRotateCommittee(t *testing.T) {
+	params := committeeTestParams()
+	cs := NewCommitteeState()
+	cs.Mortgage("first", nil, memberKey(1), big.NewInt(200), nil)
+	cs.Mortgage("second", nil, memberKey(2), big.NewInt(300), nil)
+
+	if cs.CurrentCommittee(params, 99) != nil {
+		t.Fatal("committee before activation")
+	}
+	if got := cs.NextCommittee(params, 99).Id.Int64(); got != 0 {
+		t.Errorf("next committee before activation is epoch %d, want 0", got)
+	}
+
+	for height := int32(95); height < 125; height++ {
+		cs.RotateCommittee(params, height)
+
+		// A pledge growing in epoch 1 is elected in epoch 2.
+		if height == 115 {
+			cs.Mortgage("third", nil, memberKey(3), big.NewInt(1000), nil)
+		}
+	}
+	if len(cs.CommitteeInfos) != maxCommitteeHistory {
+		t.Fatalf("%d committees kept, want %d", len(cs.CommitteeInfos),
+			maxCommitteeHistory)
+	}
+	if cs.CommitteeInfos[0].Id.Int64() != 1 || cs.CommitteeInfos[1].Id.Int64() != 2 {
+		t.Fatalf("kept committees %v and %v, want 1 and 2",
+			cs.CommitteeInfos[0].Id, cs.CommitteeInfos[1].Id)
+	}
+	if got := memberAddresses(cs.CommitteeInfos[0].Members); !sameAddresses(got, []string{"second", "first"}) {
+		t.Errorf("members of epoch 1 %v, want [second first]", got)
+	}
+	current := cs.CurrentCommittee(params, 124)
+	if current != cs.CommitteeInfos[1] {
+		t.Errorf("current committee is epoch %v, want 2", current.Id)
+	}
+	if got := memberAddresses(current.Members); !sameAddresses(got, []string{"third", "second"}) {
+		t.Errorf("members of epoch 2 %v, want [third second]", got)
+	}
+
+	// The committee of an epoch starting at the passed height is elected
+	// before it is stored.
+	if got := cs.CurrentCommittee(params, 130).Id.Int64(); got != 3 {
+		t.Errorf("committee at block 130 is epoch %d, want 3", got)
+	}
+	if got := cs.NextCommittee(params, 124).Id.Int64(); got != 3 {
+		t.Errorf("next committee is epoch %d, want 3", got)
+	}
+
+	// Committees are part of the serialized state.
+	decoded := NewCommitteeState()
+	if err := rlp.DecodeBytes(cs.ToBytes(), decoded); err != nil {
+		t.Fatalf("DecodeBytes: %v", err)
+	}
+	if decoded.Hash() != cs.Hash() || len(decoded.CommitteeInfos) != 2 {
+		t.Errorf("committees not preserved by serialization")
+	}
+}
+
+// TestVerifyCommitteeApproval ensures ConvertConfirm transactions need outputs
+// of the threshold of members.
+func TestVerifyCommitteeApproval(t *testing.T) {
+	params := committeeTestParams()
+	cs := NewCommitteeState()
+	for i := byte(1); i <= 3; i++ {
+		cs.Mortgage(string('a'+i), nil, memberKey(i), big.NewInt(100*int64(i)), nil)
+	}
+	committee := cs.CurrentCommittee(params, 100)
+
+	script := func(i byte) []byte {
+		pkScript, err := txscript.PayToPubKeyHashScript(
+			czzutil.Hash160(memberKey(i)))
+		if err != nil {
+			t.Fatalf("PayToPubKeyHashScript: %v", err)
+		}
+		return pkScript
+	}
+	tests := []struct {
+		name      string
+		pkScripts [][]byte
+		valid     bool
+	}{
+		{"no inputs", nil, false},
+		{"one member", [][]byte{script(3)}, false},
+		{"member twice", [][]byte{script(3), script(3)}, false},
+		{"backup member", [][]byte{script(3), script(1)}, false},
+		{"threshold", [][]byte{script(3), script(2)}, true},
+		{"threshold and others", [][]byte{{0x51}, script(2), script(1), script(3)}, true},
+	}
+	for _, test := range tests {
+		err := VerifyCommitteeApproval(params, committee, test.pkScripts)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got error %v, want valid %v", test.name, err,
+				test.valid)
+		}
+	}
+
+	// The threshold is capped by the number of members.
+	params.CommitteeThreshold = 2
+	small := ElectCommittee(params, cs.PledgeInfos[2:], 0)
+	if err := VerifyCommitteeApproval(params, small, [][]byte{script(3)}); err != nil {
+		t.Errorf("single member committee: %v", err)
+	}
+	empty := ElectCommittee(params, nil, 0)
+	if err := VerifyCommitteeApproval(params, empty, nil); err == nil {
+		t.Error("empty committee approved a ConvertConfirm")
+	}
+	if err := VerifyCommitteeApproval(params, nil, nil); err != nil {
+		t.Errorf("inactive committees: %v", err)
+	}
+}
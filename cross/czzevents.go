@@ -0,0 +1,215 @@
+package cross
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/classzz/classzz/rlp"
+)
+
+// CommitteeEventType identifies the kind of a committee state change.
+type CommitteeEventType int
+
+const (
+	// EventConvertItemCreated indicates a new convert item was added by a
+	// convert transaction.
+	EventConvertItemCreated CommitteeEventType = iota
+
+	// EventConvertItemConfirmed indicates a convert item was confirmed by
+	// a convert confirm transaction, or was created already confirmed.
+	EventConvertItemConfirmed
+
+	// EventCastingCreated indicates a new convert item was added by a
+	// casting transaction.
+	EventCastingCreated
+
+	// EventPledgeChanged indicates a pledge was added or updated.
+	EventPledgeChanged
+
+	// EventPoolBalanceChanged indicates the balance held by a pool address
+	// changed.
+	EventPoolBalanceChanged
+)
+
+// committeeEventTypeStrings is a map of committee event types back to their
+// constant names for pretty printing.
+var committeeEventTypeStrings = map[CommitteeEventType]string{
+	EventConvertItemCreated:   "ConvertItemCreated",
+	EventConvertItemConfirmed: "ConvertItemConfirmed",
+	EventCastingCreated:       "CastingCreated",
+	EventPledgeChanged:        "PledgeChanged",
+	EventPoolBalanceChanged:   "PoolBalanceChanged",
+}
+
+// String returns the CommitteeEventType in human-readable form.
+func (t CommitteeEventType) String() string {
+	if s, ok := committeeEventTypeStrings[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown CommitteeEventType (%d)", int(t))
+}
+
+// CommitteeEvent describes a single change between two committee states.
+type CommitteeEvent struct {
+	Type CommitteeEventType
+
+	// AssetType, ConvertType and Item are set for the convert item and
+	// casting events.
+	AssetType   uint8
+	ConvertType uint8
+	Item        *ConvertItem
+
+	// Pledge is set for EventPledgeChanged.
+	Pledge *PledgeInfo
+
+	// Address and Balance are set for EventPoolBalanceChanged.
+	Address string
+	Balance *big.Int
+}
+
+// sortedItemKeys returns the keys of the passed map in ascending order.
+func sortedItemKeys(m map[uint8]ConvertItemMap) []uint8 {
+	keys := make([]uint8, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// forEachConvertItem calls fn for every item of the passed items, ordered by
+// asset type and convert type.
+func forEachConvertItem(itemsByType map[uint8]ConvertItemMap, fn func(assetType, convertType uint8, item *ConvertItem)) {
+	for _, assetType := range sortedItemKeys(itemsByType) {
+		itemMap := itemsByType[assetType]
+		convertTypes := make([]uint8, 0, len(itemMap))
+		for convertType := range itemMap {
+			convertTypes = append(convertTypes, convertType)
+		}
+		sort.Slice(convertTypes, func(i, j int) bool {
+			return convertTypes[i] < convertTypes[j]
+		})
+		for _, convertType := range convertTypes {
+			for _, item := range itemMap[convertType] {
+				fn(assetType, convertType, item)
+			}
+		}
+	}
+}
+
+// itemIDs returns the set of the IDs of the passed items.
+func itemIDs(itemsByType map[uint8]ConvertItemMap) map[string]struct{} {
+	ids := make(map[string]struct{})
+	forEachConvertItem(itemsByType, func(_, _ uint8, item *ConvertItem) {
+		ids[item.ID.String()] = struct{}{}
+	})
+	return ids
+}
+
+// poolBalances returns the total amount of the no cost utxos of every pool
+// address.
+func poolBalances(cs *CommitteeState) map[string]*big.Int {
+	balances := make(map[string]*big.Int)
+	for addr, item := range cs.NoCostUtxos {
+		balance := big.NewInt(0)
+		for _, amount := range item.Amount {
+			balance.Add(balance, amount)
+		}
+		balances[addr] = balance
+	}
+	return balances
+}
+
+// DiffCommitteeState returns the events which turn the prev committee state
+// into cur.  A nil prev is treated as an empty state.  The events are ordered
+// by kind: convert items and castings first, then confirmations, pledges and
+// finally pool balances.
+func DiffCommitteeState(prev, cur *CommitteeState) []*CommitteeEvent {
+	if prev == nil {
+		prev = NewCommitteeState()
+	}
+	var events []*CommitteeEvent
+
+	// Items are never removed, so every ID not known to the previous state
+	// belongs to an item created in between.  Converts into czz skip the
+	// pending list and are created confirmed.
+	known := itemIDs(prev.ConvertItems)
+	for id := range itemIDs(prev.ConvertConfirmItems) {
+		known[id] = struct{}{}
+	}
+	created := func(assetType, convertType uint8, item *ConvertItem) {
+		if _, ok := known[item.ID.String()]; ok {
+			return
+		}
+		typ := EventConvertItemCreated
+		if assetType == ExpandedTxConvert_Czz {
+			typ = EventCastingCreated
+		}
+		events = append(events, &CommitteeEvent{
+			Type:        typ,
+			AssetType:   assetType,
+			ConvertType: convertType,
+			Item:        item,
+		})
+	}
+	forEachConvertItem(cur.ConvertItems, created)
+	forEachConvertItem(cur.ConvertConfirmItems, created)
+
+	confirmed := itemIDs(prev.ConvertConfirmItems)
+	forEachConvertItem(cur.ConvertConfirmItems, func(assetType, convertType uint8, item *ConvertItem) {
+		if _, ok := confirmed[item.ID.String()]; ok {
+			return
+		}
+		events = append(events, &CommitteeEvent{
+			Type:        EventConvertItemConfirmed,
+			AssetType:   assetType,
+			ConvertType: convertType,
+			Item:        item,
+		})
+	})
+
+	for _, pledge := range cur.PledgeInfos {
+		old := prev.GetPledgeInfoByID(pledge.ID)
+		if old != nil {
+			oldBytes, err1 := rlp.EncodeToBytes(old)
+			newBytes, err2 := rlp.EncodeToBytes(pledge)
+			if err1 == nil && err2 == nil && bytes.Equal(oldBytes, newBytes) {
+				continue
+			}
+		}
+		events = append(events, &CommitteeEvent{
+			Type:   EventPledgeChanged,
+			Pledge: pledge,
+		})
+	}
+
+	prevBalances, curBalances := poolBalances(prev), poolBalances(cur)
+	addrs := make([]string, 0, len(curBalances))
+	for addr := range curBalances {
+		addrs = append(addrs, addr)
+	}
+	for addr := range prevBalances {
+		if _, ok := curBalances[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		balance, ok := curBalances[addr]
+		if !ok {
+			balance = big.NewInt(0)
+		}
+		if old, ok := prevBalances[addr]; ok && old.Cmp(balance) == 0 {
+			continue
+		}
+		events = append(events, &CommitteeEvent{
+			Type:    EventPoolBalanceChanged,
+			Address: addr,
+			Balance: balance,
+		})
+	}
+
+	return events
+}
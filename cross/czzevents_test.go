@@ -0,0 +1,127 @@
+package cross
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/wire"
+)
+
+// TestDiffCommitteeState ensures the events between two committee states
+// describe the converts, castings, confirmations, pledges and pool balances
+// added in between.
+func TestDiffCommitteeState(t *testing.T) {
+	prev := NewCommitteeState()
+	prev.Mortgage("pledge1", []byte{1}, []byte{2}, big.NewInt(100), nil)
+	prev.Mortgage("pledge2", []byte{3}, []byte{4}, big.NewInt(200), nil)
+	prev.Convert(&ConvertTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_HCzz,
+		ExtTxHash:   "ext1",
+		Amount:      big.NewInt(10),
+		FeeAmount:   big.NewInt(1),
+	}, "tx1")
+	prev.PutNoCostUtxos("pool1", wire.OutPoint{Index: 0}, nil, 50)
+	prev.PutNoCostUtxos("pool2", wire.OutPoint{Index: 1}, nil, 60)
+
+	cur := NewCommitteeState()
+	if err := rlp.DecodeBytes(prev.ToBytes(), cur); err != nil {
+		t.Fatalf("DecodeBytes: %v", err)
+	}
+	cur.AddMortgage("pledge2", big.NewInt(50))
+	cur.Convert(&ConvertTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_BCzz,
+		ExtTxHash:   "ext2",
+		Amount:      big.NewInt(20),
+		FeeAmount:   big.NewInt(2),
+	}, "tx2")
+	cur.Casting(&CastingTxInfo{
+		ConvertType: ExpandedTxConvert_ECzz,
+		Amount:      big.NewInt(30),
+	}, "tx3")
+	cur.ConvertConfirm(&ConvertConfirmTxInfo{
+		AssetType:   ExpandedTxConvert_ECzz,
+		ConvertType: ExpandedTxConvert_HCzz,
+		ID:          big.NewInt(1),
+		ExtTxHash:   "confirm1",
+	})
+	cur.PutNoCostUtxos("pool2", wire.OutPoint{Index: 2}, nil, 5)
+	cur.PutNoCostUtxos("pool3", wire.OutPoint{Index: 3}, nil, 7)
+
+	type event struct {
+		typ     CommitteeEventType
+		id      int64
+		address string
+		balance int64
+	}
+	tests := []struct {
+		name string
+		prev *CommitteeState
+		cur  *CommitteeState
+		want []event
+	}{
+		{
+			name: "no changes",
+			prev: prev,
+			cur:  prev,
+		},
+		{
+			name: "changes",
+			prev: prev,
+			cur:  cur,
+			want: []event{
+				{typ: EventCastingCreated, id: 3},
+				{typ: EventConvertItemCreated, id: 2},
+				{typ: EventConvertItemConfirmed, id: 1},
+				{typ: EventPledgeChanged, id: 2},
+				{typ: EventPoolBalanceChanged, address: "pool2", balance: 65},
+				{typ: EventPoolBalanceChanged, address: "pool3", balance: 7},
+			},
+		},
+		{
+			name: "from empty state",
+			cur:  prev,
+			want: []event{
+				{typ: EventConvertItemCreated, id: 1},
+				{typ: EventPledgeChanged, id: 1},
+				{typ: EventPledgeChanged, id: 2},
+				{typ: EventPoolBalanceChanged, address: "pool1", balance: 50},
+				{typ: EventPoolBalanceChanged, address: "pool2", balance: 60},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		events := DiffCommitteeState(test.prev, test.cur)
+		if len(events) != len(test.want) {
+			t.Errorf("%s: got %d events, want %d", test.name,
+				len(events), len(test.want))
+			continue
+		}
+		for i, want := range test.want {
+			got := events[i]
+			var id int64
+			switch {
+			case got.Item != nil:
+				id = got.Item.ID.Int64()
+			case got.Pledge != nil:
+				id = got.Pledge.ID.Int64()
+			}
+			var balance int64
+			if got.Balance != nil {
+				balance = got.Balance.Int64()
+			}
+			if got.Type != want.typ || id != want.id ||
+				got.Address != want.address || balance != want.balance {
+
+				t.Errorf("%s: event #%d got %v (id %d, address %q, "+
+					"balance %d), want %v (id %d, address %q, "+
+					"balance %d)", test.name, i, got.Type, id,
+					got.Address, balance, want.typ, want.id,
+					want.address, want.balance)
+			}
+		}
+	}
+}
@@ -253,6 +253,7 @@ type CommitteeState struct {
 	ConvertItems        map[uint8]ConvertItemMap
 	ConvertConfirmItems map[uint8]ConvertItemMap
 	NoCostUtxos         map[string]*PoolAddrItem
+	Pools               []*PoolInfo
 }
 
 type StoreConvertItems struct {
@@ -409,6 +410,10 @@ type extCommitteeState struct {
 	ConvertItems        SortStoreConvertItems
 	ConvertConfirmItems SortStoreConvertConfirmItems
 	NoCostUtxos         SortStoreNoCostUtxos
+
+	// Pools is the tail of the encoding so the states before the pool
+	// multisig upgrade encode as they did without it.
+	Pools SortStorePools `rlp:"tail"`
 }
 
 func (cs *CommitteeState) DecodeRLP(s *rlp.Stream) error {
@@ -418,11 +423,13 @@ func (cs *CommitteeState) DecodeRLP(s *rlp.Stream) error {
 	}
 	cs.PledgeInfos, cs.CommitteeInfos, cs.MaxItemID = ecs.PledgeInfos, ecs.CommitteeInfos, ecs.MaxItemID
 	cs.fromSlice(ecs.ConvertItems, ecs.ConvertConfirmItems, ecs.NoCostUtxos)
+	cs.Pools = ecs.Pools
 	return nil
 }
 
 func (cs *CommitteeState) EncodeRLP(w io.Writer) error {
 	s1, s2, s3, s4, s5 := cs.toSlice()
+	sort.Sort(SortStorePools(cs.Pools))
 	return rlp.Encode(w, extCommitteeState{
 		PledgeInfos:         s1,
 		CommitteeInfos:      s2,
@@ -430,6 +437,7 @@ func (cs *CommitteeState) EncodeRLP(w io.Writer) error {
 		ConvertItems:        s3,
 		ConvertConfirmItems: s4,
 		NoCostUtxos:         s5,
+		Pools:               cs.Pools,
 	})
 }
 
@@ -489,6 +497,24 @@ func (cs *CommitteeState) GetPledgeInfoMaxId() *big.Int {
 	return maxId
 }
 
+// StakingState returns the stakes of the pledges in the form ComputeDiff
+// boosts the target of their coinbase addresses with.
+func (cs *CommitteeState) StakingState() *EntangleState {
+	bai2s := make(map[string]*BeaconAddressInfo)
+	for _, v := range cs.PledgeInfos {
+		bai2 := &BeaconAddressInfo{
+			ExchangeID:      v.ID.Uint64(),
+			StakingAmount:   v.StakingAmount,
+			CoinBaseAddress: v.CoinBaseAddress,
+		}
+		bai2s[v.Address] = bai2
+	}
+
+	return &EntangleState{
+		EnInfos: bai2s,
+	}
+}
+
 /////////////////////////////////////////////////////////////////
 // keep staking enough amount asset
 func (cs *CommitteeState) Mortgage(address string, to []byte, pubKey []byte, amount *big.Int, cba []string) {
@@ -739,6 +765,7 @@ func NewCommitteeState() *CommitteeState {
 		ConvertItems:        make(map[uint8]ConvertItemMap),
 		ConvertConfirmItems: make(map[uint8]ConvertItemMap),
 		NoCostUtxos:         make(map[string]*PoolAddrItem),
+		Pools:               make([]*PoolInfo, 0),
 	}
 }
 
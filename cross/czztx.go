@@ -2,7 +2,6 @@ package cross
 
 import (
 	"bytes"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
@@ -165,6 +164,12 @@ type ConvertConfirmTxInfo struct {
 	ExtTxHash   string
 	Index       uint32
 	Amount      *big.Int
+
+	// Signatures are the signatures of the keys of the pool of
+	// ConvertType releasing the conversion, see VerifyReleaseSignatures.
+	// They are the tail of the encoding so ConvertConfirms without them
+	// encode as they did before the pool multisig upgrade.
+	Signatures [][]byte `rlp:"tail"`
 }
 
 func (es *ConvertTxInfo) ToBytes() []byte {
@@ -837,13 +842,11 @@ func MakeMergerCoinbaseTx(params *chaincfg.Params, tx *wire.MsgTx, cState *Commi
 	}
 
 	if FeeAmountSum.Int64() != 0 {
-		CoinPool0 := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
-		pkScript, err := txscript.PayToPubKeyHashScript(CoinPool0)
+		pkScript, err := txscript.PayToPubKeyHashScript(FeePool)
 		if err != nil {
 			return err
 		}
 
-		fmt.Println("poolCFee", hex.EncodeToString(pkScript), FeeAmountSum.Int64())
 		tx.AddTxOut(&wire.TxOut{
 			Value:    FeeAmountSum.Int64(),
 			PkScript: pkScript,
@@ -870,7 +873,6 @@ func MakeMergerCoinbaseTx(params *chaincfg.Params, tx *wire.MsgTx, cState *Commi
 
 		amount = big.NewInt(0).Add(amount, v)
 		pkScript, _ := txscript.PayToPubKeyHashScript(add.ScriptAddress())
-		fmt.Println("poolC", add.String(), amount)
 		tx.AddTxOut(&wire.TxOut{
 			Value:    amount.Int64(),
 			PkScript: pkScript,
@@ -1023,13 +1025,63 @@ func ToAddressFromConvertsVerify(tx *wire.MsgTx, cState *CommitteeState, cInfo m
 			return nil, err
 		}
 		info.PubKey = tpi.Pub
-		info.FeeAmount = big.NewInt(0).Div(info.Amount, big.NewInt(1000))
+		info.FeeAmount = ConvertFee(info)
 		cTis = append(cTis, info)
 	}
 
 	return cTis, nil
 }
 
+// ConvertFee returns the fee of the passed conversion.
+func ConvertFee(info *ConvertTxInfo) *big.Int {
+	return big.NewInt(0).Div(info.Amount, big.NewInt(1000))
+}
+
+// ConvertFees returns the total fee of the conversions of the passed
+// transaction which MakeMergerCoinbaseTx pays to the fee pool, leaving out
+// the conversions to czz.  Other transactions have no conversion fees.
+func ConvertFees(tx *wire.MsgTx) *big.Int {
+	fees := big.NewInt(0)
+	cinfo, err := IsConvertTx(tx)
+	if err != nil {
+		return fees
+	}
+	for i, info := range cinfo {
+		if i >= ConvertOutNum || info.Amount == nil ||
+			info.ConvertType == ExpandedTxConvert_Czz {
+			continue
+		}
+		fees.Add(fees, ConvertFee(info))
+	}
+	return fees
+}
+
+// PayConvertFeeShare moves the passed amount from the fee pool output of a
+// coinbase made by MakeMergerCoinbaseTx to its first output, which pays the
+// miner.
+func PayConvertFeeShare(tx *wire.MsgTx, amount int64) error {
+	if amount == 0 {
+		return nil
+	}
+	pkScript, err := txscript.PayToPubKeyHashScript(FeePool)
+	if err != nil {
+		return err
+	}
+	for _, txOut := range tx.TxOut[1:] {
+		if !bytes.Equal(txOut.PkScript, pkScript) {
+			continue
+		}
+		if txOut.Value < amount {
+			return fmt.Errorf("fee pool output pays %d, less than the "+
+				"miner share %d", txOut.Value, amount)
+		}
+		txOut.Value -= amount
+		tx.TxOut[0].Value += amount
+		return nil
+	}
+	return fmt.Errorf("coinbase has no fee pool output")
+}
+
 func ConvertConfirms(eState *CommitteeState, cinfo map[uint32]*ConvertConfirmTxInfo) {
 	for _, info := range cinfo {
 		eState.ConvertConfirm(info)
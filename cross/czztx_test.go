@@ -9,6 +9,9 @@ import (
 	"github.com/classzz/classzz/chaincfg"
 	// "github.com/classzz/classzz/chaincfg/chainhash"
 
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
 )
 
@@ -227,3 +230,73 @@ func TestStateRlp(t *testing.T) {
 
 	fmt.Println("finish")
 }
+
+// TestConvertFees ensures only the fees of the conversions paid to the fee
+// pool are counted.
+func TestConvertFees(t *testing.T) {
+	convertTx := func(convertType uint8, amount int64) *wire.MsgTx {
+		data, err := rlp.EncodeToBytes(&ConvertTxInfo{
+			AssetType:   ExpandedTxConvert_ECzz,
+			ConvertType: convertType,
+			ExtTxHash:   "ext",
+			Amount:      big.NewInt(amount),
+		})
+		if err != nil {
+			t.Fatalf("EncodeToBytes: %v", err)
+		}
+		script, err := txscript.ConvertScript(data)
+		if err != nil {
+			t.Fatalf("ConvertScript: %v", err)
+		}
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxOut(wire.NewTxOut(0, script))
+		return tx
+	}
+
+	tests := []struct {
+		name string
+		tx   *wire.MsgTx
+		fees int64
+	}{
+		{"to hczz", convertTx(ExpandedTxConvert_HCzz, 5999), 5},
+		{"to czz", convertTx(ExpandedTxConvert_Czz, 5999), 0},
+		{"not a conversion", wire.NewMsgTx(wire.TxVersion), 0},
+	}
+	for _, test := range tests {
+		if fees := ConvertFees(test.tx).Int64(); fees != test.fees {
+			t.Errorf("%s: got fees %d, want %d", test.name, fees,
+				test.fees)
+		}
+	}
+}
+
+// TestPayConvertFeeShare ensures the share of the conversion fees paid to the
+// miner is taken from the fee pool output of the coinbase.
+func TestPayConvertFeeShare(t *testing.T) {
+	feePool, err := txscript.PayToPubKeyHashScript(FeePool)
+	if err != nil {
+		t.Fatalf("PayToPubKeyHashScript: %v", err)
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(100, []byte{txscript.OP_TRUE}))
+	tx.AddTxOut(wire.NewTxOut(10, feePool))
+
+	if err := PayConvertFeeShare(tx, 4); err != nil {
+		t.Fatalf("PayConvertFeeShare: %v", err)
+	}
+	if tx.TxOut[0].Value != 104 || tx.TxOut[1].Value != 6 {
+		t.Errorf("miner paid %d and fee pool %d, want 104 and 6",
+			tx.TxOut[0].Value, tx.TxOut[1].Value)
+	}
+	if err := PayConvertFeeShare(tx, 7); err == nil {
+		t.Errorf("share exceeding the fee pool was paid")
+	}
+
+	tx.TxOut = tx.TxOut[:1]
+	if err := PayConvertFeeShare(tx, 0); err != nil {
+		t.Errorf("PayConvertFeeShare of nothing: %v", err)
+	}
+	if err := PayConvertFeeShare(tx, 1); err == nil {
+		t.Errorf("share was paid without a fee pool output")
+	}
+}
@@ -544,22 +544,48 @@ func ValidPK(pk []byte) bool {
 	return true
 }
 
-func ComputeDiff(params *chaincfg.Params, target *big.Int, address czzutil.Address, eState *EntangleState) *big.Int {
-	found_t := 0
-	StakingAmount := big.NewInt(0)
+// stakingTargetExponent is the power of the staked multiple of the minimum
+// staking amount the target of a staked coinbase address is multiplied with.
+const stakingTargetExponent = 3
+
+// StakingAmount returns the total amount staked for the passed coinbase
+// address in the passed state and whether any stake was found.
+func StakingAmount(address czzutil.Address, eState *EntangleState) (*big.Int, bool) {
+	found := false
+	amount := big.NewInt(0)
 	for _, eninfo := range eState.EnInfos {
 		for _, eAddr := range eninfo.CoinBaseAddress {
 			if address.String() == eAddr {
-				StakingAmount = big.NewInt(0).Add(StakingAmount, eninfo.StakingAmount)
-				found_t = 1
+				amount = big.NewInt(0).Add(amount, eninfo.StakingAmount)
+				found = true
 				break
 			}
 		}
 	}
-	if found_t == 1 {
-		result := big.NewInt(0).Div(StakingAmount, params.MinStakingAmount)
-		result1 := big.NewInt(0).Exp(result, big.NewInt(3), nil)
-		target = big.NewInt(0).Mul(target, result1)
+	return amount, found
+}
+
+// StakingMultiplier returns the factor the target of a coinbase address with
+// the passed stake is multiplied with.  A non-zero exponent replaces the one
+// of the consensus rules, which only simulations do.
+func StakingMultiplier(params *chaincfg.Params, stakingAmount *big.Int, exponent int64) *big.Int {
+	if exponent == 0 {
+		exponent = stakingTargetExponent
+	}
+	result := big.NewInt(0).Div(stakingAmount, params.MinStakingAmount)
+	return big.NewInt(0).Exp(result, big.NewInt(exponent), nil)
+}
+
+func ComputeDiff(params *chaincfg.Params, target *big.Int, address czzutil.Address, eState *EntangleState) *big.Int {
+	return ComputeDiffWithExponent(params, target, address, eState, 0)
+}
+
+// ComputeDiffWithExponent is ComputeDiff with the exponent of the staking
+// multiplier passed to StakingMultiplier.
+func ComputeDiffWithExponent(params *chaincfg.Params, target *big.Int, address czzutil.Address, eState *EntangleState, exponent int64) *big.Int {
+	if amount, found := StakingAmount(address, eState); found {
+		target = big.NewInt(0).Mul(target,
+			StakingMultiplier(params, amount, exponent))
 	}
 	if target.Cmp(params.PowLimit) > 0 {
 		target.Set(params.PowLimit)
@@ -0,0 +1,422 @@
+package cross
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// maxPoolKeys is the highest number of keys controlling a pool.
+const maxPoolKeys = txscript.MaxPubKeysPerMultiSig
+
+// poolScriptFlags are the flags the scripts verifying the signatures of the
+// pool keys are executed with.  They are fixed rather than the standard
+// verify flags since the result is part of the consensus rules.
+const poolScriptFlags = txscript.ScriptBip16 |
+	txscript.ScriptVerifyStrictEncoding |
+	txscript.ScriptVerifyMinimalData |
+	txscript.ScriptVerifyCleanStack |
+	txscript.ScriptVerifyNullFail |
+	txscript.ScriptVerifyLowS |
+	txscript.ScriptVerifySigPushOnly |
+	txscript.ScriptVerifyCheckDataSig |
+	txscript.ScriptVerifySchnorr
+
+// NoPoolKeyRotation is returned for transactions which are not pool key
+// rotations.
+var NoPoolKeyRotation = errors.New("no PoolKeyRotation info in transcation")
+
+// initialPoolAddresses are the pool contracts on the external chains before
+// the pool multisig upgrade, which the pools of the committee state start
+// with.
+var initialPoolAddresses = map[uint8]string{
+	ExpandedTxConvert_ECzz: ethPoolAddr,
+	ExpandedTxConvert_HCzz: hecoPoolAddr,
+	ExpandedTxConvert_BCzz: bscPoolAddr,
+}
+
+// PoolInfo is the pool of an external chain.  The pool contracts at
+// ExtAddresses only release funds with the signatures of Threshold of PubKeys,
+// which are replaced by pool key rotation transactions.  Nonce is the number
+// of rotations of the pool.
+type PoolInfo struct {
+	AssetType    uint8
+	ExtAddresses []string
+	PubKeys      [][]byte
+	Threshold    uint32
+	Nonce        uint64
+}
+
+type SortStorePools []*PoolInfo
+
+func (vs SortStorePools) Len() int {
+	return len(vs)
+}
+
+func (vs SortStorePools) Less(i, j int) bool {
+	return vs[i].AssetType < vs[j].AssetType
+}
+
+func (vs SortStorePools) Swap(i, j int) {
+	vs[i], vs[j] = vs[j], vs[i]
+}
+
+// IsExtAddress returns whether the passed address is one of the pool
+// contracts.
+func (p *PoolInfo) IsExtAddress(addr string) bool {
+	for _, v := range p.ExtAddresses {
+		if strings.EqualFold(v, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate ensures the pool has valid contract addresses and keys along with
+// a threshold which the keys can reach.
+func (p *PoolInfo) validate() error {
+	if len(p.ExtAddresses) == 0 {
+		return fmt.Errorf("pool %d has no addresses", p.AssetType)
+	}
+	for _, addr := range p.ExtAddresses {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("pool %d has invalid address %q",
+				p.AssetType, addr)
+		}
+	}
+	if len(p.PubKeys) == 0 || len(p.PubKeys) > maxPoolKeys {
+		return fmt.Errorf("pool %d has %d keys, must have from 1 to %d",
+			p.AssetType, len(p.PubKeys), maxPoolKeys)
+	}
+	keys := make(map[string]bool, len(p.PubKeys))
+	for _, key := range p.PubKeys {
+		if len(key) != czzec.PubKeyBytesLenCompressed {
+			return fmt.Errorf("pool %d has uncompressed key %x",
+				p.AssetType, key)
+		}
+		if _, err := czzec.ParsePubKey(key, czzec.S256()); err != nil {
+			return fmt.Errorf("pool %d has invalid key %x: %v",
+				p.AssetType, key, err)
+		}
+		if keys[string(key)] {
+			return fmt.Errorf("pool %d has duplicate key %x",
+				p.AssetType, key)
+		}
+		keys[string(key)] = true
+	}
+	if p.Threshold < 1 || int(p.Threshold) > len(p.PubKeys) {
+		return fmt.Errorf("pool %d threshold %d must be between 1 and "+
+			"the number of keys", p.AssetType, p.Threshold)
+	}
+	return nil
+}
+
+// initialPool returns the pool of the passed asset type at the pool multisig
+// upgrade, which is controlled by the members of the committee serving at the
+// passed height with the committee threshold.
+func (cs *CommitteeState) initialPool(params *chaincfg.Params, height int32, assetType uint8) *PoolInfo {
+	pool := &PoolInfo{
+		AssetType:    assetType,
+		ExtAddresses: strings.Split(initialPoolAddresses[assetType], "|"),
+		PubKeys:      make([][]byte, 0),
+	}
+	if committee := cs.CurrentCommittee(params, height); committee != nil {
+		for _, member := range committee.Members {
+			pool.PubKeys = append(pool.PubKeys, member.Publickey)
+		}
+		pool.Threshold = uint32(committee.RequiredApprovals(params))
+	}
+	return pool
+}
+
+// InitPools stores the initial pools in the state when the pool multisig
+// upgrade activates at the passed height.  Like RotateCommittee, it must be
+// called with the state of the parent block before the transactions of the
+// block are applied, and after RotateCommittee.
+func (cs *CommitteeState) InitPools(params *chaincfg.Params, height int32) {
+	if height != params.UpgradeHeight(chaincfg.UpgradePoolMultisig) {
+		return
+	}
+
+	cs.Pools = make([]*PoolInfo, 0, len(initialPoolAddresses))
+	for _, assetType := range []uint8{ExpandedTxConvert_ECzz,
+		ExpandedTxConvert_HCzz, ExpandedTxConvert_BCzz} {
+
+		cs.Pools = append(cs.Pools, cs.initialPool(params, height, assetType))
+	}
+}
+
+// GetPool returns the pool of the passed asset type at the passed height, or
+// nil when there is none or the pool multisig upgrade is not active.
+func (cs *CommitteeState) GetPool(params *chaincfg.Params, height int32, assetType uint8) *PoolInfo {
+	if !params.IsActive(chaincfg.UpgradePoolMultisig, height) {
+		return nil
+	}
+	for _, pool := range cs.Pools {
+		if pool.AssetType == assetType {
+			return pool
+		}
+	}
+
+	// The state of the parent of the activation block has no pools yet.
+	if _, ok := initialPoolAddresses[assetType]; ok && len(cs.Pools) == 0 {
+		return cs.initialPool(params, height, assetType)
+	}
+	return nil
+}
+
+// IsPoolAddress returns whether the passed address is a pool contract of the
+// passed asset type.  The contracts are those of the pool in the state, or
+// the initial ones before the pool multisig upgrade.
+func (cs *CommitteeState) IsPoolAddress(assetType uint8, addr string) bool {
+	for _, pool := range cs.Pools {
+		if pool.AssetType == assetType {
+			return pool.IsExtAddress(addr)
+		}
+	}
+	addrs, ok := initialPoolAddresses[assetType]
+	if !ok {
+		return false
+	}
+	for _, v := range strings.Split(addrs, "|") {
+		if strings.EqualFold(v, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RotatePoolKeys replaces the pool of the passed verified rotation.
+func (cs *CommitteeState) RotatePoolKeys(info *PoolKeyRotationTxInfo) {
+	for i, pool := range cs.Pools {
+		if pool.AssetType == info.AssetType {
+			cs.Pools[i] = info.Pool()
+			return
+		}
+	}
+}
+
+// poolScript returns the script verifying the signatures of the passed
+// message by the threshold of the keys of the pool:
+//
+//	OP_0 {OP_SWAP <msg> <key> OP_CHECKDATASIG OP_ADD}... <threshold> OP_GREATERTHANOREQUAL
+//
+// It expects a signature for every key on the stack, the one of the first key
+// on top, where the keys not signing have empty signatures.
+func poolScript(pool *PoolInfo, msg []byte) ([]byte, error) {
+	builder := txscript.NewScriptBuilder().AddOp(txscript.OP_0)
+	for _, key := range pool.PubKeys {
+		builder.AddOp(txscript.OP_SWAP).AddData(msg).AddData(key).
+			AddOp(txscript.OP_CHECKDATASIG).AddOp(txscript.OP_ADD)
+	}
+	builder.AddInt64(int64(pool.Threshold)).
+		AddOp(txscript.OP_GREATERTHANOREQUAL)
+	return builder.Script()
+}
+
+// VerifyPoolSignatures ensures the threshold of the keys of the pool signed the
+// passed message.  The signatures are in the order of the keys, with an empty
+// signature for every key not signing, and may be Schnorr or ECDSA signatures
+// of the SHA256 of the message like those OP_CHECKDATASIG verifies, which is
+// what the pool script is executed with.
+func VerifyPoolSignatures(pool *PoolInfo, msg []byte, sigs [][]byte) error {
+	if len(pool.PubKeys) == 0 || pool.Threshold == 0 {
+		return fmt.Errorf("pool %d has no keys", pool.AssetType)
+	}
+	if len(sigs) != len(pool.PubKeys) {
+		return fmt.Errorf("%d signatures for the %d keys of pool %d",
+			len(sigs), len(pool.PubKeys), pool.AssetType)
+	}
+
+	pkScript, err := poolScript(pool, msg)
+	if err != nil {
+		return err
+	}
+	builder := txscript.NewScriptBuilder()
+	for i := len(sigs) - 1; i >= 0; i-- {
+		builder.AddData(sigs[i])
+	}
+	sigScript, err := builder.Script()
+	if err != nil {
+		return err
+	}
+
+	// The engine executes the scripts as the input of a transaction, which
+	// OP_CHECKDATASIG doesn't sign.
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, sigScript))
+	vm, err := txscript.NewEngine(pkScript, tx, 0, poolScriptFlags, nil,
+		nil, 0)
+	if err != nil {
+		return err
+	}
+	if err := vm.Execute(); err != nil {
+		return fmt.Errorf("signatures of the keys of pool %d: %v",
+			pool.AssetType, err)
+	}
+	return nil
+}
+
+// releaseMessage is the data the keys of a pool sign to release a conversion
+// on the external chain.
+type releaseMessage struct {
+	AssetType   uint8
+	ConvertType uint8
+	ID          *big.Int
+	PubKey      []byte
+	Amount      *big.Int
+}
+
+// ReleaseMessage returns the message the keys of the pool of convertType sign
+// to release the passed conversion, which pays its amount less the fee to the
+// owner of its public key.
+func ReleaseMessage(assetType, convertType uint8, item *ConvertItem) []byte {
+	data, err := rlp.EncodeToBytes(&releaseMessage{
+		AssetType:   assetType,
+		ConvertType: convertType,
+		ID:          item.ID,
+		PubKey:      item.PubKey,
+		Amount:      new(big.Int).Sub(item.Amount, item.FeeAmount),
+	})
+	if err != nil {
+		log.Fatal("Failed to RLP encode releaseMessage: ", err)
+	}
+	return chainhash.HashB(data)
+}
+
+// VerifyReleaseSignatures ensures the passed ConvertConfirm carries the
+// signatures of the keys of the pool of its convert type releasing the
+// conversion.  ConvertConfirms before the pool multisig upgrade carry no
+// signatures, since nodes unaware of the upgrade fail to decode them.
+func (cs *CommitteeState) VerifyReleaseSignatures(params *chaincfg.Params, info *ConvertConfirmTxInfo, height int32) error {
+	if !params.IsActive(chaincfg.UpgradePoolMultisig, height) {
+		if len(info.Signatures) > 0 {
+			return fmt.Errorf("ConvertConfirm of conversion %v carries "+
+				"signatures before the pool multisig upgrade", info.ID)
+		}
+		return nil
+	}
+	pool := cs.GetPool(params, height, info.ConvertType)
+	if pool == nil {
+		return fmt.Errorf("ConvertConfirm to unknown pool %d",
+			info.ConvertType)
+	}
+
+	var item *ConvertItem
+	for _, v := range cs.ConvertItems[info.AssetType][info.ConvertType] {
+		if v.ID.Cmp(info.ID) == 0 {
+			item = v
+			break
+		}
+	}
+	if item == nil {
+		return fmt.Errorf("ConvertConfirm of unknown conversion %v",
+			info.ID)
+	}
+
+	msg := ReleaseMessage(info.AssetType, info.ConvertType, item)
+	if err := VerifyPoolSignatures(pool, msg, info.Signatures); err != nil {
+		return fmt.Errorf("ConvertConfirm of conversion %v: %v", info.ID,
+			err)
+	}
+	return nil
+}
+
+// PoolKeyRotationTxInfo replaces the contracts, keys and threshold of the pool
+// of AssetType.  Nonce must follow the nonce of the pool, and Signatures hold
+// the signatures of the message of the rotation by the current keys of the
+// pool as VerifyPoolSignatures expects them.
+type PoolKeyRotationTxInfo struct {
+	AssetType    uint8
+	ExtAddresses []string
+	PubKeys      [][]byte
+	Threshold    uint32
+	Nonce        uint64
+	Signatures   [][]byte
+}
+
+// Pool returns the pool the rotation results in.
+func (info *PoolKeyRotationTxInfo) Pool() *PoolInfo {
+	return &PoolInfo{
+		AssetType:    info.AssetType,
+		ExtAddresses: info.ExtAddresses,
+		PubKeys:      info.PubKeys,
+		Threshold:    info.Threshold,
+		Nonce:        info.Nonce,
+	}
+}
+
+// Message returns the message the current keys of the pool sign to rotate it,
+// the hash of the resulting pool.
+func (info *PoolKeyRotationTxInfo) Message() []byte {
+	data, err := rlp.EncodeToBytes(info.Pool())
+	if err != nil {
+		log.Fatal("Failed to RLP encode PoolInfo: ", err)
+	}
+	return chainhash.HashB(data)
+}
+
+func PoolKeyRotationTxFromScript(script []byte) (*PoolKeyRotationTxInfo, error) {
+	data, err := txscript.GetPoolKeyRotationData(script)
+	if err != nil {
+		return nil, err
+	}
+	info := &PoolKeyRotationTxInfo{}
+	err = rlp.DecodeBytes(data, info)
+	return info, err
+}
+
+// IsPoolKeyRotationTx returns the rotation of the passed transaction, whose
+// first output carries it.
+func IsPoolKeyRotationTx(tx *wire.MsgTx) (*PoolKeyRotationTxInfo, error) {
+	if len(tx.TxOut) == 0 || !txscript.IsPoolKeyRotationTy(tx.TxOut[0].PkScript) {
+		return nil, NoPoolKeyRotation
+	}
+	if tx.TxOut[0].Value != 0 {
+		return nil, errors.New("the output value must be 0 in tx.")
+	}
+	info, err := PoolKeyRotationTxFromScript(tx.TxOut[0].PkScript)
+	if err != nil {
+		return nil, fmt.Errorf("PoolKeyRotationTxFromScript err %s", err)
+	}
+	return info, nil
+}
+
+// VerifyPoolKeyRotationTx ensures the pool key rotation of the passed
+// transaction, if any, is signed by the keys of the pool at the passed height.
+func (ev *CommitteeVerify) VerifyPoolKeyRotationTx(tx *wire.MsgTx, cState *CommitteeState, height int32) (*PoolKeyRotationTxInfo, error) {
+	info, err := IsPoolKeyRotationTx(tx)
+	if info == nil {
+		return nil, err
+	}
+
+	if !ev.Params.IsActive(chaincfg.UpgradePoolMultisig, height) {
+		return nil, errors.New("PoolKeyRotation before the pool multisig upgrade")
+	}
+	pool := cState.GetPool(ev.Params, height, info.AssetType)
+	if pool == nil {
+		return nil, fmt.Errorf("PoolKeyRotation of unknown pool %d",
+			info.AssetType)
+	}
+	if info.Nonce != pool.Nonce+1 {
+		return nil, fmt.Errorf("PoolKeyRotation nonce %d, expected %d",
+			info.Nonce, pool.Nonce+1)
+	}
+	if err := info.Pool().validate(); err != nil {
+		return nil, fmt.Errorf("PoolKeyRotation: %v", err)
+	}
+	if err := VerifyPoolSignatures(pool, info.Message(), info.Signatures); err != nil {
+		return nil, fmt.Errorf("PoolKeyRotation: %v", err)
+	}
+	return info, nil
+}
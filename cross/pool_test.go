@@ -0,0 +1,305 @@
+package cross
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+)
+
+// poolTestParams returns the committee test parameters with pool multisig
+// active from block 100 on.
+func poolTestParams() *chaincfg.Params {
+	params := committeeTestParams()
+	params.Upgrades[chaincfg.UpgradePoolMultisig] = 100
+	return params
+}
+
+// poolTestKeys returns n private keys for pool tests.
+func poolTestKeys(t *testing.T, n int) []*czzec.PrivateKey {
+	keys := make([]*czzec.PrivateKey, 0, n)
+	for i := 1; i <= n; i++ {
+		seed := sha256.Sum256([]byte{byte(i)})
+		key, _ := czzec.PrivKeyFromBytes(czzec.S256(), seed[:])
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// poolSigs returns the signatures of the passed message by the passed keys,
+// with an empty signature for the keys not in signers.
+func poolSigs(t *testing.T, keys []*czzec.PrivateKey, msg []byte, schnorr bool, signers ...int) [][]byte {
+	hash := sha256.Sum256(msg)
+	sigs := make([][]byte, len(keys))
+	for _, i := range signers {
+		sign := keys[i].SignECDSA
+		if schnorr {
+			sign = keys[i].SignSchnorr
+		}
+		sig, err := sign(hash[:])
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		sigs[i] = sig.Serialize()
+	}
+	return sigs
+}
+
+// testPool returns a pool of the passed keys with the passed threshold.
+func testPool(keys []*czzec.PrivateKey, threshold uint32) *PoolInfo {
+	pool := &PoolInfo{
+		AssetType:    ExpandedTxConvert_ECzz,
+		ExtAddresses: strings.Split(ethPoolAddr, "|"),
+		Threshold:    threshold,
+	}
+	for _, key := range keys {
+		pool.PubKeys = append(pool.PubKeys, key.PubKey().SerializeCompressed())
+	}
+	return pool
+}
+
+// TestVerifyPoolSignatures ensures pool signatures are only accepted from the
+// threshold of the keys of the pool.
+func TestVerifyPoolSignatures(t *testing.T) {
+	keys := poolTestKeys(t, 3)
+	pool := testPool(keys, 2)
+	msg := testMessage("release")
+	other := poolTestKeys(t, 4)[3:]
+
+	wrongKey := poolSigs(t, keys, msg, true, 0)
+	wrongKey[1] = poolSigs(t, other, msg, true, 0)[0]
+	tests := []struct {
+		name  string
+		sigs  [][]byte
+		valid bool
+	}{
+		{"schnorr threshold", poolSigs(t, keys, msg, true, 0, 2), true},
+		{"ecdsa all keys", poolSigs(t, keys, msg, false, 0, 1, 2), true},
+		{"mixed", append(poolSigs(t, keys[:2], msg, false, 0),
+			poolSigs(t, keys[2:], msg, true, 0)[0]), true},
+		{"below threshold", poolSigs(t, keys, msg, true, 1), false},
+		{"wrong message", poolSigs(t, keys, testMessage("other"), true, 0, 1), false},
+		{"wrong key", wrongKey, false},
+		{"missing signature", poolSigs(t, keys, msg, true, 0, 1)[:2], false},
+	}
+	for _, test := range tests {
+		err := VerifyPoolSignatures(pool, msg, test.sigs)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got error %v, want valid %v", test.name, err,
+				test.valid)
+		}
+	}
+}
+
+// testMessage returns a 32 byte message for pool tests.
+func testMessage(s string) []byte {
+	hash := sha256.Sum256([]byte(s))
+	return hash[:]
+}
+
+// TestInitPools ensures the pools start with the keys of the committee at the
+// pool multisig upgrade and are stored in the state.
+func TestInitPools(t *testing.T) {
+	params := poolTestParams()
+	keys := poolTestKeys(t, 3)
+	cs := NewCommitteeState()
+	for i, key := range keys {
+		cs.Mortgage(string(rune('a'+i)), nil, key.PubKey().SerializeCompressed(),
+			big.NewInt(100*int64(i+1)), nil)
+	}
+
+	if cs.GetPool(params, 99, ExpandedTxConvert_ECzz) != nil {
+		t.Fatal("pool before activation")
+	}
+	addr := strings.Split(hecoPoolAddr, "|")[1]
+	if !cs.IsPoolAddress(ExpandedTxConvert_HCzz, addr) {
+		t.Error("initial pool address not accepted before activation")
+	}
+	for _, addr := range []string{"", addr[:20], hecoPoolAddr} {
+		if cs.IsPoolAddress(ExpandedTxConvert_HCzz, addr) {
+			t.Errorf("partial pool address %q accepted", addr)
+		}
+	}
+
+	// The parent state of the activation block has no pools yet.
+	pool := cs.GetPool(params, 100, ExpandedTxConvert_ECzz)
+	if pool == nil || len(pool.PubKeys) != 2 || pool.Threshold != 2 ||
+		pool.Nonce != 0 {
+
+		t.Fatalf("unexpected initial pool %+v", pool)
+	}
+	if err := pool.validate(); err != nil {
+		t.Fatalf("initial pool: %v", err)
+	}
+
+	cs.RotateCommittee(params, 99)
+	cs.InitPools(params, 99)
+	if len(cs.Pools) != 0 {
+		t.Fatal("pools stored before activation")
+	}
+	cs.RotateCommittee(params, 100)
+	cs.InitPools(params, 100)
+	if len(cs.Pools) != 3 {
+		t.Fatalf("%d pools stored, want 3", len(cs.Pools))
+	}
+	if got := cs.GetPool(params, 101, ExpandedTxConvert_ECzz); got != cs.Pools[0] {
+		t.Errorf("stored pool not returned")
+	}
+	if cs.GetPool(params, 101, 42) != nil {
+		t.Error("unknown pool returned")
+	}
+
+	// Pools are part of the serialized state.
+	decoded := NewCommitteeState()
+	if err := rlp.DecodeBytes(cs.ToBytes(), decoded); err != nil {
+		t.Fatalf("DecodeBytes: %v", err)
+	}
+	if decoded.Hash() != cs.Hash() || len(decoded.Pools) != 3 {
+		t.Errorf("pools not preserved by serialization")
+	}
+}
+
+// TestPoolKeyRotation ensures pool key rotations need the signatures of the
+// current keys and the next nonce, and replace the pool.
+func TestPoolKeyRotation(t *testing.T) {
+	params := poolTestParams()
+	keys := poolTestKeys(t, 5)
+	cs := NewCommitteeState()
+	cs.Pools = append(cs.Pools, testPool(keys[:3], 2))
+	ev := &CommitteeVerify{Params: params}
+
+	newRotation := func(nonce uint64, threshold uint32, signers ...int) *wire.MsgTx {
+		info := &PoolKeyRotationTxInfo{
+			AssetType:    ExpandedTxConvert_ECzz,
+			ExtAddresses: strings.Split(ethPoolAddr, "|"),
+			Threshold:    threshold,
+			Nonce:        nonce,
+		}
+		for _, key := range keys[2:] {
+			info.PubKeys = append(info.PubKeys, key.PubKey().SerializeCompressed())
+		}
+		info.Signatures = poolSigs(t, keys[:3], info.Message(), true, signers...)
+		data, err := rlp.EncodeToBytes(info)
+		if err != nil {
+			t.Fatalf("EncodeToBytes: %v", err)
+		}
+		script, err := txscript.PoolKeyRotationScript(data)
+		if err != nil {
+			t.Fatalf("PoolKeyRotationScript: %v", err)
+		}
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxOut(wire.NewTxOut(0, script))
+		return tx
+	}
+
+	tests := []struct {
+		name  string
+		tx    *wire.MsgTx
+		valid bool
+	}{
+		{"valid", newRotation(1, 2, 0, 1), true},
+		{"below threshold", newRotation(1, 2, 1), false},
+		{"replayed nonce", newRotation(0, 2, 0, 1), false},
+		{"skipped nonce", newRotation(2, 2, 0, 1), false},
+		{"unreachable threshold", newRotation(1, 4, 0, 1), false},
+	}
+	for _, test := range tests {
+		_, err := ev.VerifyPoolKeyRotationTx(test.tx, cs, 101)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got error %v, want valid %v", test.name, err,
+				test.valid)
+		}
+	}
+	if _, err := ev.VerifyPoolKeyRotationTx(newRotation(1, 2, 0, 1), cs, 99); err == nil {
+		t.Error("rotation accepted before activation")
+	}
+	if _, err := ev.VerifyPoolKeyRotationTx(wire.NewMsgTx(wire.TxVersion), cs, 101); err != NoPoolKeyRotation {
+		t.Errorf("transaction without rotation: %v", err)
+	}
+
+	info, err := ev.VerifyPoolKeyRotationTx(newRotation(1, 2, 0, 2), cs, 101)
+	if err != nil {
+		t.Fatalf("VerifyPoolKeyRotationTx: %v", err)
+	}
+	cs.RotatePoolKeys(info)
+	pool := cs.GetPool(params, 102, ExpandedTxConvert_ECzz)
+	if pool.Nonce != 1 || len(pool.PubKeys) != 3 ||
+		string(pool.PubKeys[0]) != string(keys[2].PubKey().SerializeCompressed()) {
+
+		t.Errorf("pool not rotated: %+v", pool)
+	}
+
+	// The outgoing keys can't rotate the pool again.
+	if _, err := ev.VerifyPoolKeyRotationTx(newRotation(2, 2, 0, 1), cs, 102); err == nil {
+		t.Error("rotation signed by replaced keys accepted")
+	}
+}
+
+// TestVerifyReleaseSignatures ensures ConvertConfirms need the signatures of
+// the pool releasing the conversion after the pool multisig upgrade only.
+func TestVerifyReleaseSignatures(t *testing.T) {
+	params := poolTestParams()
+	keys := poolTestKeys(t, 2)
+	cs := NewCommitteeState()
+	cs.Pools = append(cs.Pools, testPool(keys, 2))
+	item := &ConvertItem{
+		ID:        big.NewInt(7),
+		PubKey:    keys[0].PubKey().SerializeCompressed(),
+		Amount:    big.NewInt(1000),
+		FeeAmount: big.NewInt(10),
+	}
+	cs.ConvertItems[ExpandedTxConvert_Czz] = ConvertItemMap{
+		ExpandedTxConvert_ECzz: ConvertItemList{item},
+	}
+
+	msg := ReleaseMessage(ExpandedTxConvert_Czz, ExpandedTxConvert_ECzz, item)
+	info := &ConvertConfirmTxInfo{
+		ID:          big.NewInt(7),
+		AssetType:   ExpandedTxConvert_Czz,
+		ConvertType: ExpandedTxConvert_ECzz,
+		Amount:      big.NewInt(1000),
+	}
+	if err := cs.VerifyReleaseSignatures(params, info, 99); err != nil {
+		t.Errorf("ConvertConfirm before activation: %v", err)
+	}
+	if err := cs.VerifyReleaseSignatures(params, info, 101); err == nil {
+		t.Error("ConvertConfirm without signatures accepted")
+	}
+	info.Signatures = poolSigs(t, keys, msg, true, 0, 1)
+	if err := cs.VerifyReleaseSignatures(params, info, 101); err != nil {
+		t.Errorf("signed ConvertConfirm: %v", err)
+	}
+	if err := cs.VerifyReleaseSignatures(params, info, 99); err == nil {
+		t.Error("signed ConvertConfirm before activation accepted")
+	}
+	info.ID = big.NewInt(8)
+	if err := cs.VerifyReleaseSignatures(params, info, 101); err == nil {
+		t.Error("ConvertConfirm of unknown conversion accepted")
+	}
+
+	// The signatures are carried after the fields of ConvertConfirms
+	// without them, whose encoding is unchanged.
+	unsigned := &ConvertConfirmTxInfo{ID: big.NewInt(7), Amount: big.NewInt(1)}
+	data, err := rlp.EncodeToBytes(unsigned)
+	if err != nil {
+		t.Fatalf("EncodeToBytes: %v", err)
+	}
+	decoded := &ConvertConfirmTxInfo{}
+	if err := rlp.DecodeBytes(data, decoded); err != nil || len(decoded.Signatures) != 0 {
+		t.Errorf("unsigned ConvertConfirm decoded as %+v: %v", decoded, err)
+	}
+	info.ID = big.NewInt(7)
+	data, err = rlp.EncodeToBytes(info)
+	if err != nil {
+		t.Fatalf("EncodeToBytes: %v", err)
+	}
+	if err := rlp.DecodeBytes(data, decoded); err != nil || len(decoded.Signatures) != 2 {
+		t.Errorf("signed ConvertConfirm decoded as %+v: %v", decoded, err)
+	}
+}
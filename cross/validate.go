@@ -15,7 +15,6 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"math/big"
 	"math/rand"
-	"strings"
 )
 
 var (
@@ -30,6 +29,10 @@ var (
 		ExpandedTxConvert_HCzz: {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 102},
 		ExpandedTxConvert_BCzz: {0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 103},
 	}
+
+	// FeePool is the pool the coinbase pays the fees of the conversions
+	// to.
+	FeePool = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 )
 
 type CommitteeVerify struct {
@@ -433,18 +436,8 @@ func (ev *CommitteeVerify) verifyConvertEthereumTypeTx(netName string, client *r
 		return nil, fmt.Errorf("verifyConvertEthereumTypeTx (%s) getTransactionByHash [txid:%s] err: %s", netName, eInfo.ExtTxHash, err)
 	}
 
-	if eInfo.AssetType == ExpandedTxConvert_ECzz {
-		if !strings.Contains(ethPoolAddr, txjson.tx.To().String()) {
-			return nil, fmt.Errorf("verifyConvertEthereumTypeTx (%s) ETh [ToAddress: %s] != [%s]", netName, txjson.tx.To().String(), ethPoolAddr)
-		}
-	} else if eInfo.AssetType == ExpandedTxConvert_HCzz {
-		if !strings.Contains(hecoPoolAddr, txjson.tx.To().String()) {
-			return nil, fmt.Errorf("verifyConvertEthereumTypeTx (%s) Heco [ToAddress: %s] != [%s]", netName, txjson.tx.To().String(), ethPoolAddr)
-		}
-	} else if eInfo.AssetType == ExpandedTxConvert_BCzz {
-		if !strings.Contains(bscPoolAddr, txjson.tx.To().String()) {
-			return nil, fmt.Errorf("verifyConvertEthereumTypeTx (%s) Bsc [ToAddress: %s] != [%s]", netName, txjson.tx.To().String(), ethPoolAddr)
-		}
+	if !cState.IsPoolAddress(eInfo.AssetType, txjson.tx.To().String()) {
+		return nil, fmt.Errorf("verifyConvertEthereumTypeTx (%s) [ToAddress: %s] is not a pool address", netName, txjson.tx.To().String())
 	}
 
 	extTx := txjson.tx
@@ -590,18 +583,8 @@ func (ev *CommitteeVerify) verifyConvertConfirmEthereumTypeTx(netName string, cl
 	}
 
 	// toaddress
-	if eInfo.ConvertType == ExpandedTxConvert_ECzz {
-		if !strings.Contains(ethPoolAddr, txjson.tx.To().String()) {
-			return fmt.Errorf("verifyConvertEthereumTypeTx (%s) ETh [ToAddress: %s] != [%s]", netName, txjson.tx.To().String(), ethPoolAddr)
-		}
-	} else if eInfo.ConvertType == ExpandedTxConvert_HCzz {
-		if !strings.Contains(hecoPoolAddr, txjson.tx.To().String()) {
-			return fmt.Errorf("verifyConvertEthereumTypeTx (%s) Heco [ToAddress: %s] != [%s]", netName, txjson.tx.To().String(), hecoPoolAddr)
-		}
-	} else if eInfo.ConvertType == ExpandedTxConvert_BCzz {
-		if !strings.Contains(bscPoolAddr, txjson.tx.To().String()) {
-			return fmt.Errorf("verifyConvertEthereumTypeTx (%s) Bsc [ToAddress: %s] != [%s]", netName, txjson.tx.To().String(), bscPoolAddr)
-		}
+	if !cState.IsPoolAddress(eInfo.ConvertType, txjson.tx.To().String()) {
+		return fmt.Errorf("verifyConvertConfirmEthereumTypeTx (%s) [ToAddress: %s] is not a pool address", netName, txjson.tx.To().String())
 	}
 
 	return nil
@@ -623,7 +606,7 @@ func (ev *CommitteeVerify) VerifyCastingTx(tx *wire.MsgTx, cState *CommitteeStat
 		return nil, fmt.Errorf("Casting PkScript err %s ", tx.TxOut[1].PkScript)
 	}
 
-	if tx.TxOut[1].Value != ct.Amount.Int64() && height > 1203000 {
+	if tx.TxOut[1].Value != ct.Amount.Int64() && ev.Params.IsActive(chaincfg.UpgradeCastingAmount, height) {
 		return nil, fmt.Errorf("Casting Amount err %d ", ct.Amount.Int64())
 	}
 
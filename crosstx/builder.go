@@ -0,0 +1,360 @@
+// Package crosstx builds and signs the classzz staking and cross chain
+// transactions offline, without access to a node.
+package crosstx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// Input is a previous output spent by a transaction built by this package.
+// The public key script and amount of the output are required to sign the
+// transaction without access to the chain.
+type Input struct {
+	OutPoint wire.OutPoint
+	PkScript []byte
+	Amount   int64
+}
+
+// Output pays an amount to an address, typically the change of a transaction.
+type Output struct {
+	Address czzutil.Address
+	Amount  czzutil.Amount
+}
+
+// Template holds the parts shared by all the transactions built by this
+// package.  Outputs are appended after the outputs specific to the
+// transaction type.
+type Template struct {
+	Params   *chaincfg.Params
+	Inputs   []*Input
+	Outputs  []*Output
+	LockTime uint32
+}
+
+// newTx returns a new transaction spending the inputs of the template.  The
+// staking and casting transactions identify their sender by the public key of
+// their only input, so they pass singleInput to enforce it.  maxOutputs limits
+// the number of outputs of the template accepted by the consensus rules for
+// the transaction type, or is negative when there is no such limit.
+func (t *Template) newTx(singleInput bool, maxOutputs int) (*wire.MsgTx, error) {
+	if len(t.Inputs) == 0 {
+		return nil, errors.New("transaction has no inputs")
+	}
+	if singleInput && len(t.Inputs) != 1 {
+		return nil, fmt.Errorf("transaction must spend exactly one "+
+			"input, got %d", len(t.Inputs))
+	}
+	if maxOutputs >= 0 && len(t.Outputs) > maxOutputs {
+		return nil, fmt.Errorf("transaction may have at most %d "+
+			"additional outputs, got %d", maxOutputs, len(t.Outputs))
+	}
+
+	mtx := wire.NewMsgTx(wire.TxVersion)
+	for _, input := range t.Inputs {
+		outPoint := input.OutPoint
+		txIn := wire.NewTxIn(&outPoint, nil)
+		if t.LockTime != 0 {
+			txIn.Sequence = wire.MaxTxInSequenceNum - 1
+		}
+		mtx.AddTxIn(txIn)
+	}
+	mtx.LockTime = t.LockTime
+	return mtx, nil
+}
+
+// addOutputs appends the outputs of the template to the passed transaction
+// after ensuring their addresses are of a supported type and for the network
+// of the template.
+func (t *Template) addOutputs(mtx *wire.MsgTx) error {
+	for _, output := range t.Outputs {
+		if output.Amount <= 0 || output.Amount > czzutil.MaxSatoshi {
+			return fmt.Errorf("invalid amount %v", output.Amount)
+		}
+
+		switch output.Address.(type) {
+		case *czzutil.AddressPubKeyHash:
+		case *czzutil.AddressScriptHash:
+		case *czzutil.LegacyAddressPubKeyHash:
+		default:
+			return fmt.Errorf("unsupported address %v", output.Address)
+		}
+		if !output.Address.IsForNet(t.Params) {
+			return fmt.Errorf("address %v is for the wrong network",
+				output.Address)
+		}
+
+		pkScript, err := txscript.PayToAddrScript(output.Address)
+		if err != nil {
+			return err
+		}
+		mtx.AddTxOut(wire.NewTxOut(int64(output.Amount), pkScript))
+	}
+	return nil
+}
+
+// addPayload appends a zero value output holding the RLP encoding of the
+// passed payload in the script built by makeScript.
+func addPayload(mtx *wire.MsgTx, makeScript func([]byte) ([]byte, error),
+	payload interface{}) error {
+
+	data, err := rlp.EncodeToBytes(payload)
+	if err != nil {
+		return err
+	}
+	script, err := makeScript(data)
+	if err != nil {
+		return err
+	}
+	mtx.AddTxOut(wire.NewTxOut(0, script))
+	return nil
+}
+
+// checkStakingAmount returns an error when the passed amount is below min or
+// above the maximum amount of coins.
+func checkStakingAmount(amount czzutil.Amount, min *big.Int) error {
+	if (min != nil && big.NewInt(int64(amount)).Cmp(min) < 0) ||
+		amount > czzutil.MaxSatoshi {
+		return fmt.Errorf("invalid staking amount %v", amount)
+	}
+	return nil
+}
+
+// addStake appends the output staking the passed amount to the public key hash
+// toAddress.
+func (t *Template) addStake(mtx *wire.MsgTx, toAddress []byte,
+	amount czzutil.Amount) error {
+
+	addr, err := czzutil.NewLegacyAddressPubKeyHash(toAddress, t.Params)
+	if err != nil {
+		return err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+	mtx.AddTxOut(wire.NewTxOut(int64(amount), pkScript))
+	return nil
+}
+
+// NewMortgageTx returns an unsigned transaction staking amount to the public
+// key hash toAddress to become a member of the committee which pays its
+// rewards to the passed coinbase addresses.
+func NewMortgageTx(t *Template, toAddress []byte, amount czzutil.Amount,
+	coinbaseAddrs []string) (*wire.MsgTx, error) {
+
+	if err := checkStakingAmount(amount, t.Params.MinStakingAmount); err != nil {
+		return nil, err
+	}
+	mtx, err := t.newTx(true, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &cross.Mortgage{
+		ToAddress:       toAddress,
+		CoinBaseAddress: coinbaseAddrs,
+	}
+	if err := addPayload(mtx, txscript.MortgageScript, payload); err != nil {
+		return nil, err
+	}
+	if err := t.addStake(mtx, toAddress, amount); err != nil {
+		return nil, err
+	}
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
+
+// NewAddMortgageTx returns an unsigned transaction adding amount to the stake
+// of the committee member with the public key hash toAddress.
+func NewAddMortgageTx(t *Template, toAddress []byte,
+	amount czzutil.Amount) (*wire.MsgTx, error) {
+
+	if err := checkStakingAmount(amount, t.Params.MinAddStakingAmount); err != nil {
+		return nil, err
+	}
+	mtx, err := t.newTx(true, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &cross.AddMortgage{
+		StakingAmount: big.NewInt(int64(amount)),
+	}
+	if err := addPayload(mtx, txscript.AddMortgageScript, payload); err != nil {
+		return nil, err
+	}
+	if err := t.addStake(mtx, toAddress, amount); err != nil {
+		return nil, err
+	}
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
+
+// NewUpdateCoinbaseAllTx returns an unsigned transaction replacing the
+// coinbase addresses of the committee member spending its input.
+func NewUpdateCoinbaseAllTx(t *Template, coinbaseAddrs []string) (*wire.MsgTx, error) {
+	mtx, err := t.newTx(true, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &cross.UpdateCoinbaseAll{
+		CoinBaseAddress: coinbaseAddrs,
+	}
+	if err := addPayload(mtx, txscript.UpdateCoinbaseAllScript, payload); err != nil {
+		return nil, err
+	}
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
+
+// NewConvertTx returns an unsigned transaction claiming the passed conversions
+// of assets locked on external chains.
+func NewConvertTx(t *Template, converts []*cross.ConvertTxInfo) (*wire.MsgTx, error) {
+	if len(converts) == 0 {
+		return nil, errors.New("no conversions")
+	}
+	mtx, err := t.newTx(false, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, convert := range converts {
+		if err := addPayload(mtx, txscript.ConvertScript, convert); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
+
+// NewCastingTx returns an unsigned transaction locking amount in the coin pool
+// of convertType to be minted on the external chain.
+func NewCastingTx(t *Template, convertType uint8,
+	amount czzutil.Amount) (*wire.MsgTx, error) {
+
+	pool, ok := cross.CoinPools[convertType]
+	if !ok {
+		return nil, fmt.Errorf("unknown convert type %d", convertType)
+	}
+	if amount <= 0 || amount > czzutil.MaxSatoshi {
+		return nil, fmt.Errorf("invalid amount %v", amount)
+	}
+	mtx, err := t.newTx(true, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &cross.CastingTxInfo{
+		ConvertType: convertType,
+		Amount:      big.NewInt(int64(amount)),
+	}
+	if err := addPayload(mtx, txscript.CastingScript, payload); err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToPubKeyHashScript(pool)
+	if err != nil {
+		return nil, err
+	}
+	mtx.AddTxOut(wire.NewTxOut(int64(amount), pkScript))
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
+
+// NewConvertConfirmTx returns an unsigned transaction confirming the passed
+// conversions were paid out on the external chains.
+func NewConvertConfirmTx(t *Template,
+	confirms []*cross.ConvertConfirmTxInfo) (*wire.MsgTx, error) {
+
+	if len(confirms) == 0 {
+		return nil, errors.New("no conversions to confirm")
+	}
+	mtx, err := t.newTx(false, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, confirm := range confirms {
+		if err := addPayload(mtx, txscript.ConvertConfirmScript, confirm); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
+
+// NewCommitteeConvertConfirmTx returns an unsigned transaction confirming the
+// passed conversions like NewConvertConfirmTx, approved by the committee with
+// the passed member public keys.  Once the committee upgrade is active, the
+// consensus rules only accept a ConvertConfirm which spends outputs of as many
+// members as the chain parameters require, so an error is returned unless the
+// inputs of the template pay to enough members.  Every member then signs the
+// inputs paying to it, possibly on its own machine by way of a partially signed
+// transaction.
+func NewCommitteeConvertConfirmTx(t *Template, members [][]byte,
+	confirms []*cross.ConvertConfirmTxInfo) (*wire.MsgTx, error) {
+
+	if len(members) == 0 {
+		return nil, errors.New("committee has no members")
+	}
+	committee := &cross.CommitteeInfo{
+		Members: make([]*cross.CommitteeMember, 0, len(members)),
+	}
+	for _, pubKey := range members {
+		committee.Members = append(committee.Members,
+			&cross.CommitteeMember{Publickey: pubKey})
+	}
+	pkScripts := make([][]byte, 0, len(t.Inputs))
+	for _, input := range t.Inputs {
+		pkScripts = append(pkScripts, input.PkScript)
+	}
+	approvals := committee.Approvals(pkScripts)
+	required := committee.RequiredApprovals(t.Params)
+	if approvals < required {
+		return nil, fmt.Errorf("inputs pay to %d committee members, %d "+
+			"required", approvals, required)
+	}
+	return NewConvertConfirmTx(t, confirms)
+}
+
+// NewPoolKeyRotationTx returns an unsigned transaction replacing the keys of
+// a pool with those of the passed rotation, which must carry the signatures
+// of the current keys of the pool.
+func NewPoolKeyRotationTx(t *Template, info *cross.PoolKeyRotationTxInfo) (*wire.MsgTx, error) {
+	if len(info.Signatures) == 0 {
+		return nil, errors.New("pool key rotation without signatures")
+	}
+	mtx, err := t.newTx(false, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := addPayload(mtx, txscript.PoolKeyRotationScript, info); err != nil {
+		return nil, err
+	}
+	if err := t.addOutputs(mtx); err != nil {
+		return nil, err
+	}
+	return mtx, nil
+}
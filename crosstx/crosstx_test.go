@@ -0,0 +1,255 @@
+package crosstx
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// testSigner returns a signer for the key made of 32 times b along with the
+// pay-to-pubkey-hash address of the key.
+func testSigner(t *testing.T, params *chaincfg.Params, b byte) (*KeySigner, czzutil.Address) {
+	privKey, _ := czzec.PrivKeyFromBytes(czzec.S256(), bytes.Repeat([]byte{b}, 32))
+	wif, err := czzutil.NewWIF(privKey, params, true)
+	if err != nil {
+		t.Fatalf("NewWIF: %v", err)
+	}
+	signer, err := NewKeySigner([]*czzutil.WIF{wif})
+	if err != nil {
+		t.Fatalf("NewKeySigner: %v", err)
+	}
+	addr, err := czzutil.NewAddressPubKeyHash(
+		czzutil.Hash160(wif.SerializePubKey()), params)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: %v", err)
+	}
+	return signer, addr
+}
+
+// testInputs returns n inputs paying amount to the passed address.
+func testInputs(t *testing.T, addr czzutil.Address, n int, amount int64) []*Input {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %v", err)
+	}
+	inputs := make([]*Input, n)
+	for i := range inputs {
+		inputs[i] = &Input{
+			OutPoint: wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}, Index: uint32(i)},
+			PkScript: pkScript,
+			Amount:   amount,
+		}
+	}
+	return inputs
+}
+
+// TestMortgageTx ensures a signed mortgage transaction is recognized by the
+// cross chain rules with the staked amount and the address of its signer.
+func TestMortgageTx(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	signer, addr := testSigner(t, params, 0x01)
+
+	stake := czzutil.Amount(params.MinStakingAmount.Int64())
+	tmpl := &Template{
+		Params:  params,
+		Inputs:  testInputs(t, addr, 1, int64(stake)+1e8),
+		Outputs: []*Output{{Address: addr, Amount: 1e8 - 1000}},
+	}
+	mtx, err := NewMortgageTx(tmpl, addr.ScriptAddress(), stake, []string{addr.String()})
+	if err != nil {
+		t.Fatalf("NewMortgageTx: %v", err)
+	}
+
+	for _, sigType := range []SignatureType{SigTypeSchnorr, SigTypeECDSA} {
+		err = Sign(params, mtx, tmpl.Inputs, signer, sigType, txscript.SigHashAll)
+		if err != nil {
+			t.Fatalf("Sign %v: %v", sigType, err)
+		}
+
+		info, err := cross.IsMortgageTx(mtx, params)
+		if err != nil {
+			t.Fatalf("IsMortgageTx %v: %v", sigType, err)
+		}
+		if info.StakingAmount.Int64() != int64(stake) {
+			t.Fatalf("%v: unexpected staking amount %v, want %v",
+				sigType, info.StakingAmount, stake)
+		}
+		if info.Address != addr.String() {
+			t.Fatalf("%v: unexpected address %v, want %v", sigType,
+				info.Address, addr)
+		}
+	}
+}
+
+// TestConvertTx ensures convert transactions may spend several inputs and
+// carry one payload per conversion.
+func TestConvertTx(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	signer, addr := testSigner(t, params, 0x01)
+
+	tmpl := &Template{
+		Params: params,
+		Inputs: testInputs(t, addr, 2, 1e8),
+	}
+	converts := []*cross.ConvertTxInfo{
+		{AssetType: cross.ExpandedTxConvert_ECzz, ExtTxHash: "0x01"},
+		{AssetType: cross.ExpandedTxConvert_ECzz, ExtTxHash: "0x02"},
+	}
+	mtx, err := NewConvertTx(tmpl, converts)
+	if err != nil {
+		t.Fatalf("NewConvertTx: %v", err)
+	}
+	err = Sign(params, mtx, tmpl.Inputs, signer, SigTypeSchnorr, txscript.SigHashAll)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	infos, err := cross.IsConvertTx(mtx)
+	if err != nil {
+		t.Fatalf("IsConvertTx: %v", err)
+	}
+	if len(infos) != len(converts) {
+		t.Fatalf("got %d conversions, want %d", len(infos), len(converts))
+	}
+}
+
+// TestCommitteeConvertConfirmTx ensures ConvertConfirm transactions are only
+// built when they spend outputs of enough committee members and are approved
+// once each member signed its inputs.
+func TestCommitteeConvertConfirmTx(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	required := int(params.CommitteeThreshold)
+
+	var members [][]byte
+	var signers []*KeySigner
+	var inputs []*Input
+	for i := 0; i < required+1; i++ {
+		signer, addr := testSigner(t, params, byte(i+1))
+		pubKey, err := signer.PubKey(addr)
+		if err != nil {
+			t.Fatalf("PubKey: %v", err)
+		}
+		members = append(members, pubKey.SerializeCompressed())
+		signers = append(signers, signer)
+		input := testInputs(t, addr, 1, 1e8)[0]
+		input.OutPoint.Index = uint32(i)
+		inputs = append(inputs, input)
+	}
+	confirms := []*cross.ConvertConfirmTxInfo{{
+		ID:          big.NewInt(1),
+		AssetType:   cross.ExpandedTxConvert_ECzz,
+		ConvertType: cross.ExpandedTxConvert_Czz,
+		ExtTxHash:   "0x01",
+		Amount:      big.NewInt(1e8),
+	}}
+
+	tmpl := &Template{Params: params, Inputs: inputs[:required-1]}
+	if _, err := NewCommitteeConvertConfirmTx(tmpl, members, confirms); err == nil {
+		t.Fatal("ConvertConfirm spending outputs of too few members built")
+	}
+	if _, err := NewCommitteeConvertConfirmTx(tmpl, nil, confirms); err == nil {
+		t.Fatal("ConvertConfirm without committee built")
+	}
+
+	tmpl.Inputs = inputs[:required]
+	mtx, err := NewCommitteeConvertConfirmTx(tmpl, members, confirms)
+	if err != nil {
+		t.Fatalf("NewCommitteeConvertConfirmTx: %v", err)
+	}
+
+	// Each member signs its own input and passes the transaction on.
+	for i := range tmpl.Inputs {
+		if err := Verify(mtx, tmpl.Inputs); err == nil {
+			t.Fatalf("transaction complete with %d of %d signatures",
+				i, required)
+		}
+		signed, err := SignPartial(params, mtx, tmpl.Inputs, signers[i],
+			SigTypeSchnorr, txscript.SigHashAll)
+		if err != nil {
+			t.Fatalf("SignPartial %d: %v", i, err)
+		}
+		if signed != 1 {
+			t.Fatalf("member %d signed %d inputs, want 1", i, signed)
+		}
+	}
+	if err := Verify(mtx, tmpl.Inputs); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if infos, _ := cross.IsConvertConfirmTx(mtx); len(infos) != len(confirms) {
+		t.Fatalf("got %d confirmations, want %d", len(infos), len(confirms))
+	}
+}
+
+// TestPoolKeyRotationTx ensures pool key rotation transactions carry the
+// rotation in their first output.
+func TestPoolKeyRotationTx(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	_, addr := testSigner(t, params, 0x01)
+
+	tmpl := &Template{
+		Params: params,
+		Inputs: testInputs(t, addr, 1, 1e8),
+	}
+	rotation := &cross.PoolKeyRotationTxInfo{
+		AssetType: cross.ExpandedTxConvert_HCzz,
+		Threshold: 1,
+		Nonce:     3,
+	}
+	if _, err := NewPoolKeyRotationTx(tmpl, rotation); err == nil {
+		t.Fatal("rotation without signatures built")
+	}
+	rotation.Signatures = [][]byte{bytes.Repeat([]byte{0x01}, 64)}
+	mtx, err := NewPoolKeyRotationTx(tmpl, rotation)
+	if err != nil {
+		t.Fatalf("NewPoolKeyRotationTx: %v", err)
+	}
+
+	info, err := cross.IsPoolKeyRotationTx(mtx)
+	if err != nil {
+		t.Fatalf("IsPoolKeyRotationTx: %v", err)
+	}
+	if info.AssetType != rotation.AssetType || info.Nonce != rotation.Nonce ||
+		len(info.Signatures) != 1 {
+
+		t.Fatalf("unexpected rotation %+v", info)
+	}
+}
+
+// TestTemplateErrors ensures transactions violating the cross chain rules are
+// not built and inputs without a key are not signed.
+func TestTemplateErrors(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	_, addr := testSigner(t, params, 0x01)
+	stake := czzutil.Amount(params.MinStakingAmount.Int64())
+
+	tmpl := &Template{Params: params, Inputs: testInputs(t, addr, 2, 1e8)}
+	if _, err := NewMortgageTx(tmpl, addr.ScriptAddress(), stake, nil); err == nil {
+		t.Fatal("NewMortgageTx accepted two inputs")
+	}
+
+	tmpl.Inputs = tmpl.Inputs[:1]
+	if _, err := NewMortgageTx(tmpl, addr.ScriptAddress(), stake-1, nil); err == nil {
+		t.Fatal("NewMortgageTx accepted a stake below the minimum")
+	}
+	if _, err := NewCastingTx(tmpl, 0xff, 1e8); err == nil {
+		t.Fatal("NewCastingTx accepted an unknown convert type")
+	}
+
+	mtx, err := NewCastingTx(tmpl, cross.ExpandedTxConvert_ECzz, 1e8)
+	if err != nil {
+		t.Fatalf("NewCastingTx: %v", err)
+	}
+	other, _ := testSigner(t, params, 0x02)
+	err = Sign(params, mtx, tmpl.Inputs, other, SigTypeSchnorr, txscript.SigHashAll)
+	if err == nil {
+		t.Fatal("Sign succeeded without the key of the input")
+	}
+}
@@ -0,0 +1,251 @@
+package crosstx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// SignatureType is the signature algorithm used to sign the inputs.
+type SignatureType int
+
+const (
+	// SigTypeSchnorr signs the inputs with Schnorr signatures.
+	SigTypeSchnorr SignatureType = iota
+
+	// SigTypeECDSA signs the inputs with ECDSA signatures.
+	SigTypeECDSA
+)
+
+// String returns the SignatureType in human-readable form.
+func (t SignatureType) String() string {
+	switch t {
+	case SigTypeSchnorr:
+		return "schnorr"
+	case SigTypeECDSA:
+		return "ecdsa"
+	}
+	return fmt.Sprintf("Unknown SignatureType (%d)", int(t))
+}
+
+// ErrNoKey is returned by signers which hold no key for an address.
+var ErrNoKey = errors.New("no key")
+
+// Signer provides the public keys and signatures needed to sign the inputs of
+// a transaction.  It only ever sees signature hashes, so it may be backed by a
+// device which never reveals its private keys.
+type Signer interface {
+	// PubKey returns the public key of the pay-to-pubkey-hash address.
+	// An error wrapping ErrNoKey is returned when the signer can't sign
+	// for the address.
+	PubKey(addr czzutil.Address) (*czzec.PublicKey, error)
+
+	// SignHash returns the serialized signature of the signature hash with
+	// the private key of the passed public key.
+	SignHash(pubKey *czzec.PublicKey, hash []byte,
+		sigType SignatureType) ([]byte, error)
+}
+
+// KeySigner is a Signer holding its private keys in memory.
+type KeySigner struct {
+	keys map[[20]byte]*czzec.PrivateKey
+}
+
+// NewKeySigner returns a new KeySigner for the passed private keys.  Only
+// compressed public keys are supported since the cross chain rules identify
+// senders by them.
+func NewKeySigner(wifs []*czzutil.WIF) (*KeySigner, error) {
+	s := &KeySigner{keys: make(map[[20]byte]*czzec.PrivateKey, len(wifs))}
+	for _, wif := range wifs {
+		if !wif.CompressPubKey {
+			return nil, errors.New("uncompressed keys are not supported")
+		}
+		var hash [20]byte
+		copy(hash[:], czzutil.Hash160(wif.SerializePubKey()))
+		s.keys[hash] = wif.PrivKey
+	}
+	return s, nil
+}
+
+// privKey returns the private key with the passed public key hash.
+func (s *KeySigner) privKey(pubKeyHash []byte) (*czzec.PrivateKey, bool) {
+	var hash [20]byte
+	copy(hash[:], pubKeyHash)
+	key, ok := s.keys[hash]
+	return key, ok && len(pubKeyHash) == len(hash)
+}
+
+// PubKey returns the public key of the pay-to-pubkey-hash address.
+//
+// This is part of the Signer interface.
+func (s *KeySigner) PubKey(addr czzutil.Address) (*czzec.PublicKey, error) {
+	key, ok := s.privKey(addr.ScriptAddress())
+	if !ok {
+		return nil, fmt.Errorf("%w for address %v", ErrNoKey, addr)
+	}
+	return key.PubKey(), nil
+}
+
+// SignHash returns the serialized signature of the signature hash with the
+// private key of the passed public key.
+//
+// This is part of the Signer interface.
+func (s *KeySigner) SignHash(pubKey *czzec.PublicKey, hash []byte,
+	sigType SignatureType) ([]byte, error) {
+
+	key, ok := s.privKey(czzutil.Hash160(pubKey.SerializeCompressed()))
+	if !ok {
+		return nil, errors.New("no key for public key")
+	}
+
+	var sig *czzec.Signature
+	var err error
+	switch sigType {
+	case SigTypeSchnorr:
+		sig, err = key.SignSchnorr(hash)
+	case SigTypeECDSA:
+		sig, err = key.SignECDSA(hash)
+	default:
+		return nil, fmt.Errorf("unsupported signature type %v", sigType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
+
+// Sign signs all inputs of the transaction, which spend the passed inputs in
+// the same order, with the keys of the signer.  Only pay-to-pubkey-hash inputs
+// are supported.  The signed scripts are executed afterwards to ensure the
+// transaction is valid before it leaves the offline machine.
+func Sign(params *chaincfg.Params, mtx *wire.MsgTx, inputs []*Input,
+	signer Signer, sigType SignatureType, hashType txscript.SigHashType) error {
+
+	if err := checkInputs(mtx, inputs); err != nil {
+		return err
+	}
+	sigHashes := txscript.NewTxSigHashes(mtx)
+	for i, input := range inputs {
+		err := signInput(params, mtx, i, input, sigHashes, signer, sigType,
+			hashType)
+		if err != nil {
+			return err
+		}
+	}
+	return Verify(mtx, inputs)
+}
+
+// SignPartial signs the inputs of the transaction which are not signed yet
+// and whose keys are held by the signer, and returns their number.  Unlike
+// Sign, the other inputs are left alone and the scripts are not executed, so
+// the transaction can be passed on to the holders of the other keys, such as
+// the other members of a committee approving a ConvertConfirm transaction.
+// Verify ensures the transaction is completely signed.
+func SignPartial(params *chaincfg.Params, mtx *wire.MsgTx, inputs []*Input,
+	signer Signer, sigType SignatureType, hashType txscript.SigHashType) (int, error) {
+
+	if err := checkInputs(mtx, inputs); err != nil {
+		return 0, err
+	}
+	sigHashes := txscript.NewTxSigHashes(mtx)
+	signed := 0
+	for i, input := range inputs {
+		if len(mtx.TxIn[i].SignatureScript) > 0 {
+			continue
+		}
+		err := signInput(params, mtx, i, input, sigHashes, signer, sigType,
+			hashType)
+		if errors.Is(err, ErrNoKey) {
+			continue
+		}
+		if err != nil {
+			return signed, err
+		}
+		signed++
+	}
+	return signed, nil
+}
+
+// Verify executes the scripts of all inputs of the transaction, which spend
+// the passed inputs in the same order, to ensure it is completely and
+// correctly signed.
+func Verify(mtx *wire.MsgTx, inputs []*Input) error {
+	if err := checkInputs(mtx, inputs); err != nil {
+		return err
+	}
+	sigHashes := txscript.NewTxSigHashes(mtx)
+	for i, input := range inputs {
+		vm, err := txscript.NewEngine(input.PkScript, mtx, i,
+			txscript.StandardVerifyFlags, nil, sigHashes, input.Amount)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("input %d: invalid signature: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// checkInputs ensures the transaction spends the passed inputs in the same
+// order.
+func checkInputs(mtx *wire.MsgTx, inputs []*Input) error {
+	if len(inputs) != len(mtx.TxIn) {
+		return fmt.Errorf("transaction has %d inputs, got %d previous "+
+			"outputs", len(mtx.TxIn), len(inputs))
+	}
+	for i, input := range inputs {
+		if mtx.TxIn[i].PreviousOutPoint != input.OutPoint {
+			return fmt.Errorf("input %d spends %v, not %v", i,
+				mtx.TxIn[i].PreviousOutPoint, input.OutPoint)
+		}
+	}
+	return nil
+}
+
+// signInput signs the input with the passed index, which spends the passed
+// pay-to-pubkey-hash output, with the key of the signer.  An error wrapping
+// ErrNoKey is returned when the signer holds no key for the output.
+func signInput(params *chaincfg.Params, mtx *wire.MsgTx, i int, input *Input,
+	sigHashes *txscript.TxSigHashes, signer Signer, sigType SignatureType,
+	hashType txscript.SigHashType) error {
+
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(input.PkScript,
+		params)
+	if err != nil {
+		return err
+	}
+	if class != txscript.PubKeyHashTy {
+		return fmt.Errorf("input %d: unsupported script class %v", i,
+			class)
+	}
+
+	pubKey, err := signer.PubKey(addrs[0])
+	if err != nil {
+		return fmt.Errorf("input %d: %w", i, err)
+	}
+	hashType |= txscript.SigHashForkID
+	hash, err := txscript.CalcSignatureHash(input.PkScript, sigHashes,
+		hashType, mtx, i, input.Amount, true)
+	if err != nil {
+		return err
+	}
+	sig, err := signer.SignHash(pubKey, hash, sigType)
+	if err != nil {
+		return fmt.Errorf("input %d: %v", i, err)
+	}
+
+	sigScript, err := txscript.NewScriptBuilder().
+		AddData(append(sig, byte(hashType))).
+		AddData(pubKey.SerializeCompressed()).Script()
+	if err != nil {
+		return err
+	}
+	mtx.TxIn[i].SignatureScript = sigScript
+	return nil
+}
@@ -0,0 +1,372 @@
+package czzrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AuthenticationTokenKey is the key of the context metadata clients put their
+// authentication token in.
+const AuthenticationTokenKey = "AuthenticationToken"
+
+// AuthToken describes a named credential of the gRPC server and what it is
+// allowed to do.
+type AuthToken struct {
+	// Name identifies the token in the logs.
+	Name string `json:"name"`
+
+	// Token is the secret clients send under AuthenticationTokenKey.
+	Token string `json:"token"`
+
+	// ClientCert is the common name of a verified TLS client certificate
+	// which authenticates as this token when mutual TLS is enabled.
+	ClientCert string `json:"clientcert"`
+
+	// Methods lists the names of the methods, such as SubmitTransaction,
+	// the token may call.  All methods are allowed when it is empty or
+	// contains "*".
+	Methods []string `json:"methods"`
+
+	// RateLimit is the number of requests per second the token may make
+	// on average, and Burst the number it may make at once.  There is no
+	// limit when RateLimit is zero.  Burst defaults to the rate rounded up.
+	RateLimit float64 `json:"ratelimit"`
+	Burst     int     `json:"burst"`
+}
+
+// AuthConfig holds the access control of the gRPC server.
+type AuthConfig struct {
+	// PublicMethods lists the methods that may be called without
+	// authenticating.  "*" makes all methods public.
+	PublicMethods []string `json:"public"`
+
+	// Tokens are the named credentials of the server.
+	Tokens []*AuthToken `json:"tokens"`
+}
+
+// LoadAuthConfig reads an access control configuration from the passed JSON
+// file.
+func LoadAuthConfig(path string) (*AuthConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg AuthConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("malformed gRPC auth file %s: %v", path, err)
+	}
+	return &cfg, nil
+}
+
+// methodSet is a set of method names where "*" matches every method.
+type methodSet map[string]struct{}
+
+// newMethodSet returns the set of the passed method names.
+func newMethodSet(methods []string) methodSet {
+	set := make(methodSet)
+	for _, method := range methods {
+		set[method] = struct{}{}
+	}
+	return set
+}
+
+// contains returns whether the set contains the passed method name.
+func (s methodSet) contains(method string) bool {
+	if _, ok := s["*"]; ok {
+		return true
+	}
+	_, ok := s[method]
+	return ok
+}
+
+// rateLimiter is a token bucket allowing rate requests per second on average
+// and burst requests at once.
+type rateLimiter struct {
+	mtx    sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a rate limiter with a full bucket.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// allow returns whether a request made at the passed time is within the
+// limit and consumes a token if so.
+func (l *rateLimiter) allow(now time.Time) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+// authEntry is a configured token along with its access control state.
+type authEntry struct {
+	name      string
+	tokenHash [sha256.Size]byte
+	hasToken  bool
+	methods   methodSet
+	limiter   *rateLimiter
+}
+
+// Authenticator implements the access control of the gRPC server as unary and
+// streaming interceptors.  Requests are authenticated with a token in the
+// context metadata or a TLS client certificate, checked against the methods
+// allowed for their credential, rate limited per credential and logged.
+type Authenticator struct {
+	open    bool
+	public  methodSet
+	entries []*authEntry
+	certs   map[string]*authEntry
+}
+
+// NewAuthenticator returns an authenticator enforcing the passed
+// configuration.  A nil configuration leaves every method open to everyone,
+// while a configuration without tokens only allows its public methods.
+func NewAuthenticator(cfg *AuthConfig) (*Authenticator, error) {
+	a := &Authenticator{
+		public: make(methodSet),
+		certs:  make(map[string]*authEntry),
+	}
+	if cfg == nil {
+		a.open = true
+		return a, nil
+	}
+
+	a.public = newMethodSet(cfg.PublicMethods)
+	names := make(map[string]struct{})
+	tokens := make(map[string]struct{})
+	for _, token := range cfg.Tokens {
+		if token.Name == "" {
+			return nil, fmt.Errorf("gRPC auth token without a name")
+		}
+		if _, ok := names[token.Name]; ok {
+			return nil, fmt.Errorf("duplicate gRPC auth token name %q",
+				token.Name)
+		}
+		names[token.Name] = struct{}{}
+		if token.Token == "" && token.ClientCert == "" {
+			return nil, fmt.Errorf("gRPC auth token %q has neither a "+
+				"token nor a client certificate", token.Name)
+		}
+		if token.RateLimit < 0 || token.Burst < 0 {
+			return nil, fmt.Errorf("gRPC auth token %q has a negative "+
+				"rate limit", token.Name)
+		}
+
+		entry := &authEntry{
+			name:    token.Name,
+			methods: newMethodSet(token.Methods),
+		}
+		if len(token.Methods) == 0 {
+			entry.methods = newMethodSet([]string{"*"})
+		}
+		if token.Token != "" {
+			if _, ok := tokens[token.Token]; ok {
+				return nil, fmt.Errorf("gRPC auth token %q reuses "+
+					"the token of another entry", token.Name)
+			}
+			tokens[token.Token] = struct{}{}
+			entry.tokenHash = sha256.Sum256([]byte(token.Token))
+			entry.hasToken = true
+		}
+		if token.ClientCert != "" {
+			if _, ok := a.certs[token.ClientCert]; ok {
+				return nil, fmt.Errorf("gRPC auth token %q reuses "+
+					"the client certificate of another entry",
+					token.Name)
+			}
+			a.certs[token.ClientCert] = entry
+		}
+		if token.RateLimit > 0 {
+			burst := token.Burst
+			if burst == 0 {
+				burst = int(token.RateLimit)
+				if float64(burst) < token.RateLimit {
+					burst++
+				}
+			}
+			entry.limiter = newRateLimiter(token.RateLimit, burst)
+		}
+		a.entries = append(a.entries, entry)
+	}
+	return a, nil
+}
+
+// methodName returns the method segment from the full gRPC method name
+// `/package.service/method`.
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// identify returns the configured entry the request of the passed context
+// authenticates as, or nil when it carries no credential.
+func (a *Authenticator) identify(ctx context.Context) (*authEntry, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(md.Get(AuthenticationTokenKey)) != 0 {
+		// Check every entry in constant time so the comparisons do not
+		// leak which tokens exist.
+		tokenHash := sha256.Sum256([]byte(md.Get(AuthenticationTokenKey)[0]))
+		var match *authEntry
+		for _, entry := range a.entries {
+			if !entry.hasToken {
+				continue
+			}
+			cmp := subtle.ConstantTimeCompare(tokenHash[:], entry.tokenHash[:])
+			if cmp == 1 {
+				match = entry
+			}
+		}
+		if match == nil {
+			return nil, status.Error(codes.Unauthenticated,
+				"invalid authentication token")
+		}
+		return match, nil
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			for _, chain := range tlsInfo.State.VerifiedChains {
+				if len(chain) == 0 {
+					continue
+				}
+				entry, ok := a.certs[chain[0].Subject.CommonName]
+				if ok {
+					return entry, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// authorize checks that the request of the passed context may call the passed
+// method and returns the name of its credential.
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) (string, error) {
+	if a.open {
+		return "", nil
+	}
+
+	method := methodName(fullMethod)
+	entry, err := a.identify(ctx)
+	if err != nil {
+		return "", err
+	}
+	if entry == nil {
+		if a.public.contains(method) {
+			return "", nil
+		}
+		return "", status.Errorf(codes.Unauthenticated,
+			"method %s requires authentication", method)
+	}
+	if !entry.methods.contains(method) && !a.public.contains(method) {
+		return entry.name, status.Errorf(codes.PermissionDenied,
+			"method %s is not allowed for %s", method, entry.name)
+	}
+	if entry.limiter != nil && !entry.limiter.allow(time.Now()) {
+		return entry.name, status.Errorf(codes.ResourceExhausted,
+			"rate limit exceeded for %s", entry.name)
+	}
+	return entry.name, nil
+}
+
+// caller describes the origin of the request of the passed context for the
+// logs.
+func caller(ctx context.Context, name string) string {
+	addr := "unknown peer"
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	if name == "" {
+		return addr
+	}
+	return fmt.Sprintf("%s (%s)", addr, name)
+}
+
+// UnaryServerInterceptor returns the interceptor enforcing the access control
+// of unary methods.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		name, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			log.Warnf("Unary method %s invoked by %s rejected: %v",
+				info.FullMethod, caller(ctx, name), err)
+			return nil, err
+		}
+		log.Infof("Unary method %s invoked by %s", info.FullMethod,
+			caller(ctx, name))
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if err != nil {
+			log.Errorf("Unary method %s invoked by %s errored: %v",
+				info.FullMethod, caller(ctx, name), err)
+		}
+		log.Debugf("Unary method %s invoked by %s took %v",
+			info.FullMethod, caller(ctx, name), time.Since(start))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor enforcing the access control
+// of streaming methods.  A stream counts as a single request towards the rate
+// limit.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		ctx := ss.Context()
+		name, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			log.Warnf("Streaming method %s invoked by %s rejected: %v",
+				info.FullMethod, caller(ctx, name), err)
+			return err
+		}
+		log.Infof("Streaming method %s invoked by %s", info.FullMethod,
+			caller(ctx, name))
+
+		start := time.Now()
+		err = handler(srv, ss)
+		if err != nil {
+			log.Errorf("Streaming method %s invoked by %s errored: %v",
+				info.FullMethod, caller(ctx, name), err)
+		}
+		log.Debugf("Streaming method %s invoked by %s closed after %v",
+			info.FullMethod, caller(ctx, name), time.Since(start))
+		return err
+	}
+}
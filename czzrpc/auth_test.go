@@ -0,0 +1,143 @@
+package czzrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestAuthenticator ensures requests are authorized according to the public
+// methods and the methods allowed for their token.
+func TestAuthenticator(t *testing.T) {
+	a, err := NewAuthenticator(&AuthConfig{
+		PublicMethods: []string{"GetBlockchainInfo"},
+		Tokens: []*AuthToken{
+			{Name: "wallet", Token: "wallettoken", Methods: []string{"SubmitTransaction"}},
+			{Name: "admin", Token: "admintoken"},
+			{Name: "limited", Token: "limitedtoken", RateLimit: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+
+	withToken := func(token string) context.Context {
+		ctx := context.Background()
+		if token == "" {
+			return ctx
+		}
+		md := metadata.Pairs(AuthenticationTokenKey, token)
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		code   codes.Code
+		caller string
+	}{
+		{"public without token", "", "GetBlockchainInfo", codes.OK, ""},
+		{"private without token", "", "SubmitTransaction", codes.Unauthenticated, ""},
+		{"unknown token", "bogus", "GetBlockchainInfo", codes.Unauthenticated, ""},
+		{"allowed method", "wallettoken", "SubmitTransaction", codes.OK, "wallet"},
+		{"public method with token", "wallettoken", "GetBlockchainInfo", codes.OK, "wallet"},
+		{"denied method", "wallettoken", "GetBlock", codes.PermissionDenied, "wallet"},
+		{"all methods", "admintoken", "GetBlock", codes.OK, "admin"},
+		{"within rate limit", "limitedtoken", "GetBlock", codes.OK, "limited"},
+		{"over rate limit", "limitedtoken", "GetBlock", codes.ResourceExhausted, "limited"},
+	}
+	for _, test := range tests {
+		name, err := a.authorize(withToken(test.token), "/pb.czzrpc/"+test.method)
+		if code := status.Code(err); code != test.code {
+			t.Errorf("%s: got code %v, want %v (%v)", test.name, code,
+				test.code, err)
+		}
+		if name != test.caller {
+			t.Errorf("%s: got caller %q, want %q", test.name, name,
+				test.caller)
+		}
+	}
+
+	// Only the public methods are allowed by a configuration without
+	// tokens.
+	public, err := NewAuthenticator(&AuthConfig{
+		PublicMethods: []string{"GetBlockchainInfo"},
+	})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	if _, err := public.authorize(withToken(""), "/pb.czzrpc/GetBlockchainInfo"); err != nil {
+		t.Errorf("public method rejected: %v", err)
+	}
+	_, err = public.authorize(withToken(""), "/pb.czzrpc/SubmitTransaction")
+	if code := status.Code(err); code != codes.Unauthenticated {
+		t.Errorf("private method without tokens: got code %v, want %v",
+			code, codes.Unauthenticated)
+	}
+
+	// Everything is allowed without a configuration.
+	open, err := NewAuthenticator(nil)
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	if _, err := open.authorize(withToken("bogus"), "/pb.czzrpc/SubmitTransaction"); err != nil {
+		t.Errorf("open authenticator rejected request: %v", err)
+	}
+}
+
+// TestAuthenticatorConfig ensures invalid access control configurations are
+// rejected.
+func TestAuthenticatorConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens []*AuthToken
+	}{
+		{"missing name", []*AuthToken{{Token: "a"}}},
+		{"duplicate name", []*AuthToken{{Name: "a", Token: "a"}, {Name: "a", Token: "b"}}},
+		{"duplicate token", []*AuthToken{{Name: "a", Token: "a"}, {Name: "b", Token: "a"}}},
+		{"no credential", []*AuthToken{{Name: "a"}}},
+		{"duplicate client cert", []*AuthToken{{Name: "a", ClientCert: "c"}, {Name: "b", ClientCert: "c"}}},
+		{"negative rate", []*AuthToken{{Name: "a", Token: "a", RateLimit: -1}}},
+	}
+	for _, test := range tests {
+		if _, err := NewAuthenticator(&AuthConfig{Tokens: test.tokens}); err == nil {
+			t.Errorf("%s: configuration was accepted", test.name)
+		}
+	}
+}
+
+// TestRateLimiter ensures the rate limiter allows bursts and refills at the
+// configured rate.
+func TestRateLimiter(t *testing.T) {
+	l := newRateLimiter(2, 3)
+	now := time.Unix(1000, 0)
+	for i := 0; i < 3; i++ {
+		if !l.allow(now) {
+			t.Fatalf("request #%d of the burst was rejected", i)
+		}
+	}
+	if l.allow(now) {
+		t.Fatal("request beyond the burst was allowed")
+	}
+	now = now.Add(500 * time.Millisecond)
+	if !l.allow(now) {
+		t.Fatal("request after refill was rejected")
+	}
+	if l.allow(now) {
+		t.Fatal("request beyond the refill was allowed")
+	}
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if !l.allow(now) {
+			t.Fatalf("request #%d after a long pause was rejected", i)
+		}
+	}
+	if l.allow(now) {
+		t.Fatal("bucket filled beyond the burst")
+	}
+}
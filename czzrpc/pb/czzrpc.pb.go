@@ -23,6 +23,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type GetMempoolEntryResponse_TransactionType int32
+
+const (
+	GetMempoolEntryResponse_STANDARD GetMempoolEntryResponse_TransactionType = 0
+	GetMempoolEntryResponse_CONVERT  GetMempoolEntryResponse_TransactionType = 1
+	GetMempoolEntryResponse_CASTING  GetMempoolEntryResponse_TransactionType = 2
+	GetMempoolEntryResponse_MORTGAGE GetMempoolEntryResponse_TransactionType = 3
+)
+
+var GetMempoolEntryResponse_TransactionType_name = map[int32]string{
+	0: "STANDARD",
+	1: "CONVERT",
+	2: "CASTING",
+	3: "MORTGAGE",
+}
+var GetMempoolEntryResponse_TransactionType_value = map[string]int32{
+	"STANDARD": 0,
+	"CONVERT":  1,
+	"CASTING":  2,
+	"MORTGAGE": 3,
+}
+
+func (x GetMempoolEntryResponse_TransactionType) String() string {
+	return proto.EnumName(GetMempoolEntryResponse_TransactionType_name, int32(x))
+}
+func (GetMempoolEntryResponse_TransactionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{3, 0}
+}
+
 type GetBlockchainInfoResponse_BitcoinNet int32
 
 const (
@@ -49,7 +78,7 @@ func (x GetBlockchainInfoResponse_BitcoinNet) String() string {
 	return proto.EnumName(GetBlockchainInfoResponse_BitcoinNet_name, int32(x))
 }
 func (GetBlockchainInfoResponse_BitcoinNet) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{3, 0}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{5, 0}
 }
 
 type BlockNotification_Type int32
@@ -72,7 +101,39 @@ func (x BlockNotification_Type) String() string {
 	return proto.EnumName(BlockNotification_Type_name, int32(x))
 }
 func (BlockNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{30, 0}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{45, 0}
+}
+
+type CommitteeEventNotification_Type int32
+
+const (
+	CommitteeEventNotification_CONVERT_ITEM_CREATED   CommitteeEventNotification_Type = 0
+	CommitteeEventNotification_CONVERT_ITEM_CONFIRMED CommitteeEventNotification_Type = 1
+	CommitteeEventNotification_CASTING_CREATED        CommitteeEventNotification_Type = 2
+	CommitteeEventNotification_PLEDGE_CHANGED         CommitteeEventNotification_Type = 3
+	CommitteeEventNotification_POOL_BALANCE_CHANGED   CommitteeEventNotification_Type = 4
+)
+
+var CommitteeEventNotification_Type_name = map[int32]string{
+	0: "CONVERT_ITEM_CREATED",
+	1: "CONVERT_ITEM_CONFIRMED",
+	2: "CASTING_CREATED",
+	3: "PLEDGE_CHANGED",
+	4: "POOL_BALANCE_CHANGED",
+}
+var CommitteeEventNotification_Type_value = map[string]int32{
+	"CONVERT_ITEM_CREATED":   0,
+	"CONVERT_ITEM_CONFIRMED": 1,
+	"CASTING_CREATED":        2,
+	"PLEDGE_CHANGED":         3,
+	"POOL_BALANCE_CHANGED":   4,
+}
+
+func (x CommitteeEventNotification_Type) String() string {
+	return proto.EnumName(CommitteeEventNotification_Type_name, int32(x))
+}
+func (CommitteeEventNotification_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{46, 0}
 }
 
 type TransactionNotification_Type int32
@@ -95,7 +156,7 @@ func (x TransactionNotification_Type) String() string {
 	return proto.EnumName(TransactionNotification_Type_name, int32(x))
 }
 func (TransactionNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{31, 0}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{47, 0}
 }
 
 type GetMempoolInfoRequest struct {
@@ -108,7 +169,7 @@ func (m *GetMempoolInfoRequest) Reset()         { *m = GetMempoolInfoRequest{} }
 func (m *GetMempoolInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMempoolInfoRequest) ProtoMessage()    {}
 func (*GetMempoolInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{0}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{0}
 }
 func (m *GetMempoolInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMempoolInfoRequest.Unmarshal(m, b)
@@ -129,8 +190,8 @@ func (m *GetMempoolInfoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetMempoolInfoRequest proto.InternalMessageInfo
 
 type GetMempoolInfoResponse struct {
-	Size                 uint32   `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
-	Bytes                uint32   `protobuf:"varint,2,opt,name=bytes" json:"bytes,omitempty"`
+	Size                 uint32   `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Bytes                uint32   `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -140,7 +201,7 @@ func (m *GetMempoolInfoResponse) Reset()         { *m = GetMempoolInfoResponse{}
 func (m *GetMempoolInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMempoolInfoResponse) ProtoMessage()    {}
 func (*GetMempoolInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{1}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{1}
 }
 func (m *GetMempoolInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMempoolInfoResponse.Unmarshal(m, b)
@@ -174,6 +235,170 @@ func (m *GetMempoolInfoResponse) GetBytes() uint32 {
 	return 0
 }
 
+type GetMempoolEntryRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMempoolEntryRequest) Reset()         { *m = GetMempoolEntryRequest{} }
+func (m *GetMempoolEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolEntryRequest) ProtoMessage()    {}
+func (*GetMempoolEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{2}
+}
+func (m *GetMempoolEntryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMempoolEntryRequest.Unmarshal(m, b)
+}
+func (m *GetMempoolEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMempoolEntryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMempoolEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolEntryRequest.Merge(dst, src)
+}
+func (m *GetMempoolEntryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMempoolEntryRequest.Size(m)
+}
+func (m *GetMempoolEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMempoolEntryRequest proto.InternalMessageInfo
+
+func (m *GetMempoolEntryRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+type GetMempoolEntryResponse struct {
+	Transaction *MempoolTransaction                     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Type        GetMempoolEntryResponse_TransactionType `protobuf:"varint,2,opt,name=type,proto3,enum=pb.GetMempoolEntryResponse_TransactionType" json:"type,omitempty"`
+	// The serialized size of the transaction in bytes.
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The hashes of the transactions in the mempool this transaction
+	// spends outputs of.
+	Depends [][]byte `protobuf:"bytes,4,rep,name=depends,proto3" json:"depends,omitempty"`
+	// The hashes of the transactions in the mempool spending outputs of
+	// this transaction.
+	SpentBy [][]byte `protobuf:"bytes,5,rep,name=spent_by,json=spentBy,proto3" json:"spent_by,omitempty"`
+	// The number, the total size in bytes and the total fees in satoshi
+	// of the in-mempool ancestors and descendants. They include the
+	// transaction itself.
+	AncestorCount        uint32   `protobuf:"varint,6,opt,name=ancestor_count,json=ancestorCount,proto3" json:"ancestor_count,omitempty"`
+	AncestorSize         uint64   `protobuf:"varint,7,opt,name=ancestor_size,json=ancestorSize,proto3" json:"ancestor_size,omitempty"`
+	AncestorFees         int64    `protobuf:"varint,8,opt,name=ancestor_fees,json=ancestorFees,proto3" json:"ancestor_fees,omitempty"`
+	DescendantCount      uint32   `protobuf:"varint,9,opt,name=descendant_count,json=descendantCount,proto3" json:"descendant_count,omitempty"`
+	DescendantSize       uint64   `protobuf:"varint,10,opt,name=descendant_size,json=descendantSize,proto3" json:"descendant_size,omitempty"`
+	DescendantFees       int64    `protobuf:"varint,11,opt,name=descendant_fees,json=descendantFees,proto3" json:"descendant_fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMempoolEntryResponse) Reset()         { *m = GetMempoolEntryResponse{} }
+func (m *GetMempoolEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolEntryResponse) ProtoMessage()    {}
+func (*GetMempoolEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{3}
+}
+func (m *GetMempoolEntryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMempoolEntryResponse.Unmarshal(m, b)
+}
+func (m *GetMempoolEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMempoolEntryResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMempoolEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolEntryResponse.Merge(dst, src)
+}
+func (m *GetMempoolEntryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMempoolEntryResponse.Size(m)
+}
+func (m *GetMempoolEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMempoolEntryResponse proto.InternalMessageInfo
+
+func (m *GetMempoolEntryResponse) GetTransaction() *MempoolTransaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *GetMempoolEntryResponse) GetType() GetMempoolEntryResponse_TransactionType {
+	if m != nil {
+		return m.Type
+	}
+	return GetMempoolEntryResponse_STANDARD
+}
+
+func (m *GetMempoolEntryResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetDepends() [][]byte {
+	if m != nil {
+		return m.Depends
+	}
+	return nil
+}
+
+func (m *GetMempoolEntryResponse) GetSpentBy() [][]byte {
+	if m != nil {
+		return m.SpentBy
+	}
+	return nil
+}
+
+func (m *GetMempoolEntryResponse) GetAncestorCount() uint32 {
+	if m != nil {
+		return m.AncestorCount
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetAncestorSize() uint64 {
+	if m != nil {
+		return m.AncestorSize
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetAncestorFees() int64 {
+	if m != nil {
+		return m.AncestorFees
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetDescendantCount() uint32 {
+	if m != nil {
+		return m.DescendantCount
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetDescendantSize() uint64 {
+	if m != nil {
+		return m.DescendantSize
+	}
+	return 0
+}
+
+func (m *GetMempoolEntryResponse) GetDescendantFees() int64 {
+	if m != nil {
+		return m.DescendantFees
+	}
+	return 0
+}
+
 type GetBlockchainInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -184,7 +409,7 @@ func (m *GetBlockchainInfoRequest) Reset()         { *m = GetBlockchainInfoReque
 func (m *GetBlockchainInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockchainInfoRequest) ProtoMessage()    {}
 func (*GetBlockchainInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{2}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{4}
 }
 func (m *GetBlockchainInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockchainInfoRequest.Unmarshal(m, b)
@@ -205,13 +430,13 @@ func (m *GetBlockchainInfoRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetBlockchainInfoRequest proto.InternalMessageInfo
 
 type GetBlockchainInfoResponse struct {
-	BitcoinNet           GetBlockchainInfoResponse_BitcoinNet `protobuf:"varint,1,opt,name=bitcoin_net,json=bitcoinNet,enum=pb.GetBlockchainInfoResponse_BitcoinNet" json:"bitcoin_net,omitempty"`
-	BestHeight           int32                                `protobuf:"varint,2,opt,name=best_height,json=bestHeight" json:"best_height,omitempty"`
+	BitcoinNet           GetBlockchainInfoResponse_BitcoinNet `protobuf:"varint,1,opt,name=bitcoin_net,json=bitcoinNet,proto3,enum=pb.GetBlockchainInfoResponse_BitcoinNet" json:"bitcoin_net,omitempty"`
+	BestHeight           int32                                `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
 	BestBlockHash        []byte                               `protobuf:"bytes,3,opt,name=best_block_hash,json=bestBlockHash,proto3" json:"best_block_hash,omitempty"`
-	Difficulty           float64                              `protobuf:"fixed64,4,opt,name=difficulty" json:"difficulty,omitempty"`
-	MedianTime           int64                                `protobuf:"varint,5,opt,name=median_time,json=medianTime" json:"median_time,omitempty"`
-	TxIndex              bool                                 `protobuf:"varint,6,opt,name=tx_index,json=txIndex" json:"tx_index,omitempty"`
-	AddrIndex            bool                                 `protobuf:"varint,7,opt,name=addr_index,json=addrIndex" json:"addr_index,omitempty"`
+	Difficulty           float64                              `protobuf:"fixed64,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	MedianTime           int64                                `protobuf:"varint,5,opt,name=median_time,json=medianTime,proto3" json:"median_time,omitempty"`
+	TxIndex              bool                                 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	AddrIndex            bool                                 `protobuf:"varint,7,opt,name=addr_index,json=addrIndex,proto3" json:"addr_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
 	XXX_unrecognized     []byte                               `json:"-"`
 	XXX_sizecache        int32                                `json:"-"`
@@ -221,7 +446,7 @@ func (m *GetBlockchainInfoResponse) Reset()         { *m = GetBlockchainInfoResp
 func (m *GetBlockchainInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockchainInfoResponse) ProtoMessage()    {}
 func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{3}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{5}
 }
 func (m *GetBlockchainInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockchainInfoResponse.Unmarshal(m, b)
@@ -304,7 +529,7 @@ func (m *GetBlockInfoRequest) Reset()         { *m = GetBlockInfoRequest{} }
 func (m *GetBlockInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockInfoRequest) ProtoMessage()    {}
 func (*GetBlockInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{4}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{6}
 }
 func (m *GetBlockInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockInfoRequest.Unmarshal(m, b)
@@ -331,11 +556,13 @@ type isGetBlockInfoRequest_HashOrHeight interface {
 type GetBlockInfoRequest_Hash struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
+
 type GetBlockInfoRequest_Height struct {
-	Height int32 `protobuf:"varint,2,opt,name=height,oneof"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (*GetBlockInfoRequest_Hash) isGetBlockInfoRequest_HashOrHeight()   {}
+func (*GetBlockInfoRequest_Hash) isGetBlockInfoRequest_HashOrHeight() {}
+
 func (*GetBlockInfoRequest_Height) isGetBlockInfoRequest_HashOrHeight() {}
 
 func (m *GetBlockInfoRequest) GetHashOrHeight() isGetBlockInfoRequest_HashOrHeight {
@@ -425,7 +652,7 @@ func _GetBlockInfoRequest_OneofSizer(msg proto.Message) (n int) {
 }
 
 type GetBlockInfoResponse struct {
-	Info                 *BlockInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Info                 *BlockInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -435,7 +662,7 @@ func (m *GetBlockInfoResponse) Reset()         { *m = GetBlockInfoResponse{} }
 func (m *GetBlockInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockInfoResponse) ProtoMessage()    {}
 func (*GetBlockInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{5}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{7}
 }
 func (m *GetBlockInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockInfoResponse.Unmarshal(m, b)
@@ -468,7 +695,7 @@ type GetBlockRequest struct {
 	//	*GetBlockRequest_Height
 	HashOrHeight isGetBlockRequest_HashOrHeight `protobuf_oneof:"hash_or_height"`
 	// Provide full transaction info instead of only the hashes.
-	FullTransactions     bool     `protobuf:"varint,3,opt,name=full_transactions,json=fullTransactions" json:"full_transactions,omitempty"`
+	FullTransactions     bool     `protobuf:"varint,3,opt,name=full_transactions,json=fullTransactions,proto3" json:"full_transactions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -478,7 +705,7 @@ func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
 func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockRequest) ProtoMessage()    {}
 func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{6}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{8}
 }
 func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockRequest.Unmarshal(m, b)
@@ -505,11 +732,13 @@ type isGetBlockRequest_HashOrHeight interface {
 type GetBlockRequest_Hash struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
+
 type GetBlockRequest_Height struct {
-	Height int32 `protobuf:"varint,2,opt,name=height,oneof"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (*GetBlockRequest_Hash) isGetBlockRequest_HashOrHeight()   {}
+func (*GetBlockRequest_Hash) isGetBlockRequest_HashOrHeight() {}
+
 func (*GetBlockRequest_Height) isGetBlockRequest_HashOrHeight() {}
 
 func (m *GetBlockRequest) GetHashOrHeight() isGetBlockRequest_HashOrHeight {
@@ -606,7 +835,7 @@ func _GetBlockRequest_OneofSizer(msg proto.Message) (n int) {
 }
 
 type GetBlockResponse struct {
-	Block                *Block   `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -616,7 +845,7 @@ func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
 func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockResponse) ProtoMessage()    {}
 func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{7}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{9}
 }
 func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockResponse.Unmarshal(m, b)
@@ -657,7 +886,7 @@ func (m *GetRawBlockRequest) Reset()         { *m = GetRawBlockRequest{} }
 func (m *GetRawBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRawBlockRequest) ProtoMessage()    {}
 func (*GetRawBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{8}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{10}
 }
 func (m *GetRawBlockRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRawBlockRequest.Unmarshal(m, b)
@@ -684,11 +913,13 @@ type isGetRawBlockRequest_HashOrHeight interface {
 type GetRawBlockRequest_Hash struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
+
 type GetRawBlockRequest_Height struct {
-	Height int32 `protobuf:"varint,2,opt,name=height,oneof"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (*GetRawBlockRequest_Hash) isGetRawBlockRequest_HashOrHeight()   {}
+func (*GetRawBlockRequest_Hash) isGetRawBlockRequest_HashOrHeight() {}
+
 func (*GetRawBlockRequest_Height) isGetRawBlockRequest_HashOrHeight() {}
 
 func (m *GetRawBlockRequest) GetHashOrHeight() isGetRawBlockRequest_HashOrHeight {
@@ -788,7 +1019,7 @@ func (m *GetRawBlockResponse) Reset()         { *m = GetRawBlockResponse{} }
 func (m *GetRawBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRawBlockResponse) ProtoMessage()    {}
 func (*GetRawBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{9}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{11}
 }
 func (m *GetRawBlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRawBlockResponse.Unmarshal(m, b)
@@ -829,7 +1060,7 @@ func (m *GetBlockFilterRequest) Reset()         { *m = GetBlockFilterRequest{} }
 func (m *GetBlockFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockFilterRequest) ProtoMessage()    {}
 func (*GetBlockFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{10}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{12}
 }
 func (m *GetBlockFilterRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockFilterRequest.Unmarshal(m, b)
@@ -856,11 +1087,13 @@ type isGetBlockFilterRequest_HashOrHeight interface {
 type GetBlockFilterRequest_Hash struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3,oneof"`
 }
+
 type GetBlockFilterRequest_Height struct {
-	Height int32 `protobuf:"varint,2,opt,name=height,oneof"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3,oneof"`
 }
 
-func (*GetBlockFilterRequest_Hash) isGetBlockFilterRequest_HashOrHeight()   {}
+func (*GetBlockFilterRequest_Hash) isGetBlockFilterRequest_HashOrHeight() {}
+
 func (*GetBlockFilterRequest_Height) isGetBlockFilterRequest_HashOrHeight() {}
 
 func (m *GetBlockFilterRequest) GetHashOrHeight() isGetBlockFilterRequest_HashOrHeight {
@@ -960,7 +1193,7 @@ func (m *GetBlockFilterResponse) Reset()         { *m = GetBlockFilterResponse{}
 func (m *GetBlockFilterResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockFilterResponse) ProtoMessage()    {}
 func (*GetBlockFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{11}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{13}
 }
 func (m *GetBlockFilterResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockFilterResponse.Unmarshal(m, b)
@@ -999,7 +1232,7 @@ func (m *GetHeadersRequest) Reset()         { *m = GetHeadersRequest{} }
 func (m *GetHeadersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHeadersRequest) ProtoMessage()    {}
 func (*GetHeadersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{12}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{14}
 }
 func (m *GetHeadersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHeadersRequest.Unmarshal(m, b)
@@ -1034,7 +1267,7 @@ func (m *GetHeadersRequest) GetStopHash() []byte {
 }
 
 type GetHeadersResponse struct {
-	Headers              []*BlockInfo `protobuf:"bytes,1,rep,name=headers" json:"headers,omitempty"`
+	Headers              []*BlockInfo `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1044,7 +1277,7 @@ func (m *GetHeadersResponse) Reset()         { *m = GetHeadersResponse{} }
 func (m *GetHeadersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHeadersResponse) ProtoMessage()    {}
 func (*GetHeadersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{13}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{15}
 }
 func (m *GetHeadersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHeadersResponse.Unmarshal(m, b)
@@ -1082,7 +1315,7 @@ func (m *GetTransactionRequest) Reset()         { *m = GetTransactionRequest{} }
 func (m *GetTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionRequest) ProtoMessage()    {}
 func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{14}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{16}
 }
 func (m *GetTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionRequest.Unmarshal(m, b)
@@ -1110,7 +1343,7 @@ func (m *GetTransactionRequest) GetHash() []byte {
 }
 
 type GetTransactionResponse struct {
-	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	Transaction          *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1120,7 +1353,7 @@ func (m *GetTransactionResponse) Reset()         { *m = GetTransactionResponse{}
 func (m *GetTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionResponse) ProtoMessage()    {}
 func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{15}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{17}
 }
 func (m *GetTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionResponse.Unmarshal(m, b)
@@ -1158,7 +1391,7 @@ func (m *GetRawTransactionRequest) Reset()         { *m = GetRawTransactionReque
 func (m *GetRawTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRawTransactionRequest) ProtoMessage()    {}
 func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{16}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{18}
 }
 func (m *GetRawTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRawTransactionRequest.Unmarshal(m, b)
@@ -1196,7 +1429,7 @@ func (m *GetRawTransactionResponse) Reset()         { *m = GetRawTransactionResp
 func (m *GetRawTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRawTransactionResponse) ProtoMessage()    {}
 func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{17}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{19}
 }
 func (m *GetRawTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRawTransactionResponse.Unmarshal(m, b)
@@ -1224,12 +1457,12 @@ func (m *GetRawTransactionResponse) GetTransaction() []byte {
 }
 
 type GetAddressTransactionsRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// Control the number of transactions to be fetched from the blockchain.
 	// These controls only apply to the confirmed transactions. All unconfirmed
 	// ones will be returned always.
-	NbSkip  uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip" json:"nb_skip,omitempty"`
-	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch" json:"nb_fetch,omitempty"`
+	NbSkip  uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
+	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
 	// If the start block is provided it will only return transactions after this
 	// block. This should be used if possible to save bandwidth.
 	//
@@ -1246,7 +1479,7 @@ func (m *GetAddressTransactionsRequest) Reset()         { *m = GetAddressTransac
 func (m *GetAddressTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAddressTransactionsRequest) ProtoMessage()    {}
 func (*GetAddressTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{18}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{20}
 }
 func (m *GetAddressTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAddressTransactionsRequest.Unmarshal(m, b)
@@ -1266,27 +1499,6 @@ func (m *GetAddressTransactionsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetAddressTransactionsRequest proto.InternalMessageInfo
 
-type isGetAddressTransactionsRequest_StartBlock interface {
-	isGetAddressTransactionsRequest_StartBlock()
-}
-
-type GetAddressTransactionsRequest_Hash struct {
-	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
-}
-type GetAddressTransactionsRequest_Height struct {
-	Height int32 `protobuf:"varint,5,opt,name=height,oneof"`
-}
-
-func (*GetAddressTransactionsRequest_Hash) isGetAddressTransactionsRequest_StartBlock()   {}
-func (*GetAddressTransactionsRequest_Height) isGetAddressTransactionsRequest_StartBlock() {}
-
-func (m *GetAddressTransactionsRequest) GetStartBlock() isGetAddressTransactionsRequest_StartBlock {
-	if m != nil {
-		return m.StartBlock
-	}
-	return nil
-}
-
 func (m *GetAddressTransactionsRequest) GetAddress() string {
 	if m != nil {
 		return m.Address
@@ -1308,6 +1520,29 @@ func (m *GetAddressTransactionsRequest) GetNbFetch() uint32 {
 	return 0
 }
 
+type isGetAddressTransactionsRequest_StartBlock interface {
+	isGetAddressTransactionsRequest_StartBlock()
+}
+
+type GetAddressTransactionsRequest_Hash struct {
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
+}
+
+type GetAddressTransactionsRequest_Height struct {
+	Height int32 `protobuf:"varint,5,opt,name=height,proto3,oneof"`
+}
+
+func (*GetAddressTransactionsRequest_Hash) isGetAddressTransactionsRequest_StartBlock() {}
+
+func (*GetAddressTransactionsRequest_Height) isGetAddressTransactionsRequest_StartBlock() {}
+
+func (m *GetAddressTransactionsRequest) GetStartBlock() isGetAddressTransactionsRequest_StartBlock {
+	if m != nil {
+		return m.StartBlock
+	}
+	return nil
+}
+
 func (m *GetAddressTransactionsRequest) GetHash() []byte {
 	if x, ok := m.GetStartBlock().(*GetAddressTransactionsRequest_Hash); ok {
 		return x.Hash
@@ -1388,8 +1623,8 @@ func _GetAddressTransactionsRequest_OneofSizer(msg proto.Message) (n int) {
 }
 
 type GetAddressTransactionsResponse struct {
-	ConfirmedTransactions   []*Transaction        `protobuf:"bytes,1,rep,name=confirmed_transactions,json=confirmedTransactions" json:"confirmed_transactions,omitempty"`
-	UnconfirmedTransactions []*MempoolTransaction `protobuf:"bytes,2,rep,name=unconfirmed_transactions,json=unconfirmedTransactions" json:"unconfirmed_transactions,omitempty"`
+	ConfirmedTransactions   []*Transaction        `protobuf:"bytes,1,rep,name=confirmed_transactions,json=confirmedTransactions,proto3" json:"confirmed_transactions,omitempty"`
+	UnconfirmedTransactions []*MempoolTransaction `protobuf:"bytes,2,rep,name=unconfirmed_transactions,json=unconfirmedTransactions,proto3" json:"unconfirmed_transactions,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}              `json:"-"`
 	XXX_unrecognized        []byte                `json:"-"`
 	XXX_sizecache           int32                 `json:"-"`
@@ -1399,7 +1634,7 @@ func (m *GetAddressTransactionsResponse) Reset()         { *m = GetAddressTransa
 func (m *GetAddressTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAddressTransactionsResponse) ProtoMessage()    {}
 func (*GetAddressTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{19}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{21}
 }
 func (m *GetAddressTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAddressTransactionsResponse.Unmarshal(m, b)
@@ -1434,12 +1669,12 @@ func (m *GetAddressTransactionsResponse) GetUnconfirmedTransactions() []*Mempool
 }
 
 type GetRawAddressTransactionsRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// Control the number of transactions to be fetched from the blockchain.
 	// These controls only apply to the confirmed transactions. All unconfirmed
 	// ones will be returned always.
-	NbSkip  uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip" json:"nb_skip,omitempty"`
-	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch" json:"nb_fetch,omitempty"`
+	NbSkip  uint32 `protobuf:"varint,2,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
+	NbFetch uint32 `protobuf:"varint,3,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
 	// If the start block is provided it will only return transactions after this
 	// block. This should be used if possible to save bandwidth.
 	//
@@ -1456,7 +1691,7 @@ func (m *GetRawAddressTransactionsRequest) Reset()         { *m = GetRawAddressT
 func (m *GetRawAddressTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRawAddressTransactionsRequest) ProtoMessage()    {}
 func (*GetRawAddressTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{20}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{22}
 }
 func (m *GetRawAddressTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRawAddressTransactionsRequest.Unmarshal(m, b)
@@ -1476,27 +1711,6 @@ func (m *GetRawAddressTransactionsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetRawAddressTransactionsRequest proto.InternalMessageInfo
 
-type isGetRawAddressTransactionsRequest_StartBlock interface {
-	isGetRawAddressTransactionsRequest_StartBlock()
-}
-
-type GetRawAddressTransactionsRequest_Hash struct {
-	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
-}
-type GetRawAddressTransactionsRequest_Height struct {
-	Height int32 `protobuf:"varint,5,opt,name=height,oneof"`
-}
-
-func (*GetRawAddressTransactionsRequest_Hash) isGetRawAddressTransactionsRequest_StartBlock()   {}
-func (*GetRawAddressTransactionsRequest_Height) isGetRawAddressTransactionsRequest_StartBlock() {}
-
-func (m *GetRawAddressTransactionsRequest) GetStartBlock() isGetRawAddressTransactionsRequest_StartBlock {
-	if m != nil {
-		return m.StartBlock
-	}
-	return nil
-}
-
 func (m *GetRawAddressTransactionsRequest) GetAddress() string {
 	if m != nil {
 		return m.Address
@@ -1518,6 +1732,29 @@ func (m *GetRawAddressTransactionsRequest) GetNbFetch() uint32 {
 	return 0
 }
 
+type isGetRawAddressTransactionsRequest_StartBlock interface {
+	isGetRawAddressTransactionsRequest_StartBlock()
+}
+
+type GetRawAddressTransactionsRequest_Hash struct {
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3,oneof"`
+}
+
+type GetRawAddressTransactionsRequest_Height struct {
+	Height int32 `protobuf:"varint,5,opt,name=height,proto3,oneof"`
+}
+
+func (*GetRawAddressTransactionsRequest_Hash) isGetRawAddressTransactionsRequest_StartBlock() {}
+
+func (*GetRawAddressTransactionsRequest_Height) isGetRawAddressTransactionsRequest_StartBlock() {}
+
+func (m *GetRawAddressTransactionsRequest) GetStartBlock() isGetRawAddressTransactionsRequest_StartBlock {
+	if m != nil {
+		return m.StartBlock
+	}
+	return nil
+}
+
 func (m *GetRawAddressTransactionsRequest) GetHash() []byte {
 	if x, ok := m.GetStartBlock().(*GetRawAddressTransactionsRequest_Hash); ok {
 		return x.Hash
@@ -1609,7 +1846,7 @@ func (m *GetRawAddressTransactionsResponse) Reset()         { *m = GetRawAddress
 func (m *GetRawAddressTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRawAddressTransactionsResponse) ProtoMessage()    {}
 func (*GetRawAddressTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{21}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{23}
 }
 func (m *GetRawAddressTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRawAddressTransactionsResponse.Unmarshal(m, b)
@@ -1644,7 +1881,7 @@ func (m *GetRawAddressTransactionsResponse) GetUnconfirmedTransactions() [][]byt
 }
 
 type GetAddressUnspentOutputsRequest struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1654,7 +1891,7 @@ func (m *GetAddressUnspentOutputsRequest) Reset()         { *m = GetAddressUnspe
 func (m *GetAddressUnspentOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAddressUnspentOutputsRequest) ProtoMessage()    {}
 func (*GetAddressUnspentOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{22}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{24}
 }
 func (m *GetAddressUnspentOutputsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAddressUnspentOutputsRequest.Unmarshal(m, b)
@@ -1682,7 +1919,7 @@ func (m *GetAddressUnspentOutputsRequest) GetAddress() string {
 }
 
 type GetAddressUnspentOutputsResponse struct {
-	Outputs              []*UnspentOutput `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
+	Outputs              []*UnspentOutput `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1692,7 +1929,7 @@ func (m *GetAddressUnspentOutputsResponse) Reset()         { *m = GetAddressUnsp
 func (m *GetAddressUnspentOutputsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAddressUnspentOutputsResponse) ProtoMessage()    {}
 func (*GetAddressUnspentOutputsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{23}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{25}
 }
 func (m *GetAddressUnspentOutputsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAddressUnspentOutputsResponse.Unmarshal(m, b)
@@ -1730,7 +1967,7 @@ func (m *GetMerkleProofRequest) Reset()         { *m = GetMerkleProofRequest{} }
 func (m *GetMerkleProofRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMerkleProofRequest) ProtoMessage()    {}
 func (*GetMerkleProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{24}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{26}
 }
 func (m *GetMerkleProofRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerkleProofRequest.Unmarshal(m, b)
@@ -1758,7 +1995,7 @@ func (m *GetMerkleProofRequest) GetTransactionHash() []byte {
 }
 
 type GetMerkleProofResponse struct {
-	Block                *BlockInfo `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	Block                *BlockInfo `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	Hashes               [][]byte   `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
 	Flags                []byte     `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1770,7 +2007,7 @@ func (m *GetMerkleProofResponse) Reset()         { *m = GetMerkleProofResponse{}
 func (m *GetMerkleProofResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMerkleProofResponse) ProtoMessage()    {}
 func (*GetMerkleProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{25}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{27}
 }
 func (m *GetMerkleProofResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerkleProofResponse.Unmarshal(m, b)
@@ -1822,7 +2059,7 @@ func (m *SubmitTransactionRequest) Reset()         { *m = SubmitTransactionReque
 func (m *SubmitTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*SubmitTransactionRequest) ProtoMessage()    {}
 func (*SubmitTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{26}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{28}
 }
 func (m *SubmitTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubmitTransactionRequest.Unmarshal(m, b)
@@ -1860,7 +2097,7 @@ func (m *SubmitTransactionResponse) Reset()         { *m = SubmitTransactionResp
 func (m *SubmitTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*SubmitTransactionResponse) ProtoMessage()    {}
 func (*SubmitTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{27}
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{29}
 }
 func (m *SubmitTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubmitTransactionResponse.Unmarshal(m, b)
@@ -1887,292 +2124,205 @@ func (m *SubmitTransactionResponse) GetHash() []byte {
 	return nil
 }
 
-type SubscribeTransactionsRequest struct {
-	Subscribe   *TransactionFilter `protobuf:"bytes,1,opt,name=subscribe" json:"subscribe,omitempty"`
-	Unsubscribe *TransactionFilter `protobuf:"bytes,2,opt,name=unsubscribe" json:"unsubscribe,omitempty"`
-	// When this is true, new transactions coming in from the mempool are
-	// included apart from the ones confirmed in a block.
-	IncludeMempool bool `protobuf:"varint,3,opt,name=include_mempool,json=includeMempool" json:"include_mempool,omitempty"`
-	// When this is true, transactions are included when they are confirmed.
-	// This notification is sent in addition to any requested mempool notifications.
-	IncludeInBlock       bool     `protobuf:"varint,4,opt,name=include_in_block,json=includeInBlock" json:"include_in_block,omitempty"`
+type GetNetMsgStatsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeTransactionsRequest) Reset()         { *m = SubscribeTransactionsRequest{} }
-func (m *SubscribeTransactionsRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeTransactionsRequest) ProtoMessage()    {}
-func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{28}
+func (m *GetNetMsgStatsRequest) Reset()         { *m = GetNetMsgStatsRequest{} }
+func (m *GetNetMsgStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNetMsgStatsRequest) ProtoMessage()    {}
+func (*GetNetMsgStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{30}
 }
-func (m *SubscribeTransactionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeTransactionsRequest.Unmarshal(m, b)
+func (m *GetNetMsgStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetMsgStatsRequest.Unmarshal(m, b)
 }
-func (m *SubscribeTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeTransactionsRequest.Marshal(b, m, deterministic)
+func (m *GetNetMsgStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetMsgStatsRequest.Marshal(b, m, deterministic)
 }
-func (dst *SubscribeTransactionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeTransactionsRequest.Merge(dst, src)
+func (dst *GetNetMsgStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetMsgStatsRequest.Merge(dst, src)
 }
-func (m *SubscribeTransactionsRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeTransactionsRequest.Size(m)
+func (m *GetNetMsgStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNetMsgStatsRequest.Size(m)
 }
-func (m *SubscribeTransactionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeTransactionsRequest.DiscardUnknown(m)
+func (m *GetNetMsgStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetMsgStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeTransactionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetNetMsgStatsRequest proto.InternalMessageInfo
 
-func (m *SubscribeTransactionsRequest) GetSubscribe() *TransactionFilter {
-	if m != nil {
-		return m.Subscribe
-	}
-	return nil
+type GetNetMsgStatsResponse struct {
+	Peers                []*PeerNetMsgStats `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *SubscribeTransactionsRequest) GetUnsubscribe() *TransactionFilter {
-	if m != nil {
-		return m.Unsubscribe
-	}
-	return nil
+func (m *GetNetMsgStatsResponse) Reset()         { *m = GetNetMsgStatsResponse{} }
+func (m *GetNetMsgStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNetMsgStatsResponse) ProtoMessage()    {}
+func (*GetNetMsgStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{31}
 }
-
-func (m *SubscribeTransactionsRequest) GetIncludeMempool() bool {
-	if m != nil {
-		return m.IncludeMempool
-	}
-	return false
+func (m *GetNetMsgStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetMsgStatsResponse.Unmarshal(m, b)
+}
+func (m *GetNetMsgStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetMsgStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetNetMsgStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetMsgStatsResponse.Merge(dst, src)
+}
+func (m *GetNetMsgStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNetMsgStatsResponse.Size(m)
+}
+func (m *GetNetMsgStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetMsgStatsResponse.DiscardUnknown(m)
 }
 
-func (m *SubscribeTransactionsRequest) GetIncludeInBlock() bool {
+var xxx_messageInfo_GetNetMsgStatsResponse proto.InternalMessageInfo
+
+func (m *GetNetMsgStatsResponse) GetPeers() []*PeerNetMsgStats {
 	if m != nil {
-		return m.IncludeInBlock
+		return m.Peers
 	}
-	return false
+	return nil
 }
 
-type SubscribeBlocksRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The block of the state is the best block when neither the hash nor the
+// height of the block is set.
+type GetCommitteeStateRequest struct {
+	// Types that are valid to be assigned to Block:
+	//	*GetCommitteeStateRequest_BlockHash
+	//	*GetCommitteeStateRequest_BlockHeight
+	Block                isGetCommitteeStateRequest_Block `protobuf_oneof:"block"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *SubscribeBlocksRequest) Reset()         { *m = SubscribeBlocksRequest{} }
-func (m *SubscribeBlocksRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeBlocksRequest) ProtoMessage()    {}
-func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{29}
+func (m *GetCommitteeStateRequest) Reset()         { *m = GetCommitteeStateRequest{} }
+func (m *GetCommitteeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCommitteeStateRequest) ProtoMessage()    {}
+func (*GetCommitteeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{32}
 }
-func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeBlocksRequest.Unmarshal(m, b)
+func (m *GetCommitteeStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCommitteeStateRequest.Unmarshal(m, b)
 }
-func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeBlocksRequest.Marshal(b, m, deterministic)
+func (m *GetCommitteeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCommitteeStateRequest.Marshal(b, m, deterministic)
 }
-func (dst *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeBlocksRequest.Merge(dst, src)
-}
-func (m *SubscribeBlocksRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeBlocksRequest.Size(m)
-}
-func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
-
-type BlockNotification struct {
-	Type                 BlockNotification_Type `protobuf:"varint,1,opt,name=type,enum=pb.BlockNotification_Type" json:"type,omitempty"`
-	Block                *BlockInfo             `protobuf:"bytes,2,opt,name=block" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
-}
-
-func (m *BlockNotification) Reset()         { *m = BlockNotification{} }
-func (m *BlockNotification) String() string { return proto.CompactTextString(m) }
-func (*BlockNotification) ProtoMessage()    {}
-func (*BlockNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{30}
-}
-func (m *BlockNotification) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockNotification.Unmarshal(m, b)
-}
-func (m *BlockNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockNotification.Marshal(b, m, deterministic)
-}
-func (dst *BlockNotification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockNotification.Merge(dst, src)
-}
-func (m *BlockNotification) XXX_Size() int {
-	return xxx_messageInfo_BlockNotification.Size(m)
+func (dst *GetCommitteeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommitteeStateRequest.Merge(dst, src)
 }
-func (m *BlockNotification) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockNotification.DiscardUnknown(m)
+func (m *GetCommitteeStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCommitteeStateRequest.Size(m)
 }
-
-var xxx_messageInfo_BlockNotification proto.InternalMessageInfo
-
-func (m *BlockNotification) GetType() BlockNotification_Type {
-	if m != nil {
-		return m.Type
-	}
-	return BlockNotification_CONNECTED
+func (m *GetCommitteeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommitteeStateRequest.DiscardUnknown(m)
 }
 
-func (m *BlockNotification) GetBlock() *BlockInfo {
-	if m != nil {
-		return m.Block
-	}
-	return nil
-}
+var xxx_messageInfo_GetCommitteeStateRequest proto.InternalMessageInfo
 
-type TransactionNotification struct {
-	Type TransactionNotification_Type `protobuf:"varint,1,opt,name=type,enum=pb.TransactionNotification_Type" json:"type,omitempty"`
-	// Types that are valid to be assigned to Transaction:
-	//	*TransactionNotification_ConfirmedTransaction
-	//	*TransactionNotification_UnconfirmedTransaction
-	Transaction          isTransactionNotification_Transaction `protobuf_oneof:"transaction"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type isGetCommitteeStateRequest_Block interface {
+	isGetCommitteeStateRequest_Block()
 }
 
-func (m *TransactionNotification) Reset()         { *m = TransactionNotification{} }
-func (m *TransactionNotification) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotification) ProtoMessage()    {}
-func (*TransactionNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{31}
-}
-func (m *TransactionNotification) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionNotification.Unmarshal(m, b)
-}
-func (m *TransactionNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionNotification.Marshal(b, m, deterministic)
-}
-func (dst *TransactionNotification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionNotification.Merge(dst, src)
-}
-func (m *TransactionNotification) XXX_Size() int {
-	return xxx_messageInfo_TransactionNotification.Size(m)
-}
-func (m *TransactionNotification) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionNotification.DiscardUnknown(m)
+type GetCommitteeStateRequest_BlockHash struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3,oneof"`
 }
 
-var xxx_messageInfo_TransactionNotification proto.InternalMessageInfo
-
-type isTransactionNotification_Transaction interface {
-	isTransactionNotification_Transaction()
+type GetCommitteeStateRequest_BlockHeight struct {
+	BlockHeight int32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3,oneof"`
 }
 
-type TransactionNotification_ConfirmedTransaction struct {
-	ConfirmedTransaction *Transaction `protobuf:"bytes,2,opt,name=confirmed_transaction,json=confirmedTransaction,oneof"`
-}
-type TransactionNotification_UnconfirmedTransaction struct {
-	UnconfirmedTransaction *MempoolTransaction `protobuf:"bytes,3,opt,name=unconfirmed_transaction,json=unconfirmedTransaction,oneof"`
-}
+func (*GetCommitteeStateRequest_BlockHash) isGetCommitteeStateRequest_Block() {}
 
-func (*TransactionNotification_ConfirmedTransaction) isTransactionNotification_Transaction()   {}
-func (*TransactionNotification_UnconfirmedTransaction) isTransactionNotification_Transaction() {}
+func (*GetCommitteeStateRequest_BlockHeight) isGetCommitteeStateRequest_Block() {}
 
-func (m *TransactionNotification) GetTransaction() isTransactionNotification_Transaction {
+func (m *GetCommitteeStateRequest) GetBlock() isGetCommitteeStateRequest_Block {
 	if m != nil {
-		return m.Transaction
+		return m.Block
 	}
 	return nil
 }
 
-func (m *TransactionNotification) GetType() TransactionNotification_Type {
-	if m != nil {
-		return m.Type
-	}
-	return TransactionNotification_UNCONFIRMED
-}
-
-func (m *TransactionNotification) GetConfirmedTransaction() *Transaction {
-	if x, ok := m.GetTransaction().(*TransactionNotification_ConfirmedTransaction); ok {
-		return x.ConfirmedTransaction
+func (m *GetCommitteeStateRequest) GetBlockHash() []byte {
+	if x, ok := m.GetBlock().(*GetCommitteeStateRequest_BlockHash); ok {
+		return x.BlockHash
 	}
 	return nil
 }
 
-func (m *TransactionNotification) GetUnconfirmedTransaction() *MempoolTransaction {
-	if x, ok := m.GetTransaction().(*TransactionNotification_UnconfirmedTransaction); ok {
-		return x.UnconfirmedTransaction
+func (m *GetCommitteeStateRequest) GetBlockHeight() int32 {
+	if x, ok := m.GetBlock().(*GetCommitteeStateRequest_BlockHeight); ok {
+		return x.BlockHeight
 	}
-	return nil
+	return 0
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*TransactionNotification) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _TransactionNotification_OneofMarshaler, _TransactionNotification_OneofUnmarshaler, _TransactionNotification_OneofSizer, []interface{}{
-		(*TransactionNotification_ConfirmedTransaction)(nil),
-		(*TransactionNotification_UnconfirmedTransaction)(nil),
+func (*GetCommitteeStateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GetCommitteeStateRequest_OneofMarshaler, _GetCommitteeStateRequest_OneofUnmarshaler, _GetCommitteeStateRequest_OneofSizer, []interface{}{
+		(*GetCommitteeStateRequest_BlockHash)(nil),
+		(*GetCommitteeStateRequest_BlockHeight)(nil),
 	}
 }
 
-func _TransactionNotification_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*TransactionNotification)
-	// transaction
-	switch x := m.Transaction.(type) {
-	case *TransactionNotification_ConfirmedTransaction:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ConfirmedTransaction); err != nil {
-			return err
-		}
-	case *TransactionNotification_UnconfirmedTransaction:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.UnconfirmedTransaction); err != nil {
-			return err
-		}
+func _GetCommitteeStateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GetCommitteeStateRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetCommitteeStateRequest_BlockHash:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.BlockHash)
+	case *GetCommitteeStateRequest_BlockHeight:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.BlockHeight))
 	case nil:
 	default:
-		return fmt.Errorf("TransactionNotification.Transaction has unexpected type %T", x)
+		return fmt.Errorf("GetCommitteeStateRequest.Block has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _TransactionNotification_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*TransactionNotification)
+func _GetCommitteeStateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GetCommitteeStateRequest)
 	switch tag {
-	case 2: // transaction.confirmed_transaction
+	case 1: // block.block_hash
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(Transaction)
-		err := b.DecodeMessage(msg)
-		m.Transaction = &TransactionNotification_ConfirmedTransaction{msg}
+		x, err := b.DecodeRawBytes(true)
+		m.Block = &GetCommitteeStateRequest_BlockHash{x}
 		return true, err
-	case 3: // transaction.unconfirmed_transaction
-		if wire != proto.WireBytes {
+	case 2: // block.block_height
+		if wire != proto.WireVarint {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(MempoolTransaction)
-		err := b.DecodeMessage(msg)
-		m.Transaction = &TransactionNotification_UnconfirmedTransaction{msg}
+		x, err := b.DecodeVarint()
+		m.Block = &GetCommitteeStateRequest_BlockHeight{int32(x)}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _TransactionNotification_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*TransactionNotification)
-	// transaction
-	switch x := m.Transaction.(type) {
-	case *TransactionNotification_ConfirmedTransaction:
-		s := proto.Size(x.ConfirmedTransaction)
+func _GetCommitteeStateRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GetCommitteeStateRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetCommitteeStateRequest_BlockHash:
 		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *TransactionNotification_UnconfirmedTransaction:
-		s := proto.Size(x.UnconfirmedTransaction)
+		n += proto.SizeVarint(uint64(len(x.BlockHash)))
+		n += len(x.BlockHash)
+	case *GetCommitteeStateRequest_BlockHeight:
 		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
+		n += proto.SizeVarint(uint64(x.BlockHeight))
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2180,315 +2330,548 @@ func _TransactionNotification_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type BlockInfo struct {
-	// Identification.
-	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Height int32  `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
-	// Block header data.
-	Version       int32  `protobuf:"varint,3,opt,name=version" json:"version,omitempty"`
-	PreviousBlock []byte `protobuf:"bytes,4,opt,name=previous_block,json=previousBlock,proto3" json:"previous_block,omitempty"`
-	MerkleRoot    []byte `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
-	Timestamp     int64  `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
-	Bits          uint32 `protobuf:"varint,7,opt,name=bits" json:"bits,omitempty"`
-	Nonce         uint64 `protobuf:"varint,8,opt,name=nonce" json:"nonce,omitempty"`
-	// Metadata.
-	Confirmations        int32    `protobuf:"varint,9,opt,name=confirmations" json:"confirmations,omitempty"`
-	Difficulty           float64  `protobuf:"fixed64,10,opt,name=difficulty" json:"difficulty,omitempty"`
-	NextBlockHash        []byte   `protobuf:"bytes,11,opt,name=next_block_hash,json=nextBlockHash,proto3" json:"next_block_hash,omitempty"`
-	Size                 int32    `protobuf:"varint,12,opt,name=size" json:"size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetCommitteeStateResponse struct {
+	BlockHash   []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The hash of the serialized committee state.
+	StateHash               []byte       `protobuf:"bytes,3,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	Pledges                 []*Pledge    `protobuf:"bytes,4,rep,name=pledges,proto3" json:"pledges,omitempty"`
+	Committees              []*Committee `protobuf:"bytes,5,rep,name=committees,proto3" json:"committees,omitempty"`
+	MaxItemId               uint64       `protobuf:"varint,6,opt,name=max_item_id,json=maxItemId,proto3" json:"max_item_id,omitempty"`
+	ConvertItemCount        uint32       `protobuf:"varint,7,opt,name=convert_item_count,json=convertItemCount,proto3" json:"convert_item_count,omitempty"`
+	ConvertConfirmItemCount uint32       `protobuf:"varint,8,opt,name=convert_confirm_item_count,json=convertConfirmItemCount,proto3" json:"convert_confirm_item_count,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}     `json:"-"`
+	XXX_unrecognized        []byte       `json:"-"`
+	XXX_sizecache           int32        `json:"-"`
 }
 
-func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
-func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
-func (*BlockInfo) ProtoMessage()    {}
-func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{32}
+func (m *GetCommitteeStateResponse) Reset()         { *m = GetCommitteeStateResponse{} }
+func (m *GetCommitteeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCommitteeStateResponse) ProtoMessage()    {}
+func (*GetCommitteeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{33}
 }
-func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BlockInfo.Unmarshal(m, b)
+func (m *GetCommitteeStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCommitteeStateResponse.Unmarshal(m, b)
 }
-func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+func (m *GetCommitteeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCommitteeStateResponse.Marshal(b, m, deterministic)
 }
-func (dst *BlockInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockInfo.Merge(dst, src)
+func (dst *GetCommitteeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommitteeStateResponse.Merge(dst, src)
 }
-func (m *BlockInfo) XXX_Size() int {
-	return xxx_messageInfo_BlockInfo.Size(m)
+func (m *GetCommitteeStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCommitteeStateResponse.Size(m)
 }
-func (m *BlockInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+func (m *GetCommitteeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommitteeStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetCommitteeStateResponse proto.InternalMessageInfo
 
-func (m *BlockInfo) GetHash() []byte {
+func (m *GetCommitteeStateResponse) GetBlockHash() []byte {
 	if m != nil {
-		return m.Hash
+		return m.BlockHash
 	}
 	return nil
 }
 
-func (m *BlockInfo) GetHeight() int32 {
+func (m *GetCommitteeStateResponse) GetBlockHeight() int32 {
 	if m != nil {
-		return m.Height
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *BlockInfo) GetVersion() int32 {
+func (m *GetCommitteeStateResponse) GetStateHash() []byte {
 	if m != nil {
-		return m.Version
+		return m.StateHash
 	}
-	return 0
+	return nil
 }
 
-func (m *BlockInfo) GetPreviousBlock() []byte {
+func (m *GetCommitteeStateResponse) GetPledges() []*Pledge {
 	if m != nil {
-		return m.PreviousBlock
+		return m.Pledges
 	}
 	return nil
 }
 
-func (m *BlockInfo) GetMerkleRoot() []byte {
+func (m *GetCommitteeStateResponse) GetCommittees() []*Committee {
 	if m != nil {
-		return m.MerkleRoot
+		return m.Committees
 	}
 	return nil
 }
 
-func (m *BlockInfo) GetTimestamp() int64 {
+func (m *GetCommitteeStateResponse) GetMaxItemId() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.MaxItemId
 	}
 	return 0
 }
 
-func (m *BlockInfo) GetBits() uint32 {
+func (m *GetCommitteeStateResponse) GetConvertItemCount() uint32 {
 	if m != nil {
-		return m.Bits
+		return m.ConvertItemCount
 	}
 	return 0
 }
 
-func (m *BlockInfo) GetNonce() uint64 {
+func (m *GetCommitteeStateResponse) GetConvertConfirmItemCount() uint32 {
 	if m != nil {
-		return m.Nonce
+		return m.ConvertConfirmItemCount
 	}
 	return 0
 }
 
-func (m *BlockInfo) GetConfirmations() int32 {
-	if m != nil {
-		return m.Confirmations
-	}
-	return 0
+type GetPledgeRequest struct {
+	// Types that are valid to be assigned to Block:
+	//	*GetPledgeRequest_BlockHash
+	//	*GetPledgeRequest_BlockHeight
+	Block isGetPledgeRequest_Block `protobuf_oneof:"block"`
+	// Types that are valid to be assigned to Pledge:
+	//	*GetPledgeRequest_Id
+	//	*GetPledgeRequest_Address
+	Pledge               isGetPledgeRequest_Pledge `protobuf_oneof:"pledge"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *BlockInfo) GetDifficulty() float64 {
+func (m *GetPledgeRequest) Reset()         { *m = GetPledgeRequest{} }
+func (m *GetPledgeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPledgeRequest) ProtoMessage()    {}
+func (*GetPledgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{34}
+}
+func (m *GetPledgeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPledgeRequest.Unmarshal(m, b)
+}
+func (m *GetPledgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPledgeRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPledgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPledgeRequest.Merge(dst, src)
+}
+func (m *GetPledgeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPledgeRequest.Size(m)
+}
+func (m *GetPledgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPledgeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPledgeRequest proto.InternalMessageInfo
+
+type isGetPledgeRequest_Block interface {
+	isGetPledgeRequest_Block()
+}
+
+type GetPledgeRequest_BlockHash struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3,oneof"`
+}
+
+type GetPledgeRequest_BlockHeight struct {
+	BlockHeight int32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3,oneof"`
+}
+
+func (*GetPledgeRequest_BlockHash) isGetPledgeRequest_Block() {}
+
+func (*GetPledgeRequest_BlockHeight) isGetPledgeRequest_Block() {}
+
+func (m *GetPledgeRequest) GetBlock() isGetPledgeRequest_Block {
 	if m != nil {
-		return m.Difficulty
+		return m.Block
 	}
-	return 0
+	return nil
 }
 
-func (m *BlockInfo) GetNextBlockHash() []byte {
-	if m != nil {
-		return m.NextBlockHash
+func (m *GetPledgeRequest) GetBlockHash() []byte {
+	if x, ok := m.GetBlock().(*GetPledgeRequest_BlockHash); ok {
+		return x.BlockHash
 	}
 	return nil
 }
 
-func (m *BlockInfo) GetSize() int32 {
-	if m != nil {
-		return m.Size
+func (m *GetPledgeRequest) GetBlockHeight() int32 {
+	if x, ok := m.GetBlock().(*GetPledgeRequest_BlockHeight); ok {
+		return x.BlockHeight
 	}
 	return 0
 }
 
-type Block struct {
-	Info                 *BlockInfo               `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
-	TransactionData      []*Block_TransactionData `protobuf:"bytes,2,rep,name=transaction_data,json=transactionData" json:"transaction_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type isGetPledgeRequest_Pledge interface {
+	isGetPledgeRequest_Pledge()
 }
 
-func (m *Block) Reset()         { *m = Block{} }
-func (m *Block) String() string { return proto.CompactTextString(m) }
-func (*Block) ProtoMessage()    {}
-func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{33}
-}
-func (m *Block) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block.Unmarshal(m, b)
-}
-func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
-}
-func (dst *Block) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block.Merge(dst, src)
-}
-func (m *Block) XXX_Size() int {
-	return xxx_messageInfo_Block.Size(m)
+type GetPledgeRequest_Id struct {
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3,oneof"`
 }
-func (m *Block) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block.DiscardUnknown(m)
+
+type GetPledgeRequest_Address struct {
+	Address string `protobuf:"bytes,4,opt,name=address,proto3,oneof"`
 }
 
-var xxx_messageInfo_Block proto.InternalMessageInfo
+func (*GetPledgeRequest_Id) isGetPledgeRequest_Pledge() {}
 
-func (m *Block) GetInfo() *BlockInfo {
+func (*GetPledgeRequest_Address) isGetPledgeRequest_Pledge() {}
+
+func (m *GetPledgeRequest) GetPledge() isGetPledgeRequest_Pledge {
 	if m != nil {
-		return m.Info
+		return m.Pledge
 	}
 	return nil
 }
 
-func (m *Block) GetTransactionData() []*Block_TransactionData {
+func (m *GetPledgeRequest) GetId() uint64 {
+	if x, ok := m.GetPledge().(*GetPledgeRequest_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (m *GetPledgeRequest) GetAddress() string {
+	if x, ok := m.GetPledge().(*GetPledgeRequest_Address); ok {
+		return x.Address
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GetPledgeRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GetPledgeRequest_OneofMarshaler, _GetPledgeRequest_OneofUnmarshaler, _GetPledgeRequest_OneofSizer, []interface{}{
+		(*GetPledgeRequest_BlockHash)(nil),
+		(*GetPledgeRequest_BlockHeight)(nil),
+		(*GetPledgeRequest_Id)(nil),
+		(*GetPledgeRequest_Address)(nil),
+	}
+}
+
+func _GetPledgeRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GetPledgeRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetPledgeRequest_BlockHash:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.BlockHash)
+	case *GetPledgeRequest_BlockHeight:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.BlockHeight))
+	case nil:
+	default:
+		return fmt.Errorf("GetPledgeRequest.Block has unexpected type %T", x)
+	}
+	// pledge
+	switch x := m.Pledge.(type) {
+	case *GetPledgeRequest_Id:
+		b.EncodeVarint(3<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Id))
+	case *GetPledgeRequest_Address:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Address)
+	case nil:
+	default:
+		return fmt.Errorf("GetPledgeRequest.Pledge has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _GetPledgeRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GetPledgeRequest)
+	switch tag {
+	case 1: // block.block_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Block = &GetPledgeRequest_BlockHash{x}
+		return true, err
+	case 2: // block.block_height
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Block = &GetPledgeRequest_BlockHeight{int32(x)}
+		return true, err
+	case 3: // pledge.id
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Pledge = &GetPledgeRequest_Id{x}
+		return true, err
+	case 4: // pledge.address
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Pledge = &GetPledgeRequest_Address{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GetPledgeRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GetPledgeRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetPledgeRequest_BlockHash:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.BlockHash)))
+		n += len(x.BlockHash)
+	case *GetPledgeRequest_BlockHeight:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.BlockHeight))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	// pledge
+	switch x := m.Pledge.(type) {
+	case *GetPledgeRequest_Id:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Id))
+	case *GetPledgeRequest_Address:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Address)))
+		n += len(x.Address)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type GetPledgeResponse struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Pledge               *Pledge  `protobuf:"bytes,3,opt,name=pledge,proto3" json:"pledge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPledgeResponse) Reset()         { *m = GetPledgeResponse{} }
+func (m *GetPledgeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPledgeResponse) ProtoMessage()    {}
+func (*GetPledgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{35}
+}
+func (m *GetPledgeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPledgeResponse.Unmarshal(m, b)
+}
+func (m *GetPledgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPledgeResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPledgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPledgeResponse.Merge(dst, src)
+}
+func (m *GetPledgeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPledgeResponse.Size(m)
+}
+func (m *GetPledgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPledgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPledgeResponse proto.InternalMessageInfo
+
+func (m *GetPledgeResponse) GetBlockHash() []byte {
 	if m != nil {
-		return m.TransactionData
+		return m.BlockHash
 	}
 	return nil
 }
 
-type Block_TransactionData struct {
-	// Either one of the two following is provided, depending on the request.
-	//
-	// Types that are valid to be assigned to TxidsOrTxs:
-	//	*Block_TransactionData_TransactionHash
-	//	*Block_TransactionData_Transaction
-	TxidsOrTxs           isBlock_TransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+func (m *GetPledgeResponse) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *Block_TransactionData) Reset()         { *m = Block_TransactionData{} }
-func (m *Block_TransactionData) String() string { return proto.CompactTextString(m) }
-func (*Block_TransactionData) ProtoMessage()    {}
-func (*Block_TransactionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{33, 0}
+func (m *GetPledgeResponse) GetPledge() *Pledge {
+	if m != nil {
+		return m.Pledge
+	}
+	return nil
 }
-func (m *Block_TransactionData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Block_TransactionData.Unmarshal(m, b)
+
+type ListConvertItemsRequest struct {
+	// Types that are valid to be assigned to Block:
+	//	*ListConvertItemsRequest_BlockHash
+	//	*ListConvertItemsRequest_BlockHeight
+	Block isListConvertItemsRequest_Block `protobuf_oneof:"block"`
+	// List the confirmed convert items instead of the pending ones.
+	Confirmed bool `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Only list the items of the given asset and convert types. All types
+	// are listed when empty.
+	AssetTypes   []uint32 `protobuf:"varint,4,rep,packed,name=asset_types,json=assetTypes,proto3" json:"asset_types,omitempty"`
+	ConvertTypes []uint32 `protobuf:"varint,5,rep,packed,name=convert_types,json=convertTypes,proto3" json:"convert_types,omitempty"`
+	// The items are sorted by id. Control the number of items to be
+	// skipped and returned. All remaining items are returned when
+	// nb_fetch is zero.
+	NbSkip               uint32   `protobuf:"varint,6,opt,name=nb_skip,json=nbSkip,proto3" json:"nb_skip,omitempty"`
+	NbFetch              uint32   `protobuf:"varint,7,opt,name=nb_fetch,json=nbFetch,proto3" json:"nb_fetch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Block_TransactionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Block_TransactionData.Marshal(b, m, deterministic)
+
+func (m *ListConvertItemsRequest) Reset()         { *m = ListConvertItemsRequest{} }
+func (m *ListConvertItemsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListConvertItemsRequest) ProtoMessage()    {}
+func (*ListConvertItemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{36}
 }
-func (dst *Block_TransactionData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block_TransactionData.Merge(dst, src)
+func (m *ListConvertItemsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListConvertItemsRequest.Unmarshal(m, b)
 }
-func (m *Block_TransactionData) XXX_Size() int {
-	return xxx_messageInfo_Block_TransactionData.Size(m)
+func (m *ListConvertItemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListConvertItemsRequest.Marshal(b, m, deterministic)
 }
-func (m *Block_TransactionData) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block_TransactionData.DiscardUnknown(m)
+func (dst *ListConvertItemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConvertItemsRequest.Merge(dst, src)
+}
+func (m *ListConvertItemsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListConvertItemsRequest.Size(m)
+}
+func (m *ListConvertItemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConvertItemsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block_TransactionData proto.InternalMessageInfo
+var xxx_messageInfo_ListConvertItemsRequest proto.InternalMessageInfo
 
-type isBlock_TransactionData_TxidsOrTxs interface {
-	isBlock_TransactionData_TxidsOrTxs()
+type isListConvertItemsRequest_Block interface {
+	isListConvertItemsRequest_Block()
 }
 
-type Block_TransactionData_TransactionHash struct {
-	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+type ListConvertItemsRequest_BlockHash struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3,oneof"`
 }
-type Block_TransactionData_Transaction struct {
-	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,oneof"`
+
+type ListConvertItemsRequest_BlockHeight struct {
+	BlockHeight int32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3,oneof"`
 }
 
-func (*Block_TransactionData_TransactionHash) isBlock_TransactionData_TxidsOrTxs() {}
-func (*Block_TransactionData_Transaction) isBlock_TransactionData_TxidsOrTxs()     {}
+func (*ListConvertItemsRequest_BlockHash) isListConvertItemsRequest_Block() {}
 
-func (m *Block_TransactionData) GetTxidsOrTxs() isBlock_TransactionData_TxidsOrTxs {
+func (*ListConvertItemsRequest_BlockHeight) isListConvertItemsRequest_Block() {}
+
+func (m *ListConvertItemsRequest) GetBlock() isListConvertItemsRequest_Block {
 	if m != nil {
-		return m.TxidsOrTxs
+		return m.Block
 	}
 	return nil
 }
 
-func (m *Block_TransactionData) GetTransactionHash() []byte {
-	if x, ok := m.GetTxidsOrTxs().(*Block_TransactionData_TransactionHash); ok {
-		return x.TransactionHash
+func (m *ListConvertItemsRequest) GetBlockHash() []byte {
+	if x, ok := m.GetBlock().(*ListConvertItemsRequest_BlockHash); ok {
+		return x.BlockHash
 	}
 	return nil
 }
 
-func (m *Block_TransactionData) GetTransaction() *Transaction {
-	if x, ok := m.GetTxidsOrTxs().(*Block_TransactionData_Transaction); ok {
-		return x.Transaction
+func (m *ListConvertItemsRequest) GetBlockHeight() int32 {
+	if x, ok := m.GetBlock().(*ListConvertItemsRequest_BlockHeight); ok {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListConvertItemsRequest) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *ListConvertItemsRequest) GetAssetTypes() []uint32 {
+	if m != nil {
+		return m.AssetTypes
 	}
 	return nil
 }
 
+func (m *ListConvertItemsRequest) GetConvertTypes() []uint32 {
+	if m != nil {
+		return m.ConvertTypes
+	}
+	return nil
+}
+
+func (m *ListConvertItemsRequest) GetNbSkip() uint32 {
+	if m != nil {
+		return m.NbSkip
+	}
+	return 0
+}
+
+func (m *ListConvertItemsRequest) GetNbFetch() uint32 {
+	if m != nil {
+		return m.NbFetch
+	}
+	return 0
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*Block_TransactionData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Block_TransactionData_OneofMarshaler, _Block_TransactionData_OneofUnmarshaler, _Block_TransactionData_OneofSizer, []interface{}{
-		(*Block_TransactionData_TransactionHash)(nil),
-		(*Block_TransactionData_Transaction)(nil),
+func (*ListConvertItemsRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ListConvertItemsRequest_OneofMarshaler, _ListConvertItemsRequest_OneofUnmarshaler, _ListConvertItemsRequest_OneofSizer, []interface{}{
+		(*ListConvertItemsRequest_BlockHash)(nil),
+		(*ListConvertItemsRequest_BlockHeight)(nil),
 	}
 }
 
-func _Block_TransactionData_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Block_TransactionData)
-	// txids_or_txs
-	switch x := m.TxidsOrTxs.(type) {
-	case *Block_TransactionData_TransactionHash:
+func _ListConvertItemsRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ListConvertItemsRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *ListConvertItemsRequest_BlockHash:
 		b.EncodeVarint(1<<3 | proto.WireBytes)
-		b.EncodeRawBytes(x.TransactionHash)
-	case *Block_TransactionData_Transaction:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Transaction); err != nil {
-			return err
-		}
+		b.EncodeRawBytes(x.BlockHash)
+	case *ListConvertItemsRequest_BlockHeight:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.BlockHeight))
 	case nil:
 	default:
-		return fmt.Errorf("Block_TransactionData.TxidsOrTxs has unexpected type %T", x)
+		return fmt.Errorf("ListConvertItemsRequest.Block has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _Block_TransactionData_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Block_TransactionData)
+func _ListConvertItemsRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ListConvertItemsRequest)
 	switch tag {
-	case 1: // txids_or_txs.transaction_hash
+	case 1: // block.block_hash
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
 		x, err := b.DecodeRawBytes(true)
-		m.TxidsOrTxs = &Block_TransactionData_TransactionHash{x}
+		m.Block = &ListConvertItemsRequest_BlockHash{x}
 		return true, err
-	case 2: // txids_or_txs.transaction
-		if wire != proto.WireBytes {
+	case 2: // block.block_height
+		if wire != proto.WireVarint {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(Transaction)
-		err := b.DecodeMessage(msg)
-		m.TxidsOrTxs = &Block_TransactionData_Transaction{msg}
+		x, err := b.DecodeVarint()
+		m.Block = &ListConvertItemsRequest_BlockHeight{int32(x)}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _Block_TransactionData_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Block_TransactionData)
-	// txids_or_txs
-	switch x := m.TxidsOrTxs.(type) {
-	case *Block_TransactionData_TransactionHash:
+func _ListConvertItemsRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ListConvertItemsRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *ListConvertItemsRequest_BlockHash:
 		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(len(x.TransactionHash)))
-		n += len(x.TransactionHash)
-	case *Block_TransactionData_Transaction:
-		s := proto.Size(x.Transaction)
+		n += proto.SizeVarint(uint64(len(x.BlockHash)))
+		n += len(x.BlockHash)
+	case *ListConvertItemsRequest_BlockHeight:
 		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
+		n += proto.SizeVarint(uint64(x.BlockHeight))
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2496,547 +2879,2618 @@ func _Block_TransactionData_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type Transaction struct {
-	Hash     []byte                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Version  int32                 `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
-	Inputs   []*Transaction_Input  `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
-	Outputs  []*Transaction_Output `protobuf:"bytes,4,rep,name=outputs" json:"outputs,omitempty"`
-	LockTime uint32                `protobuf:"varint,5,opt,name=lock_time,json=lockTime" json:"lock_time,omitempty"`
-	// Metadata
-	Size                 int32    `protobuf:"varint,8,opt,name=size" json:"size,omitempty"`
-	Timestamp            int64    `protobuf:"varint,9,opt,name=timestamp" json:"timestamp,omitempty"`
-	Confirmations        int32    `protobuf:"varint,10,opt,name=confirmations" json:"confirmations,omitempty"`
-	BlockHeight          int32    `protobuf:"varint,11,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
-	BlockHash            []byte   `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+type ListConvertItemsResponse struct {
+	BlockHash   []byte         `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight int32          `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Items       []*ConvertItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// The number of items matching the filters, without paging.
+	Total                uint32   `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Transaction) Reset()         { *m = Transaction{} }
-func (m *Transaction) String() string { return proto.CompactTextString(m) }
-func (*Transaction) ProtoMessage()    {}
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{34}
+func (m *ListConvertItemsResponse) Reset()         { *m = ListConvertItemsResponse{} }
+func (m *ListConvertItemsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListConvertItemsResponse) ProtoMessage()    {}
+func (*ListConvertItemsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{37}
 }
-func (m *Transaction) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transaction.Unmarshal(m, b)
+func (m *ListConvertItemsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListConvertItemsResponse.Unmarshal(m, b)
 }
-func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+func (m *ListConvertItemsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListConvertItemsResponse.Marshal(b, m, deterministic)
 }
-func (dst *Transaction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transaction.Merge(dst, src)
+func (dst *ListConvertItemsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConvertItemsResponse.Merge(dst, src)
 }
-func (m *Transaction) XXX_Size() int {
-	return xxx_messageInfo_Transaction.Size(m)
+func (m *ListConvertItemsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListConvertItemsResponse.Size(m)
 }
-func (m *Transaction) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transaction.DiscardUnknown(m)
+func (m *ListConvertItemsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConvertItemsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Transaction proto.InternalMessageInfo
+var xxx_messageInfo_ListConvertItemsResponse proto.InternalMessageInfo
 
-func (m *Transaction) GetHash() []byte {
+func (m *ListConvertItemsResponse) GetBlockHash() []byte {
 	if m != nil {
-		return m.Hash
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ListConvertItemsResponse) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListConvertItemsResponse) GetItems() []*ConvertItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListConvertItemsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type GetPoolBalancesRequest struct {
+	// Types that are valid to be assigned to Block:
+	//	*GetPoolBalancesRequest_BlockHash
+	//	*GetPoolBalancesRequest_BlockHeight
+	Block                isGetPoolBalancesRequest_Block `protobuf_oneof:"block"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *GetPoolBalancesRequest) Reset()         { *m = GetPoolBalancesRequest{} }
+func (m *GetPoolBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPoolBalancesRequest) ProtoMessage()    {}
+func (*GetPoolBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{38}
+}
+func (m *GetPoolBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPoolBalancesRequest.Unmarshal(m, b)
+}
+func (m *GetPoolBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPoolBalancesRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPoolBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPoolBalancesRequest.Merge(dst, src)
+}
+func (m *GetPoolBalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPoolBalancesRequest.Size(m)
+}
+func (m *GetPoolBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPoolBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPoolBalancesRequest proto.InternalMessageInfo
+
+type isGetPoolBalancesRequest_Block interface {
+	isGetPoolBalancesRequest_Block()
+}
+
+type GetPoolBalancesRequest_BlockHash struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3,oneof"`
+}
+
+type GetPoolBalancesRequest_BlockHeight struct {
+	BlockHeight int32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3,oneof"`
+}
+
+func (*GetPoolBalancesRequest_BlockHash) isGetPoolBalancesRequest_Block() {}
+
+func (*GetPoolBalancesRequest_BlockHeight) isGetPoolBalancesRequest_Block() {}
+
+func (m *GetPoolBalancesRequest) GetBlock() isGetPoolBalancesRequest_Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetPoolBalancesRequest) GetBlockHash() []byte {
+	if x, ok := m.GetBlock().(*GetPoolBalancesRequest_BlockHash); ok {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (m *GetPoolBalancesRequest) GetBlockHeight() int32 {
+	if x, ok := m.GetBlock().(*GetPoolBalancesRequest_BlockHeight); ok {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GetPoolBalancesRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GetPoolBalancesRequest_OneofMarshaler, _GetPoolBalancesRequest_OneofUnmarshaler, _GetPoolBalancesRequest_OneofSizer, []interface{}{
+		(*GetPoolBalancesRequest_BlockHash)(nil),
+		(*GetPoolBalancesRequest_BlockHeight)(nil),
+	}
+}
+
+func _GetPoolBalancesRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GetPoolBalancesRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetPoolBalancesRequest_BlockHash:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.BlockHash)
+	case *GetPoolBalancesRequest_BlockHeight:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.BlockHeight))
+	case nil:
+	default:
+		return fmt.Errorf("GetPoolBalancesRequest.Block has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _GetPoolBalancesRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GetPoolBalancesRequest)
+	switch tag {
+	case 1: // block.block_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Block = &GetPoolBalancesRequest_BlockHash{x}
+		return true, err
+	case 2: // block.block_height
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Block = &GetPoolBalancesRequest_BlockHeight{int32(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GetPoolBalancesRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GetPoolBalancesRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetPoolBalancesRequest_BlockHash:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.BlockHash)))
+		n += len(x.BlockHash)
+	case *GetPoolBalancesRequest_BlockHeight:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.BlockHeight))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type GetPoolBalancesResponse struct {
+	BlockHash            []byte                          `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight          int32                           `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Pools                []*GetPoolBalancesResponse_Pool `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GetPoolBalancesResponse) Reset()         { *m = GetPoolBalancesResponse{} }
+func (m *GetPoolBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPoolBalancesResponse) ProtoMessage()    {}
+func (*GetPoolBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{39}
+}
+func (m *GetPoolBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPoolBalancesResponse.Unmarshal(m, b)
+}
+func (m *GetPoolBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPoolBalancesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPoolBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPoolBalancesResponse.Merge(dst, src)
+}
+func (m *GetPoolBalancesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPoolBalancesResponse.Size(m)
+}
+func (m *GetPoolBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPoolBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPoolBalancesResponse proto.InternalMessageInfo
+
+func (m *GetPoolBalancesResponse) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetPoolBalancesResponse) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetPoolBalancesResponse) GetPools() []*GetPoolBalancesResponse_Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type GetPoolBalancesResponse_Pool struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The sum of the values of the outputs.
+	Balance              int64            `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Outputs              []*UnspentOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetPoolBalancesResponse_Pool) Reset()         { *m = GetPoolBalancesResponse_Pool{} }
+func (m *GetPoolBalancesResponse_Pool) String() string { return proto.CompactTextString(m) }
+func (*GetPoolBalancesResponse_Pool) ProtoMessage()    {}
+func (*GetPoolBalancesResponse_Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{39, 0}
+}
+func (m *GetPoolBalancesResponse_Pool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPoolBalancesResponse_Pool.Unmarshal(m, b)
+}
+func (m *GetPoolBalancesResponse_Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPoolBalancesResponse_Pool.Marshal(b, m, deterministic)
+}
+func (dst *GetPoolBalancesResponse_Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPoolBalancesResponse_Pool.Merge(dst, src)
+}
+func (m *GetPoolBalancesResponse_Pool) XXX_Size() int {
+	return xxx_messageInfo_GetPoolBalancesResponse_Pool.Size(m)
+}
+func (m *GetPoolBalancesResponse_Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPoolBalancesResponse_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPoolBalancesResponse_Pool proto.InternalMessageInfo
+
+func (m *GetPoolBalancesResponse_Pool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetPoolBalancesResponse_Pool) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *GetPoolBalancesResponse_Pool) GetOutputs() []*UnspentOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type GetEntangleStateRequest struct {
+	// Types that are valid to be assigned to Block:
+	//	*GetEntangleStateRequest_BlockHash
+	//	*GetEntangleStateRequest_BlockHeight
+	Block                isGetEntangleStateRequest_Block `protobuf_oneof:"block"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GetEntangleStateRequest) Reset()         { *m = GetEntangleStateRequest{} }
+func (m *GetEntangleStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEntangleStateRequest) ProtoMessage()    {}
+func (*GetEntangleStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{40}
+}
+func (m *GetEntangleStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEntangleStateRequest.Unmarshal(m, b)
+}
+func (m *GetEntangleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEntangleStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetEntangleStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEntangleStateRequest.Merge(dst, src)
+}
+func (m *GetEntangleStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEntangleStateRequest.Size(m)
+}
+func (m *GetEntangleStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEntangleStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEntangleStateRequest proto.InternalMessageInfo
+
+type isGetEntangleStateRequest_Block interface {
+	isGetEntangleStateRequest_Block()
+}
+
+type GetEntangleStateRequest_BlockHash struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3,oneof"`
+}
+
+type GetEntangleStateRequest_BlockHeight struct {
+	BlockHeight int32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3,oneof"`
+}
+
+func (*GetEntangleStateRequest_BlockHash) isGetEntangleStateRequest_Block() {}
+
+func (*GetEntangleStateRequest_BlockHeight) isGetEntangleStateRequest_Block() {}
+
+func (m *GetEntangleStateRequest) GetBlock() isGetEntangleStateRequest_Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetEntangleStateRequest) GetBlockHash() []byte {
+	if x, ok := m.GetBlock().(*GetEntangleStateRequest_BlockHash); ok {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (m *GetEntangleStateRequest) GetBlockHeight() int32 {
+	if x, ok := m.GetBlock().(*GetEntangleStateRequest_BlockHeight); ok {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GetEntangleStateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GetEntangleStateRequest_OneofMarshaler, _GetEntangleStateRequest_OneofUnmarshaler, _GetEntangleStateRequest_OneofSizer, []interface{}{
+		(*GetEntangleStateRequest_BlockHash)(nil),
+		(*GetEntangleStateRequest_BlockHeight)(nil),
+	}
+}
+
+func _GetEntangleStateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GetEntangleStateRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetEntangleStateRequest_BlockHash:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.BlockHash)
+	case *GetEntangleStateRequest_BlockHeight:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.BlockHeight))
+	case nil:
+	default:
+		return fmt.Errorf("GetEntangleStateRequest.Block has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _GetEntangleStateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GetEntangleStateRequest)
+	switch tag {
+	case 1: // block.block_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Block = &GetEntangleStateRequest_BlockHash{x}
+		return true, err
+	case 2: // block.block_height
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Block = &GetEntangleStateRequest_BlockHeight{int32(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GetEntangleStateRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GetEntangleStateRequest)
+	// block
+	switch x := m.Block.(type) {
+	case *GetEntangleStateRequest_BlockHash:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.BlockHash)))
+		n += len(x.BlockHash)
+	case *GetEntangleStateRequest_BlockHeight:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.BlockHeight))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type GetEntangleStateResponse struct {
+	BlockHash            []byte                             `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight          int32                              `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Beacons              []*GetEntangleStateResponse_Beacon `protobuf:"bytes,3,rep,name=beacons,proto3" json:"beacons,omitempty"`
+	PoolAmount1          int64                              `protobuf:"varint,4,opt,name=pool_amount1,json=poolAmount1,proto3" json:"pool_amount1,omitempty"`
+	PoolAmount2          int64                              `protobuf:"varint,5,opt,name=pool_amount2,json=poolAmount2,proto3" json:"pool_amount2,omitempty"`
+	CurExchangeId        uint64                             `protobuf:"varint,6,opt,name=cur_exchange_id,json=curExchangeId,proto3" json:"cur_exchange_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *GetEntangleStateResponse) Reset()         { *m = GetEntangleStateResponse{} }
+func (m *GetEntangleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEntangleStateResponse) ProtoMessage()    {}
+func (*GetEntangleStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{41}
+}
+func (m *GetEntangleStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEntangleStateResponse.Unmarshal(m, b)
+}
+func (m *GetEntangleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEntangleStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetEntangleStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEntangleStateResponse.Merge(dst, src)
+}
+func (m *GetEntangleStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEntangleStateResponse.Size(m)
+}
+func (m *GetEntangleStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEntangleStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEntangleStateResponse proto.InternalMessageInfo
+
+func (m *GetEntangleStateResponse) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetEntangleStateResponse) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse) GetBeacons() []*GetEntangleStateResponse_Beacon {
+	if m != nil {
+		return m.Beacons
+	}
+	return nil
+}
+
+func (m *GetEntangleStateResponse) GetPoolAmount1() int64 {
+	if m != nil {
+		return m.PoolAmount1
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse) GetPoolAmount2() int64 {
+	if m != nil {
+		return m.PoolAmount2
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse) GetCurExchangeId() uint64 {
+	if m != nil {
+		return m.CurExchangeId
+	}
+	return 0
+}
+
+type GetEntangleStateResponse_Beacon struct {
+	ExchangeId           uint64   `protobuf:"varint,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ToAddress            []byte   `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	StakingAmount        int64    `protobuf:"varint,4,opt,name=staking_amount,json=stakingAmount,proto3" json:"staking_amount,omitempty"`
+	EntangleAmount       int64    `protobuf:"varint,5,opt,name=entangle_amount,json=entangleAmount,proto3" json:"entangle_amount,omitempty"`
+	AssetFlag            uint32   `protobuf:"varint,6,opt,name=asset_flag,json=assetFlag,proto3" json:"asset_flag,omitempty"`
+	Fee                  uint64   `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	KeepTime             uint64   `protobuf:"varint,8,opt,name=keep_time,json=keepTime,proto3" json:"keep_time,omitempty"`
+	CoinbaseAddresses    []string `protobuf:"bytes,9,rep,name=coinbase_addresses,json=coinbaseAddresses,proto3" json:"coinbase_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEntangleStateResponse_Beacon) Reset()         { *m = GetEntangleStateResponse_Beacon{} }
+func (m *GetEntangleStateResponse_Beacon) String() string { return proto.CompactTextString(m) }
+func (*GetEntangleStateResponse_Beacon) ProtoMessage()    {}
+func (*GetEntangleStateResponse_Beacon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{41, 0}
+}
+func (m *GetEntangleStateResponse_Beacon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEntangleStateResponse_Beacon.Unmarshal(m, b)
+}
+func (m *GetEntangleStateResponse_Beacon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEntangleStateResponse_Beacon.Marshal(b, m, deterministic)
+}
+func (dst *GetEntangleStateResponse_Beacon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEntangleStateResponse_Beacon.Merge(dst, src)
+}
+func (m *GetEntangleStateResponse_Beacon) XXX_Size() int {
+	return xxx_messageInfo_GetEntangleStateResponse_Beacon.Size(m)
+}
+func (m *GetEntangleStateResponse_Beacon) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEntangleStateResponse_Beacon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEntangleStateResponse_Beacon proto.InternalMessageInfo
+
+func (m *GetEntangleStateResponse_Beacon) GetExchangeId() uint64 {
+	if m != nil {
+		return m.ExchangeId
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetToAddress() []byte {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetStakingAmount() int64 {
+	if m != nil {
+		return m.StakingAmount
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetEntangleAmount() int64 {
+	if m != nil {
+		return m.EntangleAmount
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetAssetFlag() uint32 {
+	if m != nil {
+		return m.AssetFlag
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetKeepTime() uint64 {
+	if m != nil {
+		return m.KeepTime
+	}
+	return 0
+}
+
+func (m *GetEntangleStateResponse_Beacon) GetCoinbaseAddresses() []string {
+	if m != nil {
+		return m.CoinbaseAddresses
+	}
+	return nil
+}
+
+type SubscribeTransactionsRequest struct {
+	Subscribe   *TransactionFilter `protobuf:"bytes,1,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	Unsubscribe *TransactionFilter `protobuf:"bytes,2,opt,name=unsubscribe,proto3" json:"unsubscribe,omitempty"`
+	// When this is true, new transactions coming in from the mempool are
+	// included apart from the ones confirmed in a block.
+	IncludeMempool bool `protobuf:"varint,3,opt,name=include_mempool,json=includeMempool,proto3" json:"include_mempool,omitempty"`
+	// When this is true, transactions are included when they are confirmed.
+	// This notification is sent in addition to any requested mempool notifications.
+	IncludeInBlock       bool     `protobuf:"varint,4,opt,name=include_in_block,json=includeInBlock,proto3" json:"include_in_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeTransactionsRequest) Reset()         { *m = SubscribeTransactionsRequest{} }
+func (m *SubscribeTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTransactionsRequest) ProtoMessage()    {}
+func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{42}
+}
+func (m *SubscribeTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeTransactionsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTransactionsRequest.Merge(dst, src)
+}
+func (m *SubscribeTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeTransactionsRequest.Size(m)
+}
+func (m *SubscribeTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTransactionsRequest proto.InternalMessageInfo
+
+func (m *SubscribeTransactionsRequest) GetSubscribe() *TransactionFilter {
+	if m != nil {
+		return m.Subscribe
+	}
+	return nil
+}
+
+func (m *SubscribeTransactionsRequest) GetUnsubscribe() *TransactionFilter {
+	if m != nil {
+		return m.Unsubscribe
+	}
+	return nil
+}
+
+func (m *SubscribeTransactionsRequest) GetIncludeMempool() bool {
+	if m != nil {
+		return m.IncludeMempool
+	}
+	return false
+}
+
+func (m *SubscribeTransactionsRequest) GetIncludeInBlock() bool {
+	if m != nil {
+		return m.IncludeInBlock
+	}
+	return false
+}
+
+type SubscribeBlocksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeBlocksRequest) Reset()         { *m = SubscribeBlocksRequest{} }
+func (m *SubscribeBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksRequest) ProtoMessage()    {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{43}
+}
+func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeBlocksRequest.Unmarshal(m, b)
+}
+func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeBlocksRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksRequest.Merge(dst, src)
+}
+func (m *SubscribeBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeBlocksRequest.Size(m)
+}
+func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
+
+type SubscribeCommitteeEventsRequest struct {
+	// The kinds of events to receive. All events are sent when empty.
+	EventTypes           []CommitteeEventNotification_Type `protobuf:"varint,1,rep,packed,name=event_types,json=eventTypes,proto3,enum=pb.CommitteeEventNotification_Type" json:"event_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *SubscribeCommitteeEventsRequest) Reset()         { *m = SubscribeCommitteeEventsRequest{} }
+func (m *SubscribeCommitteeEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeCommitteeEventsRequest) ProtoMessage()    {}
+func (*SubscribeCommitteeEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{44}
+}
+func (m *SubscribeCommitteeEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeCommitteeEventsRequest.Unmarshal(m, b)
+}
+func (m *SubscribeCommitteeEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeCommitteeEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeCommitteeEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeCommitteeEventsRequest.Merge(dst, src)
+}
+func (m *SubscribeCommitteeEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeCommitteeEventsRequest.Size(m)
+}
+func (m *SubscribeCommitteeEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeCommitteeEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeCommitteeEventsRequest proto.InternalMessageInfo
+
+func (m *SubscribeCommitteeEventsRequest) GetEventTypes() []CommitteeEventNotification_Type {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+type BlockNotification struct {
+	Type                 BlockNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.BlockNotification_Type" json:"type,omitempty"`
+	Block                *BlockInfo             `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *BlockNotification) Reset()         { *m = BlockNotification{} }
+func (m *BlockNotification) String() string { return proto.CompactTextString(m) }
+func (*BlockNotification) ProtoMessage()    {}
+func (*BlockNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{45}
+}
+func (m *BlockNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockNotification.Unmarshal(m, b)
+}
+func (m *BlockNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockNotification.Marshal(b, m, deterministic)
+}
+func (dst *BlockNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockNotification.Merge(dst, src)
+}
+func (m *BlockNotification) XXX_Size() int {
+	return xxx_messageInfo_BlockNotification.Size(m)
+}
+func (m *BlockNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockNotification proto.InternalMessageInfo
+
+func (m *BlockNotification) GetType() BlockNotification_Type {
+	if m != nil {
+		return m.Type
+	}
+	return BlockNotification_CONNECTED
+}
+
+func (m *BlockNotification) GetBlock() *BlockInfo {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type CommitteeEventNotification struct {
+	Type CommitteeEventNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.CommitteeEventNotification_Type" json:"type,omitempty"`
+	// Set when the block that caused the event was disconnected and the
+	// event is to be undone.
+	Removed     bool   `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	BlockHash   []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight int32  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*CommitteeEventNotification_ConvertItem
+	//	*CommitteeEventNotification_Pledge
+	//	*CommitteeEventNotification_PoolBalance_
+	Event                isCommitteeEventNotification_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *CommitteeEventNotification) Reset()         { *m = CommitteeEventNotification{} }
+func (m *CommitteeEventNotification) String() string { return proto.CompactTextString(m) }
+func (*CommitteeEventNotification) ProtoMessage()    {}
+func (*CommitteeEventNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{46}
+}
+func (m *CommitteeEventNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitteeEventNotification.Unmarshal(m, b)
+}
+func (m *CommitteeEventNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitteeEventNotification.Marshal(b, m, deterministic)
+}
+func (dst *CommitteeEventNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeEventNotification.Merge(dst, src)
+}
+func (m *CommitteeEventNotification) XXX_Size() int {
+	return xxx_messageInfo_CommitteeEventNotification.Size(m)
+}
+func (m *CommitteeEventNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeEventNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeEventNotification proto.InternalMessageInfo
+
+func (m *CommitteeEventNotification) GetType() CommitteeEventNotification_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CommitteeEventNotification_CONVERT_ITEM_CREATED
+}
+
+func (m *CommitteeEventNotification) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+func (m *CommitteeEventNotification) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *CommitteeEventNotification) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type isCommitteeEventNotification_Event interface {
+	isCommitteeEventNotification_Event()
+}
+
+type CommitteeEventNotification_ConvertItem struct {
+	ConvertItem *ConvertItem `protobuf:"bytes,5,opt,name=convert_item,json=convertItem,proto3,oneof"`
+}
+
+type CommitteeEventNotification_Pledge struct {
+	Pledge *Pledge `protobuf:"bytes,6,opt,name=pledge,proto3,oneof"`
+}
+
+type CommitteeEventNotification_PoolBalance_ struct {
+	PoolBalance *CommitteeEventNotification_PoolBalance `protobuf:"bytes,7,opt,name=pool_balance,json=poolBalance,proto3,oneof"`
+}
+
+func (*CommitteeEventNotification_ConvertItem) isCommitteeEventNotification_Event() {}
+
+func (*CommitteeEventNotification_Pledge) isCommitteeEventNotification_Event() {}
+
+func (*CommitteeEventNotification_PoolBalance_) isCommitteeEventNotification_Event() {}
+
+func (m *CommitteeEventNotification) GetEvent() isCommitteeEventNotification_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *CommitteeEventNotification) GetConvertItem() *ConvertItem {
+	if x, ok := m.GetEvent().(*CommitteeEventNotification_ConvertItem); ok {
+		return x.ConvertItem
+	}
+	return nil
+}
+
+func (m *CommitteeEventNotification) GetPledge() *Pledge {
+	if x, ok := m.GetEvent().(*CommitteeEventNotification_Pledge); ok {
+		return x.Pledge
+	}
+	return nil
+}
+
+func (m *CommitteeEventNotification) GetPoolBalance() *CommitteeEventNotification_PoolBalance {
+	if x, ok := m.GetEvent().(*CommitteeEventNotification_PoolBalance_); ok {
+		return x.PoolBalance
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CommitteeEventNotification) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CommitteeEventNotification_OneofMarshaler, _CommitteeEventNotification_OneofUnmarshaler, _CommitteeEventNotification_OneofSizer, []interface{}{
+		(*CommitteeEventNotification_ConvertItem)(nil),
+		(*CommitteeEventNotification_Pledge)(nil),
+		(*CommitteeEventNotification_PoolBalance_)(nil),
+	}
+}
+
+func _CommitteeEventNotification_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CommitteeEventNotification)
+	// event
+	switch x := m.Event.(type) {
+	case *CommitteeEventNotification_ConvertItem:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ConvertItem); err != nil {
+			return err
+		}
+	case *CommitteeEventNotification_Pledge:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Pledge); err != nil {
+			return err
+		}
+	case *CommitteeEventNotification_PoolBalance_:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PoolBalance); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CommitteeEventNotification.Event has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _CommitteeEventNotification_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CommitteeEventNotification)
+	switch tag {
+	case 5: // event.convert_item
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConvertItem)
+		err := b.DecodeMessage(msg)
+		m.Event = &CommitteeEventNotification_ConvertItem{msg}
+		return true, err
+	case 6: // event.pledge
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Pledge)
+		err := b.DecodeMessage(msg)
+		m.Event = &CommitteeEventNotification_Pledge{msg}
+		return true, err
+	case 7: // event.pool_balance
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CommitteeEventNotification_PoolBalance)
+		err := b.DecodeMessage(msg)
+		m.Event = &CommitteeEventNotification_PoolBalance_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _CommitteeEventNotification_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CommitteeEventNotification)
+	// event
+	switch x := m.Event.(type) {
+	case *CommitteeEventNotification_ConvertItem:
+		s := proto.Size(x.ConvertItem)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CommitteeEventNotification_Pledge:
+		s := proto.Size(x.Pledge)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CommitteeEventNotification_PoolBalance_:
+		s := proto.Size(x.PoolBalance)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type CommitteeEventNotification_PoolBalance struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              int64    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitteeEventNotification_PoolBalance) Reset() {
+	*m = CommitteeEventNotification_PoolBalance{}
+}
+func (m *CommitteeEventNotification_PoolBalance) String() string { return proto.CompactTextString(m) }
+func (*CommitteeEventNotification_PoolBalance) ProtoMessage()    {}
+func (*CommitteeEventNotification_PoolBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{46, 0}
+}
+func (m *CommitteeEventNotification_PoolBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitteeEventNotification_PoolBalance.Unmarshal(m, b)
+}
+func (m *CommitteeEventNotification_PoolBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitteeEventNotification_PoolBalance.Marshal(b, m, deterministic)
+}
+func (dst *CommitteeEventNotification_PoolBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeEventNotification_PoolBalance.Merge(dst, src)
+}
+func (m *CommitteeEventNotification_PoolBalance) XXX_Size() int {
+	return xxx_messageInfo_CommitteeEventNotification_PoolBalance.Size(m)
+}
+func (m *CommitteeEventNotification_PoolBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeEventNotification_PoolBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeEventNotification_PoolBalance proto.InternalMessageInfo
+
+func (m *CommitteeEventNotification_PoolBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CommitteeEventNotification_PoolBalance) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type TransactionNotification struct {
+	Type TransactionNotification_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.TransactionNotification_Type" json:"type,omitempty"`
+	// Types that are valid to be assigned to Transaction:
+	//	*TransactionNotification_ConfirmedTransaction
+	//	*TransactionNotification_UnconfirmedTransaction
+	Transaction          isTransactionNotification_Transaction `protobuf_oneof:"transaction"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *TransactionNotification) Reset()         { *m = TransactionNotification{} }
+func (m *TransactionNotification) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotification) ProtoMessage()    {}
+func (*TransactionNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{47}
+}
+func (m *TransactionNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNotification.Unmarshal(m, b)
+}
+func (m *TransactionNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNotification.Marshal(b, m, deterministic)
+}
+func (dst *TransactionNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNotification.Merge(dst, src)
+}
+func (m *TransactionNotification) XXX_Size() int {
+	return xxx_messageInfo_TransactionNotification.Size(m)
+}
+func (m *TransactionNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNotification proto.InternalMessageInfo
+
+func (m *TransactionNotification) GetType() TransactionNotification_Type {
+	if m != nil {
+		return m.Type
+	}
+	return TransactionNotification_UNCONFIRMED
+}
+
+type isTransactionNotification_Transaction interface {
+	isTransactionNotification_Transaction()
+}
+
+type TransactionNotification_ConfirmedTransaction struct {
+	ConfirmedTransaction *Transaction `protobuf:"bytes,2,opt,name=confirmed_transaction,json=confirmedTransaction,proto3,oneof"`
+}
+
+type TransactionNotification_UnconfirmedTransaction struct {
+	UnconfirmedTransaction *MempoolTransaction `protobuf:"bytes,3,opt,name=unconfirmed_transaction,json=unconfirmedTransaction,proto3,oneof"`
+}
+
+func (*TransactionNotification_ConfirmedTransaction) isTransactionNotification_Transaction() {}
+
+func (*TransactionNotification_UnconfirmedTransaction) isTransactionNotification_Transaction() {}
+
+func (m *TransactionNotification) GetTransaction() isTransactionNotification_Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *TransactionNotification) GetConfirmedTransaction() *Transaction {
+	if x, ok := m.GetTransaction().(*TransactionNotification_ConfirmedTransaction); ok {
+		return x.ConfirmedTransaction
+	}
+	return nil
+}
+
+func (m *TransactionNotification) GetUnconfirmedTransaction() *MempoolTransaction {
+	if x, ok := m.GetTransaction().(*TransactionNotification_UnconfirmedTransaction); ok {
+		return x.UnconfirmedTransaction
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*TransactionNotification) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _TransactionNotification_OneofMarshaler, _TransactionNotification_OneofUnmarshaler, _TransactionNotification_OneofSizer, []interface{}{
+		(*TransactionNotification_ConfirmedTransaction)(nil),
+		(*TransactionNotification_UnconfirmedTransaction)(nil),
+	}
+}
+
+func _TransactionNotification_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*TransactionNotification)
+	// transaction
+	switch x := m.Transaction.(type) {
+	case *TransactionNotification_ConfirmedTransaction:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ConfirmedTransaction); err != nil {
+			return err
+		}
+	case *TransactionNotification_UnconfirmedTransaction:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UnconfirmedTransaction); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("TransactionNotification.Transaction has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _TransactionNotification_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*TransactionNotification)
+	switch tag {
+	case 2: // transaction.confirmed_transaction
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Transaction)
+		err := b.DecodeMessage(msg)
+		m.Transaction = &TransactionNotification_ConfirmedTransaction{msg}
+		return true, err
+	case 3: // transaction.unconfirmed_transaction
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MempoolTransaction)
+		err := b.DecodeMessage(msg)
+		m.Transaction = &TransactionNotification_UnconfirmedTransaction{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _TransactionNotification_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*TransactionNotification)
+	// transaction
+	switch x := m.Transaction.(type) {
+	case *TransactionNotification_ConfirmedTransaction:
+		s := proto.Size(x.ConfirmedTransaction)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TransactionNotification_UnconfirmedTransaction:
+		s := proto.Size(x.UnconfirmedTransaction)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type BlockInfo struct {
+	// Identification.
+	Hash   []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height int32  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Block header data.
+	Version       int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	PreviousBlock []byte `protobuf:"bytes,4,opt,name=previous_block,json=previousBlock,proto3" json:"previous_block,omitempty"`
+	MerkleRoot    []byte `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Timestamp     int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Bits          uint32 `protobuf:"varint,7,opt,name=bits,proto3" json:"bits,omitempty"`
+	Nonce         uint64 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Metadata.
+	Confirmations        int32    `protobuf:"varint,9,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Difficulty           float64  `protobuf:"fixed64,10,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	NextBlockHash        []byte   `protobuf:"bytes,11,opt,name=next_block_hash,json=nextBlockHash,proto3" json:"next_block_hash,omitempty"`
+	Size                 int32    `protobuf:"varint,12,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
+func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockInfo) ProtoMessage()    {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{48}
+}
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfo.Unmarshal(m, b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+}
+func (dst *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(dst, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return xxx_messageInfo_BlockInfo.Size(m)
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetPreviousBlock() []byte {
+	if m != nil {
+		return m.PreviousBlock
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetBits() uint32 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetDifficulty() float64 {
+	if m != nil {
+		return m.Difficulty
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetNextBlockHash() []byte {
+	if m != nil {
+		return m.NextBlockHash
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type Block struct {
+	Info                 *BlockInfo               `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	TransactionData      []*Block_TransactionData `protobuf:"bytes,2,rep,name=transaction_data,json=transactionData,proto3" json:"transaction_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{49}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block.Unmarshal(m, b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+}
+func (dst *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(dst, src)
+}
+func (m *Block) XXX_Size() int {
+	return xxx_messageInfo_Block.Size(m)
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetInfo() *BlockInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *Block) GetTransactionData() []*Block_TransactionData {
+	if m != nil {
+		return m.TransactionData
+	}
+	return nil
+}
+
+type Block_TransactionData struct {
+	// Either one of the two following is provided, depending on the request.
+	//
+	// Types that are valid to be assigned to TxidsOrTxs:
+	//	*Block_TransactionData_TransactionHash
+	//	*Block_TransactionData_Transaction
+	TxidsOrTxs           isBlock_TransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *Block_TransactionData) Reset()         { *m = Block_TransactionData{} }
+func (m *Block_TransactionData) String() string { return proto.CompactTextString(m) }
+func (*Block_TransactionData) ProtoMessage()    {}
+func (*Block_TransactionData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{49, 0}
+}
+func (m *Block_TransactionData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Block_TransactionData.Unmarshal(m, b)
+}
+func (m *Block_TransactionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Block_TransactionData.Marshal(b, m, deterministic)
+}
+func (dst *Block_TransactionData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block_TransactionData.Merge(dst, src)
+}
+func (m *Block_TransactionData) XXX_Size() int {
+	return xxx_messageInfo_Block_TransactionData.Size(m)
+}
+func (m *Block_TransactionData) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block_TransactionData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block_TransactionData proto.InternalMessageInfo
+
+type isBlock_TransactionData_TxidsOrTxs interface {
+	isBlock_TransactionData_TxidsOrTxs()
+}
+
+type Block_TransactionData_TransactionHash struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3,oneof"`
+}
+
+type Block_TransactionData_Transaction struct {
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+}
+
+func (*Block_TransactionData_TransactionHash) isBlock_TransactionData_TxidsOrTxs() {}
+
+func (*Block_TransactionData_Transaction) isBlock_TransactionData_TxidsOrTxs() {}
+
+func (m *Block_TransactionData) GetTxidsOrTxs() isBlock_TransactionData_TxidsOrTxs {
+	if m != nil {
+		return m.TxidsOrTxs
+	}
+	return nil
+}
+
+func (m *Block_TransactionData) GetTransactionHash() []byte {
+	if x, ok := m.GetTxidsOrTxs().(*Block_TransactionData_TransactionHash); ok {
+		return x.TransactionHash
+	}
+	return nil
+}
+
+func (m *Block_TransactionData) GetTransaction() *Transaction {
+	if x, ok := m.GetTxidsOrTxs().(*Block_TransactionData_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Block_TransactionData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Block_TransactionData_OneofMarshaler, _Block_TransactionData_OneofUnmarshaler, _Block_TransactionData_OneofSizer, []interface{}{
+		(*Block_TransactionData_TransactionHash)(nil),
+		(*Block_TransactionData_Transaction)(nil),
+	}
+}
+
+func _Block_TransactionData_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Block_TransactionData)
+	// txids_or_txs
+	switch x := m.TxidsOrTxs.(type) {
+	case *Block_TransactionData_TransactionHash:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.TransactionHash)
+	case *Block_TransactionData_Transaction:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transaction); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Block_TransactionData.TxidsOrTxs has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Block_TransactionData_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Block_TransactionData)
+	switch tag {
+	case 1: // txids_or_txs.transaction_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.TxidsOrTxs = &Block_TransactionData_TransactionHash{x}
+		return true, err
+	case 2: // txids_or_txs.transaction
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Transaction)
+		err := b.DecodeMessage(msg)
+		m.TxidsOrTxs = &Block_TransactionData_Transaction{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Block_TransactionData_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Block_TransactionData)
+	// txids_or_txs
+	switch x := m.TxidsOrTxs.(type) {
+	case *Block_TransactionData_TransactionHash:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.TransactionHash)))
+		n += len(x.TransactionHash)
+	case *Block_TransactionData_Transaction:
+		s := proto.Size(x.Transaction)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Transaction struct {
+	Hash     []byte                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Version  int32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Inputs   []*Transaction_Input  `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs  []*Transaction_Output `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	LockTime uint32                `protobuf:"varint,5,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	// Metadata
+	Size                 int32    `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Timestamp            int64    `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Confirmations        int32    `protobuf:"varint,10,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	BlockHeight          int32    `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,12,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()    {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{50}
+}
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction.Unmarshal(m, b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+}
+func (dst *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(dst, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return xxx_messageInfo_Transaction.Size(m)
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Transaction) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Transaction) GetInputs() []*Transaction_Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *Transaction) GetOutputs() []*Transaction_Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *Transaction) GetLockTime() uint32 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
+func (m *Transaction) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Transaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Transaction) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *Transaction) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Transaction) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type Transaction_Input struct {
+	Index                uint32                      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Outpoint             *Transaction_Input_Outpoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	SignatureScript      []byte                      `protobuf:"bytes,3,opt,name=signature_script,json=signatureScript,proto3" json:"signature_script,omitempty"`
+	Sequence             uint32                      `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Value                int64                       `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	PreviousScript       []byte                      `protobuf:"bytes,6,opt,name=previous_script,json=previousScript,proto3" json:"previous_script,omitempty"`
+	Address              string                      `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *Transaction_Input) Reset()         { *m = Transaction_Input{} }
+func (m *Transaction_Input) String() string { return proto.CompactTextString(m) }
+func (*Transaction_Input) ProtoMessage()    {}
+func (*Transaction_Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{50, 0}
+}
+func (m *Transaction_Input) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction_Input.Unmarshal(m, b)
+}
+func (m *Transaction_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction_Input.Marshal(b, m, deterministic)
+}
+func (dst *Transaction_Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction_Input.Merge(dst, src)
+}
+func (m *Transaction_Input) XXX_Size() int {
+	return xxx_messageInfo_Transaction_Input.Size(m)
+}
+func (m *Transaction_Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction_Input.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction_Input proto.InternalMessageInfo
+
+func (m *Transaction_Input) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Transaction_Input) GetOutpoint() *Transaction_Input_Outpoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *Transaction_Input) GetSignatureScript() []byte {
+	if m != nil {
+		return m.SignatureScript
+	}
+	return nil
+}
+
+func (m *Transaction_Input) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Transaction_Input) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Transaction_Input) GetPreviousScript() []byte {
+	if m != nil {
+		return m.PreviousScript
+	}
+	return nil
+}
+
+func (m *Transaction_Input) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type Transaction_Input_Outpoint struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction_Input_Outpoint) Reset()         { *m = Transaction_Input_Outpoint{} }
+func (m *Transaction_Input_Outpoint) String() string { return proto.CompactTextString(m) }
+func (*Transaction_Input_Outpoint) ProtoMessage()    {}
+func (*Transaction_Input_Outpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{50, 0, 0}
+}
+func (m *Transaction_Input_Outpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction_Input_Outpoint.Unmarshal(m, b)
+}
+func (m *Transaction_Input_Outpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction_Input_Outpoint.Marshal(b, m, deterministic)
+}
+func (dst *Transaction_Input_Outpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction_Input_Outpoint.Merge(dst, src)
+}
+func (m *Transaction_Input_Outpoint) XXX_Size() int {
+	return xxx_messageInfo_Transaction_Input_Outpoint.Size(m)
+}
+func (m *Transaction_Input_Outpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction_Input_Outpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction_Input_Outpoint proto.InternalMessageInfo
+
+func (m *Transaction_Input_Outpoint) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Transaction_Input_Outpoint) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type Transaction_Output struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	PubkeyScript         []byte   `protobuf:"bytes,3,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
+	Address              string   `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	ScriptClass          string   `protobuf:"bytes,5,opt,name=script_class,json=scriptClass,proto3" json:"script_class,omitempty"`
+	DisassembledScript   string   `protobuf:"bytes,6,opt,name=disassembled_script,json=disassembledScript,proto3" json:"disassembled_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction_Output) Reset()         { *m = Transaction_Output{} }
+func (m *Transaction_Output) String() string { return proto.CompactTextString(m) }
+func (*Transaction_Output) ProtoMessage()    {}
+func (*Transaction_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{50, 1}
+}
+func (m *Transaction_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transaction_Output.Unmarshal(m, b)
+}
+func (m *Transaction_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transaction_Output.Marshal(b, m, deterministic)
+}
+func (dst *Transaction_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction_Output.Merge(dst, src)
+}
+func (m *Transaction_Output) XXX_Size() int {
+	return xxx_messageInfo_Transaction_Output.Size(m)
+}
+func (m *Transaction_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction_Output proto.InternalMessageInfo
+
+func (m *Transaction_Output) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Transaction_Output) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Transaction_Output) GetPubkeyScript() []byte {
+	if m != nil {
+		return m.PubkeyScript
+	}
+	return nil
+}
+
+func (m *Transaction_Output) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Transaction_Output) GetScriptClass() string {
+	if m != nil {
+		return m.ScriptClass
+	}
+	return ""
+}
+
+func (m *Transaction_Output) GetDisassembledScript() string {
+	if m != nil {
+		return m.DisassembledScript
+	}
+	return ""
+}
+
+type MempoolTransaction struct {
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The time when the transaction was added too the pool.
+	AddedTime int64 `protobuf:"varint,2,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+	// The block height when the transaction was added to the pool.
+	AddedHeight int32 `protobuf:"varint,3,opt,name=added_height,json=addedHeight,proto3" json:"added_height,omitempty"`
+	// The total fee in satoshi the transaction pays.
+	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee in satoshi per kilobyte the transaction pays.
+	FeePerKb int64 `protobuf:"varint,5,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	// The priority of the transaction when it was added to the pool.
+	StartingPriority     float64  `protobuf:"fixed64,6,opt,name=starting_priority,json=startingPriority,proto3" json:"starting_priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolTransaction) Reset()         { *m = MempoolTransaction{} }
+func (m *MempoolTransaction) String() string { return proto.CompactTextString(m) }
+func (*MempoolTransaction) ProtoMessage()    {}
+func (*MempoolTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{51}
+}
+func (m *MempoolTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolTransaction.Unmarshal(m, b)
+}
+func (m *MempoolTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolTransaction.Marshal(b, m, deterministic)
+}
+func (dst *MempoolTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolTransaction.Merge(dst, src)
+}
+func (m *MempoolTransaction) XXX_Size() int {
+	return xxx_messageInfo_MempoolTransaction.Size(m)
+}
+func (m *MempoolTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolTransaction proto.InternalMessageInfo
+
+func (m *MempoolTransaction) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *MempoolTransaction) GetAddedTime() int64 {
+	if m != nil {
+		return m.AddedTime
+	}
+	return 0
+}
+
+func (m *MempoolTransaction) GetAddedHeight() int32 {
+	if m != nil {
+		return m.AddedHeight
+	}
+	return 0
+}
+
+func (m *MempoolTransaction) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *MempoolTransaction) GetFeePerKb() int64 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
+}
+
+func (m *MempoolTransaction) GetStartingPriority() float64 {
+	if m != nil {
+		return m.StartingPriority
+	}
+	return 0
+}
+
+type UnspentOutput struct {
+	Outpoint             *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	PubkeyScript         []byte                      `protobuf:"bytes,2,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
+	Value                int64                       `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	IsCoinbase           bool                        `protobuf:"varint,4,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
+	BlockHeight          int32                       `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *UnspentOutput) Reset()         { *m = UnspentOutput{} }
+func (m *UnspentOutput) String() string { return proto.CompactTextString(m) }
+func (*UnspentOutput) ProtoMessage()    {}
+func (*UnspentOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{52}
+}
+func (m *UnspentOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnspentOutput.Unmarshal(m, b)
+}
+func (m *UnspentOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnspentOutput.Marshal(b, m, deterministic)
+}
+func (dst *UnspentOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnspentOutput.Merge(dst, src)
+}
+func (m *UnspentOutput) XXX_Size() int {
+	return xxx_messageInfo_UnspentOutput.Size(m)
+}
+func (m *UnspentOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnspentOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnspentOutput proto.InternalMessageInfo
+
+func (m *UnspentOutput) GetOutpoint() *Transaction_Input_Outpoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *UnspentOutput) GetPubkeyScript() []byte {
+	if m != nil {
+		return m.PubkeyScript
+	}
+	return nil
+}
+
+func (m *UnspentOutput) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *UnspentOutput) GetIsCoinbase() bool {
+	if m != nil {
+		return m.IsCoinbase
+	}
+	return false
+}
+
+func (m *UnspentOutput) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type TransactionFilter struct {
+	Addresses    []string                      `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Outpoints    []*Transaction_Input_Outpoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	DataElements [][]byte                      `protobuf:"bytes,3,rep,name=data_elements,json=dataElements,proto3" json:"data_elements,omitempty"`
+	// Subscribed/Unsubscribe to everything. Other filters
+	// will be ignored.
+	AllTransactions      bool     `protobuf:"varint,4,opt,name=all_transactions,json=allTransactions,proto3" json:"all_transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionFilter) Reset()         { *m = TransactionFilter{} }
+func (m *TransactionFilter) String() string { return proto.CompactTextString(m) }
+func (*TransactionFilter) ProtoMessage()    {}
+func (*TransactionFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{53}
+}
+func (m *TransactionFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionFilter.Unmarshal(m, b)
+}
+func (m *TransactionFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionFilter.Marshal(b, m, deterministic)
+}
+func (dst *TransactionFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionFilter.Merge(dst, src)
+}
+func (m *TransactionFilter) XXX_Size() int {
+	return xxx_messageInfo_TransactionFilter.Size(m)
+}
+func (m *TransactionFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionFilter proto.InternalMessageInfo
+
+func (m *TransactionFilter) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
 	return nil
 }
 
-func (m *Transaction) GetVersion() int32 {
+func (m *TransactionFilter) GetOutpoints() []*Transaction_Input_Outpoint {
 	if m != nil {
-		return m.Version
+		return m.Outpoints
 	}
-	return 0
+	return nil
 }
 
-func (m *Transaction) GetInputs() []*Transaction_Input {
+func (m *TransactionFilter) GetDataElements() [][]byte {
 	if m != nil {
-		return m.Inputs
+		return m.DataElements
 	}
 	return nil
 }
 
-func (m *Transaction) GetOutputs() []*Transaction_Output {
+func (m *TransactionFilter) GetAllTransactions() bool {
 	if m != nil {
-		return m.Outputs
+		return m.AllTransactions
 	}
-	return nil
+	return false
 }
 
-func (m *Transaction) GetLockTime() uint32 {
+type Pledge struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	ToAddress            []byte   `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	StakingAmount        int64    `protobuf:"varint,5,opt,name=staking_amount,json=stakingAmount,proto3" json:"staking_amount,omitempty"`
+	CoinbaseAddresses    []string `protobuf:"bytes,6,rep,name=coinbase_addresses,json=coinbaseAddresses,proto3" json:"coinbase_addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Pledge) Reset()         { *m = Pledge{} }
+func (m *Pledge) String() string { return proto.CompactTextString(m) }
+func (*Pledge) ProtoMessage()    {}
+func (*Pledge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{54}
+}
+func (m *Pledge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pledge.Unmarshal(m, b)
+}
+func (m *Pledge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pledge.Marshal(b, m, deterministic)
+}
+func (dst *Pledge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pledge.Merge(dst, src)
+}
+func (m *Pledge) XXX_Size() int {
+	return xxx_messageInfo_Pledge.Size(m)
+}
+func (m *Pledge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pledge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pledge proto.InternalMessageInfo
+
+func (m *Pledge) GetId() uint64 {
 	if m != nil {
-		return m.LockTime
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Transaction) GetSize() int32 {
+func (m *Pledge) GetAddress() string {
 	if m != nil {
-		return m.Size
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *Transaction) GetTimestamp() int64 {
+func (m *Pledge) GetPubkey() []byte {
 	if m != nil {
-		return m.Timestamp
+		return m.Pubkey
 	}
-	return 0
+	return nil
 }
 
-func (m *Transaction) GetConfirmations() int32 {
+func (m *Pledge) GetToAddress() []byte {
 	if m != nil {
-		return m.Confirmations
+		return m.ToAddress
 	}
-	return 0
+	return nil
 }
 
-func (m *Transaction) GetBlockHeight() int32 {
+func (m *Pledge) GetStakingAmount() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.StakingAmount
 	}
 	return 0
 }
 
-func (m *Transaction) GetBlockHash() []byte {
+func (m *Pledge) GetCoinbaseAddresses() []string {
 	if m != nil {
-		return m.BlockHash
+		return m.CoinbaseAddresses
 	}
 	return nil
 }
 
-type Transaction_Input struct {
-	Index                uint32                      `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
-	Outpoint             *Transaction_Input_Outpoint `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
-	SignatureScript      []byte                      `protobuf:"bytes,3,opt,name=signature_script,json=signatureScript,proto3" json:"signature_script,omitempty"`
-	Sequence             uint32                      `protobuf:"varint,4,opt,name=sequence" json:"sequence,omitempty"`
-	Value                int64                       `protobuf:"varint,5,opt,name=value" json:"value,omitempty"`
-	PreviousScript       []byte                      `protobuf:"bytes,6,opt,name=previous_script,json=previousScript,proto3" json:"previous_script,omitempty"`
-	Address              string                      `protobuf:"bytes,7,opt,name=address" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type Committee struct {
+	Id                   uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartHeight          int64               `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight            int64               `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Members              []*Committee_Member `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	BackMembers          []*Committee_Member `protobuf:"bytes,5,rep,name=back_members,json=backMembers,proto3" json:"back_members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *Transaction_Input) Reset()         { *m = Transaction_Input{} }
-func (m *Transaction_Input) String() string { return proto.CompactTextString(m) }
-func (*Transaction_Input) ProtoMessage()    {}
-func (*Transaction_Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{34, 0}
+func (m *Committee) Reset()         { *m = Committee{} }
+func (m *Committee) String() string { return proto.CompactTextString(m) }
+func (*Committee) ProtoMessage()    {}
+func (*Committee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{55}
 }
-func (m *Transaction_Input) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transaction_Input.Unmarshal(m, b)
+func (m *Committee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Committee.Unmarshal(m, b)
 }
-func (m *Transaction_Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transaction_Input.Marshal(b, m, deterministic)
+func (m *Committee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Committee.Marshal(b, m, deterministic)
 }
-func (dst *Transaction_Input) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transaction_Input.Merge(dst, src)
+func (dst *Committee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Committee.Merge(dst, src)
 }
-func (m *Transaction_Input) XXX_Size() int {
-	return xxx_messageInfo_Transaction_Input.Size(m)
+func (m *Committee) XXX_Size() int {
+	return xxx_messageInfo_Committee.Size(m)
 }
-func (m *Transaction_Input) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transaction_Input.DiscardUnknown(m)
+func (m *Committee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Committee.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Transaction_Input proto.InternalMessageInfo
+var xxx_messageInfo_Committee proto.InternalMessageInfo
 
-func (m *Transaction_Input) GetIndex() uint32 {
+func (m *Committee) GetId() uint64 {
 	if m != nil {
-		return m.Index
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Transaction_Input) GetOutpoint() *Transaction_Input_Outpoint {
-	if m != nil {
-		return m.Outpoint
-	}
-	return nil
-}
-
-func (m *Transaction_Input) GetSignatureScript() []byte {
-	if m != nil {
-		return m.SignatureScript
-	}
-	return nil
-}
-
-func (m *Transaction_Input) GetSequence() uint32 {
+func (m *Committee) GetStartHeight() int64 {
 	if m != nil {
-		return m.Sequence
+		return m.StartHeight
 	}
 	return 0
 }
 
-func (m *Transaction_Input) GetValue() int64 {
+func (m *Committee) GetEndHeight() int64 {
 	if m != nil {
-		return m.Value
+		return m.EndHeight
 	}
 	return 0
 }
 
-func (m *Transaction_Input) GetPreviousScript() []byte {
+func (m *Committee) GetMembers() []*Committee_Member {
 	if m != nil {
-		return m.PreviousScript
+		return m.Members
 	}
 	return nil
 }
 
-func (m *Transaction_Input) GetAddress() string {
+func (m *Committee) GetBackMembers() []*Committee_Member {
 	if m != nil {
-		return m.Address
+		return m.BackMembers
 	}
-	return ""
+	return nil
 }
 
-type Transaction_Input_Outpoint struct {
-	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Index                uint32   `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+type Committee_Member struct {
+	Coinbase             string   `protobuf:"bytes,1,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	CommitteeBase        string   `protobuf:"bytes,2,opt,name=committee_base,json=committeeBase,proto3" json:"committee_base,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Flag                 uint32   `protobuf:"varint,4,opt,name=flag,proto3" json:"flag,omitempty"`
+	Type                 uint32   `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Transaction_Input_Outpoint) Reset()         { *m = Transaction_Input_Outpoint{} }
-func (m *Transaction_Input_Outpoint) String() string { return proto.CompactTextString(m) }
-func (*Transaction_Input_Outpoint) ProtoMessage()    {}
-func (*Transaction_Input_Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{34, 0, 0}
+func (m *Committee_Member) Reset()         { *m = Committee_Member{} }
+func (m *Committee_Member) String() string { return proto.CompactTextString(m) }
+func (*Committee_Member) ProtoMessage()    {}
+func (*Committee_Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{55, 0}
 }
-func (m *Transaction_Input_Outpoint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transaction_Input_Outpoint.Unmarshal(m, b)
+func (m *Committee_Member) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Committee_Member.Unmarshal(m, b)
 }
-func (m *Transaction_Input_Outpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transaction_Input_Outpoint.Marshal(b, m, deterministic)
+func (m *Committee_Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Committee_Member.Marshal(b, m, deterministic)
 }
-func (dst *Transaction_Input_Outpoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transaction_Input_Outpoint.Merge(dst, src)
+func (dst *Committee_Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Committee_Member.Merge(dst, src)
 }
-func (m *Transaction_Input_Outpoint) XXX_Size() int {
-	return xxx_messageInfo_Transaction_Input_Outpoint.Size(m)
+func (m *Committee_Member) XXX_Size() int {
+	return xxx_messageInfo_Committee_Member.Size(m)
 }
-func (m *Transaction_Input_Outpoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transaction_Input_Outpoint.DiscardUnknown(m)
+func (m *Committee_Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Committee_Member.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Transaction_Input_Outpoint proto.InternalMessageInfo
+var xxx_messageInfo_Committee_Member proto.InternalMessageInfo
 
-func (m *Transaction_Input_Outpoint) GetHash() []byte {
+func (m *Committee_Member) GetCoinbase() string {
 	if m != nil {
-		return m.Hash
+		return m.Coinbase
+	}
+	return ""
+}
+
+func (m *Committee_Member) GetCommitteeBase() string {
+	if m != nil {
+		return m.CommitteeBase
+	}
+	return ""
+}
+
+func (m *Committee_Member) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
 	}
 	return nil
 }
 
-func (m *Transaction_Input_Outpoint) GetIndex() uint32 {
+func (m *Committee_Member) GetFlag() uint32 {
 	if m != nil {
-		return m.Index
+		return m.Flag
 	}
 	return 0
 }
 
-type Transaction_Output struct {
-	Index                uint32   `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
-	Value                int64    `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
-	PubkeyScript         []byte   `protobuf:"bytes,3,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
-	Address              string   `protobuf:"bytes,4,opt,name=address" json:"address,omitempty"`
-	ScriptClass          string   `protobuf:"bytes,5,opt,name=script_class,json=scriptClass" json:"script_class,omitempty"`
-	DisassembledScript   string   `protobuf:"bytes,6,opt,name=disassembled_script,json=disassembledScript" json:"disassembled_script,omitempty"`
+func (m *Committee_Member) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+type ConvertItem struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssetType            uint32   `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	ConvertType          uint32   `protobuf:"varint,3,opt,name=convert_type,json=convertType,proto3" json:"convert_type,omitempty"`
+	TxHash               string   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	ExtTxHash            string   `protobuf:"bytes,5,opt,name=ext_tx_hash,json=extTxHash,proto3" json:"ext_tx_hash,omitempty"`
+	ConfirmExtTxHash     string   `protobuf:"bytes,6,opt,name=confirm_ext_tx_hash,json=confirmExtTxHash,proto3" json:"confirm_ext_tx_hash,omitempty"`
+	ToToken              string   `protobuf:"bytes,7,opt,name=to_token,json=toToken,proto3" json:"to_token,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Amount               int64    `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount            int64    `protobuf:"varint,10,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Transaction_Output) Reset()         { *m = Transaction_Output{} }
-func (m *Transaction_Output) String() string { return proto.CompactTextString(m) }
-func (*Transaction_Output) ProtoMessage()    {}
-func (*Transaction_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{34, 1}
+func (m *ConvertItem) Reset()         { *m = ConvertItem{} }
+func (m *ConvertItem) String() string { return proto.CompactTextString(m) }
+func (*ConvertItem) ProtoMessage()    {}
+func (*ConvertItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{56}
 }
-func (m *Transaction_Output) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transaction_Output.Unmarshal(m, b)
+func (m *ConvertItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConvertItem.Unmarshal(m, b)
 }
-func (m *Transaction_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transaction_Output.Marshal(b, m, deterministic)
+func (m *ConvertItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConvertItem.Marshal(b, m, deterministic)
 }
-func (dst *Transaction_Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transaction_Output.Merge(dst, src)
+func (dst *ConvertItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertItem.Merge(dst, src)
 }
-func (m *Transaction_Output) XXX_Size() int {
-	return xxx_messageInfo_Transaction_Output.Size(m)
+func (m *ConvertItem) XXX_Size() int {
+	return xxx_messageInfo_ConvertItem.Size(m)
 }
-func (m *Transaction_Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transaction_Output.DiscardUnknown(m)
+func (m *ConvertItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Transaction_Output proto.InternalMessageInfo
+var xxx_messageInfo_ConvertItem proto.InternalMessageInfo
 
-func (m *Transaction_Output) GetIndex() uint32 {
+func (m *ConvertItem) GetId() uint64 {
 	if m != nil {
-		return m.Index
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Transaction_Output) GetValue() int64 {
+func (m *ConvertItem) GetAssetType() uint32 {
 	if m != nil {
-		return m.Value
+		return m.AssetType
 	}
 	return 0
 }
 
-func (m *Transaction_Output) GetPubkeyScript() []byte {
+func (m *ConvertItem) GetConvertType() uint32 {
 	if m != nil {
-		return m.PubkeyScript
+		return m.ConvertType
 	}
-	return nil
+	return 0
 }
 
-func (m *Transaction_Output) GetAddress() string {
+func (m *ConvertItem) GetTxHash() string {
 	if m != nil {
-		return m.Address
+		return m.TxHash
 	}
 	return ""
 }
 
-func (m *Transaction_Output) GetScriptClass() string {
+func (m *ConvertItem) GetExtTxHash() string {
 	if m != nil {
-		return m.ScriptClass
+		return m.ExtTxHash
 	}
 	return ""
 }
 
-func (m *Transaction_Output) GetDisassembledScript() string {
+func (m *ConvertItem) GetConfirmExtTxHash() string {
 	if m != nil {
-		return m.DisassembledScript
+		return m.ConfirmExtTxHash
 	}
 	return ""
 }
 
-type MempoolTransaction struct {
-	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
-	// The time when the transaction was added too the pool.
-	AddedTime int64 `protobuf:"varint,2,opt,name=added_time,json=addedTime" json:"added_time,omitempty"`
-	// The block height when the transaction was added to the pool.
-	AddedHeight int32 `protobuf:"varint,3,opt,name=added_height,json=addedHeight" json:"added_height,omitempty"`
-	// The total fee in satoshi the transaction pays.
-	Fee int64 `protobuf:"varint,4,opt,name=fee" json:"fee,omitempty"`
-	// The fee in satoshi per kilobyte the transaction pays.
-	FeePerKb int64 `protobuf:"varint,5,opt,name=fee_per_kb,json=feePerKb" json:"fee_per_kb,omitempty"`
-	// The priority of the transaction when it was added to the pool.
-	StartingPriority     float64  `protobuf:"fixed64,6,opt,name=starting_priority,json=startingPriority" json:"starting_priority,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *MempoolTransaction) Reset()         { *m = MempoolTransaction{} }
-func (m *MempoolTransaction) String() string { return proto.CompactTextString(m) }
-func (*MempoolTransaction) ProtoMessage()    {}
-func (*MempoolTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{35}
-}
-func (m *MempoolTransaction) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MempoolTransaction.Unmarshal(m, b)
-}
-func (m *MempoolTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MempoolTransaction.Marshal(b, m, deterministic)
-}
-func (dst *MempoolTransaction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MempoolTransaction.Merge(dst, src)
-}
-func (m *MempoolTransaction) XXX_Size() int {
-	return xxx_messageInfo_MempoolTransaction.Size(m)
-}
-func (m *MempoolTransaction) XXX_DiscardUnknown() {
-	xxx_messageInfo_MempoolTransaction.DiscardUnknown(m)
+func (m *ConvertItem) GetToToken() string {
+	if m != nil {
+		return m.ToToken
+	}
+	return ""
 }
 
-var xxx_messageInfo_MempoolTransaction proto.InternalMessageInfo
-
-func (m *MempoolTransaction) GetTransaction() *Transaction {
+func (m *ConvertItem) GetPubkey() []byte {
 	if m != nil {
-		return m.Transaction
+		return m.Pubkey
 	}
 	return nil
 }
 
-func (m *MempoolTransaction) GetAddedTime() int64 {
+func (m *ConvertItem) GetAmount() int64 {
 	if m != nil {
-		return m.AddedTime
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *MempoolTransaction) GetAddedHeight() int32 {
+func (m *ConvertItem) GetFeeAmount() int64 {
 	if m != nil {
-		return m.AddedHeight
+		return m.FeeAmount
 	}
 	return 0
 }
 
-func (m *MempoolTransaction) GetFee() int64 {
+type PeerNetMsgStats struct {
+	Id                   int32                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addr                 string                            `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Inbound              bool                              `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	Sent                 []*PeerNetMsgStats_MsgStats       `protobuf:"bytes,4,rep,name=sent,proto3" json:"sent,omitempty"`
+	Received             []*PeerNetMsgStats_MsgStats       `protobuf:"bytes,5,rep,name=received,proto3" json:"received,omitempty"`
+	BlockLatency         *PeerNetMsgStats_LatencyHistogram `protobuf:"bytes,6,opt,name=block_latency,json=blockLatency,proto3" json:"block_latency,omitempty"`
+	TxLatency            *PeerNetMsgStats_LatencyHistogram `protobuf:"bytes,7,opt,name=tx_latency,json=txLatency,proto3" json:"tx_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *PeerNetMsgStats) Reset()         { *m = PeerNetMsgStats{} }
+func (m *PeerNetMsgStats) String() string { return proto.CompactTextString(m) }
+func (*PeerNetMsgStats) ProtoMessage()    {}
+func (*PeerNetMsgStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{57}
+}
+func (m *PeerNetMsgStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerNetMsgStats.Unmarshal(m, b)
+}
+func (m *PeerNetMsgStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerNetMsgStats.Marshal(b, m, deterministic)
+}
+func (dst *PeerNetMsgStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerNetMsgStats.Merge(dst, src)
+}
+func (m *PeerNetMsgStats) XXX_Size() int {
+	return xxx_messageInfo_PeerNetMsgStats.Size(m)
+}
+func (m *PeerNetMsgStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerNetMsgStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerNetMsgStats proto.InternalMessageInfo
+
+func (m *PeerNetMsgStats) GetId() int32 {
 	if m != nil {
-		return m.Fee
+		return m.Id
 	}
 	return 0
 }
 
-func (m *MempoolTransaction) GetFeePerKb() int64 {
+func (m *PeerNetMsgStats) GetAddr() string {
 	if m != nil {
-		return m.FeePerKb
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *MempoolTransaction) GetStartingPriority() float64 {
+func (m *PeerNetMsgStats) GetInbound() bool {
 	if m != nil {
-		return m.StartingPriority
+		return m.Inbound
 	}
-	return 0
+	return false
 }
 
-type UnspentOutput struct {
-	Outpoint             *Transaction_Input_Outpoint `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
-	PubkeyScript         []byte                      `protobuf:"bytes,2,opt,name=pubkey_script,json=pubkeyScript,proto3" json:"pubkey_script,omitempty"`
-	Value                int64                       `protobuf:"varint,3,opt,name=value" json:"value,omitempty"`
-	IsCoinbase           bool                        `protobuf:"varint,4,opt,name=is_coinbase,json=isCoinbase" json:"is_coinbase,omitempty"`
-	BlockHeight          int32                       `protobuf:"varint,5,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *PeerNetMsgStats) GetSent() []*PeerNetMsgStats_MsgStats {
+	if m != nil {
+		return m.Sent
+	}
+	return nil
 }
 
-func (m *UnspentOutput) Reset()         { *m = UnspentOutput{} }
-func (m *UnspentOutput) String() string { return proto.CompactTextString(m) }
-func (*UnspentOutput) ProtoMessage()    {}
-func (*UnspentOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{36}
-}
-func (m *UnspentOutput) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UnspentOutput.Unmarshal(m, b)
-}
-func (m *UnspentOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UnspentOutput.Marshal(b, m, deterministic)
-}
-func (dst *UnspentOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UnspentOutput.Merge(dst, src)
-}
-func (m *UnspentOutput) XXX_Size() int {
-	return xxx_messageInfo_UnspentOutput.Size(m)
-}
-func (m *UnspentOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_UnspentOutput.DiscardUnknown(m)
+func (m *PeerNetMsgStats) GetReceived() []*PeerNetMsgStats_MsgStats {
+	if m != nil {
+		return m.Received
+	}
+	return nil
 }
 
-var xxx_messageInfo_UnspentOutput proto.InternalMessageInfo
-
-func (m *UnspentOutput) GetOutpoint() *Transaction_Input_Outpoint {
+func (m *PeerNetMsgStats) GetBlockLatency() *PeerNetMsgStats_LatencyHistogram {
 	if m != nil {
-		return m.Outpoint
+		return m.BlockLatency
 	}
 	return nil
 }
 
-func (m *UnspentOutput) GetPubkeyScript() []byte {
+func (m *PeerNetMsgStats) GetTxLatency() *PeerNetMsgStats_LatencyHistogram {
 	if m != nil {
-		return m.PubkeyScript
+		return m.TxLatency
 	}
 	return nil
 }
 
-func (m *UnspentOutput) GetValue() int64 {
+type PeerNetMsgStats_MsgStats struct {
+	Command              string   `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Bytes                uint64   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerNetMsgStats_MsgStats) Reset()         { *m = PeerNetMsgStats_MsgStats{} }
+func (m *PeerNetMsgStats_MsgStats) String() string { return proto.CompactTextString(m) }
+func (*PeerNetMsgStats_MsgStats) ProtoMessage()    {}
+func (*PeerNetMsgStats_MsgStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{57, 0}
+}
+func (m *PeerNetMsgStats_MsgStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerNetMsgStats_MsgStats.Unmarshal(m, b)
+}
+func (m *PeerNetMsgStats_MsgStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerNetMsgStats_MsgStats.Marshal(b, m, deterministic)
+}
+func (dst *PeerNetMsgStats_MsgStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerNetMsgStats_MsgStats.Merge(dst, src)
+}
+func (m *PeerNetMsgStats_MsgStats) XXX_Size() int {
+	return xxx_messageInfo_PeerNetMsgStats_MsgStats.Size(m)
+}
+func (m *PeerNetMsgStats_MsgStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerNetMsgStats_MsgStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerNetMsgStats_MsgStats proto.InternalMessageInfo
+
+func (m *PeerNetMsgStats_MsgStats) GetCommand() string {
 	if m != nil {
-		return m.Value
+		return m.Command
 	}
-	return 0
+	return ""
 }
 
-func (m *UnspentOutput) GetIsCoinbase() bool {
+func (m *PeerNetMsgStats_MsgStats) GetCount() uint64 {
 	if m != nil {
-		return m.IsCoinbase
+		return m.Count
 	}
-	return false
+	return 0
 }
 
-func (m *UnspentOutput) GetBlockHeight() int32 {
+func (m *PeerNetMsgStats_MsgStats) GetBytes() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.Bytes
 	}
 	return 0
 }
 
-type TransactionFilter struct {
-	Addresses    []string                      `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
-	Outpoints    []*Transaction_Input_Outpoint `protobuf:"bytes,2,rep,name=outpoints" json:"outpoints,omitempty"`
-	DataElements [][]byte                      `protobuf:"bytes,3,rep,name=data_elements,json=dataElements,proto3" json:"data_elements,omitempty"`
-	// Subscribed/Unsubscribe to everything. Other filters
-	// will be ignored.
-	AllTransactions      bool     `protobuf:"varint,4,opt,name=all_transactions,json=allTransactions" json:"all_transactions,omitempty"`
+// The latencies between requesting data and receiving it. The
+// counts hold one entry per bound followed by the count of the
+// latencies beyond the last bound.
+type PeerNetMsgStats_LatencyHistogram struct {
+	Count                uint64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MeanMicros           int64    `protobuf:"varint,2,opt,name=mean_micros,json=meanMicros,proto3" json:"mean_micros,omitempty"`
+	BoundsMicros         []int64  `protobuf:"varint,3,rep,packed,name=bounds_micros,json=boundsMicros,proto3" json:"bounds_micros,omitempty"`
+	Counts               []uint64 `protobuf:"varint,4,rep,packed,name=counts,proto3" json:"counts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TransactionFilter) Reset()         { *m = TransactionFilter{} }
-func (m *TransactionFilter) String() string { return proto.CompactTextString(m) }
-func (*TransactionFilter) ProtoMessage()    {}
-func (*TransactionFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_czzrpc_fb7c66a7538a4f4c, []int{37}
+func (m *PeerNetMsgStats_LatencyHistogram) Reset()         { *m = PeerNetMsgStats_LatencyHistogram{} }
+func (m *PeerNetMsgStats_LatencyHistogram) String() string { return proto.CompactTextString(m) }
+func (*PeerNetMsgStats_LatencyHistogram) ProtoMessage()    {}
+func (*PeerNetMsgStats_LatencyHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_czzrpc_9cc4b6e5cc00c728, []int{57, 1}
 }
-func (m *TransactionFilter) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionFilter.Unmarshal(m, b)
+func (m *PeerNetMsgStats_LatencyHistogram) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerNetMsgStats_LatencyHistogram.Unmarshal(m, b)
 }
-func (m *TransactionFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionFilter.Marshal(b, m, deterministic)
+func (m *PeerNetMsgStats_LatencyHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerNetMsgStats_LatencyHistogram.Marshal(b, m, deterministic)
 }
-func (dst *TransactionFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionFilter.Merge(dst, src)
+func (dst *PeerNetMsgStats_LatencyHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerNetMsgStats_LatencyHistogram.Merge(dst, src)
 }
-func (m *TransactionFilter) XXX_Size() int {
-	return xxx_messageInfo_TransactionFilter.Size(m)
+func (m *PeerNetMsgStats_LatencyHistogram) XXX_Size() int {
+	return xxx_messageInfo_PeerNetMsgStats_LatencyHistogram.Size(m)
 }
-func (m *TransactionFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionFilter.DiscardUnknown(m)
+func (m *PeerNetMsgStats_LatencyHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerNetMsgStats_LatencyHistogram.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransactionFilter proto.InternalMessageInfo
+var xxx_messageInfo_PeerNetMsgStats_LatencyHistogram proto.InternalMessageInfo
 
-func (m *TransactionFilter) GetAddresses() []string {
+func (m *PeerNetMsgStats_LatencyHistogram) GetCount() uint64 {
 	if m != nil {
-		return m.Addresses
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionFilter) GetOutpoints() []*Transaction_Input_Outpoint {
+func (m *PeerNetMsgStats_LatencyHistogram) GetMeanMicros() int64 {
 	if m != nil {
-		return m.Outpoints
+		return m.MeanMicros
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionFilter) GetDataElements() [][]byte {
+func (m *PeerNetMsgStats_LatencyHistogram) GetBoundsMicros() []int64 {
 	if m != nil {
-		return m.DataElements
+		return m.BoundsMicros
 	}
 	return nil
 }
 
-func (m *TransactionFilter) GetAllTransactions() bool {
+func (m *PeerNetMsgStats_LatencyHistogram) GetCounts() []uint64 {
 	if m != nil {
-		return m.AllTransactions
+		return m.Counts
 	}
-	return false
+	return nil
 }
 
 func init() {
 	proto.RegisterType((*GetMempoolInfoRequest)(nil), "pb.GetMempoolInfoRequest")
 	proto.RegisterType((*GetMempoolInfoResponse)(nil), "pb.GetMempoolInfoResponse")
+	proto.RegisterType((*GetMempoolEntryRequest)(nil), "pb.GetMempoolEntryRequest")
+	proto.RegisterType((*GetMempoolEntryResponse)(nil), "pb.GetMempoolEntryResponse")
 	proto.RegisterType((*GetBlockchainInfoRequest)(nil), "pb.GetBlockchainInfoRequest")
 	proto.RegisterType((*GetBlockchainInfoResponse)(nil), "pb.GetBlockchainInfoResponse")
 	proto.RegisterType((*GetBlockInfoRequest)(nil), "pb.GetBlockInfoRequest")
@@ -3063,9 +5517,26 @@ func init() {
 	proto.RegisterType((*GetMerkleProofResponse)(nil), "pb.GetMerkleProofResponse")
 	proto.RegisterType((*SubmitTransactionRequest)(nil), "pb.SubmitTransactionRequest")
 	proto.RegisterType((*SubmitTransactionResponse)(nil), "pb.SubmitTransactionResponse")
+	proto.RegisterType((*GetNetMsgStatsRequest)(nil), "pb.GetNetMsgStatsRequest")
+	proto.RegisterType((*GetNetMsgStatsResponse)(nil), "pb.GetNetMsgStatsResponse")
+	proto.RegisterType((*GetCommitteeStateRequest)(nil), "pb.GetCommitteeStateRequest")
+	proto.RegisterType((*GetCommitteeStateResponse)(nil), "pb.GetCommitteeStateResponse")
+	proto.RegisterType((*GetPledgeRequest)(nil), "pb.GetPledgeRequest")
+	proto.RegisterType((*GetPledgeResponse)(nil), "pb.GetPledgeResponse")
+	proto.RegisterType((*ListConvertItemsRequest)(nil), "pb.ListConvertItemsRequest")
+	proto.RegisterType((*ListConvertItemsResponse)(nil), "pb.ListConvertItemsResponse")
+	proto.RegisterType((*GetPoolBalancesRequest)(nil), "pb.GetPoolBalancesRequest")
+	proto.RegisterType((*GetPoolBalancesResponse)(nil), "pb.GetPoolBalancesResponse")
+	proto.RegisterType((*GetPoolBalancesResponse_Pool)(nil), "pb.GetPoolBalancesResponse.Pool")
+	proto.RegisterType((*GetEntangleStateRequest)(nil), "pb.GetEntangleStateRequest")
+	proto.RegisterType((*GetEntangleStateResponse)(nil), "pb.GetEntangleStateResponse")
+	proto.RegisterType((*GetEntangleStateResponse_Beacon)(nil), "pb.GetEntangleStateResponse.Beacon")
 	proto.RegisterType((*SubscribeTransactionsRequest)(nil), "pb.SubscribeTransactionsRequest")
 	proto.RegisterType((*SubscribeBlocksRequest)(nil), "pb.SubscribeBlocksRequest")
+	proto.RegisterType((*SubscribeCommitteeEventsRequest)(nil), "pb.SubscribeCommitteeEventsRequest")
 	proto.RegisterType((*BlockNotification)(nil), "pb.BlockNotification")
+	proto.RegisterType((*CommitteeEventNotification)(nil), "pb.CommitteeEventNotification")
+	proto.RegisterType((*CommitteeEventNotification_PoolBalance)(nil), "pb.CommitteeEventNotification.PoolBalance")
 	proto.RegisterType((*TransactionNotification)(nil), "pb.TransactionNotification")
 	proto.RegisterType((*BlockInfo)(nil), "pb.BlockInfo")
 	proto.RegisterType((*Block)(nil), "pb.Block")
@@ -3077,8 +5548,17 @@ func init() {
 	proto.RegisterType((*MempoolTransaction)(nil), "pb.MempoolTransaction")
 	proto.RegisterType((*UnspentOutput)(nil), "pb.UnspentOutput")
 	proto.RegisterType((*TransactionFilter)(nil), "pb.TransactionFilter")
+	proto.RegisterType((*Pledge)(nil), "pb.Pledge")
+	proto.RegisterType((*Committee)(nil), "pb.Committee")
+	proto.RegisterType((*Committee_Member)(nil), "pb.Committee.Member")
+	proto.RegisterType((*ConvertItem)(nil), "pb.ConvertItem")
+	proto.RegisterType((*PeerNetMsgStats)(nil), "pb.PeerNetMsgStats")
+	proto.RegisterType((*PeerNetMsgStats_MsgStats)(nil), "pb.PeerNetMsgStats.MsgStats")
+	proto.RegisterType((*PeerNetMsgStats_LatencyHistogram)(nil), "pb.PeerNetMsgStats.LatencyHistogram")
+	proto.RegisterEnum("pb.GetMempoolEntryResponse_TransactionType", GetMempoolEntryResponse_TransactionType_name, GetMempoolEntryResponse_TransactionType_value)
 	proto.RegisterEnum("pb.GetBlockchainInfoResponse_BitcoinNet", GetBlockchainInfoResponse_BitcoinNet_name, GetBlockchainInfoResponse_BitcoinNet_value)
 	proto.RegisterEnum("pb.BlockNotification_Type", BlockNotification_Type_name, BlockNotification_Type_value)
+	proto.RegisterEnum("pb.CommitteeEventNotification_Type", CommitteeEventNotification_Type_name, CommitteeEventNotification_Type_value)
 	proto.RegisterEnum("pb.TransactionNotification_Type", TransactionNotification_Type_name, TransactionNotification_Type_value)
 }
 
@@ -3096,6 +5576,9 @@ const _ = grpc.SupportPackageIsVersion4
 type CzzrpcClient interface {
 	// Get info about the mempool.
 	GetMempoolInfo(ctx context.Context, in *GetMempoolInfoRequest, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error)
+	// Get info about a transaction in the mempool along with its
+	// relationships to the other transactions of the mempool.
+	GetMempoolEntry(ctx context.Context, in *GetMempoolEntryRequest, opts ...grpc.CallOption) (*GetMempoolEntryResponse, error)
 	// GetBlockchainInfo info about the blockchain including the most recent
 	// block hash and height.
 	GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error)
@@ -3143,6 +5626,28 @@ type CzzrpcClient interface {
 	GetMerkleProof(ctx context.Context, in *GetMerkleProofRequest, opts ...grpc.CallOption) (*GetMerkleProofResponse, error)
 	// Submit a transaction to all connected peers.
 	SubmitTransaction(ctx context.Context, in *SubmitTransactionRequest, opts ...grpc.CallOption) (*SubmitTransactionResponse, error)
+	// Get per-message traffic counters and block and transaction relay
+	// latencies of the connected peers.
+	GetNetMsgStats(ctx context.Context, in *GetNetMsgStatsRequest, opts ...grpc.CallOption) (*GetNetMsgStatsResponse, error)
+	// Get the committee state as of the given block: the pledges, the
+	// committees and the number of pending and confirmed convert items.
+	//
+	// The committee state exists from the Maui fork on.
+	GetCommitteeState(ctx context.Context, in *GetCommitteeStateRequest, opts ...grpc.CallOption) (*GetCommitteeStateResponse, error)
+	// Get a pledge of the committee state by its id or address.
+	GetPledge(ctx context.Context, in *GetPledgeRequest, opts ...grpc.CallOption) (*GetPledgeResponse, error)
+	// List the pending or confirmed convert items of the committee state,
+	// optionally filtered by asset and convert type. Offers offset and
+	// limit options.
+	ListConvertItems(ctx context.Context, in *ListConvertItemsRequest, opts ...grpc.CallOption) (*ListConvertItemsResponse, error)
+	// Get the outputs and balances of the pool addresses of the committee
+	// state.
+	GetPoolBalances(ctx context.Context, in *GetPoolBalancesRequest, opts ...grpc.CallOption) (*GetPoolBalancesResponse, error)
+	// Get the entangle state of the beacon addresses as of the given block.
+	//
+	// The entangle state exists from the beacon fork up to the Maui fork,
+	// the last one is used for the blocks after the Maui fork.
+	GetEntangleState(ctx context.Context, in *GetEntangleStateRequest, opts ...grpc.CallOption) (*GetEntangleStateResponse, error)
 	// Subscribe to relevant transactions based on the subscription requests.
 	// The parameters to filter transactions on can be updated by sending new
 	// SubscribeTransactionsRequest objects on the stream.
@@ -3166,6 +5671,11 @@ type CzzrpcClient interface {
 	// Subscribe to notifications of new blocks being connected to the blockchain
 	// or blocks being disconnected.
 	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Czzrpc_SubscribeBlocksClient, error)
+	// Subscribe to changes of the committee state caused by blocks being
+	// connected to the blockchain or blocks being disconnected. The events
+	// of a disconnected block are sent again in reverse order and flagged
+	// as removed.
+	SubscribeCommitteeEvents(ctx context.Context, in *SubscribeCommitteeEventsRequest, opts ...grpc.CallOption) (Czzrpc_SubscribeCommitteeEventsClient, error)
 }
 
 type czzrpcClient struct {
@@ -3185,6 +5695,15 @@ func (c *czzrpcClient) GetMempoolInfo(ctx context.Context, in *GetMempoolInfoReq
 	return out, nil
 }
 
+func (c *czzrpcClient) GetMempoolEntry(ctx context.Context, in *GetMempoolEntryRequest, opts ...grpc.CallOption) (*GetMempoolEntryResponse, error) {
+	out := new(GetMempoolEntryResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetMempoolEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *czzrpcClient) GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error) {
 	out := new(GetBlockchainInfoResponse)
 	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetBlockchainInfo", in, out, opts...)
@@ -3302,6 +5821,60 @@ func (c *czzrpcClient) SubmitTransaction(ctx context.Context, in *SubmitTransact
 	return out, nil
 }
 
+func (c *czzrpcClient) GetNetMsgStats(ctx context.Context, in *GetNetMsgStatsRequest, opts ...grpc.CallOption) (*GetNetMsgStatsResponse, error) {
+	out := new(GetNetMsgStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetNetMsgStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *czzrpcClient) GetCommitteeState(ctx context.Context, in *GetCommitteeStateRequest, opts ...grpc.CallOption) (*GetCommitteeStateResponse, error) {
+	out := new(GetCommitteeStateResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetCommitteeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *czzrpcClient) GetPledge(ctx context.Context, in *GetPledgeRequest, opts ...grpc.CallOption) (*GetPledgeResponse, error) {
+	out := new(GetPledgeResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetPledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *czzrpcClient) ListConvertItems(ctx context.Context, in *ListConvertItemsRequest, opts ...grpc.CallOption) (*ListConvertItemsResponse, error) {
+	out := new(ListConvertItemsResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/ListConvertItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *czzrpcClient) GetPoolBalances(ctx context.Context, in *GetPoolBalancesRequest, opts ...grpc.CallOption) (*GetPoolBalancesResponse, error) {
+	out := new(GetPoolBalancesResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetPoolBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *czzrpcClient) GetEntangleState(ctx context.Context, in *GetEntangleStateRequest, opts ...grpc.CallOption) (*GetEntangleStateResponse, error) {
+	out := new(GetEntangleStateResponse)
+	err := c.cc.Invoke(ctx, "/pb.czzrpc/GetEntangleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *czzrpcClient) SubscribeTransactions(ctx context.Context, in *SubscribeTransactionsRequest, opts ...grpc.CallOption) (Czzrpc_SubscribeTransactionsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Czzrpc_serviceDesc.Streams[0], "/pb.czzrpc/SubscribeTransactions", opts...)
 	if err != nil {
@@ -3397,10 +5970,45 @@ func (x *czzrpcSubscribeBlocksClient) Recv() (*BlockNotification, error) {
 	return m, nil
 }
 
+func (c *czzrpcClient) SubscribeCommitteeEvents(ctx context.Context, in *SubscribeCommitteeEventsRequest, opts ...grpc.CallOption) (Czzrpc_SubscribeCommitteeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Czzrpc_serviceDesc.Streams[3], "/pb.czzrpc/SubscribeCommitteeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &czzrpcSubscribeCommitteeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Czzrpc_SubscribeCommitteeEventsClient interface {
+	Recv() (*CommitteeEventNotification, error)
+	grpc.ClientStream
+}
+
+type czzrpcSubscribeCommitteeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *czzrpcSubscribeCommitteeEventsClient) Recv() (*CommitteeEventNotification, error) {
+	m := new(CommitteeEventNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CzzrpcServer is the server API for Czzrpc service.
 type CzzrpcServer interface {
 	// Get info about the mempool.
 	GetMempoolInfo(context.Context, *GetMempoolInfoRequest) (*GetMempoolInfoResponse, error)
+	// Get info about a transaction in the mempool along with its
+	// relationships to the other transactions of the mempool.
+	GetMempoolEntry(context.Context, *GetMempoolEntryRequest) (*GetMempoolEntryResponse, error)
 	// GetBlockchainInfo info about the blockchain including the most recent
 	// block hash and height.
 	GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error)
@@ -3448,6 +6056,28 @@ type CzzrpcServer interface {
 	GetMerkleProof(context.Context, *GetMerkleProofRequest) (*GetMerkleProofResponse, error)
 	// Submit a transaction to all connected peers.
 	SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error)
+	// Get per-message traffic counters and block and transaction relay
+	// latencies of the connected peers.
+	GetNetMsgStats(context.Context, *GetNetMsgStatsRequest) (*GetNetMsgStatsResponse, error)
+	// Get the committee state as of the given block: the pledges, the
+	// committees and the number of pending and confirmed convert items.
+	//
+	// The committee state exists from the Maui fork on.
+	GetCommitteeState(context.Context, *GetCommitteeStateRequest) (*GetCommitteeStateResponse, error)
+	// Get a pledge of the committee state by its id or address.
+	GetPledge(context.Context, *GetPledgeRequest) (*GetPledgeResponse, error)
+	// List the pending or confirmed convert items of the committee state,
+	// optionally filtered by asset and convert type. Offers offset and
+	// limit options.
+	ListConvertItems(context.Context, *ListConvertItemsRequest) (*ListConvertItemsResponse, error)
+	// Get the outputs and balances of the pool addresses of the committee
+	// state.
+	GetPoolBalances(context.Context, *GetPoolBalancesRequest) (*GetPoolBalancesResponse, error)
+	// Get the entangle state of the beacon addresses as of the given block.
+	//
+	// The entangle state exists from the beacon fork up to the Maui fork,
+	// the last one is used for the blocks after the Maui fork.
+	GetEntangleState(context.Context, *GetEntangleStateRequest) (*GetEntangleStateResponse, error)
 	// Subscribe to relevant transactions based on the subscription requests.
 	// The parameters to filter transactions on can be updated by sending new
 	// SubscribeTransactionsRequest objects on the stream.
@@ -3471,6 +6101,11 @@ type CzzrpcServer interface {
 	// Subscribe to notifications of new blocks being connected to the blockchain
 	// or blocks being disconnected.
 	SubscribeBlocks(*SubscribeBlocksRequest, Czzrpc_SubscribeBlocksServer) error
+	// Subscribe to changes of the committee state caused by blocks being
+	// connected to the blockchain or blocks being disconnected. The events
+	// of a disconnected block are sent again in reverse order and flagged
+	// as removed.
+	SubscribeCommitteeEvents(*SubscribeCommitteeEventsRequest, Czzrpc_SubscribeCommitteeEventsServer) error
 }
 
 func RegisterCzzrpcServer(s *grpc.Server, srv CzzrpcServer) {
@@ -3495,6 +6130,24 @@ func _Czzrpc_GetMempoolInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Czzrpc_GetMempoolEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).GetMempoolEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/GetMempoolEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).GetMempoolEntry(ctx, req.(*GetMempoolEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Czzrpc_GetBlockchainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockchainInfoRequest)
 	if err := dec(in); err != nil {
@@ -3729,6 +6382,114 @@ func _Czzrpc_SubmitTransaction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Czzrpc_GetNetMsgStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetMsgStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).GetNetMsgStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/GetNetMsgStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).GetNetMsgStats(ctx, req.(*GetNetMsgStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Czzrpc_GetCommitteeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitteeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).GetCommitteeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/GetCommitteeState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).GetCommitteeState(ctx, req.(*GetCommitteeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Czzrpc_GetPledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).GetPledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/GetPledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).GetPledge(ctx, req.(*GetPledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Czzrpc_ListConvertItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConvertItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).ListConvertItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/ListConvertItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).ListConvertItems(ctx, req.(*ListConvertItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Czzrpc_GetPoolBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).GetPoolBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/GetPoolBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).GetPoolBalances(ctx, req.(*GetPoolBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Czzrpc_GetEntangleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntangleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CzzrpcServer).GetEntangleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.czzrpc/GetEntangleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CzzrpcServer).GetEntangleState(ctx, req.(*GetEntangleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Czzrpc_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeTransactionsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3797,6 +6558,27 @@ func (x *czzrpcSubscribeBlocksServer) Send(m *BlockNotification) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Czzrpc_SubscribeCommitteeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCommitteeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CzzrpcServer).SubscribeCommitteeEvents(m, &czzrpcSubscribeCommitteeEventsServer{stream})
+}
+
+type Czzrpc_SubscribeCommitteeEventsServer interface {
+	Send(*CommitteeEventNotification) error
+	grpc.ServerStream
+}
+
+type czzrpcSubscribeCommitteeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *czzrpcSubscribeCommitteeEventsServer) Send(m *CommitteeEventNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Czzrpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.czzrpc",
 	HandlerType: (*CzzrpcServer)(nil),
@@ -3805,6 +6587,10 @@ var _Czzrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMempoolInfo",
 			Handler:    _Czzrpc_GetMempoolInfo_Handler,
 		},
+		{
+			MethodName: "GetMempoolEntry",
+			Handler:    _Czzrpc_GetMempoolEntry_Handler,
+		},
 		{
 			MethodName: "GetBlockchainInfo",
 			Handler:    _Czzrpc_GetBlockchainInfo_Handler,
@@ -3857,6 +6643,30 @@ var _Czzrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitTransaction",
 			Handler:    _Czzrpc_SubmitTransaction_Handler,
 		},
+		{
+			MethodName: "GetNetMsgStats",
+			Handler:    _Czzrpc_GetNetMsgStats_Handler,
+		},
+		{
+			MethodName: "GetCommitteeState",
+			Handler:    _Czzrpc_GetCommitteeState_Handler,
+		},
+		{
+			MethodName: "GetPledge",
+			Handler:    _Czzrpc_GetPledge_Handler,
+		},
+		{
+			MethodName: "ListConvertItems",
+			Handler:    _Czzrpc_ListConvertItems_Handler,
+		},
+		{
+			MethodName: "GetPoolBalances",
+			Handler:    _Czzrpc_GetPoolBalances_Handler,
+		},
+		{
+			MethodName: "GetEntangleState",
+			Handler:    _Czzrpc_GetEntangleState_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3875,150 +6685,257 @@ var _Czzrpc_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Czzrpc_SubscribeBlocks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeCommitteeEvents",
+			Handler:       _Czzrpc_SubscribeCommitteeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "czzrpc.proto",
 }
 
-func init() { proto.RegisterFile("czzrpc.proto", fileDescriptor_czzrpc_fb7c66a7538a4f4c) }
-
-var fileDescriptor_czzrpc_fb7c66a7538a4f4c = []byte{
-	// 2193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4b, 0x6f, 0x23, 0xc7,
-	0x11, 0xe6, 0x50, 0x14, 0x1f, 0xc5, 0xa1, 0x48, 0xf5, 0xea, 0x41, 0xd1, 0xfb, 0xa0, 0x66, 0xd7,
-	0xbb, 0x0c, 0x84, 0xc8, 0xca, 0xca, 0x81, 0xe1, 0xc4, 0x0b, 0xd8, 0xd2, 0x4a, 0x22, 0xe1, 0xac,
-	0x76, 0xdd, 0x92, 0x13, 0x24, 0x17, 0x62, 0x86, 0x6c, 0x4a, 0x13, 0x91, 0x33, 0xcc, 0x74, 0x73,
-	0x2d, 0xed, 0x29, 0x40, 0x7e, 0x41, 0x0e, 0xf9, 0x01, 0xb9, 0x07, 0xf0, 0x0f, 0xc8, 0x21, 0xc7,
-	0x00, 0xb9, 0xe4, 0x27, 0xe4, 0x10, 0x20, 0xa7, 0x1c, 0x72, 0xca, 0x39, 0xe8, 0xc7, 0xcc, 0xf4,
-	0x70, 0x86, 0x52, 0x62, 0xe7, 0xe2, 0x1b, 0xbb, 0xaa, 0xba, 0xfa, 0xeb, 0x7a, 0x4d, 0x57, 0x11,
-	0xcc, 0xc1, 0xbb, 0x77, 0xc1, 0x74, 0xb0, 0x3b, 0x0d, 0x7c, 0xe6, 0xa3, 0xfc, 0xd4, 0xb1, 0x36,
-	0x61, 0xfd, 0x84, 0xb0, 0x57, 0x64, 0x32, 0xf5, 0xfd, 0x71, 0xcf, 0x1b, 0xf9, 0x98, 0xfc, 0x6a,
-	0x46, 0x28, 0xb3, 0x0e, 0x60, 0x63, 0x9e, 0x41, 0xa7, 0xbe, 0x47, 0x09, 0x42, 0x50, 0xa0, 0xee,
-	0x3b, 0xd2, 0x34, 0xda, 0x46, 0xa7, 0x86, 0xc5, 0x6f, 0xb4, 0x06, 0xcb, 0xce, 0x0d, 0x23, 0xb4,
-	0x99, 0x17, 0x44, 0xb9, 0xb0, 0x5a, 0xd0, 0x3c, 0x21, 0xec, 0x60, 0xec, 0x0f, 0xae, 0x06, 0x97,
-	0xb6, 0xeb, 0xe9, 0xfa, 0xff, 0x99, 0x87, 0xad, 0x0c, 0xa6, 0x3a, 0xa3, 0x07, 0x55, 0xc7, 0x65,
-	0x03, 0xdf, 0xf5, 0xfa, 0x1e, 0x61, 0xe2, 0xa8, 0x95, 0xe7, 0x9d, 0xdd, 0xa9, 0xb3, 0xbb, 0x70,
-	0xcf, 0xee, 0x81, 0xdc, 0x70, 0x4a, 0x18, 0x06, 0x27, 0xfa, 0x8d, 0x1e, 0x41, 0xd5, 0x21, 0x94,
-	0xf5, 0x2f, 0x89, 0x7b, 0x71, 0xc9, 0x04, 0xc0, 0x65, 0x0c, 0x9c, 0xd4, 0x15, 0x14, 0xf4, 0x14,
-	0xea, 0x42, 0xc0, 0xe1, 0x6a, 0xfb, 0x97, 0x36, 0xbd, 0x6c, 0x2e, 0xb5, 0x8d, 0x8e, 0x89, 0x6b,
-	0x9c, 0x2c, 0x0e, 0xeb, 0xda, 0xf4, 0x12, 0x3d, 0x04, 0x18, 0xba, 0xa3, 0x91, 0x3b, 0x98, 0x8d,
-	0xd9, 0x4d, 0xb3, 0xd0, 0x36, 0x3a, 0x06, 0xd6, 0x28, 0xfc, 0xa0, 0x09, 0x19, 0xba, 0xb6, 0xd7,
-	0x67, 0xee, 0x84, 0x34, 0x97, 0xdb, 0x46, 0x67, 0x09, 0x83, 0x24, 0x9d, 0xbb, 0x13, 0x82, 0xb6,
-	0xa0, 0xcc, 0xae, 0xfb, 0xae, 0x37, 0x24, 0xd7, 0xcd, 0x62, 0xdb, 0xe8, 0x94, 0x71, 0x89, 0x5d,
-	0xf7, 0xf8, 0x12, 0x3d, 0x00, 0xb0, 0x87, 0xc3, 0x40, 0x31, 0x4b, 0x82, 0x59, 0xe1, 0x14, 0xc1,
-	0xb6, 0x3e, 0x05, 0x88, 0x6f, 0x87, 0xaa, 0x50, 0x7a, 0xf5, 0x59, 0xef, 0xf4, 0xf4, 0xe8, 0xbc,
-	0x91, 0xe3, 0x0b, 0x7c, 0x74, 0x72, 0x7e, 0x74, 0x76, 0xde, 0x30, 0x90, 0x09, 0x65, 0xfe, 0xeb,
-	0xf4, 0xe8, 0x7c, 0xbf, 0x91, 0x47, 0x00, 0xc5, 0xb3, 0xde, 0x2b, 0x2e, 0xb6, 0x64, 0xfd, 0x0c,
-	0xee, 0x85, 0x96, 0xd3, 0xbc, 0x80, 0xd6, 0xa0, 0x20, 0x2e, 0xcc, 0x0d, 0x6c, 0x76, 0x73, 0x58,
-	0xac, 0x50, 0x13, 0x8a, 0xba, 0xb5, 0xba, 0x39, 0xac, 0xd6, 0x07, 0x0d, 0x58, 0xe1, 0x12, 0x7d,
-	0x3f, 0x50, 0xf6, 0xb4, 0x3e, 0x86, 0xb5, 0xa4, 0x62, 0xe5, 0xc1, 0x6d, 0x28, 0xb8, 0xde, 0xc8,
-	0x17, 0x9a, 0xab, 0xcf, 0x6b, 0xdc, 0x75, 0xb1, 0x90, 0x60, 0x59, 0xbf, 0x36, 0xa0, 0x1e, 0xee,
-	0xfd, 0x86, 0x80, 0xd0, 0x0e, 0xac, 0x8e, 0x66, 0xe3, 0x71, 0x9f, 0x05, 0xb6, 0x47, 0xed, 0x01,
-	0x73, 0x7d, 0x8f, 0x0a, 0xf7, 0x95, 0x71, 0x83, 0x33, 0xce, 0x35, 0x7a, 0x06, 0xfa, 0x7d, 0x68,
-	0xc4, 0x08, 0x14, 0xf2, 0x47, 0xb0, 0x2c, 0x42, 0x41, 0x41, 0xaf, 0x44, 0xd0, 0xb1, 0xa4, 0x5b,
-	0x3f, 0x05, 0x74, 0x42, 0x18, 0xb6, 0xbf, 0xfa, 0x36, 0xc8, 0x33, 0xc0, 0xec, 0x08, 0x1f, 0xc5,
-	0x7a, 0x15, 0x9e, 0x35, 0x1d, 0x8f, 0x19, 0x82, 0xf8, 0xb9, 0x48, 0x5c, 0x21, 0x79, 0xec, 0x8e,
-	0x19, 0x09, 0xfe, 0x7f, 0x38, 0xf6, 0x44, 0xea, 0x27, 0x54, 0x2b, 0x28, 0x1b, 0x50, 0x1c, 0x09,
-	0x8a, 0xc2, 0xa2, 0x56, 0x96, 0x03, 0xab, 0x27, 0x84, 0x75, 0x89, 0x3d, 0x24, 0x01, 0x0d, 0x81,
-	0xec, 0xc1, 0x9a, 0x4c, 0xa9, 0xb1, 0x3f, 0xb0, 0x19, 0x57, 0x6f, 0xd3, 0x4b, 0x42, 0x9b, 0x46,
-	0x7b, 0xa9, 0x63, 0x62, 0x24, 0x78, 0x3f, 0x91, 0xac, 0xae, 0xe0, 0xa0, 0xf7, 0xa0, 0x42, 0x99,
-	0x3f, 0x95, 0x39, 0x98, 0x17, 0x27, 0x94, 0x39, 0x81, 0xb3, 0xad, 0x17, 0xc2, 0xea, 0xd1, 0x19,
-	0x0a, 0xd1, 0x33, 0x28, 0x5d, 0x4a, 0x92, 0xd0, 0x9b, 0x8a, 0xb4, 0x90, 0x6b, 0xed, 0x08, 0x7b,
-	0x69, 0xe1, 0x10, 0xc2, 0x44, 0xba, 0xbd, 0xa4, 0xb5, 0xac, 0xcf, 0x85, 0x05, 0x12, 0xc2, 0xea,
-	0xbc, 0x1f, 0x40, 0x55, 0x0b, 0x35, 0x15, 0x22, 0x75, 0x7e, 0xa6, 0x2e, 0xad, 0xcb, 0x58, 0xbb,
-	0xa2, 0x0a, 0x62, 0xfb, 0xab, 0xff, 0xf2, 0xf0, 0x17, 0xa2, 0x30, 0xce, 0xcb, 0xab, 0xf3, 0xdb,
-	0xe9, 0xf3, 0xcd, 0xe4, 0x71, 0x7f, 0x30, 0xe0, 0xc1, 0x09, 0x61, 0x9f, 0x0d, 0x87, 0x01, 0xa1,
-	0x54, 0x8f, 0xff, 0xf0, 0xd0, 0x26, 0x94, 0x6c, 0xc9, 0x15, 0xfb, 0x2b, 0x38, 0x5c, 0xa2, 0x4d,
-	0x28, 0x79, 0x4e, 0x9f, 0x5e, 0xb9, 0x53, 0x55, 0xc8, 0x8b, 0x9e, 0x73, 0x76, 0xe5, 0x4e, 0x79,
-	0xe9, 0xf2, 0x9c, 0xfe, 0x88, 0xb0, 0x81, 0x2c, 0x8e, 0x35, 0x5c, 0xf2, 0x9c, 0x63, 0xbe, 0x8c,
-	0xe2, 0xad, 0xb0, 0x20, 0xde, 0x96, 0xe7, 0xe2, 0xad, 0x06, 0x55, 0xca, 0xec, 0x40, 0xd5, 0x5b,
-	0xeb, 0x8f, 0x06, 0x3c, 0x5c, 0x04, 0x57, 0xdd, 0xf9, 0x18, 0x36, 0x06, 0xbe, 0x37, 0x72, 0x83,
-	0x09, 0x19, 0x26, 0x13, 0x5d, 0xba, 0x3c, 0x65, 0xfe, 0xf5, 0x48, 0x5c, 0xd7, 0x87, 0xbe, 0x80,
-	0xe6, 0xcc, 0x5b, 0xa0, 0x29, 0x2f, 0x34, 0x6d, 0x70, 0x4d, 0xea, 0x9b, 0xa7, 0x2b, 0xdc, 0xd4,
-	0xf6, 0xe9, 0x2a, 0xad, 0xaf, 0x0d, 0x68, 0x4b, 0x67, 0x7d, 0x57, 0xec, 0xfd, 0x3b, 0x03, 0xb6,
-	0x6f, 0x41, 0xac, 0x4c, 0xfe, 0xc3, 0x5b, 0x4d, 0x6e, 0x2e, 0xb2, 0xf0, 0xc7, 0x77, 0x58, 0xd8,
-	0x5c, 0x6c, 0xc9, 0x1f, 0xc3, 0xa3, 0x38, 0x0c, 0xbe, 0xf4, 0xe8, 0x94, 0x78, 0xec, 0xf5, 0x8c,
-	0x4d, 0x67, 0xec, 0x6e, 0x3b, 0x5a, 0xaf, 0x85, 0x17, 0x16, 0x6c, 0x56, 0x57, 0xda, 0x81, 0x92,
-	0x2f, 0x49, 0x2a, 0x6c, 0x56, 0xb9, 0xb3, 0x13, 0xc2, 0x38, 0x94, 0xb0, 0x0e, 0xd4, 0xb3, 0x28,
-	0xb8, 0x1a, 0x93, 0x37, 0x81, 0xef, 0x8f, 0x42, 0x0c, 0xdf, 0x83, 0x86, 0x76, 0xab, 0xbe, 0x96,
-	0xbc, 0x75, 0x8d, 0x2e, 0x0a, 0xd6, 0x95, 0x7a, 0x41, 0x69, 0x3a, 0x14, 0x94, 0xc7, 0xc9, 0x2f,
-	0xcc, 0x5c, 0xc9, 0x92, 0x3c, 0x5e, 0x6b, 0x55, 0xc1, 0x94, 0x96, 0x53, 0x2b, 0xfe, 0x39, 0x18,
-	0x8d, 0xed, 0x0b, 0xaa, 0x1e, 0x29, 0x72, 0x61, 0x7d, 0x02, 0xcd, 0xb3, 0x99, 0x33, 0x71, 0xb3,
-	0x2a, 0xdc, 0xdd, 0x35, 0xe3, 0x03, 0xd8, 0xca, 0xd8, 0x1d, 0xbf, 0xf7, 0x52, 0x35, 0xea, 0x6f,
-	0x06, 0xdc, 0x3f, 0x9b, 0x39, 0x74, 0x10, 0xb8, 0x0e, 0xc9, 0x8a, 0xf9, 0x7d, 0xa8, 0xd0, 0x90,
-	0xaf, 0xae, 0xb9, 0x3e, 0x97, 0xa6, 0xea, 0xdb, 0x12, 0xcb, 0xa1, 0x8f, 0xa0, 0x3a, 0xf3, 0xe2,
-	0x6d, 0xf9, 0xdb, 0xb6, 0xe9, 0x92, 0xe8, 0x19, 0xd4, 0x5d, 0x6f, 0x30, 0x9e, 0x0d, 0x49, 0x7f,
-	0x22, 0xb3, 0x57, 0xbd, 0x01, 0x56, 0x14, 0x59, 0xe5, 0x34, 0xea, 0x40, 0x23, 0x14, 0x74, 0x3d,
-	0x99, 0x11, 0x22, 0x91, 0x62, 0xc9, 0x9e, 0x27, 0x3c, 0x61, 0x35, 0x61, 0x23, 0xba, 0xa0, 0xa0,
-	0x84, 0x57, 0xb3, 0x7e, 0x6b, 0xc0, 0xaa, 0xa0, 0x9c, 0xfa, 0xcc, 0x1d, 0xb9, 0x03, 0x9b, 0xa3,
-	0x42, 0xbb, 0x50, 0x60, 0x37, 0x53, 0xa2, 0x9e, 0xaa, 0xad, 0xc8, 0xa5, 0xba, 0xd0, 0xee, 0xf9,
-	0xcd, 0x94, 0x60, 0x21, 0x17, 0xc7, 0x40, 0x7e, 0x71, 0x0c, 0x58, 0xcf, 0xa0, 0xc0, 0xb7, 0xa0,
-	0x1a, 0x54, 0x0e, 0x5f, 0x9f, 0x9e, 0x1e, 0x1d, 0x9e, 0x1f, 0xbd, 0x6c, 0xe4, 0x50, 0x03, 0xcc,
-	0x97, 0xbd, 0xb3, 0x98, 0x62, 0x58, 0xbf, 0xcf, 0xc3, 0xa6, 0x66, 0xa3, 0x04, 0xb2, 0x0f, 0x13,
-	0xc8, 0xda, 0x73, 0xe6, 0x5c, 0x84, 0xef, 0x18, 0xd6, 0x33, 0x13, 0x59, 0xe1, 0x9d, 0xaf, 0xb9,
-	0xdd, 0x1c, 0x5e, 0xcb, 0x4a, 0x6c, 0xf4, 0x05, 0x6c, 0x2e, 0x28, 0x09, 0xc2, 0x45, 0x0b, 0x6b,
-	0x6e, 0x37, 0x87, 0x37, 0xb2, 0x6b, 0x85, 0xf5, 0x54, 0x59, 0xa5, 0x0e, 0xd5, 0x2f, 0x4f, 0x0f,
-	0x5f, 0x9f, 0x1e, 0xf7, 0xf0, 0x2b, 0x61, 0x17, 0x69, 0x26, 0xb5, 0x34, 0x78, 0xe5, 0xd3, 0x83,
-	0xfc, 0xef, 0x79, 0xa8, 0x44, 0x16, 0xce, 0x8a, 0x6a, 0x91, 0x72, 0x7a, 0x97, 0x10, 0x3e, 0x32,
-	0x9b, 0x50, 0x7a, 0x4b, 0x02, 0x1a, 0x62, 0x5e, 0xc6, 0xe1, 0x12, 0xbd, 0x0f, 0x2b, 0xd3, 0x80,
-	0xbc, 0x75, 0xfd, 0x19, 0xd5, 0xa2, 0xc9, 0xc4, 0xb5, 0x90, 0x2a, 0x0e, 0x94, 0xad, 0x01, 0xaf,
-	0x03, 0xfd, 0xc0, 0xf7, 0x65, 0x89, 0x36, 0x79, 0x6b, 0xc0, 0x49, 0xd8, 0xf7, 0x19, 0xba, 0x0f,
-	0x15, 0xde, 0x34, 0x50, 0x66, 0x4f, 0xa6, 0xa2, 0x37, 0x58, 0xc2, 0x31, 0x81, 0x63, 0x75, 0x5c,
-	0x46, 0x45, 0x5f, 0x50, 0xc3, 0xe2, 0x37, 0x2f, 0x03, 0x9e, 0xef, 0x0d, 0x48, 0xb3, 0xdc, 0x36,
-	0x3a, 0x05, 0x2c, 0x17, 0xe8, 0x09, 0xd4, 0x94, 0xc9, 0x6c, 0x59, 0x75, 0x2b, 0x02, 0x6f, 0x92,
-	0x38, 0xd7, 0xc9, 0x40, 0xaa, 0x93, 0x79, 0x0a, 0x75, 0x8f, 0x5c, 0x27, 0x3a, 0xa2, 0xaa, 0xbc,
-	0x16, 0x27, 0xc7, 0x1d, 0x51, 0xd8, 0x09, 0x9a, 0xe2, 0x10, 0xf1, 0xdb, 0xfa, 0xb7, 0x01, 0xcb,
-	0xf2, 0xd2, 0x77, 0x77, 0x00, 0xe8, 0x65, 0xb2, 0x9a, 0x0e, 0x6d, 0x66, 0xab, 0x2f, 0xf1, 0x56,
-	0x24, 0xae, 0x47, 0xd9, 0x4b, 0x9b, 0xd9, 0x89, 0x42, 0xcb, 0x09, 0xad, 0xdf, 0x18, 0x50, 0x9f,
-	0x13, 0x42, 0x3b, 0x8b, 0xea, 0x74, 0x37, 0x97, 0xaa, 0xd4, 0x68, 0x3f, 0x59, 0x20, 0x17, 0x46,
-	0xb8, 0x2e, 0x75, 0xb0, 0x02, 0x26, 0xbb, 0x76, 0x87, 0x94, 0x3f, 0x9c, 0xd9, 0x35, 0xb5, 0xfe,
-	0x5a, 0x84, 0xaa, 0x1e, 0xf8, 0x59, 0x01, 0xa6, 0x05, 0x52, 0x3e, 0x19, 0x48, 0xdf, 0x87, 0xa2,
-	0xeb, 0x89, 0x8f, 0xd3, 0x92, 0xb8, 0xff, 0x7c, 0xd5, 0xdb, 0xed, 0x71, 0x2e, 0x56, 0x42, 0x68,
-	0x2f, 0xfe, 0x98, 0x15, 0xe2, 0x97, 0x8b, 0x2e, 0x3f, 0xf7, 0x45, 0xe3, 0x6f, 0x6b, 0xe1, 0xcd,
-	0xa8, 0x37, 0xad, 0xe1, 0x32, 0x27, 0x88, 0xce, 0x34, 0x74, 0x64, 0x39, 0x76, 0x64, 0x32, 0x24,
-	0x2b, 0xf3, 0x21, 0x99, 0x0a, 0x34, 0xc8, 0x0a, 0xb4, 0x6d, 0x30, 0x55, 0x0c, 0xc9, 0xb4, 0xaa,
-	0x0a, 0xa1, 0xaa, 0xa0, 0xa9, 0xee, 0xfb, 0x01, 0x80, 0x16, 0x66, 0xa6, 0x30, 0x56, 0xc5, 0x09,
-	0x43, 0xac, 0xf5, 0x75, 0x1e, 0x96, 0xc5, 0xd5, 0x79, 0xc0, 0xcb, 0xee, 0x58, 0xce, 0x1d, 0xe4,
-	0x02, 0xfd, 0x08, 0xca, 0xfc, 0x86, 0xbe, 0xeb, 0x31, 0xe5, 0xb7, 0x87, 0x99, 0x96, 0x13, 0xf6,
-	0xe0, 0x52, 0x38, 0x92, 0xe7, 0xdf, 0x72, 0xea, 0x5e, 0x78, 0x36, 0x9b, 0x05, 0xa4, 0xcf, 0x2b,
-	0xfd, 0x94, 0xa9, 0x8f, 0x6a, 0x3d, 0xa2, 0x9f, 0x09, 0x32, 0x6a, 0x41, 0x99, 0xf2, 0xf2, 0xcf,
-	0x13, 0xae, 0x20, 0x8d, 0x17, 0xae, 0x39, 0xb0, 0xb7, 0xf6, 0x78, 0x16, 0x76, 0xfc, 0x72, 0xc1,
-	0x3f, 0x49, 0x51, 0x65, 0x50, 0xba, 0x8b, 0x42, 0x77, 0x54, 0x30, 0x94, 0x6a, 0xed, 0x55, 0x53,
-	0x4a, 0xbc, 0x6a, 0x5a, 0x1f, 0x42, 0x39, 0x44, 0x9d, 0x19, 0x4d, 0x91, 0x45, 0xf2, 0x9a, 0x45,
-	0x5a, 0x7f, 0x36, 0xa0, 0x28, 0x9d, 0xbf, 0xc0, 0x64, 0x11, 0xde, 0xbc, 0x8e, 0xf7, 0x31, 0xd4,
-	0xa6, 0x33, 0xe7, 0x8a, 0xdc, 0x24, 0x2d, 0x61, 0x4a, 0x62, 0x1a, 0x6b, 0x21, 0xf9, 0x92, 0xdd,
-	0x06, 0x53, 0xee, 0xeb, 0x0f, 0xc6, 0x36, 0xa5, 0xc2, 0x16, 0x15, 0x5c, 0x95, 0xb4, 0x43, 0x4e,
-	0x42, 0x1f, 0xc0, 0xbd, 0xa1, 0x4b, 0x6d, 0x4a, 0xc9, 0xc4, 0x19, 0x93, 0xa1, 0x6e, 0x95, 0x0a,
-	0x46, 0x3a, 0x4b, 0x9e, 0x66, 0xfd, 0xc3, 0x00, 0x94, 0xfe, 0x30, 0x7c, 0x83, 0x16, 0x4c, 0x8d,
-	0x57, 0xf8, 0xe7, 0x87, 0x47, 0xbf, 0xbc, 0x77, 0x45, 0x50, 0x44, 0xf8, 0x6f, 0x83, 0x29, 0xd9,
-	0x2a, 0x4c, 0x65, 0x91, 0xaf, 0x0a, 0x9a, 0x0a, 0xd3, 0x06, 0x2c, 0x8d, 0x88, 0xf4, 0xfd, 0x12,
-	0xe6, 0x3f, 0xd1, 0x7d, 0x80, 0x11, 0x21, 0xfd, 0x29, 0x09, 0xfa, 0x57, 0x8e, 0xf2, 0x7d, 0x79,
-	0x44, 0xc8, 0x1b, 0x12, 0x7c, 0xee, 0xa0, 0x1d, 0x58, 0x15, 0xaf, 0x6e, 0xd7, 0xbb, 0xe8, 0x4f,
-	0x03, 0xd7, 0x0f, 0x5c, 0x76, 0x23, 0xae, 0x6a, 0xe0, 0x46, 0xc8, 0x78, 0xa3, 0xe8, 0xd6, 0x5f,
-	0x0c, 0xa8, 0x25, 0x1e, 0xa2, 0x89, 0xb0, 0x36, 0xfe, 0xc7, 0xb0, 0x4e, 0x79, 0x32, 0x9f, 0xe1,
-	0xc9, 0x28, 0x08, 0x96, 0xf4, 0x20, 0x78, 0x04, 0x55, 0x97, 0xf6, 0x07, 0xbe, 0xeb, 0x39, 0x36,
-	0x25, 0xea, 0x65, 0x04, 0x2e, 0x3d, 0x54, 0x94, 0x54, 0x42, 0x2f, 0xa7, 0x12, 0xda, 0xfa, 0x93,
-	0x01, 0xab, 0xa9, 0xe7, 0x1a, 0xaf, 0x26, 0x2a, 0x54, 0xd4, 0x04, 0xa0, 0x82, 0x63, 0x02, 0xfa,
-	0x04, 0x2a, 0x21, 0xfc, 0xb0, 0x15, 0xbb, 0xeb, 0xbe, 0xf1, 0x06, 0x7e, 0x61, 0xfe, 0xe5, 0xe8,
-	0x93, 0x31, 0x99, 0x10, 0x4f, 0x95, 0x50, 0x13, 0x9b, 0x9c, 0x78, 0xa4, 0x68, 0x3c, 0xd9, 0xed,
-	0xf9, 0x39, 0x91, 0xbc, 0x5f, 0xdd, 0x4e, 0x8e, 0x89, 0x9e, 0xff, 0x0b, 0xa0, 0x28, 0x07, 0xa5,
-	0xa8, 0x07, 0x2b, 0xc9, 0x29, 0x28, 0xda, 0x52, 0x43, 0xc8, 0xf4, 0xc8, 0xb4, 0xd5, 0xca, 0x62,
-	0xc9, 0x47, 0xb4, 0x95, 0x43, 0x58, 0xcc, 0x48, 0x92, 0xb3, 0x4b, 0x74, 0x7f, 0xc1, 0x48, 0x53,
-	0x2a, 0x7c, 0x70, 0xeb, 0xc0, 0xd3, 0xca, 0xa1, 0x43, 0x30, 0xf5, 0xe1, 0x1b, 0xda, 0xd4, 0x37,
-	0xe8, 0x9a, 0x9a, 0x69, 0x46, 0xa4, 0xe4, 0x23, 0x28, 0x87, 0x1c, 0x74, 0x4f, 0x97, 0x0b, 0x37,
-	0xaf, 0x25, 0x89, 0xd1, 0xc6, 0x4f, 0xa1, 0xaa, 0xcd, 0xab, 0xd0, 0x86, 0x12, 0x9b, 0x1b, 0x8c,
-	0xb5, 0x36, 0x53, 0xf4, 0x48, 0x83, 0x34, 0xaf, 0x36, 0x69, 0x8a, 0xcc, 0x9b, 0x1e, 0x6c, 0x45,
-	0xe6, 0xcd, 0x18, 0x4c, 0x59, 0x39, 0xf4, 0x02, 0x20, 0x1e, 0x0f, 0xa1, 0x75, 0x25, 0x9b, 0x1c,
-	0x49, 0xb5, 0x36, 0xe6, 0xc9, 0x73, 0x48, 0xf4, 0x32, 0x13, 0x22, 0x49, 0x37, 0x54, 0x11, 0x92,
-	0x8c, 0x6e, 0x29, 0x72, 0x74, 0x72, 0x7e, 0x13, 0x39, 0x3a, 0x73, 0x0c, 0x14, 0x39, 0x3a, 0x7b,
-	0xe8, 0x63, 0xe5, 0x90, 0x2d, 0x7a, 0xc9, 0x8c, 0x8e, 0x1d, 0x6d, 0xab, 0xad, 0x8b, 0xe7, 0x0f,
-	0x2d, 0xeb, 0x36, 0x91, 0xe8, 0x88, 0x5f, 0x86, 0x63, 0xa7, 0xac, 0x53, 0x9e, 0xc4, 0x00, 0x6f,
-	0x39, 0xe8, 0xfd, 0x3b, 0xa4, 0xa2, 0xb3, 0x2e, 0xc4, 0x48, 0x2c, 0xb3, 0x5f, 0x47, 0x8f, 0x93,
-	0x68, 0x33, 0x47, 0x01, 0xad, 0x27, 0xb7, 0x0b, 0xcd, 0xb9, 0x55, 0xeb, 0xc1, 0xb5, 0xfc, 0x9d,
-	0xef, 0xed, 0xb5, 0xfc, 0x4d, 0xb5, 0xec, 0xd2, 0xad, 0xa9, 0x1e, 0x59, 0xba, 0x75, 0x51, 0xe3,
-	0x2d, 0xdd, 0xba, 0xb0, 0xb1, 0xb6, 0x72, 0xe8, 0x17, 0xb0, 0x9e, 0xd9, 0x45, 0xa3, 0xb6, 0xda,
-	0xb9, 0xb0, 0xc1, 0x6e, 0xbd, 0x77, 0x4b, 0x1f, 0x67, 0xe5, 0xf6, 0x0c, 0x64, 0x43, 0x2b, 0x4b,
-	0xc1, 0x19, 0x0b, 0x88, 0x3d, 0xf9, 0xd6, 0x07, 0x74, 0x8c, 0x3d, 0x03, 0x75, 0xa1, 0x3e, 0xd7,
-	0x23, 0xa3, 0x56, 0x42, 0x6f, 0xa2, 0x71, 0x6e, 0xad, 0x67, 0x36, 0xc5, 0x1c, 0xac, 0x53, 0x14,
-	0xff, 0x48, 0xed, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0x6f, 0x80, 0xed, 0xa1, 0x1a, 0x00,
-	0x00,
+func init() { proto.RegisterFile("czzrpc.proto", fileDescriptor_czzrpc_9cc4b6e5cc00c728) }
+
+var fileDescriptor_czzrpc_9cc4b6e5cc00c728 = []byte{
+	// 3829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x3b, 0x6c, 0x23, 0x4b,
+	0x72, 0x1a, 0xfe, 0x59, 0x24, 0x25, 0x6e, 0xaf, 0x56, 0xe2, 0xce, 0x5b, 0xed, 0x6a, 0x67, 0xf7,
+	0xbd, 0xa7, 0xf3, 0xfa, 0xe9, 0xf6, 0xf6, 0x3d, 0xfb, 0xdd, 0x6f, 0x71, 0xd6, 0x87, 0x2b, 0x11,
+	0xb7, 0xfa, 0xdc, 0x48, 0x77, 0x86, 0x9d, 0x0c, 0x86, 0x64, 0x53, 0x1a, 0x8b, 0x9c, 0xa1, 0x67,
+	0x9a, 0x7b, 0xd2, 0x4b, 0x7c, 0x80, 0x01, 0x27, 0x8e, 0x0c, 0xd8, 0xc1, 0x85, 0x4e, 0x9c, 0xd8,
+	0xc0, 0xc1, 0xf1, 0x05, 0x0e, 0x0d, 0x38, 0x71, 0xe2, 0xd0, 0x80, 0x03, 0x03, 0x4e, 0x0c, 0x38,
+	0x74, 0xe4, 0xc0, 0xa8, 0xfe, 0xcc, 0xf4, 0x0c, 0x87, 0xd2, 0xbb, 0xbd, 0xbd, 0xe0, 0x32, 0x76,
+	0x55, 0x75, 0x57, 0x75, 0x75, 0x4d, 0x75, 0x7d, 0x9a, 0xd0, 0x1c, 0x7c, 0xf5, 0x55, 0x38, 0x1d,
+	0x6c, 0x4f, 0xc3, 0x80, 0x05, 0xa4, 0x30, 0xed, 0x5b, 0xeb, 0xf0, 0xe0, 0x80, 0xb2, 0x23, 0x3a,
+	0x99, 0x06, 0xc1, 0xb8, 0xe7, 0x8f, 0x02, 0x9b, 0xfe, 0xe9, 0x8c, 0x46, 0xcc, 0xda, 0x85, 0xb5,
+	0x2c, 0x22, 0x9a, 0x06, 0x7e, 0x44, 0x09, 0x81, 0x52, 0xe4, 0x7d, 0x45, 0x3b, 0xc6, 0xa6, 0xb1,
+	0xd5, 0xb2, 0xf9, 0x6f, 0xb2, 0x0a, 0xe5, 0xfe, 0x0d, 0xa3, 0x51, 0xa7, 0xc0, 0x81, 0x62, 0x60,
+	0xed, 0xe9, 0x6b, 0x74, 0x7d, 0x16, 0xde, 0xc8, 0xd5, 0xc9, 0x37, 0xa0, 0xcd, 0x42, 0xd7, 0x8f,
+	0xdc, 0x01, 0xf3, 0x02, 0xdf, 0xb9, 0x74, 0xa3, 0x4b, 0xbe, 0x5e, 0xd3, 0x5e, 0xd1, 0xe0, 0x87,
+	0x6e, 0x74, 0x69, 0xfd, 0x75, 0x09, 0xd6, 0xe7, 0x56, 0x91, 0xa2, 0x7c, 0x1b, 0x1a, 0x1a, 0x39,
+	0x5f, 0xa1, 0xf1, 0x6a, 0x6d, 0x7b, 0xda, 0xdf, 0x96, 0xe4, 0xe7, 0x09, 0xd6, 0xd6, 0x49, 0xc9,
+	0x0f, 0xa0, 0xc4, 0x6e, 0xa6, 0x94, 0xcb, 0xbb, 0xfc, 0xea, 0x05, 0x4e, 0x59, 0xc0, 0x64, 0x5b,
+	0x5b, 0xe3, 0xfc, 0x66, 0x4a, 0x6d, 0x3e, 0x31, 0xd6, 0x42, 0x51, 0xd3, 0x42, 0x07, 0xaa, 0x43,
+	0x3a, 0xa5, 0xfe, 0x30, 0xea, 0x94, 0x36, 0x8b, 0x5b, 0x4d, 0x5b, 0x0d, 0xc9, 0x43, 0xa8, 0x45,
+	0x53, 0xea, 0x33, 0xa7, 0x7f, 0xd3, 0x29, 0x0b, 0x14, 0x1f, 0xef, 0xde, 0x90, 0x8f, 0x61, 0xd9,
+	0xf5, 0x07, 0x34, 0x62, 0x41, 0xe8, 0x0c, 0x82, 0x99, 0xcf, 0x3a, 0x15, 0xbe, 0x64, 0x4b, 0x41,
+	0xf7, 0x10, 0x48, 0x9e, 0x41, 0x0c, 0x70, 0x38, 0xe3, 0xea, 0xa6, 0xb1, 0x55, 0xb2, 0x9b, 0x0a,
+	0x78, 0x86, 0x02, 0xe8, 0x44, 0x23, 0x4a, 0xa3, 0x4e, 0x6d, 0xd3, 0xd8, 0x2a, 0x26, 0x44, 0x6f,
+	0x28, 0x8d, 0x50, 0xf7, 0x43, 0x1a, 0x0d, 0xa8, 0x3f, 0x74, 0x7d, 0x26, 0x59, 0xd6, 0x39, 0xcb,
+	0x95, 0x04, 0x2e, 0x98, 0x7e, 0x0a, 0x1a, 0x48, 0xb0, 0x05, 0xce, 0x76, 0x39, 0x01, 0x73, 0xc6,
+	0x69, 0x42, 0xce, 0xba, 0xc1, 0x59, 0x6b, 0x84, 0xc8, 0xdc, 0x3a, 0x80, 0x95, 0x8c, 0x3e, 0x49,
+	0x13, 0x6a, 0x67, 0xe7, 0x3b, 0xc7, 0xfb, 0x3b, 0xf6, 0x7e, 0x7b, 0x89, 0x34, 0xa0, 0xba, 0x77,
+	0x72, 0xfc, 0x93, 0xae, 0x7d, 0xde, 0x36, 0xf8, 0x60, 0xe7, 0xec, 0xbc, 0x77, 0x7c, 0xd0, 0x2e,
+	0x20, 0xdd, 0xd1, 0x89, 0x7d, 0x7e, 0xb0, 0x73, 0xd0, 0x6d, 0x17, 0x2d, 0x13, 0x3a, 0x07, 0x94,
+	0xed, 0x8e, 0x83, 0xc1, 0xd5, 0xe0, 0xd2, 0xf5, 0x7c, 0xdd, 0x76, 0xff, 0xbb, 0x00, 0x0f, 0x73,
+	0x90, 0xd2, 0x68, 0x7a, 0xd0, 0xe8, 0x7b, 0x6c, 0x10, 0x78, 0xbe, 0xe3, 0x53, 0xc6, 0x8d, 0x66,
+	0xf9, 0xd5, 0x96, 0xb4, 0x80, 0xfc, 0x39, 0xdb, 0xbb, 0x62, 0xc2, 0x31, 0x65, 0x36, 0xf4, 0xe3,
+	0xdf, 0xe4, 0x09, 0x34, 0xfa, 0x34, 0x62, 0xce, 0x25, 0xf5, 0x2e, 0x2e, 0x19, 0x37, 0xa6, 0xb2,
+	0x0d, 0x08, 0x3a, 0xe4, 0x10, 0xf2, 0x09, 0xac, 0x70, 0x82, 0x3e, 0x2e, 0x2b, 0xcc, 0xbc, 0xc8,
+	0xcd, 0xbc, 0x85, 0x60, 0xce, 0x0c, 0x8d, 0x9c, 0x3c, 0x06, 0x18, 0x7a, 0xa3, 0x91, 0x37, 0x98,
+	0x8d, 0xd9, 0x4d, 0xa7, 0xb4, 0x69, 0x6c, 0x19, 0xb6, 0x06, 0x41, 0x46, 0x13, 0x3a, 0xf4, 0x5c,
+	0xdf, 0x61, 0xde, 0x84, 0x76, 0xca, 0x5c, 0xb7, 0x20, 0x40, 0xe7, 0xde, 0x84, 0xa2, 0x81, 0xb1,
+	0x6b, 0xc7, 0xf3, 0x87, 0xf4, 0x9a, 0xdb, 0x4f, 0xcd, 0xae, 0xb2, 0xeb, 0x1e, 0x0e, 0xc9, 0x06,
+	0x80, 0x3b, 0x1c, 0x86, 0x12, 0x59, 0xe5, 0xc8, 0x3a, 0x42, 0x38, 0xda, 0xfa, 0x01, 0x40, 0xb2,
+	0x3b, 0xd4, 0xf8, 0xd1, 0x4e, 0xef, 0xf8, 0xb8, 0x7b, 0x2e, 0xce, 0xc2, 0xee, 0x1e, 0x9c, 0x77,
+	0xcf, 0xe4, 0x59, 0xe0, 0x2f, 0xc4, 0x14, 0x08, 0x40, 0xe5, 0xac, 0x77, 0x84, 0xbf, 0x8b, 0xd6,
+	0x1f, 0xc2, 0x7d, 0xa5, 0x38, 0xed, 0x10, 0xc8, 0x2a, 0x94, 0x92, 0xcf, 0xfa, 0x70, 0xc9, 0xe6,
+	0x23, 0xd2, 0x81, 0x8a, 0xae, 0xac, 0xc3, 0x25, 0x5b, 0x8e, 0x77, 0xdb, 0xb0, 0x8c, 0x14, 0x4e,
+	0x10, 0x4a, 0x75, 0x5a, 0xdf, 0x81, 0xd5, 0xf4, 0xc2, 0xf2, 0x00, 0x9f, 0x42, 0xc9, 0xf3, 0x47,
+	0x81, 0xfc, 0xdc, 0x5b, 0x78, 0x72, 0x09, 0x11, 0x47, 0x59, 0x3f, 0x33, 0x60, 0x45, 0xcd, 0x7d,
+	0x4f, 0x81, 0xc8, 0x0b, 0xb8, 0x37, 0x9a, 0x8d, 0xc7, 0x8e, 0xe6, 0x36, 0x22, 0x7e, 0x7a, 0x35,
+	0xbb, 0x8d, 0x08, 0xcd, 0x8e, 0xa3, 0x1c, 0xe9, 0x3f, 0x87, 0x76, 0x22, 0x81, 0x94, 0xfc, 0x09,
+	0x94, 0xb9, 0x25, 0x48, 0xd1, 0xeb, 0xb1, 0xe8, 0xb6, 0x80, 0x5b, 0x3f, 0x01, 0x72, 0x40, 0x99,
+	0xed, 0xfe, 0xf4, 0xd7, 0x91, 0x3c, 0x47, 0x98, 0x17, 0x70, 0x3f, 0xb5, 0xae, 0x94, 0x67, 0x55,
+	0x97, 0xa7, 0xa9, 0x84, 0xf8, 0x23, 0x7e, 0x27, 0x70, 0xca, 0x37, 0xde, 0x98, 0xd1, 0xf0, 0xc3,
+	0xc9, 0xf1, 0x12, 0xd6, 0xb2, 0x4b, 0x4b, 0x51, 0xd6, 0xa0, 0x32, 0xe2, 0x10, 0x29, 0x8b, 0x1c,
+	0x59, 0x7d, 0xb8, 0x77, 0x40, 0xd9, 0x21, 0x75, 0x87, 0x34, 0x8c, 0x94, 0x20, 0x2f, 0x61, 0x55,
+	0x7c, 0x51, 0xe3, 0x60, 0xe0, 0xa2, 0xb3, 0x43, 0x36, 0x34, 0xea, 0x18, 0xdc, 0xb5, 0x12, 0x8e,
+	0x7b, 0x2b, 0x50, 0x87, 0x1c, 0x43, 0x3e, 0x82, 0x7a, 0xc4, 0x82, 0xa9, 0xf8, 0x04, 0x0b, 0x9c,
+	0x43, 0x0d, 0x01, 0xfc, 0x8a, 0x79, 0x0d, 0x44, 0xe7, 0x21, 0x25, 0xfa, 0x14, 0xaa, 0x97, 0x02,
+	0xc4, 0xd7, 0x9d, 0xb3, 0x34, 0x85, 0xb5, 0x5e, 0x70, 0x7d, 0xe9, 0x57, 0x8d, 0x14, 0x93, 0xe8,
+	0xfa, 0x12, 0xda, 0xb2, 0x7e, 0x08, 0x6b, 0x59, 0x62, 0xc9, 0xef, 0x5b, 0x79, 0x97, 0xd9, 0x0a,
+	0xf2, 0x5c, 0x74, 0x8b, 0x59, 0xdb, 0xdc, 0x09, 0xda, 0xee, 0x4f, 0xbf, 0x26, 0xf3, 0xd7, 0xf0,
+	0x30, 0x87, 0x5e, 0xf2, 0xdf, 0x9c, 0xe7, 0xdf, 0x4c, 0xb3, 0xfb, 0x07, 0x03, 0x36, 0x0e, 0x28,
+	0xdb, 0x19, 0x0e, 0x43, 0x1a, 0x45, 0xba, 0xfd, 0x2b, 0xa6, 0x1d, 0xa8, 0xba, 0x02, 0xcb, 0xe7,
+	0xd7, 0x6d, 0x35, 0x24, 0xeb, 0x50, 0xf5, 0xfb, 0x4e, 0x74, 0xe5, 0x4d, 0x65, 0x8c, 0x50, 0xf1,
+	0xfb, 0x67, 0x57, 0xde, 0x14, 0x3d, 0x97, 0xdf, 0x77, 0x46, 0x94, 0x0d, 0x2e, 0xe5, 0x65, 0x5a,
+	0xf5, 0xfb, 0x6f, 0x70, 0x18, 0xdb, 0x5b, 0x69, 0x81, 0xbd, 0x95, 0x33, 0xf6, 0xd6, 0x82, 0x46,
+	0xc4, 0xdc, 0x50, 0xba, 0x5b, 0xeb, 0x97, 0x06, 0x3c, 0x5e, 0x24, 0xae, 0xdc, 0xf3, 0x1b, 0x58,
+	0x1b, 0x04, 0xfe, 0xc8, 0x0b, 0x27, 0x74, 0x98, 0xfe, 0xd0, 0xc5, 0x91, 0xcf, 0xa9, 0xff, 0x41,
+	0x4c, 0xae, 0xaf, 0x47, 0x7e, 0x04, 0x9d, 0x99, 0xbf, 0x60, 0xa5, 0xc2, 0x66, 0xf1, 0x96, 0xa8,
+	0x64, 0x5d, 0x9b, 0xa7, 0x2f, 0x69, 0xfd, 0xc2, 0x80, 0x4d, 0x71, 0x58, 0xbf, 0x2d, 0xfa, 0xfe,
+	0x1b, 0x03, 0x9e, 0xde, 0x22, 0xb1, 0x54, 0xf9, 0xef, 0xdd, 0xaa, 0xf2, 0xe6, 0x22, 0x0d, 0x7f,
+	0xe7, 0x0e, 0x0d, 0x37, 0x17, 0x6b, 0xf2, 0x7b, 0xf0, 0x24, 0x31, 0x83, 0x1f, 0xfb, 0x3c, 0xf0,
+	0x3a, 0x99, 0xb1, 0xe9, 0x8c, 0xdd, 0xad, 0x47, 0xeb, 0x04, 0x36, 0x17, 0x4f, 0x96, 0x5b, 0x7a,
+	0x01, 0xd5, 0x40, 0x80, 0xa4, 0xd9, 0xdc, 0xc3, 0xc3, 0x4e, 0x11, 0xdb, 0x8a, 0xc2, 0xda, 0x95,
+	0x11, 0x77, 0x78, 0x35, 0xa6, 0xa7, 0x61, 0x10, 0x8c, 0xde, 0x23, 0x26, 0xbe, 0x82, 0xb5, 0xec,
+	0x1a, 0x52, 0x94, 0x67, 0xe9, 0x1b, 0x26, 0xe3, 0xb2, 0x04, 0x0e, 0x7d, 0xad, 0x74, 0x98, 0x42,
+	0x73, 0x72, 0x84, 0xd7, 0xc1, 0x68, 0xec, 0x5e, 0x44, 0x32, 0x46, 0x11, 0x03, 0xeb, 0xfb, 0xd0,
+	0x39, 0x9b, 0xf5, 0x27, 0x5e, 0x9e, 0x87, 0xbb, 0xdb, 0x67, 0x7c, 0x13, 0x1e, 0xe6, 0xcc, 0x4e,
+	0x52, 0x89, 0x39, 0x1f, 0x25, 0x32, 0x92, 0x63, 0xca, 0x8e, 0xa2, 0x8b, 0x33, 0xe6, 0xc6, 0x67,
+	0x24, 0xb3, 0x89, 0x14, 0x42, 0x2e, 0xf3, 0x0d, 0x28, 0x4f, 0x69, 0xe2, 0xa7, 0xef, 0xe3, 0xa6,
+	0x4f, 0x29, 0x0d, 0x75, 0x5a, 0x41, 0x61, 0x79, 0xdc, 0x63, 0xee, 0x05, 0x93, 0x89, 0xc7, 0x18,
+	0xa5, 0x88, 0xa3, 0x6a, 0x33, 0x4f, 0x00, 0xb4, 0x38, 0x4d, 0x5d, 0x72, 0xf5, 0x7e, 0x1c, 0xa5,
+	0x3d, 0x83, 0xa6, 0x24, 0x48, 0xdf, 0x77, 0x0d, 0x41, 0x22, 0x3e, 0x8a, 0xaa, 0x3c, 0x01, 0xeb,
+	0xdf, 0x45, 0x14, 0x9a, 0xe5, 0x25, 0x65, 0xde, 0x98, 0x67, 0xa6, 0xb3, 0x7a, 0x9a, 0xc7, 0x2a,
+	0xc5, 0x08, 0x57, 0x88, 0x70, 0x49, 0x3d, 0xac, 0xac, 0x73, 0x08, 0x5f, 0xe1, 0x39, 0x54, 0xa7,
+	0x63, 0x3a, 0xbc, 0xa0, 0x22, 0x19, 0x69, 0xbc, 0x02, 0xae, 0x16, 0x0e, 0xb2, 0x15, 0x8a, 0x7c,
+	0x06, 0x30, 0x50, 0x02, 0x46, 0x3c, 0x35, 0x91, 0x46, 0x13, 0x8b, 0x6d, 0x6b, 0x04, 0xe4, 0x31,
+	0x34, 0x26, 0xee, 0xb5, 0xe3, 0x31, 0x3a, 0x71, 0xbc, 0x21, 0x8f, 0x34, 0x4b, 0x76, 0x7d, 0xe2,
+	0x5e, 0xf7, 0x18, 0x9d, 0xf4, 0x86, 0xe4, 0x77, 0x81, 0x0c, 0x02, 0xff, 0x1d, 0x0d, 0x99, 0xa0,
+	0x11, 0xd9, 0x45, 0x95, 0xbb, 0x99, 0xb6, 0xc4, 0x20, 0xa9, 0x48, 0x2f, 0xbe, 0x07, 0xa6, 0xa2,
+	0x96, 0x5f, 0xae, 0x3e, 0xab, 0xc6, 0x67, 0xad, 0x4b, 0x8a, 0x3d, 0x41, 0x10, 0x4f, 0xb6, 0x7e,
+	0x6e, 0xf0, 0x00, 0x4b, 0x6e, 0xe8, 0x43, 0x1e, 0x21, 0x69, 0x43, 0xc1, 0x1b, 0x72, 0x8d, 0x96,
+	0x0e, 0x0d, 0xbb, 0xe0, 0x0d, 0x89, 0x99, 0xf8, 0x07, 0x74, 0x8e, 0xf5, 0x43, 0x23, 0xf6, 0x10,
+	0xf1, 0x81, 0xef, 0xd6, 0xa0, 0x22, 0xd4, 0x6a, 0xdd, 0xc0, 0x3d, 0x4d, 0xb4, 0x0f, 0x76, 0xe2,
+	0x96, 0x62, 0xc0, 0x65, 0x4b, 0x9f, 0xa8, 0x62, 0xfd, 0xb3, 0x02, 0xac, 0xbf, 0xf5, 0x22, 0xb6,
+	0x27, 0xd4, 0x86, 0xfa, 0x8a, 0x3e, 0xac, 0x76, 0x1e, 0x41, 0x3d, 0xf6, 0xb3, 0x32, 0x1e, 0x4e,
+	0x00, 0x98, 0xa9, 0xb8, 0x51, 0x44, 0x99, 0x83, 0x59, 0xb2, 0x30, 0xbd, 0x96, 0x0d, 0x1c, 0x84,
+	0xd9, 0x5e, 0x84, 0x39, 0xaa, 0x3a, 0x74, 0x41, 0x52, 0xe6, 0x24, 0x4d, 0x09, 0x14, 0x44, 0xda,
+	0xed, 0x55, 0x59, 0x78, 0x7b, 0x55, 0x53, 0xb7, 0x57, 0xf2, 0xe1, 0xfd, 0xdc, 0x80, 0xce, 0xbc,
+	0x0a, 0x3e, 0xd8, 0x29, 0x7c, 0x0c, 0x65, 0xb4, 0x52, 0xf4, 0x92, 0x71, 0x88, 0xa0, 0xb1, 0xb2,
+	0x05, 0x16, 0x9d, 0x29, 0x0b, 0x98, 0x3b, 0xe6, 0x06, 0xd3, 0xb2, 0xc5, 0xc0, 0xba, 0xe0, 0x4e,
+	0xec, 0x34, 0x08, 0xc6, 0xbb, 0xee, 0x98, 0xa7, 0xe5, 0xbf, 0x21, 0xef, 0xf3, 0x7f, 0x06, 0xac,
+	0xcf, 0x71, 0xfa, 0x60, 0x3a, 0xf8, 0x7d, 0x28, 0x63, 0x20, 0xa3, 0x74, 0xb0, 0x29, 0xb3, 0xe7,
+	0x3c, 0x6e, 0xdb, 0x08, 0xb4, 0x05, 0xb9, 0x79, 0x01, 0x25, 0x1c, 0xde, 0x12, 0xb7, 0x74, 0xa0,
+	0xda, 0x17, 0x2b, 0x70, 0xbe, 0x45, 0x5b, 0x0d, 0xf5, 0x5b, 0xb6, 0x78, 0xe7, 0x2d, 0x7b, 0xc9,
+	0x77, 0xdf, 0xf5, 0x99, 0xeb, 0x5f, 0x8c, 0x7f, 0xa3, 0x6e, 0xfe, 0x97, 0x25, 0xe8, 0xcc, 0xb3,
+	0xfa, 0x60, 0x9a, 0x7e, 0x0d, 0xd5, 0x3e, 0x75, 0x07, 0x81, 0xaf, 0x76, 0xfd, 0x4c, 0xea, 0x3a,
+	0x97, 0xe1, 0xf6, 0x2e, 0xa7, 0xb5, 0xd5, 0x1c, 0xe4, 0x80, 0x9a, 0x77, 0xdc, 0x09, 0x3a, 0xcd,
+	0x6f, 0x71, 0x63, 0x2c, 0xda, 0x0d, 0x84, 0xed, 0x08, 0x50, 0x86, 0xe4, 0x55, 0xa7, 0x9c, 0x25,
+	0x79, 0x85, 0x65, 0x8c, 0xc1, 0x2c, 0x74, 0xe8, 0xf5, 0xe0, 0xd2, 0xf5, 0x2f, 0x68, 0xe2, 0xfa,
+	0x5b, 0x83, 0x59, 0xd8, 0x95, 0xd0, 0xde, 0xd0, 0xfc, 0x45, 0x01, 0x2a, 0x42, 0x02, 0xf4, 0x03,
+	0x3a, 0xb9, 0xc1, 0xc9, 0x81, 0xc6, 0xb4, 0xba, 0x09, 0x14, 0xd2, 0x26, 0xb0, 0x01, 0xc0, 0x02,
+	0x47, 0x21, 0xe5, 0xc5, 0xc6, 0x02, 0x19, 0x83, 0x61, 0xc1, 0x2c, 0x62, 0xee, 0x95, 0xe7, 0x5f,
+	0x48, 0x91, 0xe5, 0xa6, 0x5a, 0x12, 0x2a, 0x84, 0xc6, 0x92, 0x14, 0x95, 0x2a, 0x52, 0x74, 0x62,
+	0x67, 0xcb, 0x0a, 0x2c, 0x09, 0x37, 0x40, 0xb8, 0x27, 0x07, 0xc3, 0x1d, 0xe9, 0x6e, 0xea, 0x1c,
+	0xf2, 0x66, 0xec, 0x5e, 0x90, 0x36, 0x14, 0x47, 0x54, 0x95, 0xdb, 0xf0, 0x27, 0xe6, 0x92, 0x57,
+	0x94, 0x4e, 0x45, 0x29, 0xa6, 0xc6, 0xe1, 0x35, 0x04, 0xf0, 0x42, 0xcc, 0x67, 0x78, 0x03, 0x7a,
+	0x7e, 0xdf, 0x8d, 0xa8, 0xda, 0x02, 0x8d, 0x3a, 0xf5, 0xcd, 0xe2, 0x56, 0xdd, 0xbe, 0xa7, 0x30,
+	0x3b, 0x0a, 0x61, 0xfd, 0x87, 0x01, 0x8f, 0xce, 0x66, 0xfd, 0x68, 0x10, 0x7a, 0x7d, 0x9a, 0x17,
+	0xe1, 0x7f, 0x0e, 0xf5, 0x48, 0xe1, 0x65, 0x50, 0xf7, 0x20, 0x93, 0x94, 0xc8, 0x4c, 0x3a, 0xa1,
+	0x23, 0x5f, 0x42, 0x63, 0xe6, 0x27, 0xd3, 0x0a, 0xb7, 0x4d, 0xd3, 0x29, 0x51, 0x69, 0x9e, 0x3f,
+	0x18, 0xcf, 0x86, 0xd4, 0x99, 0x88, 0x5c, 0x45, 0x7a, 0xf8, 0x65, 0x09, 0x96, 0x19, 0x0c, 0xd9,
+	0x82, 0xb6, 0x22, 0xf4, 0x7c, 0x11, 0xff, 0x77, 0x4a, 0x29, 0xca, 0x9e, 0xcf, 0xe3, 0x4e, 0xab,
+	0x03, 0x6b, 0xf1, 0x06, 0x39, 0x24, 0x0e, 0xe8, 0x2e, 0xe0, 0x49, 0x8c, 0x89, 0xc3, 0x8d, 0xee,
+	0x3b, 0xea, 0x27, 0x71, 0xf9, 0x3e, 0x34, 0x28, 0x02, 0xe4, 0x55, 0x81, 0xf1, 0xdd, 0xb2, 0xf8,
+	0x02, 0xd2, 0x13, 0x8e, 0x03, 0xe6, 0x8d, 0xbc, 0x81, 0x8b, 0xfb, 0xda, 0xe6, 0x55, 0x5a, 0xe0,
+	0xf3, 0xf0, 0x67, 0x64, 0xfd, 0x95, 0x01, 0xf7, 0x38, 0x6b, 0x9d, 0x8c, 0x6c, 0xcb, 0x12, 0xb0,
+	0x28, 0x00, 0x9a, 0x71, 0xa4, 0x3c, 0xbf, 0x16, 0xa7, 0x4b, 0x42, 0xeb, 0xc2, 0xe2, 0xd0, 0xda,
+	0xfa, 0x14, 0x4a, 0x38, 0x85, 0xb4, 0xa0, 0xbe, 0x77, 0x72, 0x7c, 0xdc, 0xdd, 0x3b, 0xef, 0x62,
+	0x55, 0xb3, 0x0d, 0xcd, 0xfd, 0xde, 0x59, 0x02, 0x31, 0xac, 0xbf, 0x2f, 0x81, 0xb9, 0x78, 0x0f,
+	0xe4, 0xcb, 0x94, 0x70, 0x5f, 0x6b, 0xc7, 0x42, 0xca, 0x0e, 0x54, 0x43, 0x3a, 0x09, 0xde, 0xd1,
+	0x21, 0x97, 0xb3, 0x66, 0xab, 0x61, 0xc6, 0x17, 0x15, 0xef, 0xf2, 0x45, 0xa5, 0x79, 0x5f, 0xf4,
+	0x05, 0x34, 0xf5, 0xe8, 0x8e, 0x7f, 0x4f, 0xf3, 0x17, 0x20, 0x7a, 0x4a, 0x2d, 0xd4, 0x23, 0xcf,
+	0xe3, 0xa8, 0xa5, 0x92, 0x8d, 0x5a, 0x30, 0x97, 0x14, 0x38, 0x72, 0x22, 0xbd, 0x90, 0x72, 0xfe,
+	0x55, 0x4e, 0xfb, 0x3b, 0x77, 0x6c, 0x5c, 0xbb, 0x70, 0x90, 0xed, 0x34, 0x19, 0x9a, 0x3b, 0xd0,
+	0xd0, 0xb0, 0xef, 0x73, 0xe3, 0x58, 0x7f, 0x26, 0x0f, 0xb3, 0x03, 0xab, 0xb2, 0x26, 0xed, 0xf4,
+	0xce, 0xbb, 0x47, 0xce, 0x9e, 0xdd, 0xdd, 0x11, 0xe7, 0x6a, 0xc2, 0x5a, 0x1a, 0x73, 0x72, 0xfc,
+	0xa6, 0x67, 0x1f, 0xe1, 0x09, 0x93, 0xfb, 0xb0, 0x22, 0x8b, 0xd7, 0xf1, 0x84, 0x02, 0x21, 0xb0,
+	0x7c, 0xfa, 0xb6, 0xbb, 0x7f, 0xd0, 0x75, 0xf6, 0x0e, 0x77, 0x8e, 0x0f, 0xba, 0xfb, 0xed, 0x22,
+	0x2e, 0x7f, 0x7a, 0x72, 0xf2, 0xd6, 0xd9, 0xdd, 0x79, 0xbb, 0x73, 0xbc, 0x97, 0x60, 0x4a, 0x78,
+	0xc9, 0x70, 0x33, 0xb6, 0xfe, 0xb6, 0x00, 0xeb, 0xda, 0xa7, 0x9b, 0x32, 0x95, 0x2f, 0x52, 0xa6,
+	0xb2, 0x99, 0xf9, 0xca, 0x17, 0xd9, 0xc9, 0x1b, 0x78, 0x90, 0x9b, 0x4d, 0x77, 0x0a, 0xc9, 0xa1,
+	0x6a, 0xcb, 0x1c, 0x2e, 0xd9, 0xab, 0x79, 0xd9, 0x35, 0xf9, 0x11, 0xac, 0x2f, 0xc8, 0xcb, 0x65,
+	0x90, 0xba, 0xa0, 0xf0, 0x71, 0xb8, 0x64, 0xaf, 0xe5, 0x27, 0xec, 0xd6, 0x27, 0x52, 0xed, 0x2b,
+	0xd0, 0xf8, 0xf1, 0x71, 0xa2, 0xd1, 0x25, 0xf9, 0x51, 0xc9, 0xa1, 0x81, 0xe5, 0x07, 0x3d, 0xd3,
+	0xfc, 0xcf, 0x02, 0xd4, 0xe3, 0xef, 0x31, 0x2f, 0xb5, 0xe4, 0x79, 0xaf, 0x7e, 0xd1, 0xca, 0x11,
+	0x5a, 0xc0, 0x3b, 0x1a, 0x46, 0x4a, 0xe6, 0xb2, 0xad, 0x86, 0x78, 0xd7, 0x4c, 0x43, 0xfa, 0xce,
+	0x0b, 0x66, 0x91, 0xe6, 0xe4, 0x9a, 0x76, 0x4b, 0x41, 0x39, 0x43, 0x51, 0x9e, 0xc7, 0x64, 0xdc,
+	0x09, 0x83, 0x40, 0xdc, 0x33, 0x4d, 0x1b, 0x04, 0xc8, 0x0e, 0x02, 0x1e, 0x33, 0xe3, 0x6d, 0x11,
+	0x31, 0x77, 0x22, 0x22, 0xda, 0xa2, 0x9d, 0x00, 0x50, 0xd6, 0xbe, 0xc7, 0x22, 0x19, 0xd0, 0xf2,
+	0xdf, 0x18, 0x3e, 0xfa, 0x81, 0x3f, 0x50, 0x17, 0x8c, 0x18, 0x90, 0xe7, 0xd0, 0x92, 0x2a, 0x73,
+	0x45, 0xe9, 0xa3, 0xce, 0xe5, 0x4d, 0x03, 0x33, 0xdd, 0x04, 0x98, 0xeb, 0x26, 0x7c, 0x02, 0x2b,
+	0x3e, 0xbd, 0x4e, 0x75, 0x25, 0x1a, 0x62, 0x5b, 0x08, 0x4e, 0xba, 0x12, 0xaa, 0xc7, 0xd5, 0xe4,
+	0x4c, 0xf8, 0x6f, 0xeb, 0x7f, 0x0d, 0x28, 0x8b, 0x4d, 0xdf, 0x5d, 0x86, 0x27, 0xfb, 0xe9, 0x92,
+	0xc6, 0xd0, 0x65, 0xae, 0x2c, 0x87, 0x3d, 0x8c, 0xc9, 0x75, 0x2b, 0xdb, 0x77, 0x99, 0x9b, 0xaa,
+	0x76, 0x20, 0xc0, 0xfc, 0x73, 0x23, 0xd5, 0x34, 0x42, 0x18, 0x79, 0xb1, 0xa8, 0x58, 0x72, 0xb8,
+	0x34, 0x57, 0x2e, 0x21, 0x9f, 0xa7, 0xab, 0x14, 0x0b, 0x2d, 0x5c, 0xa7, 0xda, 0x5d, 0x86, 0x26,
+	0xbb, 0xf6, 0x86, 0x11, 0x56, 0xaf, 0xd9, 0x75, 0x64, 0xfd, 0x6b, 0x05, 0x1a, 0xba, 0xe1, 0xe7,
+	0x19, 0x98, 0x66, 0x48, 0x85, 0xb4, 0x21, 0x7d, 0x06, 0x15, 0xcf, 0xd7, 0x62, 0xd7, 0xec, 0x65,
+	0xbc, 0xdd, 0x43, 0xac, 0x2d, 0x89, 0xc8, 0xcb, 0x24, 0xd6, 0x2d, 0x25, 0xe5, 0x43, 0x9d, 0x3e,
+	0x13, 0xf0, 0x62, 0x50, 0xc2, 0x4f, 0x33, 0xee, 0x0f, 0xb5, 0xec, 0x1a, 0x02, 0x78, 0x50, 0xa2,
+	0x0e, 0xb2, 0x96, 0x1c, 0x64, 0xda, 0x24, 0xeb, 0x59, 0x93, 0x9c, 0x33, 0x34, 0xc8, 0x33, 0xb4,
+	0xec, 0x9d, 0xd1, 0xc8, 0xad, 0x52, 0x68, 0x66, 0xd6, 0xcc, 0xdc, 0x3a, 0x18, 0x31, 0x96, 0xf9,
+	0xd6, 0xd1, 0xe0, 0x45, 0x87, 0x4a, 0xf4, 0x95, 0xc5, 0x80, 0x7c, 0x17, 0x6a, 0xb8, 0xc3, 0xc0,
+	0xf3, 0x99, 0x3c, 0xb7, 0xc7, 0xb9, 0x9a, 0xdb, 0x3e, 0x91, 0x54, 0x76, 0x4c, 0x8f, 0x05, 0xb5,
+	0xc8, 0xbb, 0xf0, 0x5d, 0x36, 0x0b, 0xa9, 0x83, 0x61, 0xc6, 0x94, 0xc9, 0x6b, 0x6f, 0x25, 0x86,
+	0x9f, 0x71, 0x30, 0x31, 0xa1, 0x16, 0x61, 0xc8, 0x81, 0x1f, 0x9c, 0xc8, 0xd7, 0xe2, 0x31, 0x0a,
+	0xf6, 0xce, 0x1d, 0xcf, 0x54, 0xd7, 0x4d, 0x0c, 0x30, 0x52, 0x8a, 0x3d, 0x83, 0x5c, 0xbb, 0xc2,
+	0xd7, 0x8e, 0x1d, 0x86, 0x5c, 0x5a, 0xbb, 0x78, 0xaa, 0xa9, 0x8b, 0xc7, 0xfc, 0x02, 0x6a, 0x4a,
+	0xea, 0x5c, 0x6b, 0x8a, 0x35, 0x52, 0xd0, 0x34, 0x62, 0xfe, 0xb3, 0x01, 0x15, 0x71, 0xf8, 0x0b,
+	0x54, 0x16, 0xcb, 0x5b, 0xd0, 0xe5, 0x7d, 0x06, 0xad, 0xe9, 0xac, 0x7f, 0x45, 0x6f, 0xd2, 0x9a,
+	0x68, 0x0a, 0xe0, 0xbc, 0xac, 0xa5, 0xf4, 0x25, 0xf9, 0x14, 0x9a, 0x62, 0x9e, 0x33, 0x18, 0xbb,
+	0x51, 0xc4, 0x75, 0x51, 0xb7, 0x1b, 0x02, 0xb6, 0x87, 0x20, 0xf2, 0x4d, 0xb8, 0x3f, 0xf4, 0x22,
+	0x37, 0x8a, 0xe8, 0xa4, 0x3f, 0xa6, 0x43, 0x5d, 0x2b, 0x75, 0x9b, 0xe8, 0x28, 0xc1, 0xcd, 0xfa,
+	0x2f, 0x03, 0xc8, 0xfc, 0xc5, 0xf0, 0x1e, 0x7d, 0x10, 0xd9, 0xe2, 0xa4, 0x43, 0x61, 0xfd, 0x62,
+	0xdf, 0x75, 0x0e, 0xe1, 0xe6, 0xff, 0x14, 0x9a, 0x02, 0x2d, 0xcd, 0x54, 0x38, 0xf9, 0x06, 0x87,
+	0xc5, 0x25, 0x1f, 0x1e, 0xe5, 0x8b, 0x4c, 0x02, 0x7f, 0x92, 0x47, 0x00, 0x23, 0x4a, 0x9d, 0x29,
+	0x0d, 0x9d, 0xab, 0xbe, 0x3c, 0xfb, 0xda, 0x88, 0xd2, 0x53, 0x1a, 0xfe, 0xb0, 0x8f, 0xcd, 0x41,
+	0x5e, 0xfa, 0xc6, 0x2c, 0x64, 0x1a, 0x7a, 0x41, 0xe8, 0xb1, 0x1b, 0xbe, 0x55, 0xc3, 0x6e, 0x2b,
+	0xc4, 0xa9, 0x84, 0x5b, 0xff, 0x62, 0x40, 0x2b, 0x95, 0xa7, 0xa6, 0xcc, 0xda, 0xf8, 0x15, 0xcd,
+	0x7a, 0xee, 0x24, 0x0b, 0x39, 0x27, 0x19, 0x1b, 0x41, 0x51, 0x37, 0x82, 0x27, 0xd0, 0xf0, 0x22,
+	0x47, 0x65, 0x21, 0x32, 0x60, 0x07, 0x2f, 0xda, 0x93, 0x90, 0xb9, 0x0f, 0xba, 0x3c, 0xf7, 0x41,
+	0x5b, 0xff, 0x64, 0xc0, 0xbd, 0xb9, 0x2c, 0x02, 0xbd, 0x49, 0x92, 0xed, 0x18, 0x3c, 0xdb, 0x49,
+	0x00, 0xe4, 0xfb, 0x50, 0x57, 0xe2, 0xab, 0x7e, 0xc8, 0x5d, 0xfb, 0x4d, 0x26, 0xe0, 0x86, 0xf1,
+	0xe6, 0x70, 0xe8, 0x98, 0x4e, 0xa8, 0x2f, 0x5d, 0x68, 0xd3, 0x6e, 0x22, 0xb0, 0x2b, 0x61, 0xf8,
+	0xb1, 0xbb, 0xd9, 0x66, 0xad, 0xd8, 0xdf, 0x8a, 0x9b, 0xee, 0xd5, 0xe2, 0x0e, 0x2a, 0x22, 0xfe,
+	0x24, 0xcb, 0xbc, 0xd2, 0x27, 0x92, 0x53, 0xac, 0xf3, 0x2d, 0x4e, 0x4a, 0xd7, 0xa0, 0x22, 0x14,
+	0x2c, 0x3f, 0x1c, 0x39, 0xca, 0x24, 0xab, 0xa5, 0xbb, 0x93, 0xd5, 0x72, 0x5e, 0xb2, 0x9a, 0x9f,
+	0x35, 0x56, 0x16, 0x65, 0x8d, 0xff, 0x56, 0x80, 0x7a, 0x1c, 0x15, 0xcf, 0x6d, 0x02, 0xbf, 0x55,
+	0xde, 0x96, 0xd1, 0x82, 0x9d, 0xa2, 0x2d, 0x5a, 0x35, 0x89, 0x57, 0xa6, 0x7e, 0xea, 0x7b, 0x28,
+	0xda, 0x75, 0xea, 0xab, 0xaf, 0x61, 0x1b, 0xaa, 0x13, 0x3a, 0xe9, 0xd3, 0x50, 0x5d, 0x3f, 0xab,
+	0xa9, 0x38, 0x1c, 0xc3, 0xb9, 0x3e, 0x0d, 0x6d, 0x45, 0x44, 0xbe, 0x84, 0x66, 0xdf, 0x1d, 0x5c,
+	0x39, 0x6a, 0x52, 0xf9, 0x96, 0x49, 0x0d, 0xa4, 0x14, 0xbf, 0x23, 0xf3, 0x2f, 0x0d, 0xa8, 0x88,
+	0xdf, 0xe8, 0x82, 0x63, 0xc3, 0x14, 0x11, 0x7a, 0x3c, 0x46, 0x2d, 0xc6, 0x45, 0x68, 0x87, 0x53,
+	0x88, 0xd3, 0x69, 0xc5, 0xd0, 0x5d, 0x57, 0xf4, 0x90, 0x73, 0xcf, 0x88, 0x40, 0x89, 0xe7, 0xf6,
+	0xc2, 0xb3, 0xf3, 0xdf, 0x08, 0xe3, 0x51, 0xb3, 0xb8, 0x2a, 0xf9, 0x6f, 0xeb, 0x1f, 0x0b, 0xd0,
+	0xd0, 0x12, 0x99, 0x39, 0xc5, 0xc6, 0x95, 0x82, 0xf8, 0xe9, 0x90, 0xaa, 0x14, 0xf0, 0x78, 0xf5,
+	0x69, 0x92, 0x1e, 0x71, 0x02, 0xd1, 0x5d, 0x6b, 0x68, 0x85, 0x4d, 0xac, 0x6b, 0xb2, 0x6b, 0x27,
+	0x6e, 0xb2, 0xd5, 0xed, 0x0a, 0xbb, 0x96, 0x0f, 0x40, 0x1a, 0x18, 0x91, 0x29, 0xa4, 0x70, 0xaf,
+	0x75, 0x7a, 0xcd, 0xce, 0x05, 0xfe, 0x33, 0xb8, 0xaf, 0x4a, 0xe4, 0x3a, 0x9d, 0x70, 0xae, 0x6d,
+	0x89, 0xea, 0xc6, 0xe4, 0xf8, 0x1c, 0x24, 0x70, 0x58, 0x70, 0x45, 0x7d, 0x75, 0xeb, 0xb0, 0xe0,
+	0x1c, 0x87, 0x9a, 0x92, 0x6a, 0x29, 0x25, 0xad, 0x41, 0x45, 0x5a, 0xa8, 0x08, 0x06, 0xe4, 0x88,
+	0x6c, 0x08, 0x3f, 0x28, 0x71, 0x20, 0x4c, 0x65, 0x44, 0x65, 0xf5, 0xc4, 0xfa, 0xbb, 0x12, 0xac,
+	0x64, 0x7a, 0x2d, 0x9a, 0xde, 0xca, 0x5c, 0x6f, 0x04, 0x4a, 0x68, 0xd4, 0xf2, 0xd0, 0xf8, 0x6f,
+	0xfc, 0xd2, 0x3c, 0xbf, 0x1f, 0xcc, 0x7c, 0x55, 0x43, 0x56, 0x43, 0xf2, 0x12, 0x4a, 0x11, 0xe5,
+	0x55, 0x1d, 0x34, 0xa2, 0x47, 0x39, 0xcd, 0x9c, 0x6d, 0xf5, 0xc3, 0xe6, 0x94, 0xe4, 0xdb, 0x50,
+	0x0b, 0xe9, 0x80, 0x7a, 0x98, 0xf4, 0x96, 0xbf, 0xc6, 0xac, 0x98, 0x9a, 0xf4, 0xa0, 0x25, 0x1f,
+	0x12, 0xb8, 0x8c, 0xfa, 0x83, 0x1b, 0x99, 0xa2, 0x3e, 0xcf, 0x9b, 0xfe, 0x56, 0x90, 0x1c, 0x7a,
+	0x11, 0x0b, 0x2e, 0x42, 0x77, 0x62, 0x0b, 0x57, 0x29, 0xc1, 0x64, 0x0f, 0x80, 0x5d, 0xc7, 0xeb,
+	0x54, 0x7f, 0x85, 0x75, 0xea, 0xec, 0x5a, 0xc2, 0xcc, 0x53, 0xa8, 0xc5, 0x5a, 0xec, 0x40, 0x15,
+	0xcd, 0xdb, 0xf5, 0x87, 0x2a, 0x63, 0x95, 0x43, 0x74, 0xee, 0xa2, 0x45, 0x52, 0x10, 0xb9, 0x01,
+	0x1f, 0x24, 0x6f, 0xf0, 0x8a, 0x02, 0xca, 0x07, 0xe6, 0x5f, 0x18, 0xd0, 0xce, 0x72, 0x4c, 0x16,
+	0x30, 0xf4, 0x05, 0x78, 0x16, 0xe3, 0xfa, 0xce, 0xc4, 0x1b, 0x84, 0x41, 0x24, 0xdd, 0x06, 0x20,
+	0xe8, 0x88, 0x43, 0xd0, 0x11, 0xf3, 0x23, 0x8a, 0x14, 0x09, 0x3a, 0xe2, 0xa2, 0xdd, 0x14, 0x40,
+	0x49, 0xb4, 0x06, 0x15, 0xbe, 0x9c, 0x70, 0x1d, 0x25, 0x5b, 0x8e, 0x5e, 0xfd, 0xcf, 0x0a, 0x54,
+	0xc4, 0xf3, 0x43, 0xd2, 0x83, 0xe5, 0xf4, 0xdb, 0x42, 0xf2, 0x30, 0xfd, 0x00, 0x4f, 0x7b, 0x47,
+	0x64, 0x9a, 0x79, 0x28, 0x51, 0xed, 0xb4, 0x96, 0xc8, 0x5b, 0x58, 0x49, 0x70, 0xfc, 0xdd, 0x1e,
+	0x31, 0x73, 0x1f, 0xf3, 0x89, 0xc5, 0x3e, 0xba, 0xe5, 0xa1, 0x9f, 0xb5, 0x44, 0x6c, 0xde, 0xb7,
+	0x49, 0xbf, 0x01, 0x23, 0x8f, 0x16, 0x3c, 0x0d, 0x13, 0x2b, 0x6e, 0xdc, 0xfa, 0x70, 0xcc, 0x5a,
+	0x22, 0x7b, 0xd0, 0xd4, 0x5f, 0x31, 0x91, 0x75, 0x7d, 0x82, 0xbe, 0x52, 0x67, 0x1e, 0x11, 0x2f,
+	0xf2, 0x25, 0xd4, 0x14, 0x86, 0xdc, 0xd7, 0xe9, 0xd4, 0xe4, 0xd5, 0x34, 0x30, 0x9e, 0xf8, 0x07,
+	0xd0, 0xd0, 0x1e, 0xfe, 0x90, 0x35, 0x49, 0x96, 0x79, 0x61, 0x64, 0xae, 0xcf, 0xc1, 0xe3, 0x15,
+	0xc4, 0x61, 0x69, 0x4f, 0x76, 0xe2, 0xc3, 0x9a, 0x7f, 0x21, 0x64, 0x9a, 0x79, 0xa8, 0x78, 0xa9,
+	0xd7, 0x00, 0xc9, 0x3b, 0x1b, 0xf2, 0x40, 0xd2, 0xa6, 0xdf, 0xf6, 0x98, 0x6b, 0x59, 0x70, 0x46,
+	0x12, 0x3d, 0x54, 0x54, 0x92, 0xcc, 0x77, 0xa6, 0x4d, 0x33, 0x0f, 0x95, 0x39, 0xe8, 0xf4, 0x43,
+	0x98, 0xf8, 0xa0, 0x73, 0xdf, 0xd3, 0x98, 0x1b, 0x0b, 0xb0, 0xf1, 0x9a, 0x2e, 0x6f, 0xed, 0xe4,
+	0x3c, 0x7d, 0x20, 0x4f, 0xe5, 0xd4, 0xc5, 0x0f, 0x39, 0x4c, 0xeb, 0x36, 0x92, 0x98, 0xc5, 0x9f,
+	0xa8, 0xf7, 0x3b, 0x79, 0x5c, 0x9e, 0x27, 0x02, 0xde, 0xc2, 0xe8, 0xe3, 0x3b, 0xa8, 0x62, 0x5e,
+	0x17, 0xbc, 0xad, 0x91, 0xfb, 0xf0, 0x81, 0x3c, 0x4b, 0x4b, 0x9b, 0xfb, 0xa6, 0xc2, 0x7c, 0x7e,
+	0x3b, 0x51, 0xe6, 0x58, 0xb5, 0xc7, 0x0c, 0x9a, 0x37, 0xc8, 0x3e, 0x92, 0x30, 0xcd, 0x3c, 0x94,
+	0x7e, 0xac, 0x73, 0x8f, 0x0d, 0xc4, 0xb1, 0x2e, 0x7a, 0xc1, 0x60, 0x6e, 0x2c, 0xc0, 0x66, 0xc4,
+	0xd3, 0xaf, 0x37, 0x25, 0xde, 0xfc, 0x1b, 0x05, 0xd3, 0xcc, 0x43, 0x65, 0xac, 0x2e, 0xfd, 0x20,
+	0x20, 0xb6, 0xba, 0xdc, 0x37, 0x09, 0xe6, 0xc6, 0x02, 0x6c, 0xbc, 0xe6, 0x77, 0xa1, 0x1e, 0xb7,
+	0x9a, 0x89, 0xf2, 0x02, 0xa9, 0xa6, 0xb8, 0xf9, 0x20, 0x03, 0x8d, 0xe7, 0x9e, 0x40, 0x3b, 0xdb,
+	0x27, 0x25, 0xdc, 0x43, 0x2e, 0x68, 0x20, 0x9b, 0x8f, 0xf2, 0x91, 0x19, 0x6f, 0xac, 0x77, 0x01,
+	0x63, 0x6f, 0x9c, 0xd3, 0xf2, 0x34, 0x3f, 0xca, 0xc5, 0xe9, 0xe2, 0x65, 0xfb, 0x5c, 0xe4, 0xa3,
+	0xfc, 0xee, 0x97, 0x26, 0xde, 0xa2, 0xd6, 0x98, 0xb5, 0x44, 0xfe, 0x18, 0x1e, 0xe4, 0xf6, 0x5a,
+	0xc8, 0xa6, 0x34, 0x82, 0x85, 0x6d, 0x18, 0x21, 0xea, 0x82, 0xb2, 0xaa, 0xb5, 0xf4, 0xd2, 0x20,
+	0x2e, 0x98, 0x79, 0x0b, 0x9c, 0xb1, 0x90, 0xba, 0x93, 0x5f, 0x9b, 0xc1, 0x96, 0xf1, 0xd2, 0x20,
+	0x87, 0xb0, 0x92, 0xe9, 0xa4, 0x08, 0xed, 0xe6, 0xb7, 0x57, 0xc4, 0xb1, 0xcf, 0x75, 0x34, 0xb8,
+	0xb0, 0x03, 0xe8, 0xc4, 0x93, 0x32, 0x9d, 0x17, 0xf1, 0x6d, 0xdf, 0xd1, 0x97, 0x31, 0x1f, 0xdf,
+	0x5e, 0x97, 0x47, 0x26, 0xfd, 0x0a, 0xff, 0x97, 0xc1, 0xe7, 0xff, 0x3f, 0x00, 0xd8, 0xb8, 0xc2,
+	0x3e, 0x75, 0x30, 0x00, 0x00,
 }
@@ -7,9 +7,11 @@ import (
 	"github.com/classzz/classzz/blockchain/indexers"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/cross"
 	"github.com/classzz/classzz/czzrpc/pb"
 	"github.com/classzz/classzz/database"
 	"github.com/classzz/classzz/mempool"
+	"github.com/classzz/classzz/peer"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
@@ -21,13 +23,15 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 var serviceMap = map[string]interface{}{
-	"czzrpc": &GrpcServer{},
+	"pb.czzrpc": &GrpcServer{},
 
 	"grpc.reflection.v1alpha.ServerReflection": &reflectionServer{},
 }
@@ -60,7 +64,8 @@ func ServiceReady(service string) error {
 
 // NetManager is an interface which provides functions for handling new transactions.
 // This is used by the SubmitTransaction RPC to notify the rest of the system a new
-// transaction needs to be handled.
+// transaction needs to be handled.  It also provides the peer statistics for the
+// GetNetMsgStats RPC.
 type NetManager interface {
 	// AddRebroadcastInventory adds 'iv' to the list of inventories to be
 	// rebroadcasted at random intervals until they show up in a block.
@@ -71,6 +76,10 @@ type NetManager interface {
 	// transactions.  This function should be called whenever new transactions
 	// are added to the mempool.
 	AnnounceNewTransactions(txns []*mempool.TxDesc)
+
+	// PeerStats returns a snapshot of the statistics of all connected
+	// peers.
+	PeerStats() []*peer.StatsSnap
 }
 
 // GrpcServerConfig hols the various objects needed by the GrpcServer to
@@ -136,7 +145,7 @@ func NewGrpcServer(cfg *GrpcServerConfig) *GrpcServer {
 	}
 	reflection.Register(cfg.Server)
 	pb.RegisterCzzrpcServer(cfg.Server, s)
-	serviceMap["czzrpc"] = s
+	serviceMap["pb.czzrpc"] = s
 	return s
 }
 
@@ -320,6 +329,79 @@ func (s *GrpcServer) GetMempoolInfo(ctx context.Context, req *pb.GetMempoolInfoR
 	return resp, nil
 }
 
+// GetMempoolEntry returns info about a transaction in the mempool along with
+// its relationships to the other transactions of the mempool.
+func (s *GrpcServer) GetMempoolEntry(ctx context.Context, req *pb.GetMempoolEntryRequest) (*pb.GetMempoolEntryResponse, error) {
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction hash")
+	}
+
+	entry, err := s.txMemPool.TxEntry(txHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "transaction not found in the mempool")
+	}
+
+	tx := marshalTransaction(entry.Tx, 0, nil, 0, s.chainParams)
+	tx.Timestamp = entry.Added.Unix()
+	view, err := s.txMemPool.FetchInputUtxos(entry.Tx)
+	if err == nil {
+		for i, in := range entry.Tx.MsgTx().TxIn {
+			stxo := view.LookupEntry(in.PreviousOutPoint)
+			if stxo != nil {
+				tx.Inputs[i].Value = stxo.Amount()
+				tx.Inputs[i].PreviousScript = stxo.PkScript()
+
+				_, addrs, _, err := txscript.ExtractPkScriptAddrs(stxo.PkScript(), s.chainParams)
+				if err == nil && len(addrs) > 0 {
+					tx.Inputs[i].Address = addrs[0].String()
+				}
+			}
+		}
+	}
+
+	resp := &pb.GetMempoolEntryResponse{
+		Transaction: &pb.MempoolTransaction{
+			Transaction:      tx,
+			AddedTime:        entry.Added.Unix(),
+			AddedHeight:      entry.Height,
+			Fee:              entry.Fee,
+			FeePerKb:         entry.FeePerKB,
+			StartingPriority: entry.StartingPriority,
+		},
+		Type:            marshalTxType(entry.Type),
+		Size:            uint32(entry.Size),
+		AncestorCount:   uint32(entry.AncestorCount),
+		AncestorSize:    uint64(entry.AncestorSize),
+		AncestorFees:    entry.AncestorFees,
+		DescendantCount: uint32(entry.DescendantCount),
+		DescendantSize:  uint64(entry.DescendantSize),
+		DescendantFees:  entry.DescendantFees,
+	}
+	for i := range entry.Depends {
+		resp.Depends = append(resp.Depends, entry.Depends[i][:])
+	}
+	for i := range entry.SpentBy {
+		resp.SpentBy = append(resp.SpentBy, entry.SpentBy[i][:])
+	}
+	return resp, nil
+}
+
+// marshalTxType converts a mempool transaction type to its protobuf
+// representation.
+func marshalTxType(txType mempool.TxType) pb.GetMempoolEntryResponse_TransactionType {
+	switch txType {
+	case mempool.TxTypeConvert:
+		return pb.GetMempoolEntryResponse_CONVERT
+	case mempool.TxTypeCasting:
+		return pb.GetMempoolEntryResponse_CASTING
+	case mempool.TxTypeMortgage:
+		return pb.GetMempoolEntryResponse_MORTGAGE
+	default:
+		return pb.GetMempoolEntryResponse_STANDARD
+	}
+}
+
 // GetBlockchainInfo returns info about the blockchain including the most recent
 // block hash and height.
 func (s *GrpcServer) GetBlockchainInfo(ctx context.Context, req *pb.GetBlockchainInfoRequest) (*pb.GetBlockchainInfoResponse, error) {
@@ -926,6 +1008,9 @@ func (s *GrpcServer) GetMerkleProof(ctx context.Context, req *pb.GetMerkleProofR
 
 // SubmitTransaction submits a transaction to all connected peers.
 func (s *GrpcServer) SubmitTransaction(ctx context.Context, req *pb.SubmitTransactionRequest) (*pb.SubmitTransactionResponse, error) {
+	if s.chain.HeadersOnly() {
+		return nil, status.Error(codes.Unavailable, "transactions can not be validated in headers-only mode")
+	}
 
 	var msgTx wire.MsgTx
 	if err := msgTx.Deserialize(bytes.NewReader(req.Transaction)); err != nil {
@@ -982,6 +1067,395 @@ func (s *GrpcServer) SubmitTransaction(ctx context.Context, req *pb.SubmitTransa
 	return resp, nil
 }
 
+// GetNetMsgStats returns the per-message traffic counters and the block and
+// transaction relay latencies of the connected peers.
+func (s *GrpcServer) GetNetMsgStats(ctx context.Context, req *pb.GetNetMsgStatsRequest) (*pb.GetNetMsgStatsResponse, error) {
+	resp := &pb.GetNetMsgStatsResponse{}
+	for _, snap := range s.netMgr.PeerStats() {
+		resp.Peers = append(resp.Peers, &pb.PeerNetMsgStats{
+			Id:           snap.ID,
+			Addr:         snap.Addr,
+			Inbound:      snap.Inbound,
+			Sent:         marshalMsgStats(snap.MsgsSent),
+			Received:     marshalMsgStats(snap.MsgsRecv),
+			BlockLatency: marshalLatencyHistogram(&snap.BlockLatency),
+			TxLatency:    marshalLatencyHistogram(&snap.TxLatency),
+		})
+	}
+	return resp, nil
+}
+
+// marshalMsgStats converts per-command message counters to their protobuf
+// representation sorted by command.
+func marshalMsgStats(stats map[string]peer.MsgStats) []*pb.PeerNetMsgStats_MsgStats {
+	commands := make([]string, 0, len(stats))
+	for command := range stats {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+
+	msgStats := make([]*pb.PeerNetMsgStats_MsgStats, 0, len(commands))
+	for _, command := range commands {
+		msgStats = append(msgStats, &pb.PeerNetMsgStats_MsgStats{
+			Command: command,
+			Count:   stats[command].Count,
+			Bytes:   stats[command].Bytes,
+		})
+	}
+	return msgStats
+}
+
+// marshalLatencyHistogram converts a latency histogram to its protobuf
+// representation.
+func marshalLatencyHistogram(h *peer.LatencyHistogram) *pb.PeerNetMsgStats_LatencyHistogram {
+	hist := &pb.PeerNetMsgStats_LatencyHistogram{
+		Count:      h.Count,
+		MeanMicros: int64(h.Mean() / time.Microsecond),
+		Counts:     h.Buckets,
+	}
+	for _, bound := range peer.LatencyBuckets {
+		hist.BoundsMicros = append(hist.BoundsMicros,
+			int64(bound/time.Microsecond))
+	}
+	return hist
+}
+
+// stateBlockSelector is implemented by the requests selecting the block of a
+// committee or entangle state.
+type stateBlockSelector interface {
+	GetBlockHash() []byte
+	GetBlockHeight() int32
+}
+
+// stateBlock returns the hash and height of the main chain block selected by
+// the passed request, or of the best block when neither the hash nor the
+// height of the block is set.
+func (s *GrpcServer) stateBlock(req stateBlockSelector) (*chainhash.Hash, int32, error) {
+	switch {
+	case len(req.GetBlockHash()) > 0:
+		hash, err := chainhash.NewHash(req.GetBlockHash())
+		if err != nil {
+			return nil, 0, status.Error(codes.InvalidArgument, "invalid block hash")
+		}
+		height, err := s.chain.BlockHeightByHash(hash)
+		if err != nil {
+			return nil, 0, status.Error(codes.NotFound, "block not found in the main chain")
+		}
+		return hash, height, nil
+
+	case req.GetBlockHeight() != 0:
+		hash, err := s.chain.BlockHashByHeight(req.GetBlockHeight())
+		if err != nil {
+			return nil, 0, status.Error(codes.NotFound, "block not found in the main chain")
+		}
+		return hash, req.GetBlockHeight(), nil
+
+	default:
+		best := s.chain.BestSnapshot()
+		return &best.Hash, best.Height, nil
+	}
+}
+
+// committeeState returns the committee state as of the block selected by the
+// passed request along with the hash and height of the block.
+func (s *GrpcServer) committeeState(req stateBlockSelector) (*cross.CommitteeState, *chainhash.Hash, int32, error) {
+	hash, height, err := s.stateBlock(req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	cState, err := s.chain.FetchCommitteeState(hash, height)
+	if err != nil {
+		return nil, nil, 0, status.Error(codes.NotFound, "committee state not found")
+	}
+	return cState, hash, height, nil
+}
+
+// GetCommitteeState returns the pledges and committees of the committee state
+// along with the number of its convert items.
+func (s *GrpcServer) GetCommitteeState(ctx context.Context, req *pb.GetCommitteeStateRequest) (*pb.GetCommitteeStateResponse, error) {
+	cState, hash, height, err := s.committeeState(req)
+	if err != nil {
+		return nil, err
+	}
+
+	stateHash := cState.Hash()
+	resp := &pb.GetCommitteeStateResponse{
+		BlockHash:   hash[:],
+		BlockHeight: height,
+		StateHash:   stateHash[:],
+		MaxItemId:   bigToUint64(cState.MaxItemID),
+	}
+	for _, info := range cState.PledgeInfos {
+		resp.Pledges = append(resp.Pledges, marshalPledge(info))
+	}
+	sort.Slice(resp.Pledges, func(i, j int) bool {
+		return resp.Pledges[i].Id < resp.Pledges[j].Id
+	})
+	for _, info := range cState.CommitteeInfos {
+		resp.Committees = append(resp.Committees, marshalCommittee(info))
+	}
+	sort.Slice(resp.Committees, func(i, j int) bool {
+		return resp.Committees[i].Id < resp.Committees[j].Id
+	})
+	resp.ConvertItemCount = uint32(len(convertItems(cState.ConvertItems, nil, nil)))
+	resp.ConvertConfirmItemCount = uint32(len(convertItems(cState.ConvertConfirmItems, nil, nil)))
+	return resp, nil
+}
+
+// GetPledge returns a pledge of the committee state by its id or address.
+func (s *GrpcServer) GetPledge(ctx context.Context, req *pb.GetPledgeRequest) (*pb.GetPledgeResponse, error) {
+	cState, hash, height, err := s.committeeState(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var info *cross.PledgeInfo
+	switch p := req.Pledge.(type) {
+	case *pb.GetPledgeRequest_Id:
+		info = cState.GetPledgeInfoByID(new(big.Int).SetUint64(p.Id))
+	case *pb.GetPledgeRequest_Address:
+		info = cState.GetPledgeInfoByAddress(p.Address)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "pledge id or address required")
+	}
+	if info == nil {
+		return nil, status.Error(codes.NotFound, "pledge not found")
+	}
+
+	resp := &pb.GetPledgeResponse{
+		BlockHash:   hash[:],
+		BlockHeight: height,
+		Pledge:      marshalPledge(info),
+	}
+	return resp, nil
+}
+
+// ListConvertItems returns the pending or confirmed convert items of the
+// committee state matching the asset and convert types of the request, sorted
+// by id.
+func (s *GrpcServer) ListConvertItems(ctx context.Context, req *pb.ListConvertItemsRequest) (*pb.ListConvertItemsResponse, error) {
+	cState, hash, height, err := s.committeeState(req)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsByType := cState.ConvertItems
+	if req.Confirmed {
+		itemsByType = cState.ConvertConfirmItems
+	}
+	items := convertItems(itemsByType, req.AssetTypes, req.ConvertTypes)
+
+	resp := &pb.ListConvertItemsResponse{
+		BlockHash:   hash[:],
+		BlockHeight: height,
+		Total:       uint32(len(items)),
+	}
+	if int(req.NbSkip) >= len(items) {
+		return resp, nil
+	}
+	items = items[req.NbSkip:]
+	if req.NbFetch > 0 && int(req.NbFetch) < len(items) {
+		items = items[:req.NbFetch]
+	}
+	resp.Items = items
+	return resp, nil
+}
+
+// GetPoolBalances returns the outputs and balances of the pool addresses of
+// the committee state, sorted by address.
+func (s *GrpcServer) GetPoolBalances(ctx context.Context, req *pb.GetPoolBalancesRequest) (*pb.GetPoolBalancesResponse, error) {
+	cState, hash, height, err := s.committeeState(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetPoolBalancesResponse{
+		BlockHash:   hash[:],
+		BlockHeight: height,
+	}
+	for addr, item := range cState.NoCostUtxos {
+		pool := &pb.GetPoolBalancesResponse_Pool{Address: addr}
+		for i := range item.POut {
+			output := &pb.UnspentOutput{
+				Outpoint: &pb.Transaction_Input_Outpoint{
+					Hash:  item.POut[i].Hash[:],
+					Index: item.POut[i].Index,
+				},
+			}
+			if i < len(item.Script) {
+				output.PubkeyScript = item.Script[i]
+			}
+			if i < len(item.Amount) {
+				output.Value = bigToInt64(item.Amount[i])
+			}
+			pool.Balance += output.Value
+			pool.Outputs = append(pool.Outputs, output)
+		}
+		resp.Pools = append(resp.Pools, pool)
+	}
+	sort.Slice(resp.Pools, func(i, j int) bool {
+		return resp.Pools[i].Address < resp.Pools[j].Address
+	})
+	return resp, nil
+}
+
+// GetEntangleState returns the beacon addresses and pool amounts of the
+// entangle state, which was replaced by the committee state at the Maui fork.
+func (s *GrpcServer) GetEntangleState(ctx context.Context, req *pb.GetEntangleStateRequest) (*pb.GetEntangleStateResponse, error) {
+	hash, height, err := s.stateBlock(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The entangle state is no longer updated from the Maui fork on, so
+	// use the last one for later blocks.
+	if s.chainParams.IsActive(chaincfg.UpgradeMaui, height) {
+		height = s.chainParams.UpgradeHeight(chaincfg.UpgradeMaui) - 1
+		hash, err = s.chain.BlockHashByHeight(height)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "entangle state not found")
+		}
+	}
+	eState, err := s.chain.FetchEntangleState(hash, height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "entangle state not found")
+	}
+
+	resp := &pb.GetEntangleStateResponse{
+		BlockHash:     hash[:],
+		BlockHeight:   height,
+		PoolAmount1:   bigToInt64(eState.PoolAmount1),
+		PoolAmount2:   bigToInt64(eState.PoolAmount2),
+		CurExchangeId: eState.CurExchangeID,
+	}
+	for _, info := range eState.EnInfos {
+		resp.Beacons = append(resp.Beacons, &pb.GetEntangleStateResponse_Beacon{
+			ExchangeId:        info.ExchangeID,
+			Address:           info.Address,
+			ToAddress:         info.ToAddress,
+			StakingAmount:     bigToInt64(info.StakingAmount),
+			EntangleAmount:    bigToInt64(info.EntangleAmount),
+			AssetFlag:         info.AssetFlag,
+			Fee:               info.Fee,
+			KeepTime:          info.KeepTime,
+			CoinbaseAddresses: info.CoinBaseAddress,
+		})
+	}
+	sort.Slice(resp.Beacons, func(i, j int) bool {
+		return resp.Beacons[i].ExchangeId < resp.Beacons[j].ExchangeId
+	})
+	return resp, nil
+}
+
+// convertItems returns the convert items of the passed asset and convert types
+// sorted by id.  Items of all types are returned when no types are passed.
+func convertItems(itemsByType map[uint8]cross.ConvertItemMap, assetTypes, convertTypes []uint32) []*pb.ConvertItem {
+	matches := func(types []uint32, t uint8) bool {
+		if len(types) == 0 {
+			return true
+		}
+		for _, v := range types {
+			if v == uint32(t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var items []*pb.ConvertItem
+	for assetType, itemMap := range itemsByType {
+		if !matches(assetTypes, assetType) {
+			continue
+		}
+		for convertType, itemList := range itemMap {
+			if !matches(convertTypes, convertType) {
+				continue
+			}
+			for _, item := range itemList {
+				items = append(items, marshalConvertItem(item, assetType, convertType))
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Id < items[j].Id
+	})
+	return items
+}
+
+// marshalConvertItem converts a convert item of the committee state to its
+// protobuf representation.
+func marshalConvertItem(item *cross.ConvertItem, assetType, convertType uint8) *pb.ConvertItem {
+	return &pb.ConvertItem{
+		Id:               bigToUint64(item.ID),
+		AssetType:        uint32(assetType),
+		ConvertType:      uint32(convertType),
+		TxHash:           item.TxHash,
+		ExtTxHash:        item.ExtTxHash,
+		ConfirmExtTxHash: item.ConfirmExtTxHash,
+		ToToken:          item.ToToken,
+		Pubkey:           item.PubKey,
+		Amount:           bigToInt64(item.Amount),
+		FeeAmount:        bigToInt64(item.FeeAmount),
+	}
+}
+
+// marshalPledge converts a pledge of the committee state to its protobuf
+// representation.
+func marshalPledge(info *cross.PledgeInfo) *pb.Pledge {
+	return &pb.Pledge{
+		Id:                bigToUint64(info.ID),
+		Address:           info.Address,
+		Pubkey:            info.PubKey,
+		ToAddress:         info.ToAddress,
+		StakingAmount:     bigToInt64(info.StakingAmount),
+		CoinbaseAddresses: info.CoinBaseAddress,
+	}
+}
+
+// marshalCommittee converts a committee of the committee state to its protobuf
+// representation.
+func marshalCommittee(info *cross.CommitteeInfo) *pb.Committee {
+	marshalMembers := func(members []*cross.CommitteeMember) []*pb.Committee_Member {
+		var pbMembers []*pb.Committee_Member
+		for _, member := range members {
+			pbMembers = append(pbMembers, &pb.Committee_Member{
+				Coinbase:      member.Coinbase,
+				CommitteeBase: member.CommitteeBase,
+				Pubkey:        member.Publickey,
+				Flag:          member.Flag,
+				Type:          member.MType,
+			})
+		}
+		return pbMembers
+	}
+
+	return &pb.Committee{
+		Id:          bigToUint64(info.Id),
+		StartHeight: bigToInt64(info.StartHeight),
+		EndHeight:   bigToInt64(info.EndHeight),
+		Members:     marshalMembers(info.Members),
+		BackMembers: marshalMembers(info.BackMembers),
+	}
+}
+
+// bigToInt64 returns the passed integer of the cross-chain state as an int64,
+// treating nil as zero.
+func bigToInt64(x *big.Int) int64 {
+	if x == nil {
+		return 0
+	}
+	return x.Int64()
+}
+
+// bigToUint64 returns the passed integer of the cross-chain state as an
+// uint64, treating nil as zero.
+func bigToUint64(x *big.Int) uint64 {
+	if x == nil {
+		return 0
+	}
+	return x.Uint64()
+}
+
 // SubscribeTransactions subscribes to relevant transactions based on the
 // subscription requests. The parameters to filter transactions on can be
 // updated by sending new SubscribeTransactionsRequest objects on the stream.
@@ -1265,6 +1739,102 @@ func (s *GrpcServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.C
 	}
 }
 
+// SubscribeCommitteeEvents creates a subscription for the changes of the
+// committee state caused by blocks being connected to or disconnected from the
+// main chain.
+func (s *GrpcServer) SubscribeCommitteeEvents(req *pb.SubscribeCommitteeEventsRequest, stream pb.Czzrpc_SubscribeCommitteeEventsServer) error {
+	wanted := make(map[pb.CommitteeEventNotification_Type]bool)
+	for _, typ := range req.EventTypes {
+		wanted[typ] = true
+	}
+
+	subscription := s.subscribeEvents()
+	defer subscription.Unsubscribe()
+
+	for {
+		select {
+		case event := <-subscription.Events():
+			var (
+				block   *czzutil.Block
+				removed bool
+			)
+			switch event := event.(type) {
+			case *rpcEventBlockConnected:
+				block = event.Block
+			case *rpcEventBlockDisconnected:
+				block, removed = event.Block, true
+			default:
+				continue
+			}
+
+			events, err := s.chain.CommitteeEvents(block)
+			if err != nil {
+				log.Errorf("Failed to compute committee events of block %s: %v",
+					block.Hash(), err)
+				continue
+			}
+
+			// The events of a disconnected block are undone from the
+			// last to the first.
+			for i := range events {
+				event := events[i]
+				if removed {
+					event = events[len(events)-1-i]
+				}
+				toSend := marshalCommitteeEvent(event, block, removed)
+				if len(wanted) != 0 && !wanted[toSend.Type] {
+					continue
+				}
+				if err := stream.Send(toSend); err != nil {
+					return err
+				}
+			}
+
+		case <-stream.Context().Done():
+			return nil // client disconnected
+		}
+	}
+}
+
+// committeeEventTypes maps the committee state changes to their protobuf
+// notification types.
+var committeeEventTypes = map[cross.CommitteeEventType]pb.CommitteeEventNotification_Type{
+	cross.EventConvertItemCreated:   pb.CommitteeEventNotification_CONVERT_ITEM_CREATED,
+	cross.EventConvertItemConfirmed: pb.CommitteeEventNotification_CONVERT_ITEM_CONFIRMED,
+	cross.EventCastingCreated:       pb.CommitteeEventNotification_CASTING_CREATED,
+	cross.EventPledgeChanged:        pb.CommitteeEventNotification_PLEDGE_CHANGED,
+	cross.EventPoolBalanceChanged:   pb.CommitteeEventNotification_POOL_BALANCE_CHANGED,
+}
+
+// marshalCommitteeEvent converts a committee state change caused by the passed
+// block to its protobuf notification.
+func marshalCommitteeEvent(event *cross.CommitteeEvent, block *czzutil.Block, removed bool) *pb.CommitteeEventNotification {
+	n := &pb.CommitteeEventNotification{
+		Type:        committeeEventTypes[event.Type],
+		Removed:     removed,
+		BlockHash:   block.Hash().CloneBytes(),
+		BlockHeight: block.Height(),
+	}
+	switch {
+	case event.Item != nil:
+		n.Event = &pb.CommitteeEventNotification_ConvertItem{
+			ConvertItem: marshalConvertItem(event.Item, event.AssetType, event.ConvertType),
+		}
+	case event.Pledge != nil:
+		n.Event = &pb.CommitteeEventNotification_Pledge{
+			Pledge: marshalPledge(event.Pledge),
+		}
+	default:
+		n.Event = &pb.CommitteeEventNotification_PoolBalance_{
+			PoolBalance: &pb.CommitteeEventNotification_PoolBalance{
+				Address: event.Address,
+				Balance: bigToInt64(event.Balance),
+			},
+		}
+	}
+	return n
+}
+
 func (s *GrpcServer) fetchTransactionFromBlock(txHash *chainhash.Hash) ([]byte, int32, *chainhash.Hash, error) {
 	// Look up the location of the transaction.
 	blockRegion, err := s.txIndex.TxBlockRegion(txHash)
@@ -0,0 +1,26 @@
+package czzrpc
+
+import (
+	"testing"
+
+	"github.com/classzz/classzz/czzrpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestServiceReady ensures the readiness check knows the services under the
+// names the gRPC server passes in the full method names of requests.
+func TestServiceReady(t *testing.T) {
+	server := grpc.NewServer()
+	pb.RegisterCzzrpcServer(server, &GrpcServer{})
+	for name := range server.GetServiceInfo() {
+		err := ServiceReady(name)
+		if status.Code(err) == codes.Unimplemented {
+			t.Errorf("ServiceReady(%q): %v", name, err)
+		}
+	}
+	if err := ServiceReady("czzrpc"); status.Code(err) != codes.Unimplemented {
+		t.Errorf("ServiceReady(\"czzrpc\"): unexpected error %v", err)
+	}
+}
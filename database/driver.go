@@ -27,6 +27,12 @@ type Driver struct {
 	// ErrDbDoesNotExist if the database has not already been created.
 	Open func(args ...interface{}) (DB, error)
 
+	// OpenReadOnly is the function that will be invoked with all
+	// user-specified arguments to open the database without modifying it.
+	// It is optional and must return ErrDbDoesNotExist if the database has
+	// not already been created.
+	OpenReadOnly func(args ...interface{}) (DB, error)
+
 	// UseLogger uses a specified Logger to output package logging info.
 	UseLogger func(logger czzlog.Logger)
 }
@@ -87,3 +93,25 @@ func Open(dbType string, args ...interface{}) (DB, error) {
 
 	return drv.Open(args...)
 }
+
+// OpenReadOnly opens an existing database for the specified type such that
+// nothing is ever written to it.  Writable transactions on the returned
+// database fail with ErrTxNotWritable.  The arguments are the same as for
+// Open.
+//
+// ErrDbUnknownType will be returned if the the database type is not registered
+// or the driver does not support read-only access.
+func OpenReadOnly(dbType string, args ...interface{}) (DB, error) {
+	drv, exists := drivers[dbType]
+	if !exists {
+		str := fmt.Sprintf("driver %q is not registered", dbType)
+		return nil, makeError(ErrDbUnknownType, str, nil)
+	}
+	if drv.OpenReadOnly == nil {
+		str := fmt.Sprintf("driver %q does not support read-only access",
+			dbType)
+		return nil, makeError(ErrDbUnknownType, str, nil)
+	}
+
+	return drv.OpenReadOnly(args...)
+}
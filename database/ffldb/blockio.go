@@ -829,7 +829,7 @@ func (s *blockStore) loadLastBlockHeights(dbPath string) error {
 		if err != nil {
 			break
 		}
-		file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0666)
+		file, err := os.Open(filePath)
 		if err != nil {
 			return err
 		}
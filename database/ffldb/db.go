@@ -1785,6 +1785,7 @@ type db struct {
 	writeLock sync.Mutex   // Limit to one write transaction at a time.
 	closeLock sync.RWMutex // Make database close block while txns active.
 	closed    bool         // Is the database closed?
+	readOnly  bool         // Was the database opened read-only?
 	store     *blockStore  // Handles read/writing blocks to flat files.
 	cache     *dbCache     // Cache layer which wraps underlying leveldb DB.
 }
@@ -1807,6 +1808,12 @@ func (db *db) Type() string {
 // which is used by the managed transaction code while the database method
 // returns the interface.
 func (db *db) begin(writable bool) (*transaction, error) {
+	// Refuse writable transactions on a database opened read-only.
+	if writable && db.readOnly {
+		str := "create writable transaction on read-only database"
+		return nil, makeDbErr(database.ErrTxNotWritable, str, nil)
+	}
+
 	// Make sure there is enough available disk space so we can inform the
 	// user of the problem instead of causing a db failure.
 	if writable {
@@ -2054,7 +2061,9 @@ func initDB(ldb *leveldb.DB) error {
 
 // openDB opens the database at the provided path.  database.ErrDbDoesNotExist
 // is returned if the database doesn't exist and the create flag is not set.
-func openDB(dbPath string, network wire.BitcoinNet, create bool, cacheSize uint64, flushSecs uint32) (database.DB, error) {
+// When the readOnly flag is set, neither the metadata nor the block files are
+// ever modified.
+func openDB(dbPath string, network wire.BitcoinNet, create, readOnly bool, cacheSize uint64, flushSecs uint32) (database.DB, error) {
 	// Error if the database doesn't exist and the create flag is not set.
 	metadataDbPath := filepath.Join(dbPath, metadataDbName)
 	dbExists := fileExists(metadataDbPath)
@@ -2074,6 +2083,7 @@ func openDB(dbPath string, network wire.BitcoinNet, create bool, cacheSize uint6
 	// Open the metadata database (will create it if needed).
 	opts := opt.Options{
 		ErrorIfExist: create,
+		ReadOnly:     readOnly,
 		Strict:       opt.DefaultStrict,
 		Compression:  opt.NoCompression,
 		Filter:       filter.NewBloomFilter(10),
@@ -2099,7 +2109,7 @@ func openDB(dbPath string, network wire.BitcoinNet, create bool, cacheSize uint6
 		flushSecs = defaultFlushSecs
 	}
 	cache := newDbCache(ldb, store, cacheSize, flushSecs)
-	pdb := &db{store: store, cache: cache}
+	pdb := &db{store: store, cache: cache, readOnly: readOnly}
 
 	// Perform any reconciliation needed between the block and metadata as
 	// well as database initialization, if needed.
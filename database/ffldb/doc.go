@@ -25,5 +25,13 @@ database path as a string and the block network:
 	if err != nil {
 		// Handle error
 	}
+
+An existing database can also be opened with OpenReadOnly, which takes the same
+parameters and never writes to the metadata or the block files:
+
+	db, err := database.OpenReadOnly("ffldb", "path/to/database", wire.MainNet)
+	if err != nil {
+		// Handle error
+	}
 */
 package ffldb
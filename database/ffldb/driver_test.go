@@ -6,6 +6,7 @@ package ffldb_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -254,6 +255,90 @@ func TestPersistence(t *testing.T) {
 	}
 }
 
+// dirContents returns the contents of every file under the provided path keyed
+// by their path.
+func dirContents(t *testing.T, dbPath string) map[string][]byte {
+	contents := make(map[string][]byte)
+	err := filepath.Walk(dbPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		contents[path] = data
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", dbPath, err)
+	}
+	return contents
+}
+
+// TestOpenReadOnly ensures a database opened read-only can be read, refuses
+// writable transactions, and is left untouched on disk.
+func TestOpenReadOnly(t *testing.T) {
+	t.Parallel()
+
+	dbPath := filepath.Join(os.TempDir(), "ffldb-readonlytest")
+	_ = os.RemoveAll(dbPath)
+	defer os.RemoveAll(dbPath)
+
+	// Opening a database that does not exist must fail without creating
+	// it.
+	_, err := database.OpenReadOnly(dbType, dbPath, blockDataNet)
+	if !checkDbError(t, "OpenReadOnly", err, database.ErrDbDoesNotExist) {
+		return
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Fatalf("OpenReadOnly: created database directory")
+	}
+
+	db, err := database.Create(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+	genesisBlock := czzutil.NewBlock(chaincfg.MainNetParams.GenesisBlock)
+	genesisHash := chaincfg.MainNetParams.GenesisHash
+	err = db.Update(func(tx database.Tx) error {
+		return tx.StoreBlock(genesisBlock)
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	db.Close()
+
+	before := dirContents(t, dbPath)
+	db, err = database.OpenReadOnly(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Fatalf("Failed to open test database read-only (%s) %v",
+			dbType, err)
+	}
+
+	err = db.View(func(tx database.Tx) error {
+		_, err := tx.FetchBlock(genesisHash)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("View: unexpected error: %v", err)
+	}
+
+	err = db.Update(func(tx database.Tx) error {
+		return tx.Metadata().Put([]byte("key"), []byte("value"))
+	})
+	if !checkDbError(t, "Update", err, database.ErrTxNotWritable) {
+		return
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: unexpected error: %v", err)
+	}
+
+	if after := dirContents(t, dbPath); !reflect.DeepEqual(before, after) {
+		t.Fatalf("read-only database was modified on disk")
+	}
+}
+
 // TestInterface performs all interfaces tests for this database driver.
 func TestInterface(t *testing.T) {
 	t.Parallel()
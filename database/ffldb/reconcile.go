@@ -86,6 +86,15 @@ func reconcileDB(pdb *db, create bool) (database.DB, error) {
 	if wc.curFileNum > curFileNum || (wc.curFileNum == curFileNum &&
 		wc.curOffset > curOffset) {
 
+		// The data past the metadata write cursor is not referenced by
+		// anything, so a read-only database can simply ignore it.
+		if pdb.readOnly {
+			log.Warnf("Detected unclean shutdown - block data past "+
+				"file %d, offset %d is ignored until the database "+
+				"is opened for writing", curFileNum, curOffset)
+			return pdb, nil
+		}
+
 		log.Info("Detected unclean shutdown - Repairing...")
 		log.Debugf("Metadata claims file %d, offset %d. Block data is "+
 			"at file %d, offset %d", curFileNum, curOffset,
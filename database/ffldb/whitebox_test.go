@@ -189,7 +189,7 @@ func TestCornerCases(t *testing.T) {
 	// directory is needed.
 	testName := "openDB: fail due to file at target location"
 	wantErrCode := database.ErrDriverSpecific
-	idb, err := openDB(dbPath, blockDataNet, true, 0, 0)
+	idb, err := openDB(dbPath, blockDataNet, true, false, 0, 0)
 	if !checkDbError(t, testName, err, wantErrCode) {
 		if err == nil {
 			idb.Close()
@@ -201,7 +201,7 @@ func TestCornerCases(t *testing.T) {
 	// Remove the file and create the database to run tests against.  It
 	// should be successful this time.
 	_ = os.RemoveAll(dbPath)
-	idb, err = openDB(dbPath, blockDataNet, true, 0, 0)
+	idb, err = openDB(dbPath, blockDataNet, true, false, 0, 0)
 	if err != nil {
 		t.Errorf("openDB: unexpected error: %v", err)
 		return
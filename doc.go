@@ -76,6 +76,8 @@ Application Options:
       --testnet             Use the test network
       --regtest             Use the regression test network
       --simnet              Use the simulation test network
+      --netparams=          Use the custom network defined by the passed JSON
+                            or TOML file
       --addcheckpoint=      Add a custom checkpoint.  Format: '<height>:<hash>'
       --nocheckpoints       Disable built-in checkpoints.  Don't do this unless
                             you know what you're doing.
@@ -111,6 +113,12 @@ Application Options:
                             a block (750000)
       --blockprioritysize=  Size in bytes for high-priority/low-fee transactions
                             when creating a block (50000)
+      --blockmaxconvertitems= Maximum number of conversions of Convert
+                            transactions verified when creating a block -- 0
+                            for no limit (100)
+      --blockmaxconvertvalue= Maximum value in CZZ of the conversions of Convert
+                            transactions when creating a block -- 0 for no
+                            limit
       --nopeerbloomfilters  Disable bloom filtering support.
       --nocfilters          Disable committed filtering (CF) support.
       --sigcachemaxsize=    The maximum number of entries in the signature
@@ -3,6 +3,7 @@ module github.com/classzz/classzz
 go 1.15
 
 require (
+	github.com/BurntSushi/toml v0.4.1
 	github.com/btcsuite/go-socks v0.0.0-20170105172521-4720035b7bfd
 	github.com/btcsuite/goleveldb v1.0.0
 	github.com/btcsuite/websocket v0.0.0-20150119174127-31079b680792
@@ -0,0 +1,79 @@
+package graphene
+
+import (
+	"math"
+)
+
+const (
+	// maxFilterHashFuncs is the maximum number of hash functions a filter
+	// received from a peer may use.
+	maxFilterHashFuncs = 50
+
+	// ln2Squared is ln(2)^2 which is used to calculate the optimal filter
+	// size for a given false positive rate.
+	ln2Squared = math.Ln2 * math.Ln2
+)
+
+// bloomFilter is a Bloom filter over short IDs.  Since short IDs are keyed
+// siphash outputs they are already uniformly distributed, so the bit positions
+// are derived directly from them by double hashing rather than hashing them
+// again.
+//
+// A filter without any bits matches everything.
+type bloomFilter struct {
+	bits      []byte
+	hashFuncs uint32
+}
+
+// newBloomFilter returns a filter sized to hold the passed number of elements
+// at the passed false positive rate.
+func newBloomFilter(elements int, fpRate float64) *bloomFilter {
+	if elements < 1 {
+		elements = 1
+	}
+	numBits := math.Ceil(-float64(elements) * math.Log(fpRate) / ln2Squared)
+	numBytes := int(math.Ceil(numBits / 8))
+	if numBytes < 1 {
+		numBytes = 1
+	}
+	hashFuncs := uint32(float64(numBytes*8) / float64(elements) * math.Ln2)
+	if hashFuncs < 1 {
+		hashFuncs = 1
+	}
+	if hashFuncs > maxFilterHashFuncs {
+		hashFuncs = maxFilterHashFuncs
+	}
+	return &bloomFilter{
+		bits:      make([]byte, numBytes),
+		hashFuncs: hashFuncs,
+	}
+}
+
+// position returns the bit index for the passed short ID and hash function.
+func (bf *bloomFilter) position(id uint64, hashNum uint32) uint32 {
+	h1 := uint32(id)
+	h2 := uint32(id>>32) | 1
+	return (h1 + hashNum*h2) % uint32(len(bf.bits)*8)
+}
+
+// add inserts the passed short ID into the filter.
+func (bf *bloomFilter) add(id uint64) {
+	for i := uint32(0); i < bf.hashFuncs; i++ {
+		idx := bf.position(id, i)
+		bf.bits[idx>>3] |= 1 << (idx & 7)
+	}
+}
+
+// matches returns whether or not the passed short ID may be in the filter.
+func (bf *bloomFilter) matches(id uint64) bool {
+	if len(bf.bits) == 0 {
+		return true
+	}
+	for i := uint32(0); i < bf.hashFuncs; i++ {
+		idx := bf.position(id, i)
+		if bf.bits[idx>>3]&(1<<(idx&7)) == 0 {
+			return false
+		}
+	}
+	return true
+}
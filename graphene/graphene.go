@@ -0,0 +1,410 @@
+// Package graphene implements graphene block relay.  A graphene block carries
+// a Bloom filter and an invertible Bloom lookup table over the short IDs of the
+// block's transactions.  The receiver passes its mempool through the filter and
+// reconciles the remaining candidates with the block using the lookup table,
+// which takes considerably fewer bytes than a short ID per transaction as sent
+// in compact blocks.
+package graphene
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/dchest/siphash"
+)
+
+const (
+	// ibltOverhead is the number of cells allocated per expected
+	// difference between the block and the receiver's candidates.
+	ibltOverhead = 2
+
+	// ibltSlack is the number of differences the table is sized for on top
+	// of the expected false positives to account for transactions the
+	// receiver lacks or holds beyond the sender's estimate.
+	ibltSlack = 8
+)
+
+var (
+	// ErrShortIDCollision is returned when two transactions of a block map
+	// to the same short ID.  Such a block can't be sent as a graphene block
+	// and should be sent as a compact block instead.
+	ErrShortIDCollision = errors.New("short ID collision in block")
+
+	// ErrReconciliationFailed is returned when the receiver could not
+	// recover the set of transactions of a block from its candidates.
+	// The transactions must be requested from the sender in that case.
+	ErrReconciliationFailed = errors.New("graphene set reconciliation failed")
+)
+
+// shortIDKeys returns the siphash keys used to calculate the short IDs for the
+// passed header and nonce.  They are derived the same way as for compact
+// blocks.
+func shortIDKeys(header *wire.BlockHeader, nonce uint64) (uint64, uint64, error) {
+	var buf bytes.Buffer
+	if err := header.Serialize(&buf); err != nil {
+		return 0, 0, err
+	}
+	var nonceBytes [8]byte
+	binary.LittleEndian.PutUint64(nonceBytes[:], nonce)
+	buf.Write(nonceBytes[:])
+
+	headerHash := sha256.Sum256(buf.Bytes())
+	key0 := binary.LittleEndian.Uint64(headerHash[0:8])
+	key1 := binary.LittleEndian.Uint64(headerHash[8:16])
+	return key0, key1, nil
+}
+
+// orderBits returns the number of bits used to encode a position among n
+// transactions.
+func orderBits(n int) uint {
+	if n <= 1 {
+		return 0
+	}
+	return uint(bits.Len(uint(n - 1)))
+}
+
+// encodeOrder packs the passed ranks into a bit string using the minimum
+// number of bits per rank.
+func encodeOrder(ranks []uint32) []byte {
+	width := orderBits(len(ranks))
+	order := make([]byte, (uint(len(ranks))*width+7)/8)
+	pos := uint(0)
+	for _, rank := range ranks {
+		for i := uint(0); i < width; i++ {
+			if rank&(1<<i) != 0 {
+				order[pos>>3] |= 1 << (pos & 7)
+			}
+			pos++
+		}
+	}
+	return order
+}
+
+// decodeOrder unpacks n ranks from the passed bit string and ensures they are
+// a permutation.
+func decodeOrder(order []byte, n int) ([]uint32, error) {
+	width := orderBits(n)
+	if len(order) != int((uint(n)*width+7)/8) {
+		return nil, errors.New("graphene order has wrong size")
+	}
+	ranks := make([]uint32, n)
+	seen := make([]bool, n)
+	pos := uint(0)
+	for j := range ranks {
+		var rank uint32
+		for i := uint(0); i < width; i++ {
+			if order[pos>>3]&(1<<(pos&7)) != 0 {
+				rank |= 1 << i
+			}
+			pos++
+		}
+		if int(rank) >= n || seen[rank] {
+			return nil, errors.New("graphene order is not a permutation")
+		}
+		seen[rank] = true
+		ranks[j] = rank
+	}
+	return ranks, nil
+}
+
+// filterFPRate returns the false positive rate of the Bloom filter which
+// minimizes the combined size of the filter and the IBLT for a block of n
+// transactions and a receiver holding poolSize transactions that are not part
+// of the block.  A rate of 1 means no filter should be sent.
+func filterFPRate(n, poolSize int) float64 {
+	if n == 0 || poolSize <= 0 {
+		return 1
+	}
+	fpr := float64(n) / (8 * ln2Squared * float64(poolSize) *
+		ibltOverhead * ibltCellSize)
+	if fpr >= 1 {
+		return 1
+	}
+	return fpr
+}
+
+// NewMsgGrapheneBlockFromBlock builds a graphene block message from a block.
+// The coinbase and all transactions that are not in the known inventory map
+// are sent in full as prefilled transactions like for compact blocks.  The
+// filter and IBLT are sized for a receiver whose mempool holds poolSize
+// transactions besides the ones of the block, for which the sender's own
+// mempool size is a good estimate.
+//
+// ErrShortIDCollision is returned when the block can't be encoded.
+func NewMsgGrapheneBlockFromBlock(block *wire.MsgBlock, knownInventory map[chainhash.Hash]bool, poolSize int) (*wire.MsgGrapheneBlock, error) {
+	nonce, err := wire.RandomUint64()
+	if err != nil {
+		return nil, err
+	}
+	return newMsgGrapheneBlock(block, knownInventory, poolSize, nonce)
+}
+
+// newMsgGrapheneBlock builds a graphene block message from a block like
+// NewMsgGrapheneBlockFromBlock using the passed nonce for the short IDs.
+func newMsgGrapheneBlock(block *wire.MsgBlock, knownInventory map[chainhash.Hash]bool, poolSize int, nonce uint64) (*wire.MsgGrapheneBlock, error) {
+	key0, key1, err := shortIDKeys(&block.Header, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := wire.NewMsgGrapheneBlock(&block.Header)
+	msg.Nonce = nonce
+	msg.TxCount = uint32(len(block.Transactions))
+
+	// Split the transactions into the prefilled ones and the ones the
+	// receiver is expected to have.
+	var ids []uint64
+	var hashes []chainhash.Hash
+	seen := make(map[uint64]struct{}, len(block.Transactions))
+	lastIndex := 0
+	for i, tx := range block.Transactions {
+		txHash := tx.TxHash()
+		if i == 0 || !knownInventory[txHash] {
+			msg.PrefilledTxs = append(msg.PrefilledTxs, &wire.PrefilledTx{
+				Index: uint32(i - lastIndex),
+				Tx:    tx,
+			})
+			lastIndex = i + 1
+			continue
+		}
+		id := siphash.Hash(key0, key1, txHash[:])
+		if _, ok := seen[id]; ok {
+			return nil, ErrShortIDCollision
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+		hashes = append(hashes, txHash)
+	}
+
+	n := len(ids)
+	fpr := filterFPRate(n, poolSize)
+	expected := float64(poolSize)
+	if fpr < 1 {
+		filter := newBloomFilter(n, fpr)
+		for _, id := range ids {
+			filter.add(id)
+		}
+		msg.Filter = filter.bits
+		msg.FilterHashFuncs = filter.hashFuncs
+		expected *= fpr
+	}
+	if expected < 0 {
+		expected = 0
+	}
+	numCells := math.Ceil(ibltOverhead *
+		(expected + 3*math.Sqrt(expected) + ibltSlack))
+	table := newIBLT(int(numCells))
+	for _, id := range ids {
+		table.insert(id)
+	}
+	msg.IBLT = table.serialize()
+
+	// The order can be omitted when the transactions are sorted by hash
+	// since the receiver restores that order on its own.
+	canonical := true
+	for i := 1; i < len(hashes); i++ {
+		if hashes[i-1].Compare(&hashes[i]) >= 0 {
+			canonical = false
+			break
+		}
+	}
+	if !canonical {
+		sorted := make([]uint64, n)
+		copy(sorted, ids)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		rankOf := make(map[uint64]uint32, n)
+		for i, id := range sorted {
+			rankOf[id] = uint32(i)
+		}
+		ranks := make([]uint32, n)
+		for i, id := range ids {
+			ranks[i] = rankOf[id]
+		}
+		msg.Order = encodeOrder(ranks)
+	}
+	return msg, nil
+}
+
+// Decoder recovers a block from a graphene block message and the transactions
+// of the receiver's mempool.  Candidate transactions are added with
+// AddCandidate before the block is assembled with Block.
+type Decoder struct {
+	msg        *wire.MsgGrapheneBlock
+	key0, key1 uint64
+	filter     *bloomFilter
+	table      *iblt
+	prefilled  map[chainhash.Hash]struct{}
+	candidates map[uint64]*wire.MsgTx
+	hashes     map[uint64]chainhash.Hash
+	collisions map[uint64]int
+}
+
+// NewDecoder returns a decoder for the passed graphene block message.  An
+// error is returned when the message is malformed.
+func NewDecoder(msg *wire.MsgGrapheneBlock) (*Decoder, error) {
+	if msg.TxCount == 0 || int(msg.TxCount) < len(msg.PrefilledTxs) {
+		return nil, errors.New("invalid graphene block transaction count")
+	}
+	if len(msg.Filter) > 0 && (msg.FilterHashFuncs == 0 ||
+		msg.FilterHashFuncs > maxFilterHashFuncs) {
+
+		return nil, errors.New("invalid graphene filter hash functions")
+	}
+	table, err := deserializeIBLT(msg.IBLT)
+	if err != nil {
+		return nil, err
+	}
+	key0, key1, err := shortIDKeys(&msg.Header, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	prefilled := make(map[chainhash.Hash]struct{}, len(msg.PrefilledTxs))
+	for _, ptx := range msg.PrefilledTxs {
+		prefilled[ptx.Tx.TxHash()] = struct{}{}
+	}
+	return &Decoder{
+		msg:        msg,
+		key0:       key0,
+		key1:       key1,
+		filter:     &bloomFilter{bits: msg.Filter, hashFuncs: msg.FilterHashFuncs},
+		table:      table,
+		prefilled:  prefilled,
+		candidates: make(map[uint64]*wire.MsgTx),
+		hashes:     make(map[uint64]chainhash.Hash),
+		collisions: make(map[uint64]int),
+	}, nil
+}
+
+// AddCandidate adds the passed transaction to the candidates for the block if
+// it matches the filter of the message.
+func (d *Decoder) AddCandidate(txHash *chainhash.Hash, tx *wire.MsgTx) {
+	if _, ok := d.prefilled[*txHash]; ok {
+		return
+	}
+	id := siphash.Hash(d.key0, d.key1, txHash[:])
+	if !d.filter.matches(id) {
+		return
+	}
+	if _, ok := d.candidates[id]; ok {
+		d.collisions[id]++
+		return
+	}
+	d.candidates[id] = tx
+	d.hashes[id] = *txHash
+}
+
+// Block assembles the block from the prefilled transactions and the
+// candidates.  Transactions of the block the receiver doesn't have are left
+// nil so they can be requested with a getblocktxn message.
+//
+// When the set of transactions can't be recovered, ErrReconciliationFailed is
+// returned along with a block holding only the prefilled transactions.
+func (d *Decoder) Block() (*wire.MsgBlock, error) {
+	msg := d.msg
+	msgBlock := wire.NewMsgBlock(&msg.Header)
+	msgBlock.Transactions = make([]*wire.MsgTx, msg.TxCount)
+
+	// First add all the prefilled transactions.
+	index := uint64(0)
+	for i, ptx := range msg.PrefilledTxs {
+		if i > 0 {
+			index++
+		}
+		index += uint64(ptx.Index)
+		if index >= uint64(msg.TxCount) {
+			return nil, errors.New("prefilled transaction index out of range")
+		}
+		msgBlock.Transactions[index] = ptx.Tx
+	}
+	n := int(msg.TxCount) - len(msg.PrefilledTxs)
+
+	var ranks []uint32
+	if len(msg.Order) > 0 {
+		var err error
+		ranks, err = decodeOrder(msg.Order, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Reconcile the candidates with the block.  The difference lists the
+	// short IDs of the block the receiver lacks along with the candidates
+	// that only passed the filter as false positives.
+	candidateTable := &iblt{cells: make([]ibltCell, len(d.table.cells))}
+	for id := range d.candidates {
+		for i := 0; i <= d.collisions[id]; i++ {
+			candidateTable.insert(id)
+		}
+	}
+	diff, err := d.table.subtract(candidateTable)
+	if err != nil {
+		return msgBlock, ErrReconciliationFailed
+	}
+	missing, falsePositives, ok := diff.peel()
+	if !ok {
+		return msgBlock, ErrReconciliationFailed
+	}
+
+	ids := make([]uint64, 0, n)
+	excluded := make(map[uint64]struct{}, len(falsePositives))
+	for _, id := range falsePositives {
+		// A colliding candidate leaves it ambiguous which transaction is
+		// part of the block.
+		if d.collisions[id] > 0 {
+			return msgBlock, ErrReconciliationFailed
+		}
+		excluded[id] = struct{}{}
+	}
+	for id := range d.candidates {
+		if _, ok := excluded[id]; !ok {
+			if d.collisions[id] > 0 {
+				return msgBlock, ErrReconciliationFailed
+			}
+			ids = append(ids, id)
+		}
+	}
+	ids = append(ids, missing...)
+	if len(ids) != n {
+		return msgBlock, ErrReconciliationFailed
+	}
+
+	var ordered []*wire.MsgTx
+	if ranks != nil {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		ordered = make([]*wire.MsgTx, n)
+		for i, rank := range ranks {
+			ordered[i] = d.candidates[ids[rank]]
+		}
+	} else {
+		// The position of transactions the receiver lacks can't be
+		// known in canonical order, so all of them have to be
+		// requested in that case.
+		if len(missing) > 0 {
+			return msgBlock, ErrReconciliationFailed
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			hi, hj := d.hashes[ids[i]], d.hashes[ids[j]]
+			return hi.Compare(&hj) < 0
+		})
+		ordered = make([]*wire.MsgTx, n)
+		for i, id := range ids {
+			ordered[i] = d.candidates[id]
+		}
+	}
+
+	for i, tx := range msgBlock.Transactions {
+		if tx != nil {
+			continue
+		}
+		msgBlock.Transactions[i], ordered = ordered[0], ordered[1:]
+	}
+	return msgBlock, nil
+}
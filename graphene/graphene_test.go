@@ -0,0 +1,252 @@
+package graphene
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+)
+
+// randomTx returns a transaction spending a random outpoint.
+func randomTx(rng *rand.Rand) *wire.MsgTx {
+	var prevHash chainhash.Hash
+	rng.Read(prevHash[:])
+	pkScript := make([]byte, 25)
+	rng.Read(pkScript)
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), make([]byte, 107)))
+	tx.AddTxOut(wire.NewTxOut(rng.Int63n(1e8), pkScript))
+	return tx
+}
+
+// simBlock returns a block with a coinbase followed by numTxs transactions,
+// sorted by hash when canonical is set, along with a mempool holding the
+// transactions of the block and extraTxs unrelated transactions.
+func simBlock(rng *rand.Rand, numTxs, extraTxs int, canonical bool) (*wire.MsgBlock, []*wire.MsgTx) {
+	block := wire.NewMsgBlock(&wire.BlockHeader{Version: 1, Nonce: rng.Uint64()})
+	block.AddTransaction(randomTx(rng))
+
+	txs := make([]*wire.MsgTx, numTxs)
+	for i := range txs {
+		txs[i] = randomTx(rng)
+	}
+	if canonical {
+		sort.Slice(txs, func(i, j int) bool {
+			hi, hj := txs[i].TxHash(), txs[j].TxHash()
+			return hi.Compare(&hj) < 0
+		})
+	}
+	for _, tx := range txs {
+		block.AddTransaction(tx)
+	}
+
+	pool := make([]*wire.MsgTx, 0, numTxs+extraTxs)
+	pool = append(pool, txs...)
+	for i := 0; i < extraTxs; i++ {
+		pool = append(pool, randomTx(rng))
+	}
+	return block, pool
+}
+
+// messageSize returns the serialized size of the passed message.
+func messageSize(t *testing.T, msg wire.Message) int {
+	var buf bytes.Buffer
+	err := msg.CzzEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding)
+	if err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	return buf.Len()
+}
+
+// decodeBlock encodes the passed graphene message, decodes it again and
+// recovers the block from the passed mempool.
+func decodeBlock(t *testing.T, msg *wire.MsgGrapheneBlock, pool []*wire.MsgTx) (*wire.MsgBlock, error) {
+	var buf bytes.Buffer
+	err := msg.CzzEncode(&buf, wire.ProtocolVersion, wire.BaseEncoding)
+	if err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	var received wire.MsgGrapheneBlock
+	err = received.CzzDecode(&buf, wire.ProtocolVersion, wire.BaseEncoding)
+	if err != nil {
+		t.Fatalf("CzzDecode: %v", err)
+	}
+
+	decoder, err := NewDecoder(&received)
+	if err != nil {
+		t.Fatalf("NewDecoder: %v", err)
+	}
+	for _, tx := range pool {
+		txHash := tx.TxHash()
+		decoder.AddCandidate(&txHash, tx)
+	}
+	return decoder.Block()
+}
+
+// TestGrapheneSimulation reconstructs simulated blocks of various sizes from a
+// mempool and compares the bytes sent with those of compact blocks.
+func TestGrapheneSimulation(t *testing.T) {
+	tests := []struct {
+		name      string
+		numTxs    int
+		extraTxs  int
+		canonical bool
+	}{
+		{name: "empty pool", numTxs: 100, extraTxs: 0, canonical: true},
+		{name: "small canonical", numTxs: 100, extraTxs: 200, canonical: true},
+		{name: "large canonical", numTxs: 2000, extraTxs: 4000, canonical: true},
+		{name: "small unordered", numTxs: 100, extraTxs: 200, canonical: false},
+		{name: "large unordered", numTxs: 2000, extraTxs: 4000, canonical: false},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for _, test := range tests {
+		block, pool := simBlock(rng, test.numTxs, test.extraTxs, test.canonical)
+		known := make(map[chainhash.Hash]bool, len(pool))
+		for _, tx := range pool {
+			known[tx.TxHash()] = true
+		}
+
+		grMsg, err := newMsgGrapheneBlock(block, known, test.extraTxs, 1)
+		if err != nil {
+			t.Fatalf("%s: newMsgGrapheneBlock: %v", test.name, err)
+		}
+		if test.canonical != (len(grMsg.Order) == 0) {
+			t.Errorf("%s: unexpected order of %d bytes", test.name,
+				len(grMsg.Order))
+		}
+		cmpctMsg, err := wire.NewMsgCmpctBlockFromBlock(block, known)
+		if err != nil {
+			t.Fatalf("%s: NewMsgCmpctBlockFromBlock: %v", test.name, err)
+		}
+
+		grSize := messageSize(t, grMsg)
+		cmpctSize := messageSize(t, cmpctMsg)
+		t.Logf("%s: %d txs, graphene %d bytes, compact %d bytes (%.1f%%)",
+			test.name, test.numTxs+1, grSize, cmpctSize,
+			100*float64(grSize)/float64(cmpctSize))
+		if test.numTxs >= 1000 && grSize >= cmpctSize {
+			t.Errorf("%s: graphene block is not smaller than compact "+
+				"block: %d >= %d", test.name, grSize, cmpctSize)
+		}
+
+		decoded, err := decodeBlock(t, grMsg, pool)
+		if err != nil {
+			t.Fatalf("%s: Block: %v", test.name, err)
+		}
+		if decoded.Header.MerkleRoot != block.Header.MerkleRoot {
+			t.Fatalf("%s: unexpected header", test.name)
+		}
+		for i, tx := range decoded.Transactions {
+			if tx == nil || tx.TxHash() != block.Transactions[i].TxHash() {
+				t.Fatalf("%s: transaction %d not recovered", test.name, i)
+			}
+		}
+	}
+}
+
+// TestGrapheneReconciliationRate ensures the IBLT is sized so that blocks
+// are reconciled for nearly all nonces and the getblocktxn fallback is rare.
+func TestGrapheneReconciliationRate(t *testing.T) {
+	const numNonces = 200
+
+	rng := rand.New(rand.NewSource(3))
+	block, pool := simBlock(rng, 2000, 4000, false)
+	known := make(map[chainhash.Hash]bool, len(pool))
+	for _, tx := range pool {
+		known[tx.TxHash()] = true
+	}
+
+	var failures int
+	for nonce := uint64(0); nonce < numNonces; nonce++ {
+		msg, err := newMsgGrapheneBlock(block, known, 4000, nonce)
+		if err == ErrShortIDCollision {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("newMsgGrapheneBlock: %v", err)
+		}
+		if _, err := decodeBlock(t, msg, pool); err != nil {
+			failures++
+		}
+	}
+	if failures > numNonces/200 {
+		t.Fatalf("reconciliation failed for %d of %d nonces", failures,
+			numNonces)
+	}
+}
+
+// TestGrapheneMissingTxs ensures transactions the receiver lacks are left nil
+// so they can be requested with getblocktxn, and that all transactions are
+// left to be requested when the set can't be reconciled.
+func TestGrapheneMissingTxs(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	block, pool := simBlock(rng, 200, 300, false)
+	known := make(map[chainhash.Hash]bool, len(pool))
+	for _, tx := range pool {
+		known[tx.TxHash()] = true
+	}
+	msg, err := newMsgGrapheneBlock(block, known, 300, 1)
+	if err != nil {
+		t.Fatalf("newMsgGrapheneBlock: %v", err)
+	}
+
+	// Drop a couple of the block's transactions from the receiver's pool.
+	decoded, err := decodeBlock(t, msg, pool[2:])
+	if err != nil {
+		t.Fatalf("Block: %v", err)
+	}
+	getBlockTxns := wire.NewMsgGetBlockTxnsFromBlock(decoded)
+	if len(getBlockTxns.Indexes) != 2 {
+		t.Fatalf("unexpected number of missing transactions: got %d, "+
+			"want 2", len(getBlockTxns.Indexes))
+	}
+
+	// Dropping most of them exceeds the capacity of the IBLT.
+	decoded, err = decodeBlock(t, msg, pool[150:])
+	if err != ErrReconciliationFailed {
+		t.Fatalf("unexpected error: got %v, want %v", err,
+			ErrReconciliationFailed)
+	}
+	getBlockTxns = wire.NewMsgGetBlockTxnsFromBlock(decoded)
+	if len(getBlockTxns.Indexes) != 200 {
+		t.Fatalf("unexpected number of missing transactions: got %d, "+
+			"want 200", len(getBlockTxns.Indexes))
+	}
+}
+
+// TestIBLTAdversarialPeel ensures peeling tables crafted by a peer terminates
+// with a failure.
+func TestIBLTAdversarialPeel(t *testing.T) {
+	const id = uint64(0x0123456789abcdef)
+
+	// A pure cell holding a short ID which is not inserted into it can not
+	// be emptied by peeling the short ID.
+	table := newIBLT(ibltMinCells)
+	idx := 0
+	for table.hashedTo(id, idx) {
+		idx++
+	}
+	table.cells[idx] = ibltCell{count: 1, keySum: id, checkSum: checkSum(id)}
+	if _, _, ok := table.peel(); ok {
+		t.Fatal("peel succeeded on a cell not holding its short ID")
+	}
+
+	// A short ID present in a single one of its cells is alternately
+	// inserted and erased by peeling.
+	table = newIBLT(ibltMinCells)
+	table.cells[table.index(id, 0)] = ibltCell{count: 1, keySum: id,
+		checkSum: checkSum(id)}
+	inserted, erased, ok := table.peel()
+	if ok {
+		t.Fatal("peel succeeded on a cycling table")
+	}
+	if len(inserted)+len(erased) > len(table.cells) {
+		t.Fatalf("peel listed %d short IDs from %d cells",
+			len(inserted)+len(erased), len(table.cells))
+	}
+}
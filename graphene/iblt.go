@@ -0,0 +1,236 @@
+package graphene
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/classzz/classzz/wire"
+)
+
+const (
+	// ibltHashFuncs is the number of cells each short ID is inserted into.
+	// The table is partitioned into one sub-table per hash function so
+	// every short ID always lands in distinct cells.  With only three hash
+	// functions, two short IDs sharing all their cells in the small tables
+	// sent for blocks make peeling fail for about one block in a hundred.
+	ibltHashFuncs = 4
+
+	// ibltMinCells is the minimum number of cells of a table.  Very small
+	// tables fail to decode too often to be useful.
+	ibltMinCells = 4 * ibltHashFuncs
+
+	// ibltCellSize is the approximate serialized size of a cell in bytes.
+	ibltCellSize = 1 + 8 + 4
+)
+
+// ibltCell is a single cell of an invertible Bloom lookup table.
+type ibltCell struct {
+	count    int32
+	keySum   uint64
+	checkSum uint32
+}
+
+// empty returns whether or not the cell holds no short IDs.
+func (c *ibltCell) empty() bool {
+	return c.count == 0 && c.keySum == 0 && c.checkSum == 0
+}
+
+// pure returns whether or not the cell holds exactly one short ID, either
+// inserted or erased.
+func (c *ibltCell) pure() bool {
+	return (c.count == 1 || c.count == -1) && c.checkSum == checkSum(c.keySum)
+}
+
+// iblt is an invertible Bloom lookup table over short IDs.  Subtracting the
+// table of one set from the table of another yields a table holding only the
+// symmetric difference of both sets, which can be listed as long as it is
+// small compared to the number of cells.
+type iblt struct {
+	cells []ibltCell
+}
+
+// newIBLT returns an empty table with at least the passed number of cells.
+func newIBLT(numCells int) *iblt {
+	if numCells < ibltMinCells {
+		numCells = ibltMinCells
+	}
+	if rem := numCells % ibltHashFuncs; rem != 0 {
+		numCells += ibltHashFuncs - rem
+	}
+	return &iblt{cells: make([]ibltCell, numCells)}
+}
+
+// mix is the finalizer of the 64-bit murmur3 hash.  It is used to derive
+// independent cell indexes and checksums from a short ID.
+func mix(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// checkSum returns the checksum of the passed short ID which is used to tell
+// pure cells apart from cells holding several short IDs.
+func checkSum(id uint64) uint32 {
+	return uint32(mix(id ^ 0x5bd1e9955bd1e995))
+}
+
+// index returns the index of the cell the passed short ID is inserted into by
+// the passed hash function.
+func (t *iblt) index(id uint64, hashFunc int) int {
+	subSize := uint64(len(t.cells) / ibltHashFuncs)
+	i := uint64(hashFunc)
+	return int(i*subSize + mix(id^((i+1)*0x9e3779b97f4a7c15))%subSize)
+}
+
+// update adds the passed delta to the count of all cells of the short ID.
+func (t *iblt) update(id uint64, delta int32) {
+	check := checkSum(id)
+	for i := 0; i < ibltHashFuncs; i++ {
+		cell := &t.cells[t.index(id, i)]
+		cell.count += delta
+		cell.keySum ^= id
+		cell.checkSum ^= check
+	}
+}
+
+// insert adds the passed short ID to the table.
+func (t *iblt) insert(id uint64) {
+	t.update(id, 1)
+}
+
+// subtract returns a table holding the difference of the table and the passed
+// table, which must have the same number of cells.
+func (t *iblt) subtract(other *iblt) (*iblt, error) {
+	if len(t.cells) != len(other.cells) {
+		return nil, errors.New("iblt sizes do not match")
+	}
+	diff := &iblt{cells: make([]ibltCell, len(t.cells))}
+	for i := range t.cells {
+		diff.cells[i] = ibltCell{
+			count:    t.cells[i].count - other.cells[i].count,
+			keySum:   t.cells[i].keySum ^ other.cells[i].keySum,
+			checkSum: t.cells[i].checkSum ^ other.cells[i].checkSum,
+		}
+	}
+	return diff, nil
+}
+
+// peel lists the short IDs of a difference table.  The inserted short IDs are
+// the ones only present in the minuend while the erased ones are only present
+// in the subtrahend.  The table is emptied in the process.  The returned bool
+// is false when the table could not be decoded completely.
+//
+// Tables come from peers, so a pure cell is only peeled when its short ID is
+// inserted into it, and no more short IDs are listed than the table has
+// cells.  Every short ID of a table properly built by the sender empties the
+// cell it is peeled from for good.
+func (t *iblt) peel() ([]uint64, []uint64, bool) {
+	var inserted, erased []uint64
+	for {
+		progress := false
+		for i := range t.cells {
+			cell := &t.cells[i]
+			if !cell.pure() || !t.hashedTo(cell.keySum, i) {
+				continue
+			}
+			if len(inserted)+len(erased) == len(t.cells) {
+				return inserted, erased, false
+			}
+			id, count := cell.keySum, cell.count
+			if count == 1 {
+				inserted = append(inserted, id)
+			} else {
+				erased = append(erased, id)
+			}
+			t.update(id, -count)
+			progress = true
+		}
+		if !progress {
+			break
+		}
+	}
+
+	for i := range t.cells {
+		if !t.cells[i].empty() {
+			return inserted, erased, false
+		}
+	}
+	return inserted, erased, true
+}
+
+// hashedTo returns whether or not the passed short ID is inserted into the cell
+// with the passed index.
+func (t *iblt) hashedTo(id uint64, idx int) bool {
+	for i := 0; i < ibltHashFuncs; i++ {
+		if t.index(id, i) == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// serialize returns the serialized table.  Only tables with non-negative
+// counts, as created by the sender of a block, can be serialized.
+func (t *iblt) serialize() []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(t.cells) * ibltCellSize)
+	var scratch [8]byte
+	wire.WriteVarInt(&buf, 0, uint64(len(t.cells)))
+	for _, cell := range t.cells {
+		wire.WriteVarInt(&buf, 0, uint64(cell.count))
+		binary.LittleEndian.PutUint64(scratch[:], cell.keySum)
+		buf.Write(scratch[:8])
+		binary.LittleEndian.PutUint32(scratch[:], cell.checkSum)
+		buf.Write(scratch[:4])
+	}
+	return buf.Bytes()
+}
+
+// deserializeIBLT parses a serialized table.
+func deserializeIBLT(serialized []byte) (*iblt, error) {
+	r := bytes.NewReader(serialized)
+	numCells, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Every cell takes at least 13 bytes, which bounds the allocation.
+	if numCells == 0 || numCells%ibltHashFuncs != 0 ||
+		numCells > uint64(len(serialized)/ibltCellSize) {
+
+		return nil, errors.New("invalid number of iblt cells")
+	}
+
+	t := &iblt{cells: make([]ibltCell, numCells)}
+	var scratch [8]byte
+	for i := range t.cells {
+		count, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+		if count > math.MaxInt32 {
+			return nil, errors.New("iblt cell count out of range")
+		}
+		if _, err := io.ReadFull(r, scratch[:8]); err != nil {
+			return nil, err
+		}
+		keySum := binary.LittleEndian.Uint64(scratch[:8])
+		if _, err := io.ReadFull(r, scratch[:4]); err != nil {
+			return nil, err
+		}
+		t.cells[i] = ibltCell{
+			count:    int32(count),
+			keySum:   keySum,
+			checkSum: binary.LittleEndian.Uint32(scratch[:4]),
+		}
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("trailing bytes after iblt")
+	}
+	return t, nil
+}
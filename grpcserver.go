@@ -2,32 +2,36 @@ package main
 
 import (
 	"context"
-	"errors"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"github.com/classzz/classzz/czzrpc"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/peer"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"strings"
 )
 
-// AuthenticationTokenKey is the key used in the context to authenticate clients.
-// If this is set to anything other than "" in the config, then the server expects
-// the client to set a key value in the context metadata to 'AuthenticationToken: cfg.AuthToken'
-const AuthenticationTokenKey = "AuthenticationToken"
-
 func newGrpcServer(netAddrs []net.Addr, rpcCfg *czzrpc.GrpcServerConfig, svr *server) (*czzrpc.GrpcServer, error) {
+	auth, err := newGrpcAuthenticator()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := grpcTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, addr := range netAddrs {
 		rpcCfg.NetMgr = svr
-		opts := []grpc.ServerOption{grpc.StreamInterceptor(interceptStreaming), grpc.UnaryInterceptor(interceptUnary)}
-		creds, err := credentials.NewServerTLSFromFile(cfg.RPCCert, cfg.RPCKey)
-		if err != nil {
-			return nil, err
+		opts := []grpc.ServerOption{
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(), interceptStreaming),
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(), interceptUnary),
+			grpc.Creds(credentials.NewTLS(tlsConfig)),
 		}
-		opts = append(opts, grpc.Creds(creds))
 		server := grpc.NewServer(opts...)
 
 		allowAllOrigins := grpcweb.WithOriginFunc(func(origin string) bool {
@@ -46,8 +50,9 @@ func newGrpcServer(netAddrs []net.Addr, rpcCfg *czzrpc.GrpcServerConfig, svr *se
 		}
 
 		httpServer := &http.Server{
-			Addr:    addr.String(),
-			Handler: http.HandlerFunc(handler),
+			Addr:      addr.String(),
+			Handler:   http.HandlerFunc(handler),
+			TLSConfig: tlsConfig,
 		}
 
 		rpcCfg.HTTPServer = httpServer
@@ -57,7 +62,7 @@ func newGrpcServer(netAddrs []net.Addr, rpcCfg *czzrpc.GrpcServerConfig, svr *se
 		grpcLog.Infof("Experimental gRPC server listening on %s", addr)
 
 		go func() {
-			if err := httpServer.ListenAndServeTLS(cfg.RPCCert, cfg.RPCKey); err != nil {
+			if err := httpServer.ListenAndServeTLS("", ""); err != nil {
 				grpcLog.Tracef("Finished serving expimental gRPC: %v", err)
 			}
 		}()
@@ -77,58 +82,75 @@ func serviceName(method string) string {
 	return method[:strings.IndexRune(method, '/')]
 }
 
-func interceptStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	p, ok := peer.FromContext(ss.Context())
-	if ok {
-		grpcLog.Infof("Streaming method %s invoked by %s", info.FullMethod,
-			p.Addr.String())
+// newGrpcAuthenticator returns the access control of the gRPC server as
+// configured by the --grpcauthfile and --grpcauthtoken options.  The latter
+// adds a token allowed to call every method.  Without either, every method is
+// open to everyone.
+func newGrpcAuthenticator() (*czzrpc.Authenticator, error) {
+	var authCfg *czzrpc.AuthConfig
+	if cfg.GrpcAuthFile != "" {
+		var err error
+		authCfg, err = czzrpc.LoadAuthConfig(cfg.GrpcAuthFile)
+		if err != nil {
+			return nil, err
+		}
 	}
-
-	err := validateAuthenticationToken(ss.Context())
-	if err != nil {
-		return err
+	if cfg.GrpcAuthToken != "" {
+		if authCfg == nil {
+			authCfg = &czzrpc.AuthConfig{}
+		}
+		authCfg.Tokens = append(authCfg.Tokens, &czzrpc.AuthToken{
+			Name:  "grpcauthtoken",
+			Token: cfg.GrpcAuthToken,
+		})
 	}
+	return czzrpc.NewAuthenticator(authCfg)
+}
 
-	err = czzrpc.ServiceReady(serviceName(info.FullMethod))
+// grpcTLSConfig returns the TLS configuration of the gRPC server.  Clients
+// must present a certificate signed by one of the authorities of the
+// --grpcclientca file when it is set.
+func grpcTLSConfig() (*tls.Config, error) {
+	keyPair, err := tls.LoadX509KeyPair(cfg.RPCCert, cfg.RPCKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	err = handler(srv, ss)
-	if err != nil && ok {
-		grpcLog.Errorf("Streaming method %s invoked by %s errored: %v",
-			info.FullMethod, p.Addr.String(), err)
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2"},
 	}
-	return err
-}
-
-func interceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-	p, ok := peer.FromContext(ctx)
-	if ok {
-		grpcLog.Infof("Unary method %s invoked by %s", info.FullMethod,
-			p.Addr.String())
+	if cfg.GrpcClientCA != "" {
+		pem, err := ioutil.ReadFile(cfg.GrpcClientCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s",
+				cfg.GrpcClientCA)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
 	}
+	return tlsConfig, nil
+}
 
-	err = validateAuthenticationToken(ctx)
+// interceptStreaming rejects streaming requests to services which are not
+// ready yet.
+func interceptStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := czzrpc.ServiceReady(serviceName(info.FullMethod))
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return handler(srv, ss)
+}
 
+// interceptUnary rejects unary requests to services which are not ready yet.
+func interceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 	err = czzrpc.ServiceReady(serviceName(info.FullMethod))
 	if err != nil {
 		return nil, err
 	}
-	resp, err = handler(ctx, req)
-	if err != nil && ok {
-		grpcLog.Errorf("Unary method %s invoked by %s errored: %v",
-			info.FullMethod, p.Addr.String(), err)
-	}
-	return resp, err
-}
-
-func validateAuthenticationToken(ctx context.Context) error {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if cfg.GrpcAuthToken != "" && (!ok || len(md.Get(AuthenticationTokenKey)) == 0 || md.Get(AuthenticationTokenKey)[0] != cfg.GrpcAuthToken) {
-		return errors.New("invalid authentication token")
-	}
-	return nil
+	return handler(ctx, req)
 }
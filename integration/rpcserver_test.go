@@ -14,8 +14,14 @@ import (
 	"runtime/debug"
 	"testing"
 
+	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/integration/rpctest"
+	"github.com/classzz/classzz/rpcclient"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
 )
 
 func testGetBestBlock(r *rpctest.Harness, t *testing.T) {
@@ -94,10 +100,311 @@ func testGetBlockHash(r *rpctest.Harness, t *testing.T) {
 	}
 }
 
+func testGetWorkTemplate(r *rpctest.Harness, t *testing.T) {
+	_, bestHeight, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("Call to `getbestblock` failed: %v", err)
+	}
+
+	template, err := r.Node.GetWorkTemplate()
+	if err != nil {
+		t.Fatalf("Call to `getworktemplate` failed: %v", err)
+	}
+
+	// The template should build on top of the current tip.
+	if template.Height != int64(bestHeight)+1 {
+		t.Fatalf("Template height incorrect. Got %v, wanted %v",
+			template.Height, bestHeight+1)
+	}
+}
+
+func testConversionAddress(r *rpctest.Harness, t *testing.T) {
+	addr, err := r.NewAddress()
+	if err != nil {
+		t.Fatalf("Unable to generate address: %v", err)
+	}
+
+	addrs, err := r.Node.ConversionAddress(addr)
+	if err != nil {
+		t.Fatalf("Call to `conversionaddress` failed: %v", err)
+	}
+
+	// Every supported chain should have an address.
+	for chain, conv := range map[string]string{
+		"DOGE": addrs.DOGE, "LTC": addrs.LTC, "BTC": addrs.BTC,
+		"BCH": addrs.BCH, "BSV": addrs.BSV, "USDT": addrs.USDT,
+	} {
+		if conv == "" {
+			t.Fatalf("No %s address returned for %v", chain, addr)
+		}
+	}
+}
+
+func testGetStateInfo(r *rpctest.Harness, t *testing.T) {
+	if _, err := r.Node.GetStateInfo(nil); err != nil {
+		t.Fatalf("Call to `getstateinfo` failed: %v", err)
+	}
+}
+
+func testGetConvertItems(r *rpctest.Harness, t *testing.T) {
+	// No conversions were made so there should be nothing pending or
+	// confirmed.
+	items, err := r.Node.GetConvertItems(nil, nil)
+	if err != nil {
+		t.Fatalf("Call to `getconvertitems` failed: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("Unexpected convert items: %v", items)
+	}
+
+	items, err = r.Node.GetConvertConfirmItems(nil, nil)
+	if err != nil {
+		t.Fatalf("Call to `getconvertconfirmitems` failed: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("Unexpected confirmed convert items: %v", items)
+	}
+}
+
+func testGetNetMsgStats(r *rpctest.Harness, t *testing.T) {
+	stats, err := r.Node.GetNetMsgStats(nil)
+	if err != nil {
+		t.Fatalf("Call to `getnetmsgstats` failed: %v", err)
+	}
+
+	// The harness node runs without any peers.
+	if len(stats.Peers) != 0 {
+		t.Fatalf("Unexpected peer statistics: %v", stats.Peers)
+	}
+}
+
+func testPsbt(r *rpctest.Harness, t *testing.T) {
+	addr, err := r.NewAddress()
+	if err != nil {
+		t.Fatalf("Unable to generate address: %v", err)
+	}
+
+	// The spent output is unknown to the node, so the packet can neither
+	// report a fee nor be finalized.
+	inputs := []btcjson.TransactionInput{{Txid: chainhash.Hash{0x01}.String()}}
+	amounts := map[czzutil.Address]czzutil.Amount{addr: 1e8}
+	packet, err := r.Node.CreatePsbt(inputs, amounts, nil)
+	if err != nil {
+		t.Fatalf("Call to `createpsbt` failed: %v", err)
+	}
+	if packet.Inputs[0].Utxo != nil {
+		t.Fatalf("Unexpected utxo for unknown output: %v", packet.Inputs[0].Utxo)
+	}
+
+	decoded, err := r.Node.DecodePsbt(packet)
+	if err != nil {
+		t.Fatalf("Call to `decodepsbt` failed: %v", err)
+	}
+	if decoded.Tx.Txid != packet.UnsignedTx.TxHash().String() {
+		t.Fatalf("Decoded txid incorrect. Got %v, wanted %v",
+			decoded.Tx.Txid, packet.UnsignedTx.TxHash())
+	}
+	if decoded.Fee != nil {
+		t.Fatalf("Unexpected fee %v", *decoded.Fee)
+	}
+
+	finalized, err := r.Node.FinalizePsbt(packet, nil)
+	if err != nil {
+		t.Fatalf("Call to `finalizepsbt` failed: %v", err)
+	}
+	if finalized.Complete || finalized.Psbt == "" {
+		t.Fatalf("Unsigned packet finalized: %v", finalized)
+	}
+}
+
+func testBatch(r *rpctest.Harness, t *testing.T) {
+	connCfg := r.RPCConfig()
+	connCfg.HTTPPostMode = true
+	client, err := rpcclient.NewBatch(&connCfg)
+	if err != nil {
+		t.Fatalf("Unable to create batch client: %v", err)
+	}
+	defer client.Shutdown()
+
+	_, bestHeight, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("Call to `getbestblock` failed: %v", err)
+	}
+
+	// The second request fails without failing the others.
+	countFuture := client.GetBlockCountAsync()
+	badHashFuture := client.GetBlockHashAsync(int64(bestHeight) + 1)
+	hashFuture := client.GetBlockHashAsync(int64(bestHeight))
+	if err := client.Send(); err != nil {
+		t.Fatalf("Unable to send batch: %v", err)
+	}
+
+	count, err := countFuture.Receive()
+	if err != nil {
+		t.Fatalf("Batched `getblockcount` failed: %v", err)
+	}
+	if count != int64(bestHeight) {
+		t.Fatalf("Block count incorrect. Got %v, wanted %v", count,
+			bestHeight)
+	}
+	if _, err := badHashFuture.Receive(); err == nil {
+		t.Fatal("Batched `getblockhash` above the tip succeeded")
+	}
+	if _, err := hashFuture.Receive(); err != nil {
+		t.Fatalf("Batched `getblockhash` failed: %v", err)
+	}
+}
+
+func testChainTips(r *rpctest.Harness, t *testing.T) {
+	bestHash, bestHeight, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("Call to `getbestblock` failed: %v", err)
+	}
+
+	tips, err := r.Node.GetChainTips()
+	if err != nil {
+		t.Fatalf("Call to `getchaintips` failed: %v", err)
+	}
+	var found bool
+	for _, tip := range tips {
+		if tip.Status == "active" {
+			found = true
+			if tip.Hash != bestHash.String() || tip.Height != bestHeight ||
+				tip.BranchLen != 0 {
+				t.Fatalf("Active tip incorrect. Got %+v, wanted %v at "+
+					"height %v", tip, bestHash, bestHeight)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("No active tip returned by `getchaintips`")
+	}
+
+	// Invalidating the best block turns it into the tip of an invalid
+	// branch until it is reconsidered.
+	if err := r.Node.InvalidateBlock(bestHash); err != nil {
+		t.Fatalf("Call to `invalidateblock` failed: %v", err)
+	}
+	tips, err = r.Node.GetChainTips()
+	if err != nil {
+		t.Fatalf("Call to `getchaintips` failed: %v", err)
+	}
+	found = false
+	for _, tip := range tips {
+		if tip.Hash == bestHash.String() {
+			found = tip.Status == "invalid" && tip.BranchLen == 1
+		}
+	}
+	if !found {
+		t.Fatalf("Invalidated block not reported as invalid tip: %+v", tips)
+	}
+	if err := r.Node.ReconsiderBlock(bestHash); err != nil {
+		t.Fatalf("Call to `reconsiderblock` failed: %v", err)
+	}
+
+	// Marking the best block as precious leaves it as the tip.
+	if err := r.Node.PreciousBlock(bestHash); err != nil {
+		t.Fatalf("Call to `preciousblock` failed: %v", err)
+	}
+	newHash, _, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("Call to `getbestblock` failed: %v", err)
+	}
+	if !newHash.IsEqual(bestHash) {
+		t.Fatalf("Best block changed to %v", newHash)
+	}
+}
+
+func testGetTxOutSetInfo(r *rpctest.Harness, t *testing.T) {
+	info, err := r.Node.GetTxOutSetInfo()
+	if err != nil {
+		t.Fatalf("Call to `gettxoutsetinfo` failed: %v", err)
+	}
+	bestHash, bestHeight, err := r.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("Call to `getbestblock` failed: %v", err)
+	}
+	if info.BestBlock != bestHash.String() || info.Height != bestHeight {
+		t.Fatalf("UTXO set info is for %v at height %v, wanted %v at "+
+			"height %v", info.BestBlock, info.Height, bestHash, bestHeight)
+	}
+	if info.TxOuts == 0 || info.TotalAmount <= 0 || info.UtxoSetSize == 0 {
+		t.Fatalf("UTXO set of the harness is empty: %+v", info)
+	}
+
+	// Mining a block changes the commitment.
+	if _, err := r.Node.Generate(1); err != nil {
+		t.Fatalf("Unable to generate block: %v", err)
+	}
+	newInfo, err := r.Node.GetTxOutSetInfo()
+	if err != nil {
+		t.Fatalf("Call to `gettxoutsetinfo` failed: %v", err)
+	}
+	if newInfo.Height != info.Height+1 || newInfo.UtxoSetHash == info.UtxoSetHash {
+		t.Fatalf("UTXO set info not updated by new block: %+v", newInfo)
+	}
+}
+
+func testGetMempoolEntry(r *rpctest.Harness, t *testing.T) {
+	addr, err := r.NewAddress()
+	if err != nil {
+		t.Fatalf("Unable to generate address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("Unable to create pkScript: %v", err)
+	}
+	txid, err := r.SendOutputs([]*wire.TxOut{wire.NewTxOut(1e8, pkScript)}, 10)
+	if err != nil {
+		t.Fatalf("Unable to send transaction: %v", err)
+	}
+
+	entry, err := r.Node.GetMempoolEntry(txid.String())
+	if err != nil {
+		t.Fatalf("Call to `getmempoolentry` failed: %v", err)
+	}
+	if entry.Type != "standard" || entry.Fee <= 0 || entry.AncestorCount != 1 ||
+		entry.DescendantCount != 1 || len(entry.Depends) != 0 {
+		t.Fatalf("Unexpected mempool entry: %+v", entry)
+	}
+	ancestors, err := r.Node.GetMempoolAncestors(txid.String())
+	if err != nil {
+		t.Fatalf("Call to `getmempoolancestors` failed: %v", err)
+	}
+	if len(ancestors) != 0 {
+		t.Fatalf("Unexpected ancestors %v", ancestors)
+	}
+	descendants, err := r.Node.GetMempoolDescendantsVerbose(txid.String())
+	if err != nil {
+		t.Fatalf("Call to `getmempooldescendants` failed: %v", err)
+	}
+	if len(descendants) != 0 {
+		t.Fatalf("Unexpected descendants %v", descendants)
+	}
+
+	// The transaction leaves the mempool once it is mined.
+	if _, err := r.Node.Generate(1); err != nil {
+		t.Fatalf("Unable to generate block: %v", err)
+	}
+	if _, err := r.Node.GetMempoolEntry(txid.String()); err == nil {
+		t.Fatal("Mined transaction still in the mempool")
+	}
+}
+
 var rpcTestCases = []rpctest.HarnessTestCase{
 	testGetBestBlock,
 	testGetBlockCount,
 	testGetBlockHash,
+	testGetWorkTemplate,
+	testConversionAddress,
+	testGetStateInfo,
+	testGetConvertItems,
+	testGetNetMsgStats,
+	testPsbt,
+	testBatch,
+	testChainTips,
+	testGetTxOutSetInfo,
+	testGetMempoolEntry,
 }
 
 var primaryHarness *rpctest.Harness
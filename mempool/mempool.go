@@ -23,6 +23,7 @@ import (
 	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/graphene"
 	"github.com/classzz/classzz/mining"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
@@ -840,14 +841,14 @@ func (mp *TxPool) maybeAcceptTransaction(tx *czzutil.Tx, isNew, rateLimit, rejec
 		return nil, nil, err
 	}
 
-	if mp.cfg.ChainParams.BeaconHeight < nextBlockHeight && mp.cfg.ChainParams.MauiHeight > nextBlockHeight {
+	if mp.cfg.ChainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight-1) && !mp.cfg.ChainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		if err = mp.validateStateTx(tx, nextBlockHeight); err != nil {
 			return nil, nil, errors.New("validateBeaconTransaction err: " + err.Error())
 		}
 	}
 
-	if mp.cfg.ChainParams.MauiHeight < nextBlockHeight {
-		if err := mp.validateStateCrossTx(tx, nextBlockHeight); err != nil {
+	if mp.cfg.ChainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight-1) {
+		if err := mp.validateStateCrossTx(tx, utxoView, nextBlockHeight); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -1044,7 +1045,7 @@ func (mp *TxPool) validateStateTx(tx *czzutil.Tx, nextBlockHeight int32) error {
 		return err
 	}
 
-	if bai != nil && mp.cfg.ChainParams.BeaconHeight > nextBlockHeight {
+	if bai != nil && !mp.cfg.ChainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight) {
 		return errors.New("err BeaconRegistration tx  BeaconHeight < nextBlockHeight ")
 	} else if bai != nil {
 		if _, err := mp.cfg.CommitteeVerify.VerifyBeaconRegistrationTx(tx.MsgTx(), eState); err != nil {
@@ -1058,7 +1059,7 @@ func (mp *TxPool) validateStateTx(tx *czzutil.Tx, nextBlockHeight int32) error {
 		return err1
 	}
 
-	if abp != nil && mp.cfg.ChainParams.BeaconHeight > nextBlockHeight {
+	if abp != nil && !mp.cfg.ChainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight) {
 		return errors.New("err AddBeaconPledge tx  BeaconHeight < nextBlockHeight ")
 	} else if abp != nil {
 		if _, err := mp.cfg.CommitteeVerify.VerifyAddBeaconPledgeTx(tx.MsgTx(), eState); err != nil {
@@ -1069,7 +1070,7 @@ func (mp *TxPool) validateStateTx(tx *czzutil.Tx, nextBlockHeight int32) error {
 	return nil
 }
 
-func (mp *TxPool) validateStateCrossTx(tx *czzutil.Tx, prevHeight int32) error {
+func (mp *TxPool) validateStateCrossTx(tx *czzutil.Tx, utxoView *blockchain.UtxoViewpoint, prevHeight int32) error {
 
 	cState := mp.cfg.CurrentCstate()
 	// Mortgage
@@ -1110,8 +1111,21 @@ func (mp *TxPool) validateStateCrossTx(tx *czzutil.Tx, prevHeight int32) error {
 				if err = cState.ConvertConfirmVerify(v); err != nil {
 					return err
 				}
+				if err = cState.VerifyReleaseSignatures(mp.cfg.ChainParams, v, prevHeight); err != nil {
+					return err
+				}
 			}
 		}
+		err := blockchain.CheckConvertConfirmSigners(mp.cfg.ChainParams, tx,
+			utxoView, cState, prevHeight)
+		if err != nil {
+			return err
+		}
+	}
+
+	// PoolKeyRotation
+	if _, err := mp.cfg.CommitteeVerify.VerifyPoolKeyRotationTx(tx.MsgTx(), cState, prevHeight); err != nil && err != cross.NoPoolKeyRotation {
+		return err
 	}
 	return nil
 }
@@ -1516,6 +1530,28 @@ func (mp *TxPool) DecodeCompressedBlock(iBlock interface{}) (*wire.MsgBlock, err
 			msgBlock.Transactions[i] = pop
 		}
 		return msgBlock, nil
+	case *wire.MsgGrapheneBlock:
+		decoder, err := graphene.NewDecoder(block)
+		if err != nil {
+			return nil, err
+		}
+		for txid, txdesc := range mp.pool {
+			decoder.AddCandidate(&txid, txdesc.Tx.MsgTx())
+		}
+		for txid, orphan := range mp.orphans {
+			decoder.AddCandidate(&txid, orphan.tx.MsgTx())
+		}
+
+		// A block whose transactions could not be reconciled is returned
+		// with only the prefilled transactions so the remaining ones are
+		// requested from the peer.
+		msgBlock, err := decoder.Block()
+		if err == graphene.ErrReconciliationFailed {
+			log.Debugf("Failed to reconcile graphene block %v, requesting "+
+				"all transactions", block.BlockHash())
+			err = nil
+		}
+		return msgBlock, err
 	default:
 		return nil, errors.New("unknown block type")
 	}
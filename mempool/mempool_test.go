@@ -223,8 +223,9 @@ func (p *poolHarness) CreateSignedTx(inputs []spendableOutput, numOutputs uint32
 
 	// Sign the new transaction.
 	for i := range tx.TxIn {
-		sigScript, err := txscript.LegacySignatureScript(tx, i, p.payScript,
-			txscript.SigHashAll, p.signKey, true)
+		sigScript, err := txscript.SignatureScript(tx, i,
+			int64(inputs[i].amount), p.payScript, txscript.SigHashAll,
+			p.signKey, true)
 		if err != nil {
 			return nil, err
 		}
@@ -499,7 +500,7 @@ func TestOrphanReject(t *testing.T) {
 
 		// Ensure no transactions were reported as accepted.
 		if len(acceptedTxns) != 0 {
-			t.Fatal("ProcessTransaction: reported %d accepted "+
+			t.Fatalf("ProcessTransaction: reported %d accepted "+
 				"transactions from failed orphan attempt",
 				len(acceptedTxns))
 		}
@@ -870,6 +871,95 @@ func TestCheckSpend(t *testing.T) {
 	}
 }
 
+// TestTxEntry ensures the relationships between transactions in the pool are
+// reported by TxEntry, TxAncestors and TxDescendants.
+func TestTxEntry(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	// Create the following diamond of transactions rooted with the first
+	// spendable output provided by the harness.
+	//	root -> left  -> join
+	//	     \-> right -/
+	root, err := harness.CreateSignedTx(outputs[:1], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	left, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(root, 0)}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	right, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(root, 1)}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	join, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(left, 0), txOutToSpendableOut(right, 0)}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	var totalSize int64
+	for _, tx := range []*czzutil.Tx{root, left, right, join} {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept "+
+				"tx: %v", err)
+		}
+		totalSize += int64(tx.MsgTx().SerializeSize())
+	}
+
+	entry, err := harness.txPool.TxEntry(root.Hash())
+	if err != nil {
+		t.Fatalf("TxEntry: %v", err)
+	}
+	if len(entry.Depends) != 0 || len(entry.SpentBy) != 2 ||
+		entry.SpentBy[0] != *left.Hash() || entry.SpentBy[1] != *right.Hash() {
+		t.Fatalf("unexpected relationships of root: depends %v, "+
+			"spent by %v", entry.Depends, entry.SpentBy)
+	}
+	if entry.AncestorCount != 1 || entry.DescendantCount != 4 ||
+		entry.DescendantSize != totalSize {
+		t.Fatalf("unexpected root entry %+v", entry)
+	}
+	if entry.Type != TxTypeStandard {
+		t.Fatalf("unexpected type %v", entry.Type)
+	}
+
+	entry, err = harness.txPool.TxEntry(join.Hash())
+	if err != nil {
+		t.Fatalf("TxEntry: %v", err)
+	}
+	if len(entry.Depends) != 2 || len(entry.SpentBy) != 0 {
+		t.Fatalf("unexpected relationships of join: depends %v, "+
+			"spent by %v", entry.Depends, entry.SpentBy)
+	}
+	if entry.AncestorCount != 4 || entry.AncestorSize != totalSize ||
+		entry.DescendantCount != 1 {
+		t.Fatalf("unexpected join entry %+v", entry)
+	}
+
+	ancestors, err := harness.txPool.TxAncestors(join.Hash())
+	if err != nil || len(ancestors) != 3 {
+		t.Fatalf("TxAncestors: got %d entries, %v", len(ancestors), err)
+	}
+	descendants, err := harness.txPool.TxDescendants(left.Hash())
+	if err != nil || len(descendants) != 1 ||
+		descendants[0].Tx.Hash() != join.Hash() {
+		t.Fatalf("TxDescendants: got %d entries, %v", len(descendants),
+			err)
+	}
+
+	if _, err := harness.txPool.TxEntry(&chainhash.Hash{}); err == nil {
+		t.Fatal("TxEntry: no error for a transaction not in the pool")
+	}
+}
+
 // TestTxPool_DecodeCompressedBlock tests that a compact block is decoded
 // correctly against the mempool.
 func TestTxPool_DecodeCompressedBlock(t *testing.T) {
@@ -314,6 +314,7 @@ func checkTransactionStandard(tx *czzutil.Tx, height int32,
 	numConvertOutputs := 0
 	numCastingOutputs := 0
 	numConvertConfirmOutputs := 0
+	numPoolKeyRotationOutputs := 0
 
 	for i, txOut := range msgTx.TxOut {
 		scriptClass := txscript.GetScriptClass(txOut.PkScript)
@@ -347,6 +348,8 @@ func checkTransactionStandard(tx *czzutil.Tx, height int32,
 			numCastingOutputs++
 		} else if scriptClass == txscript.ConvertConfirmTy {
 			numConvertConfirmOutputs++
+		} else if scriptClass == txscript.PoolKeyRotationTy {
+			numPoolKeyRotationOutputs++
 		} else if isDust(txOut, minRelayTxFee) {
 			str := fmt.Sprintf("transaction output %d: payment "+
 				"of %d is dust", i, txOut.Value)
@@ -391,5 +394,10 @@ func checkTransactionStandard(tx *czzutil.Tx, height int32,
 		return txRuleError(wire.RejectNonstandard, str)
 	}
 
+	if numPoolKeyRotationOutputs > 1 {
+		str := "more than one transaction output in a numPoolKeyRotationOutputs script"
+		return txRuleError(wire.RejectNonstandard, str)
+	}
+
 	return nil
 }
@@ -0,0 +1,272 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/mining"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+)
+
+// TxType identifies the kind of a transaction in the pool.
+type TxType int
+
+const (
+	// TxTypeStandard is a regular transaction.
+	TxTypeStandard TxType = iota
+
+	// TxTypeConvert is a transaction with at least one convert output.
+	TxTypeConvert
+
+	// TxTypeCasting is a casting transaction.
+	TxTypeCasting
+
+	// TxTypeMortgage is a mortgage transaction.
+	TxTypeMortgage
+)
+
+// txTypeStrings is a map of transaction types back to their constant names
+// for pretty printing.
+var txTypeStrings = map[TxType]string{
+	TxTypeStandard: "standard",
+	TxTypeConvert:  "convert",
+	TxTypeCasting:  "casting",
+	TxTypeMortgage: "mortgage",
+}
+
+// String returns the TxType in human-readable form.
+func (t TxType) String() string {
+	if s, ok := txTypeStrings[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown TxType (%d)", int(t))
+}
+
+// txTypeOf returns the type of the passed transaction.  The transaction is
+// expected to have been accepted to the pool, so only the script types of its
+// outputs are checked.
+func txTypeOf(tx *wire.MsgTx) TxType {
+	for _, txOut := range tx.TxOut {
+		if txscript.IsConvertTy(txOut.PkScript) {
+			return TxTypeConvert
+		}
+	}
+	if len(tx.TxOut) > 0 {
+		switch pkScript := tx.TxOut[0].PkScript; {
+		case txscript.IsCastingTy(pkScript):
+			return TxTypeCasting
+		case txscript.IsMortgageTy(pkScript):
+			return TxTypeMortgage
+		}
+	}
+	return TxTypeStandard
+}
+
+// TxEntry describes a transaction in the pool along with its relationships to
+// the other transactions of the pool.
+type TxEntry struct {
+	*TxDesc
+
+	// Size is the serialized size of the transaction.
+	Size int64
+
+	// CurrentPriority is the priority of the transaction at the next block
+	// height.  It is zero when the inputs of the transaction can't be
+	// found.
+	CurrentPriority float64
+
+	// Type is the kind of the transaction.
+	Type TxType
+
+	// Depends holds the hashes of the transactions in the pool the
+	// transaction spends outputs of.
+	Depends []chainhash.Hash
+
+	// SpentBy holds the hashes of the transactions in the pool spending
+	// outputs of the transaction.
+	SpentBy []chainhash.Hash
+
+	// The number, total size and total fees of the in-pool ancestors and
+	// descendants of the transaction.  They include the transaction
+	// itself.
+	AncestorCount   int64
+	AncestorSize    int64
+	AncestorFees    int64
+	DescendantCount int64
+	DescendantSize  int64
+	DescendantFees  int64
+}
+
+// parents returns the descriptors of the transactions in the pool the passed
+// transaction spends outputs of.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) parents(desc *TxDesc) []*TxDesc {
+	var parents []*TxDesc
+	seen := make(map[chainhash.Hash]struct{})
+	for _, txIn := range desc.Tx.MsgTx().TxIn {
+		hash := txIn.PreviousOutPoint.Hash
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		if parent, exists := mp.pool[hash]; exists {
+			parents = append(parents, parent)
+		}
+	}
+	return parents
+}
+
+// children returns the descriptors of the transactions in the pool spending
+// outputs of the passed transaction.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) children(desc *TxDesc) []*TxDesc {
+	var children []*TxDesc
+	seen := make(map[chainhash.Hash]struct{})
+	prevOut := wire.OutPoint{Hash: *desc.Tx.Hash()}
+	for i := range desc.Tx.MsgTx().TxOut {
+		prevOut.Index = uint32(i)
+		spender, exists := mp.outpoints[prevOut]
+		if !exists {
+			continue
+		}
+		if _, ok := seen[*spender.Hash()]; ok {
+			continue
+		}
+		seen[*spender.Hash()] = struct{}{}
+		if child, exists := mp.pool[*spender.Hash()]; exists {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// relatives returns the descriptors of all transactions reachable from the
+// passed one by repeatedly following next, excluding the transaction itself.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) relatives(desc *TxDesc, next func(*TxDesc) []*TxDesc) []*TxDesc {
+	var relatives []*TxDesc
+	seen := map[chainhash.Hash]struct{}{*desc.Tx.Hash(): {}}
+	queue := next(desc)
+	for len(queue) > 0 {
+		relative := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[*relative.Tx.Hash()]; ok {
+			continue
+		}
+		seen[*relative.Tx.Hash()] = struct{}{}
+		relatives = append(relatives, relative)
+		queue = append(queue, next(relative)...)
+	}
+	return relatives
+}
+
+// lookupTxDesc returns the descriptor of the passed transaction from the main
+// pool.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) lookupTxDesc(txHash *chainhash.Hash) (*TxDesc, error) {
+	desc, exists := mp.pool[*txHash]
+	if !exists {
+		return nil, fmt.Errorf("transaction is not in the pool")
+	}
+	return desc, nil
+}
+
+// txEntry returns the entry of the passed descriptor.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) txEntry(desc *TxDesc) *TxEntry {
+	tx := desc.Tx
+	entry := &TxEntry{
+		TxDesc:  desc,
+		Size:    int64(tx.MsgTx().SerializeSize()),
+		Type:    txTypeOf(tx.MsgTx()),
+		Depends: make([]chainhash.Hash, 0),
+		SpentBy: make([]chainhash.Hash, 0),
+	}
+	if utxos, err := mp.fetchInputUtxos(tx); err == nil {
+		entry.CurrentPriority = mining.CalcPriority(tx.MsgTx(), utxos,
+			mp.cfg.BestHeight()+1)
+	}
+	for _, parent := range mp.parents(desc) {
+		entry.Depends = append(entry.Depends, *parent.Tx.Hash())
+	}
+	for _, child := range mp.children(desc) {
+		entry.SpentBy = append(entry.SpentBy, *child.Tx.Hash())
+	}
+
+	entry.AncestorCount, entry.AncestorSize, entry.AncestorFees = 1,
+		entry.Size, desc.Fee
+	for _, ancestor := range mp.relatives(desc, mp.parents) {
+		entry.AncestorCount++
+		entry.AncestorSize += int64(ancestor.Tx.MsgTx().SerializeSize())
+		entry.AncestorFees += ancestor.Fee
+	}
+	entry.DescendantCount, entry.DescendantSize, entry.DescendantFees = 1,
+		entry.Size, desc.Fee
+	for _, descendant := range mp.relatives(desc, mp.children) {
+		entry.DescendantCount++
+		entry.DescendantSize += int64(descendant.Tx.MsgTx().SerializeSize())
+		entry.DescendantFees += descendant.Fee
+	}
+	return entry
+}
+
+// TxEntry returns the entry of the passed transaction.  This only fetches from
+// the main transaction pool and does not include orphans.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) TxEntry(txHash *chainhash.Hash) (*TxEntry, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	desc, err := mp.lookupTxDesc(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return mp.txEntry(desc), nil
+}
+
+// TxAncestors returns the entries of all transactions in the main pool the
+// passed transaction depends on, directly or through other transactions of the
+// pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) TxAncestors(txHash *chainhash.Hash) ([]*TxEntry, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	desc, err := mp.lookupTxDesc(txHash)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*TxEntry
+	for _, ancestor := range mp.relatives(desc, mp.parents) {
+		entries = append(entries, mp.txEntry(ancestor))
+	}
+	return entries, nil
+}
+
+// TxDescendants returns the entries of all transactions in the main pool which
+// depend on the passed transaction, directly or through other transactions of
+// the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) TxDescendants(txHash *chainhash.Hash) ([]*TxEntry, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	desc, err := mp.lookupTxDesc(txHash)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*TxEntry
+	for _, descendant := range mp.relatives(desc, mp.children) {
+		entries = append(entries, mp.txEntry(descendant))
+	}
+	return entries, nil
+}
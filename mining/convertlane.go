@@ -0,0 +1,146 @@
+package mining
+
+import (
+	"container/heap"
+	"fmt"
+	"math/big"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/czzutil"
+)
+
+// convertLane selects the Convert transactions of a block template.  Every
+// conversion of a Convert transaction is verified against its external chain,
+// so the lane keeps them in a queue of their own ordered by fee per kilobyte,
+// bounds the number of conversions verified for a template along with their
+// value, and passes over conversions the pools of their asset types can't pay
+// out before verifying them.
+type convertLane struct {
+	params   *chaincfg.Params
+	cState   *cross.CommitteeState
+	queue    *txPriorityQueue
+	maxItems int
+	maxValue int64
+
+	// items is the number of conversions verified so far, value the value
+	// of those selected, and balances the part of the pools of the asset
+	// types left to pay out the following ones.
+	items    int
+	value    int64
+	balances map[uint8]*big.Int
+}
+
+// newConvertLane returns a convert lane limited by the passed policy, which
+// checks the balances of the pools in the passed committee state.  The lane
+// is disabled when the state is nil, before the Maui upgrade.
+func newConvertLane(policy *Policy, params *chaincfg.Params, cState *cross.CommitteeState) *convertLane {
+	return &convertLane{
+		params:   params,
+		cState:   cState,
+		queue:    newTxPriorityQueue(0, true),
+		maxItems: int(policy.BlockMaxConvertItems),
+		maxValue: int64(policy.BlockMaxConvertValue),
+		balances: make(map[uint8]*big.Int),
+	}
+}
+
+// Len returns the number of Convert transactions waiting in the lane.
+func (l *convertLane) Len() int {
+	return l.queue.Len()
+}
+
+// push adds the passed item to the lane when it is a Convert transaction and
+// returns whether it did.  Other items are left to the priority queue.
+func (l *convertLane) push(item *txPrioItem) bool {
+	if l.cState == nil {
+		return false
+	}
+	if info, _ := cross.IsConvertTx(item.tx.MsgTx()); info == nil {
+		return false
+	}
+	item.convert = true
+	heap.Push(l.queue, item)
+	return true
+}
+
+// pop removes and returns the next transaction to consider for the block,
+// which is the Convert transaction with the highest fee per kilobyte when it
+// pays at least as much as the next transaction of the passed priority queue.
+func (l *convertLane) pop(pq *txPriorityQueue) *txPrioItem {
+	if l.queue.Len() != 0 && (pq.Len() == 0 ||
+		l.queue.items[0].feePerKB >= pq.items[0].feePerKB) {
+
+		return heap.Pop(l.queue).(*txPrioItem)
+	}
+	return heap.Pop(pq).(*txPrioItem)
+}
+
+// poolBalance returns the part of the pool of the passed asset type left to
+// pay out conversions.
+func (l *convertLane) poolBalance(assetType uint8) *big.Int {
+	if balance, ok := l.balances[assetType]; ok {
+		return balance
+	}
+	balance := big.NewInt(0)
+	if pool, ok := cross.CoinPools[assetType]; ok {
+		addr, err := czzutil.NewAddressPubKeyHash(pool, l.params)
+		if err == nil {
+			if utxos := l.cState.NoCostUtxos[addr.String()]; utxos != nil {
+				for _, amount := range utxos.Amount {
+					balance.Add(balance, amount)
+				}
+			}
+		}
+	}
+	l.balances[assetType] = balance
+	return balance
+}
+
+// reserve ensures the passed conversions of a Convert transaction fit into the
+// limits of the lane and can be paid out by the pools, and counts them as
+// verified.  It must be called before the conversions are verified.
+func (l *convertLane) reserve(infos map[uint32]*cross.ConvertTxInfo) error {
+	if l.maxItems > 0 && l.items+len(infos) > l.maxItems {
+		return fmt.Errorf("%d conversions verified, at most %d allowed",
+			l.items+len(infos), l.maxItems)
+	}
+
+	value := int64(0)
+	amounts := make(map[uint8]*big.Int)
+	for _, info := range infos {
+		if info.Amount == nil || info.Amount.Sign() <= 0 {
+			return fmt.Errorf("conversion of %s has no amount",
+				info.ExtTxHash)
+		}
+		value += info.Amount.Int64()
+		if amount, ok := amounts[info.AssetType]; ok {
+			amount.Add(amount, info.Amount)
+		} else {
+			amounts[info.AssetType] = new(big.Int).Set(info.Amount)
+		}
+	}
+	if l.maxValue > 0 && l.value+value > l.maxValue {
+		return fmt.Errorf("conversions of value %d selected, at most %d "+
+			"allowed", l.value+value, l.maxValue)
+	}
+	for assetType, amount := range amounts {
+		if balance := l.poolBalance(assetType); amount.Cmp(balance) > 0 {
+			return fmt.Errorf("conversions of %d exceed the balance %d "+
+				"left in pool %d", amount, balance, assetType)
+		}
+	}
+
+	l.items += len(infos)
+	return nil
+}
+
+// add selects the passed verified conversions, whose value is paid out of
+// the pools of their asset types.
+func (l *convertLane) add(infos []*cross.ConvertTxInfo) {
+	for _, info := range infos {
+		l.value += info.Amount.Int64()
+		balance := l.poolBalance(info.AssetType)
+		balance.Sub(balance, info.Amount)
+	}
+}
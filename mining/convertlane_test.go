@@ -0,0 +1,181 @@
+package mining
+
+import (
+	"container/heap"
+	"math/big"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/cross"
+	"github.com/classzz/classzz/rlp"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// testConvertTx returns a Convert transaction of the passed amount from the
+// pool of the passed asset type.
+func testConvertTx(t *testing.T, assetType uint8, amount int64, extTxHash string) *czzutil.Tx {
+	data, err := rlp.EncodeToBytes(&cross.ConvertTxInfo{
+		AssetType:   assetType,
+		ConvertType: cross.ExpandedTxConvert_Czz,
+		ExtTxHash:   extTxHash,
+		Amount:      big.NewInt(amount),
+		FeeAmount:   big.NewInt(0),
+	})
+	if err != nil {
+		t.Fatalf("EncodeToBytes: %v", err)
+	}
+	script, err := txscript.ConvertScript(data)
+	if err != nil {
+		t.Fatalf("ConvertScript: %v", err)
+	}
+	mtx := wire.NewMsgTx(wire.TxVersion)
+	mtx.AddTxOut(wire.NewTxOut(0, script))
+	return czzutil.NewTx(mtx)
+}
+
+// testLaneState returns a committee state whose pools of the passed asset
+// types hold the passed balances.
+func testLaneState(t *testing.T, params *chaincfg.Params, balances map[uint8]int64) *cross.CommitteeState {
+	cState := cross.NewCommitteeState()
+	for assetType, balance := range balances {
+		addr, err := czzutil.NewAddressPubKeyHash(cross.CoinPools[assetType], params)
+		if err != nil {
+			t.Fatalf("NewAddressPubKeyHash: %v", err)
+		}
+		cState.NoCostUtxos[addr.String()] = &cross.PoolAddrItem{
+			POut:   []wire.OutPoint{{Index: 1}, {Index: 2}},
+			Script: [][]byte{nil, nil},
+			Amount: []*big.Int{big.NewInt(balance / 2), big.NewInt(balance - balance/2)},
+		}
+	}
+	return cState
+}
+
+// TestConvertLaneOrder ensures Convert transactions are taken from a synthetic
+// mempool by fee per kilobyte along with the other transactions.
+func TestConvertLaneOrder(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	lane := newConvertLane(&Policy{}, params, cross.NewCommitteeState())
+	pq := newTxPriorityQueue(0, true)
+
+	mempool := []*txPrioItem{
+		{tx: testConvertTx(t, cross.ExpandedTxConvert_ECzz, 1, "a"), feePerKB: 3000},
+		{tx: czzutil.NewTx(wire.NewMsgTx(1)), feePerKB: 5000},
+		{tx: testConvertTx(t, cross.ExpandedTxConvert_ECzz, 1, "b"), feePerKB: 1000},
+		{tx: czzutil.NewTx(wire.NewMsgTx(2)), feePerKB: 2000},
+		{tx: testConvertTx(t, cross.ExpandedTxConvert_HCzz, 1, "c"), feePerKB: 8000},
+		{tx: testConvertTx(t, cross.ExpandedTxConvert_BCzz, 1, "d"), feePerKB: 2000},
+	}
+	for _, item := range mempool {
+		if !lane.push(item) {
+			heap.Push(pq, item)
+		}
+	}
+	if lane.Len() != 4 || pq.Len() != 2 {
+		t.Fatalf("%d transactions in the lane and %d in the queue, "+
+			"want 4 and 2", lane.Len(), pq.Len())
+	}
+
+	want := []struct {
+		feePerKB int64
+		convert  bool
+	}{
+		{8000, true}, {5000, false}, {3000, true}, {2000, true},
+		{2000, false}, {1000, true},
+	}
+	for i, w := range want {
+		item := lane.pop(pq)
+		if item.feePerKB != w.feePerKB || item.convert != w.convert {
+			t.Errorf("transaction %d has fee %d and convert %v, "+
+				"want %d and %v", i, item.feePerKB, item.convert,
+				w.feePerKB, w.convert)
+		}
+	}
+	if lane.Len() != 0 || pq.Len() != 0 {
+		t.Error("transactions left after popping all of them")
+	}
+
+	// Before the Maui upgrade there is no lane.
+	disabled := newConvertLane(&Policy{}, params, nil)
+	if disabled.push(mempool[0]) {
+		t.Error("Convert transaction pushed to disabled lane")
+	}
+}
+
+// TestConvertLaneLimits ensures the lane stops verifying conversions beyond
+// its limits and those the pools can't pay out.
+func TestConvertLaneLimits(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	convert := func(assetType uint8, amount int64) map[uint32]*cross.ConvertTxInfo {
+		infos, err := cross.IsConvertTx(testConvertTx(t, assetType, amount, "x").MsgTx())
+		if err != nil {
+			t.Fatalf("IsConvertTx: %v", err)
+		}
+		return infos
+	}
+	selected := func(infos map[uint32]*cross.ConvertTxInfo) []*cross.ConvertTxInfo {
+		list := make([]*cross.ConvertTxInfo, 0, len(infos))
+		for _, info := range infos {
+			list = append(list, info)
+		}
+		return list
+	}
+
+	// The pools limit the conversions of their asset types.
+	cState := testLaneState(t, params, map[uint8]int64{
+		cross.ExpandedTxConvert_ECzz: 1000,
+		cross.ExpandedTxConvert_HCzz: 500,
+	})
+	lane := newConvertLane(&Policy{}, params, cState)
+	steps := []struct {
+		name      string
+		assetType uint8
+		amount    int64
+		verified  bool
+		valid     bool
+	}{
+		{"within pool", cross.ExpandedTxConvert_ECzz, 600, true, true},
+		{"exceeding rest of pool", cross.ExpandedTxConvert_ECzz, 500, false, false},
+		{"rest of pool", cross.ExpandedTxConvert_ECzz, 400, true, true},
+		{"other pool", cross.ExpandedTxConvert_HCzz, 500, true, true},
+		{"empty pool", cross.ExpandedTxConvert_BCzz, 1, false, false},
+		{"no amount", cross.ExpandedTxConvert_HCzz, 0, false, false},
+	}
+	for _, step := range steps {
+		infos := convert(step.assetType, step.amount)
+		err := lane.reserve(infos)
+		if (err == nil) != step.valid {
+			t.Errorf("%s: got error %v, want valid %v", step.name, err,
+				step.valid)
+		}
+		if err == nil && step.verified {
+			lane.add(selected(infos))
+		}
+	}
+	if lane.value != 1500 || lane.items != 3 {
+		t.Errorf("lane selected value %d of %d conversions, want 1500 of 3",
+			lane.value, lane.items)
+	}
+
+	// Conversions failing verification count against the verification
+	// limit, and the value of the selected ones is limited.
+	policy := &Policy{BlockMaxConvertItems: 2, BlockMaxConvertValue: 700}
+	lane = newConvertLane(policy, params, testLaneState(t, params,
+		map[uint8]int64{cross.ExpandedTxConvert_ECzz: 10000}))
+	if err := lane.reserve(convert(cross.ExpandedTxConvert_ECzz, 800)); err == nil {
+		t.Error("conversion above the value limit reserved")
+	}
+	if err := lane.reserve(convert(cross.ExpandedTxConvert_ECzz, 300)); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	infos := convert(cross.ExpandedTxConvert_ECzz, 400)
+	if err := lane.reserve(infos); err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	lane.add(selected(infos))
+	if err := lane.reserve(convert(cross.ExpandedTxConvert_ECzz, 1)); err == nil {
+		t.Error("conversion beyond the verification limit reserved")
+	}
+}
@@ -89,6 +89,10 @@ type txPrioItem struct {
 	// transactions in the source pool and hence must come after them in
 	// a block.
 	dependsOn map[chainhash.Hash]struct{}
+
+	// convert is set for Convert transactions, which are selected by the
+	// convert lane rather than by priority.
+	convert bool
 }
 
 // txPriorityQueueLessFunc describes a function that can be used as a compare
@@ -168,20 +172,6 @@ func txPQByFee(pq *txPriorityQueue, i, j int) bool {
 	return pq.items[i].feePerKB > pq.items[j].feePerKB
 }
 
-func txPQByFeeAndHeight(pq *txPriorityQueue, i, j int) bool {
-	// Using > here so that pop gives the highest fee item as opposed
-	// to the lowest.  Sort by fee first, then priority.
-	if pq.items[i].feePerKB == pq.items[j].feePerKB {
-		einfos1, _ := cross.IsConvertTx(pq.items[i].tx.MsgTx())
-		einfos2, _ := cross.IsConvertTx(pq.items[j].tx.MsgTx())
-		if einfos1 != nil && einfos2 != nil {
-			return cross.GetMaxHeight(einfos1) < cross.GetMaxHeight(einfos2)
-		}
-		return pq.items[i].priority > pq.items[j].priority
-	}
-	return pq.items[i].feePerKB > pq.items[j].feePerKB
-}
-
 // newTxPriorityQueue returns a new transaction priority queue that reserves the
 // passed amount of space for the elements.  The new priority queue uses either
 // the txPQByPriority or the txPQByFee compare function depending on the
@@ -192,9 +182,8 @@ func newTxPriorityQueue(reserve int, sortByFee bool) *txPriorityQueue {
 	pq := &txPriorityQueue{
 		items: make([]*txPrioItem, 0, reserve),
 	}
-	//fmt.Println("sortByFee", sortByFee)
 	if sortByFee {
-		pq.SetLessFunc(txPQByFeeAndHeight)
+		pq.SetLessFunc(txPQByFee)
 	} else {
 		pq.SetLessFunc(txPQByPriority)
 	}
@@ -314,7 +303,7 @@ func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockH
 		SignatureScript: coinbaseScript,
 		Sequence:        wire.MaxTxInSequenceNum,
 	})
-	if nextBlockHeight >= params.EntangleHeight {
+	if params.IsActive(chaincfg.UpgradeEntangle, nextBlockHeight) {
 		// utxo of coinbase in params.EntangleHeight-1 block
 		tx.AddTxIn(&wire.TxIn{}) // for pool address hold this
 		tx.AddTxIn(&wire.TxIn{})
@@ -332,6 +321,10 @@ func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockH
 	// Calculate 1 - 20% = 80% of the reward. Coinbase
 	reward3 := reward - reward1 - reward2
 
+	// The miner alone is paid the post-subsidy reward.  The share of the
+	// conversion fees is added once the conversions are selected.
+	reward3 += blockchain.CalcPostSubsidyReward(nextBlockHeight, 0, params)
+
 	// Coinbase reward
 	tx.AddTxOut(&wire.TxOut{
 		Value:    reward3,
@@ -339,9 +332,9 @@ func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockH
 	})
 
 	//Sum up all the previous pool value
-	if nextBlockHeight == params.EntangleHeight {
-		reward1 = reward1 * int64(params.EntangleHeight-1)
-		reward2 = reward2 * int64(params.EntangleHeight-1)
+	if nextBlockHeight == params.UpgradeHeight(chaincfg.UpgradeEntangle) {
+		reward1 = reward1 * int64(params.UpgradeHeight(chaincfg.UpgradeEntangle)-1)
+		reward2 = reward2 * int64(params.UpgradeHeight(chaincfg.UpgradeEntangle)-1)
 	}
 
 	// CoinPool1 reward
@@ -356,7 +349,7 @@ func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockH
 		PkScript: pkScript2,
 	})
 	// the amount of already entangled,placeholder
-	if nextBlockHeight >= params.EntangleHeight && nextBlockHeight < params.MauiHeight {
+	if params.IsActive(chaincfg.UpgradeEntangle, nextBlockHeight) && !params.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		keepInfo := cross.KeepedAmount{Items: []cross.KeepedItem{}}
 		keepInfo.Add(cross.KeepedItem{
 			AssetType: cross.ExpandedTxEntangle_Doge,
@@ -618,7 +611,7 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress czzutil.Address) (*Bloc
 	rewards := make([]*cross.PunishedRewardItem, 0, 0)
 	mergeItems := make(map[uint64][]*cross.BeaconMergeItem)
 	var lastScriptInfo []byte
-	if g.chainParams.EntangleHeight <= nextBlockHeight && g.chainParams.MauiHeight > nextBlockHeight {
+	if g.chainParams.IsActive(chaincfg.UpgradeEntangle, nextBlockHeight) && !g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		var err error
 		lastScriptInfo, err = g.getlastScriptInfo(&cHash, cheight)
 		if err != nil {
@@ -627,11 +620,11 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress czzutil.Address) (*Bloc
 	}
 
 	var cState *cross.CommitteeState
-	if g.chainParams.MauiHeight < nextBlockHeight {
+	if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight-1) {
 		cState = g.chain.CurrentCstate()
 	}
 
-	if g.chainParams.MauiHeight == nextBlockHeight {
+	if g.chainParams.UpgradeHeight(chaincfg.UpgradeMaui) == nextBlockHeight {
 		eState := g.chain.CurrentEstate()
 		cState = cross.NewCommitteeState()
 		for _, v := range eState.EnInfos {
@@ -645,15 +638,27 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress czzutil.Address) (*Bloc
 			cState.PledgeInfos = append(cState.PledgeInfos, pi)
 		}
 	}
+	if cState != nil {
+		cState.RotateCommittee(g.chainParams, nextBlockHeight)
+		cState.InitPools(g.chainParams, nextBlockHeight)
+	}
+
+	// Convert transactions are selected by a lane of their own once the
+	// committee state exists.
+	var laneState *cross.CommitteeState
+	if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
+		laneState = cState
+	}
+	convertLane := newConvertLane(g.policy, g.chainParams, laneState)
 
 	fork := false
 	var eState *cross.EntangleState
-	if g.chainParams.BeaconHeight < nextBlockHeight && g.chainParams.MauiHeight > nextBlockHeight {
+	if g.chainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight-1) && !g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		eState = g.chain.CurrentEstate()
 		fork = true
 	}
 
-	if g.chainParams.BeaconHeight == nextBlockHeight {
+	if g.chainParams.UpgradeHeight(chaincfg.UpgradeBeacon) == nextBlockHeight {
 		eState = cross.NewEntangleState()
 	}
 
@@ -669,7 +674,7 @@ mempoolLoop:
 		tx := txDesc.Tx
 		txkeys[*tx.Hash()] = ""
 		if blockchain.IsCoinBase(tx) {
-			if g.chainParams.EntangleHeight > nextBlockHeight && len(tx.MsgTx().TxIn) == 3 {
+			if !g.chainParams.IsActive(chaincfg.UpgradeEntangle, nextBlockHeight) && len(tx.MsgTx().TxIn) == 3 {
 				log.Tracef("Skipping coinbase tx %s", tx.Hash())
 				continue
 			} else if len(tx.MsgTx().TxIn) == 1 {
@@ -745,7 +750,7 @@ mempoolLoop:
 
 		// Add the transaction to the priority queue to mark it ready
 		// for inclusion in the block unless it has dependencies.
-		if prioItem.dependsOn == nil {
+		if prioItem.dependsOn == nil && !convertLane.push(prioItem) {
 			heap.Push(priorityQueue, prioItem)
 		}
 
@@ -771,12 +776,15 @@ mempoolLoop:
 	ConvertTxMap := make(map[string]*cross.ConvertTxTemp)
 	ConvertTx := make([]*cross.ConvertTxTemp, 0, 0)
 	ConvertConfirmsTx := make([]*wire.MsgTx, 0, 0)
+	PoolKeyRotations := make([]*cross.PoolKeyRotationTxInfo, 0, 0)
+	rotatedPools := make(map[uint8]bool)
 
 	// Choose which transactions make it into the block.
-	for priorityQueue.Len() > 0 {
+	for priorityQueue.Len() > 0 || convertLane.Len() > 0 {
 		// Grab the highest priority (or highest fee per kilobyte
-		// depending on the sort order) transaction.
-		prioItem := heap.Pop(priorityQueue).(*txPrioItem)
+		// depending on the sort order) transaction, or the Convert
+		// transaction paying more.
+		prioItem := convertLane.pop(priorityQueue)
 		tx := prioItem.tx
 
 		// Grab any transactions which depend on this one.
@@ -844,8 +852,9 @@ mempoolLoop:
 		// Prioritize by fee per kilobyte once the block is larger than
 		// the priority size or there are no more high-priority
 		// transactions.
-		if !sortedByFee && (blockPlusTxSize >= g.policy.BlockPrioritySize ||
-			prioItem.priority <= MinHighPriority) {
+		if !sortedByFee && !prioItem.convert &&
+			(blockPlusTxSize >= g.policy.BlockPrioritySize ||
+				prioItem.priority <= MinHighPriority) {
 
 			log.Tracef("Switching to sort by fees per "+
 				"kilobyte blockSize %d >= BlockPrioritySize "+
@@ -881,7 +890,7 @@ mempoolLoop:
 			continue
 		}
 
-		if g.chainParams.BeaconHeight < nextBlockHeight && g.chainParams.MauiHeight > nextBlockHeight {
+		if g.chainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight-1) && !g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 
 			// BeaconRegistrationTx
 			if br, _ := cross.IsBeaconRegistrationTx(tx.MsgTx(), g.chainParams); br != nil {
@@ -899,7 +908,7 @@ mempoolLoop:
 		}
 
 		////////////////////////////////////
-		if nextBlockHeight >= g.chainParams.MauiHeight {
+		if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 
 			// Mortgage
 			if info, _ := cross.IsMortgageTx(tx.MsgTx(), g.chainParams); info != nil {
@@ -963,7 +972,12 @@ mempoolLoop:
 
 			// IsConvertTx
 			if cinfo, _ := cross.IsConvertTx(tx.MsgTx()); cinfo != nil {
-				fmt.Println("txhash ", tx.Hash().String())
+				if err := convertLane.reserve(cinfo); err != nil {
+					log.Tracef("Skipping tx %s due to the convert "+
+						"lane: %v", tx.Hash(), err)
+					logSkippedDeps(tx, deps)
+					continue
+				}
 				objs, err := cross.ToAddressFromConvertsVerify(tx.MsgTx(), cState, cinfo, g.chain.GetCommitteeVerify())
 				if err != nil {
 					log.Tracef("Skipping tx %s due to error in "+
@@ -971,6 +985,7 @@ mempoolLoop:
 					logSkippedDeps(tx, deps)
 					continue
 				}
+				convertLane.add(objs)
 
 				ctx := &cross.ConvertTxTemp{
 					Infos: objs,
@@ -987,6 +1002,48 @@ mempoolLoop:
 					logSkippedDeps(tx, deps)
 					continue
 				}
+				err := blockchain.CheckConvertConfirmSigners(g.chainParams,
+					tx, blockUtxos, cState, nextBlockHeight)
+				if err != nil {
+					log.Tracef("Skipping tx %s due to error in "+
+						"CheckConvertConfirmSigners: %v", tx.Hash(), err)
+					logSkippedDeps(tx, deps)
+					continue
+				}
+				released := true
+				for _, v := range cinfo {
+					err := cState.VerifyReleaseSignatures(g.chainParams,
+						v, nextBlockHeight)
+					if err != nil {
+						log.Tracef("Skipping tx %s due to error in "+
+							"VerifyReleaseSignatures: %v", tx.Hash(), err)
+						released = false
+						break
+					}
+				}
+				if !released {
+					logSkippedDeps(tx, deps)
+					continue
+				}
+			}
+
+			// PoolKeyRotation
+			if info, _ := cross.IsPoolKeyRotationTx(tx.MsgTx()); info != nil {
+				if rotatedPools[info.AssetType] {
+					log.Tracef("Skipping tx %s since the pool of "+
+						"asset %d is already rotated", tx.Hash(),
+						info.AssetType)
+					logSkippedDeps(tx, deps)
+					continue
+				}
+				_, err = g.chain.GetCommitteeVerify().VerifyPoolKeyRotationTx(tx.MsgTx(), cState, nextBlockHeight)
+				if err != nil {
+					log.Tracef("Skipping tx %s due to error in "+
+						"VerifyPoolKeyRotationTx: %v", tx.Hash(), err)
+					logSkippedDeps(tx, deps)
+					continue
+				}
+				rotatedPools[info.AssetType] = true
 			}
 		}
 
@@ -1025,7 +1082,7 @@ mempoolLoop:
 			// Add the transaction to the priority queue if there
 			// are no more dependencies after this one.
 			delete(item.dependsOn, *tx.Hash())
-			if len(item.dependsOn) == 0 {
+			if len(item.dependsOn) == 0 && !convertLane.push(item) {
 				heap.Push(priorityQueue, item)
 			}
 		}
@@ -1058,7 +1115,7 @@ mempoolLoop:
 	sort.Sort(TxSorter(blockTxns))
 
 	for _, tx := range blockTxns {
-		if nextBlockHeight >= g.chainParams.MauiHeight {
+		if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 
 			// IsCastingTx
 			if cinfo, _ := cross.IsCastingTx(tx.MsgTx()); cinfo != nil {
@@ -1074,10 +1131,15 @@ mempoolLoop:
 			if cinfo, _ := cross.IsConvertConfirmTx(tx.MsgTx()); cinfo != nil {
 				ConvertConfirmsTx = append(ConvertConfirmsTx, tx.MsgTx())
 			}
+
+			// PoolKeyRotation
+			if info, _ := cross.IsPoolKeyRotationTx(tx.MsgTx()); info != nil {
+				PoolKeyRotations = append(PoolKeyRotations, info)
+			}
 		}
 	}
 
-	if nextBlockHeight >= g.chainParams.MauiHeight {
+	if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		if MortgageTx != nil {
 			// Mortgage
 			if info, _ := cross.IsMortgageTx(MortgageTx, g.chainParams); info != nil {
@@ -1140,10 +1202,14 @@ mempoolLoop:
 				cross.ConvertConfirms(cState, cinfo)
 			}
 		}
+
+		for _, info := range PoolKeyRotations {
+			cState.RotatePoolKeys(info)
+		}
 	}
 
 	// make entangle tx if it exist
-	if g.chainParams.EntangleHeight <= nextBlockHeight && g.chainParams.MauiHeight > nextBlockHeight {
+	if g.chainParams.IsActive(chaincfg.UpgradeEntangle, nextBlockHeight) && !g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		eItems := make([]*cross.EntangleItem, 0)
 		if err = cross.MakeMergerCoinbaseTx2(coinbaseTx.MsgTx(), poolItem, eItems, lastScriptInfo, fork); err != nil {
 			return nil, nil, err
@@ -1151,10 +1217,21 @@ mempoolLoop:
 	}
 
 	// make entangle tx if it exist
-	if g.chainParams.MauiHeight <= nextBlockHeight {
+	if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) {
 		if err := cross.MakeMergerCoinbaseTx(g.chainParams, coinbaseTx.MsgTx(), cState, poolItem, convertItems, rewards, mergeItems); err != nil {
 			return nil, nil, err
 		}
+
+		// Pay the miner its share of the conversion fees from the fee
+		// pool.
+		if g.chainParams.PostSubsidyPolicy == chaincfg.PostSubsidyConvertFees {
+			convertFees := blockchain.CalcConvertFees(blockTxns)
+			share := blockchain.CalcPostSubsidyReward(nextBlockHeight,
+				convertFees, g.chainParams)
+			if err := cross.PayConvertFeeShare(coinbaseTx.MsgTx(), share); err != nil {
+				return nil, nil, err
+			}
+		}
 		if err := cross.MakeCoinbaseTxUtxo(g.chainParams, coinbaseTx.MsgTx(), cState, len(ConvertTx) != 0); err != nil {
 			return nil, nil, err
 		}
@@ -1163,12 +1240,12 @@ mempoolLoop:
 	CIDRoot := chainhash.Hash{}
 
 	// eState
-	if g.chainParams.BeaconHeight <= nextBlockHeight && g.chainParams.MauiHeight > nextBlockHeight && eState != nil {
+	if g.chainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight) && !g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) && eState != nil {
 		CIDRoot = eState.Hash()
 	}
 
 	// cState
-	if g.chainParams.MauiHeight <= nextBlockHeight && cState != nil {
+	if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight) && cState != nil {
 		CIDRoot = cState.Hash()
 	}
 
@@ -1206,23 +1283,10 @@ mempoolLoop:
 		blockSize, blockchain.CompactToBig(msgBlock.Header.Bits))
 
 	var cState3 *cross.EntangleState
-	if g.chainParams.BeaconHeight <= nextBlockHeight-1 && g.chainParams.MauiHeight > nextBlockHeight-1 {
+	if g.chainParams.IsActive(chaincfg.UpgradeBeacon, nextBlockHeight-1) && !g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight-1) {
 		cState3 = g.chain.CurrentEstate()
-	} else if g.chainParams.MauiHeight <= nextBlockHeight-1 {
-		cState4 := g.chain.CurrentCstate()
-		bai2s := make(map[string]*cross.BeaconAddressInfo)
-		for _, v := range cState4.PledgeInfos {
-			bai2 := &cross.BeaconAddressInfo{
-				ExchangeID:      v.ID.Uint64(),
-				StakingAmount:   v.StakingAmount,
-				CoinBaseAddress: v.CoinBaseAddress,
-			}
-			bai2s[v.Address] = bai2
-		}
-
-		cState3 = &cross.EntangleState{
-			EnInfos: bai2s,
-		}
+	} else if g.chainParams.IsActive(chaincfg.UpgradeMaui, nextBlockHeight-1) {
+		cState3 = g.chain.CurrentCstate().StakingState()
 	}
 
 	return &BlockTemplate{
@@ -1317,7 +1381,7 @@ func (g *BlkTmplGenerator) getlastScriptInfo(hash *chainhash.Hash, height int32)
 	if err != nil {
 		return nil, err
 	}
-	if height < g.chainParams.EntangleHeight {
+	if !g.chainParams.IsActive(chaincfg.UpgradeEntangle, height) {
 		return nil, nil
 	}
 	txout := tx.MsgTx().TxOut[3]
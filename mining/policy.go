@@ -37,6 +37,16 @@ type Policy struct {
 	// required for a transaction to be treated as free for mining purposes
 	// (block template generation).
 	TxMinFreeFee czzutil.Amount
+
+	// BlockMaxConvertItems is the maximum number of conversions of Convert
+	// transactions verified against the external chains when generating a
+	// block template, and thus the maximum number in the block.  Zero
+	// means no limit.
+	BlockMaxConvertItems uint32
+
+	// BlockMaxConvertValue is the maximum value of the conversions of
+	// Convert transactions in a block template.  Zero means no limit.
+	BlockMaxConvertValue czzutil.Amount
 }
 
 // minInt is a helper function to return the minimum of two ints.  This avoids
@@ -0,0 +1,457 @@
+package netsync
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	peerpkg "github.com/classzz/classzz/peer"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil/gcs"
+	"github.com/classzz/czzutil/gcs/builder"
+)
+
+// cfheadersMsg packages a bitcoin cfheaders message and the peer it came from
+// together so the block handler has access to that information.
+type cfheadersMsg struct {
+	cfheaders *wire.MsgCFHeaders
+	peer      *peerpkg.Peer
+}
+
+// cfilterMsg packages a bitcoin cfilter message and the peer it came from
+// together so the block handler has access to that information.
+type cfilterMsg struct {
+	cfilter *wire.MsgCFilter
+	peer    *peerpkg.Peer
+}
+
+// startHeadersOnlySync requests the headers that follow the current best
+// header from the passed peer.  Headers-only nodes never request full blocks
+// while syncing, so the entire header chain is fetched this way regardless of
+// checkpoints.
+func (sm *SyncManager) startHeadersOnlySync(peer *peerpkg.Peer) error {
+	locator, err := sm.chain.LatestBlockLocator()
+	if err != nil {
+		return err
+	}
+	return peer.PushGetHeadersMsg(locator, &zeroHash)
+}
+
+// handleHeadersOnlyMsg handles block header messages from all peers when in
+// headers-only mode.  Each header is validated and added to the block index
+// by the chain.  Once a peer has no more headers to send, the filters for the
+// new headers are requested.
+func (sm *SyncManager) handleHeadersOnlyMsg(hmsg *headersMsg) {
+	peer := hmsg.peer
+	msg := hmsg.headers
+	numHeaders := len(msg.Headers)
+	if numHeaders == 0 {
+		sm.fetchFilters(peer)
+		return
+	}
+
+	var finalHash *chainhash.Hash
+	for _, blockHeader := range msg.Headers {
+		blockHash := blockHeader.BlockHash()
+		finalHash = &blockHash
+
+		_, err := sm.chain.ProcessBlockHeader(blockHeader)
+		if err == nil {
+			continue
+		}
+
+		ruleErr, ok := err.(blockchain.RuleError)
+		switch {
+		// Headers we already know are expected when peers announce
+		// headers we learned from someone else.
+		case ok && ruleErr.ErrorCode == blockchain.ErrDuplicateBlock:
+			continue
+
+		// A header that doesn't connect means we are missing some of
+		// the headers in between, so ask the peer for them.
+		case ok && ruleErr.ErrorCode == blockchain.ErrPreviousBlockUnknown:
+			if err := sm.startHeadersOnlySync(peer); err != nil {
+				log.Warnf("Failed to send getheaders message to "+
+					"peer %s: %v", peer.Addr(), err)
+			}
+			return
+
+		case ok:
+			log.Warnf("Rejected block header %v from %s: %v -- "+
+				"disconnecting", blockHash, peer.Addr(), err)
+			peer.Disconnect()
+			return
+
+		default:
+			log.Errorf("Failed to process block header %v: %v",
+				blockHash, err)
+			return
+		}
+	}
+
+	sm.lastProgressTime = time.Now()
+	if sm.syncPeerState != nil && peer == sm.syncPeer {
+		sm.syncPeerState.lastBlockTime = time.Now()
+	}
+
+	best := sm.chain.BestSnapshot()
+	if height, err := sm.chain.BlockHeightByHash(finalHash); err == nil {
+		peer.UpdateLastBlockHeight(height)
+	}
+	if numHeaders < wire.MaxBlockHeadersPerMsg {
+		log.Infof("Synced headers to height %d (%v)", best.Height,
+			best.Hash)
+		sm.fetchFilters(peer)
+		return
+	}
+
+	// The peer may have more headers, so request the next batch starting
+	// after the last one received.
+	locator := blockchain.BlockLocator([]*chainhash.Hash{finalHash})
+	if err := peer.PushGetHeadersMsg(locator, &zeroHash); err != nil {
+		log.Warnf("Failed to send getheaders message to peer %s: %v",
+			peer.Addr(), err)
+	}
+}
+
+// handleHeadersOnlyInv requests the headers for the blocks announced by the
+// passed inventory vectors from the announcing peer when any of them is not
+// known yet.
+func (sm *SyncManager) handleHeadersOnlyInv(peer *peerpkg.Peer, invVects []*wire.InvVect) {
+	for _, iv := range invVects {
+		if iv.Type != wire.InvTypeBlock {
+			continue
+		}
+		peer.AddKnownInventory(iv)
+		if _, err := sm.chain.HeaderByHash(&iv.Hash); err == nil {
+			continue
+		}
+		if err := sm.startHeadersOnlySync(peer); err != nil {
+			log.Warnf("Failed to send getheaders message to peer "+
+				"%s: %v", peer.Addr(), err)
+		}
+		return
+	}
+}
+
+// filterTip returns the height of the last block in the main chain for which
+// a filter is stored.  Filters are stored in chain order, so the search walks
+// back from the last known filter height, which also accounts for blocks that
+// were reorganized out of the main chain.
+func (sm *SyncManager) filterTip() int32 {
+	best := sm.chain.BestSnapshot()
+	height := sm.filterHeight
+	if height > best.Height {
+		height = best.Height
+	}
+	for ; height >= 0; height-- {
+		hash, err := sm.chain.BlockHashByHeight(height)
+		if err != nil {
+			continue
+		}
+		filter, err := sm.cfIndex.FilterByBlockHash(hash,
+			wire.GCSFilterRegular)
+		if err == nil && filter != nil {
+			break
+		}
+	}
+	sm.filterHeight = height
+	return height
+}
+
+// filterHeader returns the stored regular filter header of the block of the
+// main chain at the passed height, which is the zero hash before the genesis
+// block.
+func (sm *SyncManager) filterHeader(height int32) (*chainhash.Hash, error) {
+	if height < 0 {
+		return &zeroHash, nil
+	}
+	hash, err := sm.chain.BlockHashByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	header, err := sm.cfIndex.FilterHeaderByBlockHash(hash,
+		wire.GCSFilterRegular)
+	if err != nil {
+		return nil, err
+	}
+	return chainhash.NewHash(header)
+}
+
+// fetchFilters requests the filter headers of the next batch of regular
+// filters for the main chain from the passed peer when there is no
+// outstanding request and the peer serves committed filters.  The filters
+// themselves are requested once the filter headers extend the stored filter
+// header chain.
+func (sm *SyncManager) fetchFilters(peer *peerpkg.Peer) {
+	if sm.cfIndex == nil || sm.filterStopHash != nil {
+		return
+	}
+	if peer.Services()&wire.SFNodeCF != wire.SFNodeCF {
+		return
+	}
+
+	best := sm.chain.BestSnapshot()
+	startHeight := sm.filterTip() + 1
+	if startHeight > best.Height {
+		return
+	}
+	stopHeight := startHeight + wire.MaxGetCFiltersReqRange - 1
+	if stopHeight > best.Height {
+		stopHeight = best.Height
+	}
+	stopHash, err := sm.chain.BlockHashByHeight(stopHeight)
+	if err != nil {
+		log.Warnf("Failed to fetch block hash at height %d: %v",
+			stopHeight, err)
+		return
+	}
+
+	log.Debugf("Requesting filter headers for blocks %d to %d from "+
+		"peer %s", startHeight, stopHeight, peer.Addr())
+	msg := wire.NewMsgGetCFHeaders(wire.GCSFilterRegular,
+		uint32(startHeight), stopHash)
+	peer.QueueMessage(msg, nil)
+	sm.filterStartHeight = startHeight
+	sm.filterStopHash = stopHash
+	sm.filterHashes = nil
+	sm.filterPeer = peer
+}
+
+// handleCFHeadersMsg handles cfheaders messages from all peers when in
+// headers-only mode.  The filter headers of the outstanding batch must extend
+// the stored filter header chain, and their filter hashes are kept to check
+// the filters of the batch, which are requested next.
+func (sm *SyncManager) handleCFHeadersMsg(cmsg *cfheadersMsg) {
+	peer := cmsg.peer
+	msg := cmsg.cfheaders
+	if peer != sm.filterPeer || sm.filterHashes != nil ||
+		msg.FilterType != wire.GCSFilterRegular ||
+		!msg.StopHash.IsEqual(sm.filterStopHash) {
+
+		log.Debugf("Ignoring unrequested filter headers for block %v "+
+			"from %s", msg.StopHash, peer)
+		return
+	}
+
+	// The batch is requested again when its stop block was reorganized
+	// out of the main chain in the meantime.
+	stopHeight, err := sm.chain.BlockHeightByHash(sm.filterStopHash)
+	if err != nil {
+		sm.filterStopHash = nil
+		sm.filterPeer = nil
+		sm.fetchFilters(peer)
+		return
+	}
+	prevHeader, err := sm.filterHeader(sm.filterStartHeight - 1)
+	if err != nil {
+		log.Errorf("Failed to fetch filter header at height %d: %v",
+			sm.filterStartHeight-1, err)
+		return
+	}
+
+	numFilters := int(stopHeight - sm.filterStartHeight + 1)
+	if len(msg.FilterHashes) != numFilters ||
+		!msg.PrevFilterHeader.IsEqual(prevHeader) {
+
+		log.Warnf("Filter headers for blocks %d to %d from %s do not "+
+			"extend the stored filter header chain -- disconnecting",
+			sm.filterStartHeight, stopHeight, peer.Addr())
+		peer.Disconnect()
+		return
+	}
+	sm.filterHashes = msg.FilterHashes
+
+	log.Debugf("Requesting filters for blocks %d to %d from peer %s",
+		sm.filterStartHeight, stopHeight, peer.Addr())
+	gcfmsg := wire.NewMsgGetCFilters(wire.GCSFilterRegular,
+		uint32(sm.filterStartHeight), sm.filterStopHash)
+	peer.QueueMessage(gcfmsg, nil)
+}
+
+// handleCFilterMsg handles cfilter messages from all peers when in
+// headers-only mode.  Filters must match the filter hashes of the filter
+// headers of their batch, are stored in chain order and the block is
+// requested when the filter matches any of the watched scripts.
+func (sm *SyncManager) handleCFilterMsg(cmsg *cfilterMsg) {
+	peer := cmsg.peer
+	msg := cmsg.cfilter
+	if peer != sm.filterPeer || sm.filterHashes == nil ||
+		msg.FilterType != wire.GCSFilterRegular {
+
+		log.Debugf("Ignoring unrequested filter for block %v from %s",
+			msg.BlockHash, peer)
+		return
+	}
+
+	// Filters must extend the stored filter header chain, so anything not
+	// following the current filter tip is ignored and requested again
+	// with the next batch.
+	height, err := sm.chain.BlockHeightByHash(&msg.BlockHash)
+	if err != nil || height != sm.filterTip()+1 {
+		log.Debugf("Ignoring out of order filter for block %v from %s",
+			msg.BlockHash, peer)
+		sm.finishFilterBatch(peer, &msg.BlockHash)
+		return
+	}
+	header, err := sm.chain.HeaderByHash(&msg.BlockHash)
+	if err != nil {
+		return
+	}
+
+	// The peer committed to the hash of the filter with the filter
+	// headers of the batch.
+	index := height - sm.filterStartHeight
+	if index < 0 || int(index) >= len(sm.filterHashes) ||
+		!sm.filterMatchesHash(msg.Data, sm.filterHashes[index]) {
+
+		log.Warnf("Filter for block %v from %s does not match its "+
+			"filter header -- disconnecting", msg.BlockHash,
+			peer.Addr())
+		peer.Disconnect()
+		return
+	}
+	if err := sm.cfIndex.AddFilter(&header, msg.FilterType, msg.Data); err != nil {
+		log.Warnf("Invalid filter for block %v from %s: %v -- "+
+			"disconnecting", msg.BlockHash, peer.Addr(), err)
+		peer.Disconnect()
+		return
+	}
+	sm.filterHeight = height
+
+	if sm.matchFilter(&msg.BlockHash, msg.Data) &&
+		!sm.chain.HaveBlockData(&msg.BlockHash) {
+
+		state, exists := sm.peerStates[peer]
+		if exists {
+			log.Infof("Filter for block %v (height %d) matches "+
+				"watched scripts, requesting block",
+				msg.BlockHash, height)
+			sm.requestedBlocks[msg.BlockHash] = struct{}{}
+			state.requestedBlocks[msg.BlockHash] = struct{}{}
+			gdmsg := wire.NewMsgGetData()
+			gdmsg.AddInvVect(wire.NewInvVect(wire.InvTypeBlock,
+				&msg.BlockHash))
+			peer.QueueMessage(gdmsg, nil)
+		}
+	}
+
+	sm.finishFilterBatch(peer, &msg.BlockHash)
+}
+
+// finishFilterBatch clears the outstanding filter request once the filter for
+// its stop hash was received and requests the next batch.
+func (sm *SyncManager) finishFilterBatch(peer *peerpkg.Peer, blockHash *chainhash.Hash) {
+	if sm.filterStopHash == nil || !sm.filterStopHash.IsEqual(blockHash) {
+		return
+	}
+	sm.filterStopHash = nil
+	sm.filterHashes = nil
+	sm.filterPeer = nil
+	sm.fetchFilters(peer)
+}
+
+// filterMatchesHash returns whether or not the passed serialized filter is
+// valid and hashes to the passed filter hash.
+func (sm *SyncManager) filterMatchesHash(data []byte, filterHash *chainhash.Hash) bool {
+	filter, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM, data)
+	if err != nil {
+		return false
+	}
+	hash, err := builder.GetFilterHash(filter)
+	return err == nil && hash.IsEqual(filterHash)
+}
+
+// matchFilter returns whether or not the passed serialized regular filter for
+// the block with the passed hash matches any of the watched scripts.
+func (sm *SyncManager) matchFilter(blockHash *chainhash.Hash, data []byte) bool {
+	if len(sm.watchedScripts) == 0 {
+		return false
+	}
+	filter, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM, data)
+	if err != nil || filter.N() == 0 {
+		return false
+	}
+	key := builder.DeriveKey(blockHash)
+	matched, err := filter.MatchAny(key, sm.watchedScripts)
+	if err != nil {
+		log.Warnf("Failed to match filter for block %v: %v", blockHash,
+			err)
+		return false
+	}
+	return matched
+}
+
+// handleHeadersOnlyBlockMsg handles block messages from all peers when in
+// headers-only mode.  Only blocks requested because their filter matched the
+// watched scripts are accepted and they are stored without being connected.
+func (sm *SyncManager) handleHeadersOnlyBlockMsg(bmsg *blockMsg) {
+	peer := bmsg.peer
+	state, exists := sm.peerStates[peer]
+	if !exists {
+		log.Warnf("Received block message from unknown peer %s", peer)
+		return
+	}
+
+	blockHash := bmsg.block.Hash()
+	if _, exists = state.requestedBlocks[*blockHash]; !exists {
+		log.Warnf("Got unrequested block %v from %s -- "+
+			"disconnecting", blockHash, peer.Addr())
+		peer.Disconnect()
+		return
+	}
+	delete(state.requestedBlocks, *blockHash)
+	delete(sm.requestedBlocks, *blockHash)
+
+	if err := sm.chain.StoreBlock(bmsg.block); err != nil {
+		log.Warnf("Failed to store block %v from %s: %v", blockHash,
+			peer.Addr(), err)
+		if _, ok := err.(blockchain.RuleError); ok {
+			peer.Disconnect()
+		}
+		return
+	}
+	log.Infof("Stored watched block %v", blockHash)
+}
+
+// handleHeadersOnlyDonePeer resets the outstanding filter request when the
+// peer serving it disconnects so the filters are requested from another peer.
+func (sm *SyncManager) handleHeadersOnlyDonePeer(peer *peerpkg.Peer) {
+	if peer != sm.filterPeer {
+		return
+	}
+	sm.filterStopHash = nil
+	sm.filterHashes = nil
+	sm.filterPeer = nil
+	for p := range sm.peerStates {
+		sm.fetchFilters(p)
+		if sm.filterPeer != nil {
+			return
+		}
+	}
+}
+
+// QueueCFHeaders adds the passed cfheaders message and peer to the block
+// handling queue.
+func (sm *SyncManager) QueueCFHeaders(cfheaders *wire.MsgCFHeaders, peer *peerpkg.Peer) {
+	// No channel handling here because peers do not need to block on
+	// cfheaders messages.
+	if atomic.LoadInt32(&sm.shutdown) != 0 {
+		return
+	}
+
+	sm.msgChan <- &cfheadersMsg{cfheaders: cfheaders, peer: peer}
+}
+
+// QueueCFilter adds the passed cfilter message and peer to the block handling
+// queue.
+func (sm *SyncManager) QueueCFilter(cfilter *wire.MsgCFilter, peer *peerpkg.Peer) {
+	// No channel handling here because peers do not need to block on
+	// cfilter messages.
+	if atomic.LoadInt32(&sm.shutdown) != 0 {
+		return
+	}
+
+	sm.msgChan <- &cfilterMsg{cfilter: cfilter, peer: peer}
+}
@@ -6,6 +6,7 @@ package netsync
 
 import (
 	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/blockchain/indexers"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/mempool"
@@ -42,4 +43,15 @@ type Config struct {
 	MinSyncPeerNetworkSpeed uint64
 
 	FastSyncMode bool
+
+	// HeadersOnlyMode syncs and validates headers only.  Filters are
+	// fetched from peers and stored in CfIndex, and only the blocks whose
+	// filters match WatchedScripts are downloaded.
+	HeadersOnlyMode bool
+	WatchedScripts  [][]byte
+	CfIndex         *indexers.CfIndex
+
+	// GrapheneBlocks indicates whether or not graphene blocks should be
+	// requested from peers that support them rather than compact blocks.
+	GrapheneBlocks bool
 }
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/classzz/classzz/blockchain"
+	"github.com/classzz/classzz/blockchain/indexers"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/database"
@@ -258,6 +259,23 @@ type SyncManager struct {
 	// signal that it has finished the UTXO set download before proceeding
 	// to make the standard getblocks request.
 	fastSyncMode bool
+
+	// grapheneBlocks indicates whether or not graphene blocks are requested
+	// from peers that support them rather than compact blocks.
+	grapheneBlocks bool
+
+	// The following fields are used for headers-only mode.  Only headers
+	// are synced and the filters for the main chain are fetched in batches
+	// of which at most one is outstanding.  Each batch starts with the
+	// filter headers, whose filter hashes the filters are checked against.
+	headersOnlyMode   bool
+	watchedScripts    [][]byte
+	cfIndex           *indexers.CfIndex
+	filterHeight      int32
+	filterStartHeight int32
+	filterStopHash    *chainhash.Hash
+	filterHashes      []*chainhash.Hash
+	filterPeer        *peerpkg.Peer
 }
 
 // resetHeaderState sets the headers-first mode state to values appropriate for
@@ -392,7 +410,15 @@ func (sm *SyncManager) startSync() {
 		// and fully validate them.  Finally, regression test mode does
 		// not support the headers-first approach so do normal block
 		// downloads when in regression test mode.
-		if sm.nextCheckpoint != nil &&
+		if sm.headersOnlyMode {
+			if err := sm.startHeadersOnlySync(bestPeer); err != nil {
+				log.Infof("Downloading headers from peer %s ,err %s",
+					bestPeer.Addr(), err.Error())
+				return
+			}
+			log.Infof("Downloading headers for blocks from %d from "+
+				"peer %s", best.Height+1, bestPeer.Addr())
+		} else if sm.nextCheckpoint != nil &&
 			best.Height < sm.nextCheckpoint.Height &&
 			sm.chainParams != &chaincfg.RegressionNetParams {
 
@@ -536,6 +562,9 @@ func (sm *SyncManager) handleDonePeerMsg(peer *peerpkg.Peer) {
 
 	// Cleanup state of requested items.
 	sm.clearRequestedState(state)
+	if sm.headersOnlyMode {
+		sm.handleHeadersOnlyDonePeer(peer)
+	}
 
 	// Fetch a new sync peer if this is the sync peer.
 	if peer == sm.syncPeer {
@@ -681,6 +710,11 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		return
 	}
 
+	if sm.headersOnlyMode {
+		sm.handleHeadersOnlyBlockMsg(bmsg)
+		return
+	}
+
 	// If we didn't ask for this block then the peer is misbehaving.
 	blockHash := bmsg.block.Hash()
 	if _, exists = state.requestedBlocks[*blockHash]; !exists {
@@ -959,6 +993,11 @@ func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 		return
 	}
 
+	if sm.headersOnlyMode {
+		sm.handleHeadersOnlyMsg(hmsg)
+		return
+	}
+
 	// The remote peer is misbehaving if we didn't request headers.
 	msg := hmsg.headers
 	numHeaders := len(msg.Headers)
@@ -1152,6 +1191,11 @@ func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
 		return
 	}
 
+	if sm.headersOnlyMode {
+		sm.handleHeadersOnlyInv(peer, imsg.inv.InvList)
+		return
+	}
+
 	// Attempt to find the final block in the inventory list.  There may
 	// not be one.
 	lastBlock := -1
@@ -1291,9 +1335,15 @@ func (sm *SyncManager) handleInvMsg(imsg *invMsg) {
 				sm.limitMap(sm.requestedBlocks, maxRequestedBlocks)
 				state.requestedBlocks[iv.Hash] = struct{}{}
 
-				// Request a compact block if this peer supports it.
+				// Request a graphene block if both we and this
+				// peer support it, otherwise a compact block.
 				if sm.current() {
 					iv.Type = wire.InvTypeCmpctBlock
+					if sm.grapheneBlocks && peer.Services()&
+						wire.SFNodeXthin == wire.SFNodeXthin {
+
+						iv.Type = wire.InvTypeGrapheneBlock
+					}
 				}
 				gdmsg.AddInvVect(iv)
 				numRequested++
@@ -1385,6 +1435,12 @@ out:
 			case *headersMsg:
 				sm.handleHeadersMsg(msg)
 
+			case *cfheadersMsg:
+				sm.handleCFHeadersMsg(msg)
+
+			case *cfilterMsg:
+				sm.handleCFilterMsg(msg)
+
 			case *donePeerMsg:
 				sm.handleDonePeerMsg(msg.peer)
 				if msg.reply != nil {
@@ -1749,10 +1805,18 @@ func New(config *Config) (*SyncManager, error) {
 		feeEstimator:            config.FeeEstimator,
 		minSyncPeerNetworkSpeed: config.MinSyncPeerNetworkSpeed,
 		fastSyncMode:            config.FastSyncMode,
+		grapheneBlocks:          config.GrapheneBlocks,
+		headersOnlyMode:         config.HeadersOnlyMode,
+		watchedScripts:          config.WatchedScripts,
+		cfIndex:                 config.CfIndex,
 	}
 
 	best := sm.chain.BestSnapshot()
-	if !config.DisableCheckpoints {
+	sm.filterHeight = best.Height
+	if sm.headersOnlyMode {
+		log.Infof("Headers-only mode is enabled, watching %d scripts",
+			len(sm.watchedScripts))
+	} else if !config.DisableCheckpoints {
 		// Initialize the next checkpoint based on the current height.
 		sm.nextCheckpoint = sm.findNextHeaderCheckpoint(best.Height)
 		if sm.nextCheckpoint != nil {
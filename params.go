@@ -5,6 +5,8 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/wire"
 )
@@ -60,6 +62,39 @@ var simNetParams = params{
 	gRRPPort: "8665",
 }
 
+// loadNetParams loads the custom network defined by the passed network
+// parameter file and registers it.  Networks which do not define their RPC
+// ports use the ones of the simulation test network since both are meant for
+// private use.
+func loadNetParams(path string) (*params, error) {
+	f, err := chaincfg.LoadNetParamsFile(path)
+	if err != nil {
+		return nil, err
+	}
+	netParams, err := f.Params()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network parameter file %s: %v",
+			path, err)
+	}
+	if err := chaincfg.Register(netParams); err != nil {
+		return nil, fmt.Errorf("unable to register network %s: %v",
+			netParams.Name, err)
+	}
+
+	p := &params{
+		Params:   netParams,
+		rpcPort:  f.RPCPort,
+		gRRPPort: f.GRPCPort,
+	}
+	if p.rpcPort == "" {
+		p.rpcPort = simNetParams.rpcPort
+	}
+	if p.gRRPPort == "" {
+		p.gRRPPort = simNetParams.gRRPPort
+	}
+	return p, nil
+}
+
 // netName returns the name used when referring to a bitcoin network.  At the
 // time of writing, classzz currently places blocks for testnet version 3 in the
 // data and log directory "testnet", which does not match the Name field of the
@@ -95,6 +95,8 @@ func invSummary(invList []*wire.InvVect) string {
 			return fmt.Sprintf("block %s", iv.Hash)
 		case wire.InvTypeCmpctBlock:
 			return fmt.Sprintf("cmpctblock %s", iv.Hash)
+		case wire.InvTypeGrapheneBlock:
+			return fmt.Sprintf("grapheneblock %s", iv.Hash)
 		case wire.InvTypeFilteredBlock:
 			return fmt.Sprintf("filteredblock %s", iv.Hash)
 		case wire.InvTypeTx:
@@ -159,6 +161,9 @@ func messageSummary(msg wire.Message) string {
 	case *wire.MsgAddr:
 		return fmt.Sprintf("%d addr", len(msg.AddrList))
 
+	case *wire.MsgAddrV2:
+		return fmt.Sprintf("%d addr", len(msg.AddrList))
+
 	case *wire.MsgPing:
 		// No summary - perhaps add nonce.
 
@@ -215,12 +220,27 @@ func messageSummary(msg wire.Message) string {
 		return fmt.Sprintf("hash %s, ver %d, %d shortIDs, %d prefilledTxs, %s", msg.BlockHash(),
 			header.Version, len(msg.ShortIDs), len(msg.PrefilledTxs), header.Timestamp)
 
+	case *wire.MsgGrapheneBlock:
+		header := &msg.Header
+		return fmt.Sprintf("hash %s, ver %d, %d txs, %d prefilledTxs, %s", msg.BlockHash(),
+			header.Version, msg.TxCount, len(msg.PrefilledTxs), header.Timestamp)
+
 	case *wire.MsgGetBlockTxns:
 		return fmt.Sprintf("indexes %d", len(msg.Indexes))
 
 	case *wire.MsgBlockTxns:
 		return fmt.Sprintf("txs %d", len(msg.Txs))
 
+	case *wire.MsgReqRecon:
+		return fmt.Sprintf("set size %d, q %d", msg.SetSize, msg.Q)
+
+	case *wire.MsgSketch:
+		return fmt.Sprintf("capacity %d", len(msg.Sketch)/4)
+
+	case *wire.MsgReconcilDiff:
+		return fmt.Sprintf("success %v, %d shortIDs", msg.Success,
+			len(msg.AskShortIDs))
+
 	case *wire.MsgReject:
 		// Ensure the variable length strings don't contain any
 		// characters which are even remotely dangerous such as HTML
@@ -0,0 +1,223 @@
+package peer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+)
+
+const (
+	// maxPendingDataRequests is the maximum number of outstanding block and
+	// transaction requests tracked per peer to measure relay latencies.
+	maxPendingDataRequests = 5000
+
+	// pendingDataTimeout is the duration after which an unanswered block or
+	// transaction request is no longer tracked.
+	pendingDataTimeout = 2 * time.Minute
+)
+
+// LatencyBuckets are the inclusive upper bounds of the buckets of a
+// LatencyHistogram.  Latencies beyond the last bound are counted in an
+// additional overflow bucket.
+var LatencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// MsgStats holds the number of messages of a single command and their total
+// size in bytes including the message header.
+type MsgStats struct {
+	Count uint64
+	Bytes uint64
+}
+
+// LatencyHistogram counts the latencies between requesting data from a peer
+// and receiving it.  Buckets holds one counter per bound in LatencyBuckets
+// followed by the overflow bucket.
+type LatencyHistogram struct {
+	Buckets []uint64
+	Count   uint64
+	Total   time.Duration
+}
+
+// observe adds the passed latency to the histogram.
+func (h *LatencyHistogram) observe(latency time.Duration) {
+	if h.Buckets == nil {
+		h.Buckets = make([]uint64, len(LatencyBuckets)+1)
+	}
+	i := 0
+	for i < len(LatencyBuckets) && latency > LatencyBuckets[i] {
+		i++
+	}
+	h.Buckets[i]++
+	h.Count++
+	h.Total += latency
+}
+
+// Mean returns the mean latency of the histogram.
+func (h *LatencyHistogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Total / time.Duration(h.Count)
+}
+
+// copy returns a deep copy of the histogram.
+func (h *LatencyHistogram) copy() LatencyHistogram {
+	c := *h
+	c.Buckets = make([]uint64, len(LatencyBuckets)+1)
+	copy(c.Buckets, h.Buckets)
+	return c
+}
+
+// pendingRequest is a block or transaction requested from the peer.
+type pendingRequest struct {
+	requested time.Time
+	block     bool
+}
+
+// msgStats tracks per-command message counters in both directions and the
+// latencies of block and transaction requests for a peer.
+type msgStats struct {
+	mtx          sync.Mutex
+	sent         map[string]*MsgStats
+	recv         map[string]*MsgStats
+	pending      map[chainhash.Hash]pendingRequest
+	blockLatency LatencyHistogram
+	txLatency    LatencyHistogram
+}
+
+// newMsgStats returns a new empty set of message statistics.
+func newMsgStats() *msgStats {
+	return &msgStats{
+		sent:    make(map[string]*MsgStats),
+		recv:    make(map[string]*MsgStats),
+		pending: make(map[chainhash.Hash]pendingRequest),
+	}
+}
+
+// addMsgStats increments the counters of the passed command.
+func addMsgStats(stats map[string]*MsgStats, command string, n int) {
+	s, ok := stats[command]
+	if !ok {
+		s = &MsgStats{}
+		stats[command] = s
+	}
+	s.Count++
+	s.Bytes += uint64(n)
+}
+
+// recordSent accounts the passed message of n bytes sent to the peer and
+// starts measuring the latency of the blocks and transactions it requests.
+func (ms *msgStats) recordSent(msg wire.Message, n int, now time.Time) {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	addMsgStats(ms.sent, msg.Command(), n)
+
+	getData, ok := msg.(*wire.MsgGetData)
+	if !ok {
+		return
+	}
+	for _, iv := range getData.InvList {
+		var block bool
+		switch iv.Type {
+		case wire.InvTypeTx:
+		case wire.InvTypeBlock, wire.InvTypeFilteredBlock,
+			wire.InvTypeCmpctBlock, wire.InvTypeGrapheneBlock:
+			block = true
+		default:
+			continue
+		}
+		if len(ms.pending) >= maxPendingDataRequests {
+			ms.expirePending(now)
+			if len(ms.pending) >= maxPendingDataRequests {
+				return
+			}
+		}
+		if _, ok := ms.pending[iv.Hash]; !ok {
+			ms.pending[iv.Hash] = pendingRequest{
+				requested: now,
+				block:     block,
+			}
+		}
+	}
+}
+
+// expirePending removes the requests the peer did not answer in time.
+//
+// This function MUST be called with the mutex held.
+func (ms *msgStats) expirePending(now time.Time) {
+	for hash, req := range ms.pending {
+		if now.Sub(req.requested) > pendingDataTimeout {
+			delete(ms.pending, hash)
+		}
+	}
+}
+
+// recordRecv accounts the passed message of n bytes received from the peer
+// and completes the latency measurement of the requested block or transaction
+// it carries.
+func (ms *msgStats) recordRecv(msg wire.Message, n int, now time.Time) {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	addMsgStats(ms.recv, msg.Command(), n)
+
+	var hash chainhash.Hash
+	switch m := msg.(type) {
+	case *wire.MsgTx:
+		hash = m.TxHash()
+	case *wire.MsgBlock:
+		hash = m.BlockHash()
+	case *wire.MsgMerkleBlock:
+		hash = m.Header.BlockHash()
+	case *wire.MsgCmpctBlock:
+		hash = m.BlockHash()
+	case *wire.MsgGrapheneBlock:
+		hash = m.BlockHash()
+	case *wire.MsgNotFound:
+		for _, iv := range m.InvList {
+			delete(ms.pending, iv.Hash)
+		}
+		return
+	default:
+		return
+	}
+
+	req, ok := ms.pending[hash]
+	if !ok {
+		return
+	}
+	delete(ms.pending, hash)
+	if req.block {
+		ms.blockLatency.observe(now.Sub(req.requested))
+	} else {
+		ms.txLatency.observe(now.Sub(req.requested))
+	}
+}
+
+// snapshot returns copies of the message counters and latency histograms.
+func (ms *msgStats) snapshot() (sent, recv map[string]MsgStats, blockLatency, txLatency LatencyHistogram) {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+
+	sent = make(map[string]MsgStats, len(ms.sent))
+	for command, s := range ms.sent {
+		sent[command] = *s
+	}
+	recv = make(map[string]MsgStats, len(ms.recv))
+	for command, s := range ms.recv {
+		recv[command] = *s
+	}
+	return sent, recv, ms.blockLatency.copy(), ms.txLatency.copy()
+}
@@ -0,0 +1,79 @@
+package peer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+)
+
+// TestMsgStats ensures messages are accounted per command and direction and
+// the latencies of requested blocks and transactions are measured.
+func TestMsgStats(t *testing.T) {
+	ms := newMsgStats()
+	now := time.Now()
+
+	tx := wire.NewMsgTx(1)
+	txHash := tx.TxHash()
+	blockHash := chainhash.Hash{0x01}
+	getData := wire.NewMsgGetData()
+	getData.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &txHash))
+	getData.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, &blockHash))
+
+	ms.recordSent(wire.NewMsgPing(1), 32, now)
+	ms.recordSent(wire.NewMsgPing(2), 32, now)
+	ms.recordSent(getData, 97, now)
+	ms.recordRecv(tx, 60, now.Add(30*time.Millisecond))
+	notFound := wire.NewMsgNotFound()
+	notFound.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, &blockHash))
+	ms.recordRecv(notFound, 61, now.Add(time.Second))
+
+	// A transaction that was not requested must not be measured.
+	ms.recordRecv(tx, 60, now.Add(time.Second))
+
+	sent, recv, blockLatency, txLatency := ms.snapshot()
+	if got := sent[wire.CmdPing]; got != (MsgStats{Count: 2, Bytes: 64}) {
+		t.Errorf("unexpected ping stats %+v", got)
+	}
+	if got := sent[wire.CmdGetData]; got != (MsgStats{Count: 1, Bytes: 97}) {
+		t.Errorf("unexpected getdata stats %+v", got)
+	}
+	if got := recv[wire.CmdTx]; got != (MsgStats{Count: 2, Bytes: 120}) {
+		t.Errorf("unexpected tx stats %+v", got)
+	}
+	if _, ok := recv[wire.CmdPing]; ok {
+		t.Error("ping accounted as received")
+	}
+
+	if txLatency.Count != 1 || txLatency.Buckets[1] != 1 ||
+		txLatency.Mean() != 30*time.Millisecond {
+		t.Errorf("unexpected tx latency %+v", txLatency)
+	}
+	if blockLatency.Count != 0 || len(ms.pending) != 0 {
+		t.Errorf("unexpected block latency %+v", blockLatency)
+	}
+}
+
+// TestLatencyHistogram ensures latencies are counted in the right buckets.
+func TestLatencyHistogram(t *testing.T) {
+	var h LatencyHistogram
+	h.observe(0)
+	h.observe(10 * time.Millisecond)
+	h.observe(11 * time.Millisecond)
+	h.observe(time.Hour)
+
+	want := make([]uint64, len(LatencyBuckets)+1)
+	want[0] = 2
+	want[1] = 1
+	want[len(LatencyBuckets)] = 1
+	for i := range want {
+		if h.Buckets[i] != want[i] {
+			t.Fatalf("bucket %d: got %d, want %d", i, h.Buckets[i],
+				want[i])
+		}
+	}
+	if h.Count != 4 {
+		t.Fatalf("got count %d, want 4", h.Count)
+	}
+}
@@ -98,6 +98,9 @@ type MessageListeners struct {
 	// OnAddr is invoked when a peer receives an addr bitcoin message.
 	OnAddr func(p *Peer, msg *wire.MsgAddr)
 
+	// OnAddrV2 is invoked when a peer receives an addrv2 bitcoin message.
+	OnAddrV2 func(p *Peer, msg *wire.MsgAddrV2)
+
 	// OnPing is invoked when a peer receives a ping bitcoin message.
 	OnPing func(p *Peer, msg *wire.MsgPing)
 
@@ -197,6 +200,10 @@ type MessageListeners struct {
 	// message.
 	OnSendHeaders func(p *Peer, msg *wire.MsgSendHeaders)
 
+	// OnSendAddrV2 is invoked when a peer receives a sendaddrv2 bitcoin
+	// message.
+	OnSendAddrV2 func(p *Peer, msg *wire.MsgSendAddrV2)
+
 	// OnSendCmpct is invoked when a peer receives a sendcmpct bitcoin
 	// message.
 	OnSendCmpct func(p *Peer, msg *wire.MsgSendCmpct)
@@ -205,10 +212,24 @@ type MessageListeners struct {
 	// message.
 	OnCmpctBlock func(p *Peer, msg *wire.MsgCmpctBlock)
 
+	// OnGrapheneBlock is invoked when a peer receives a grblk bitcoin
+	// message.
+	OnGrapheneBlock func(p *Peer, msg *wire.MsgGrapheneBlock)
+
 	// OnGetBlockTxns is invoked when a peer receives a getblocktxn bitcoin
 	// message.
 	OnGetBlockTxns func(p *Peer, msg *wire.MsgGetBlockTxns)
 
+	// OnReqRecon is invoked when a peer receives a reqrecon bitcoin message.
+	OnReqRecon func(p *Peer, msg *wire.MsgReqRecon)
+
+	// OnSketch is invoked when a peer receives a sketch bitcoin message.
+	OnSketch func(p *Peer, msg *wire.MsgSketch)
+
+	// OnReconcilDiff is invoked when a peer receives a reconcildiff bitcoin
+	// message.
+	OnReconcilDiff func(p *Peer, msg *wire.MsgReconcilDiff)
+
 	// OnBlockTxns is invoked when a peer receives a blocktxns bitcoin
 	// message.
 	OnBlockTxns func(p *Peer, msg *wire.MsgBlockTxns)
@@ -405,6 +426,10 @@ type StatsSnap struct {
 	LastPingTime   time.Time
 	LastPingMicros int64
 	SyncPeer       bool
+	MsgsSent       map[string]MsgStats
+	MsgsRecv       map[string]MsgStats
+	BlockLatency   LatencyHistogram
+	TxLatency      LatencyHistogram
 }
 
 // HashFunc is a function which returns a block hash, height and error
@@ -471,6 +496,7 @@ type Peer struct {
 	advertisedProtoVer   uint32 // protocol version advertised by remote
 	protocolVersion      uint32 // negotiated protocol version
 	sendHeadersPreferred bool   // peer sent a sendheaders message
+	addrV2Preferred      bool   // peer sent a sendaddrv2 message
 	verAckReceived       bool
 	xVersionReceived     bool
 	syncPeer             bool
@@ -497,6 +523,10 @@ type Peer struct {
 	lastPingTime       time.Time // Time we sent last ping.
 	lastPingMicros     int64     // Time for last ping to return.
 
+	// msgStats tracks the per-command message statistics and relay
+	// latencies.  It is protected by its own mutex.
+	msgStats *msgStats
+
 	stallControl  chan stallControlMsg
 	outputQueue   chan outMsg
 	sendQueue     chan outMsg
@@ -605,6 +635,8 @@ func (p *Peer) StatsSnapshot() *StatsSnap {
 	protocolVersion := p.advertisedProtoVer
 	p.flagsMtx.Unlock()
 
+	msgsSent, msgsRecv, blockLatency, txLatency := p.msgStats.snapshot()
+
 	// Get a copy of all relevant flags and stats.
 	statsSnap := &StatsSnap{
 		ID:             id,
@@ -625,6 +657,10 @@ func (p *Peer) StatsSnapshot() *StatsSnap {
 		LastPingMicros: p.lastPingMicros,
 		LastPingTime:   p.lastPingTime,
 		SyncPeer:       p.SyncPeer(),
+		MsgsSent:       msgsSent,
+		MsgsRecv:       msgsRecv,
+		BlockLatency:   blockLatency,
+		TxLatency:      txLatency,
 	}
 
 	p.statsMtx.RUnlock()
@@ -889,6 +925,18 @@ func (p *Peer) WantsHeaders() bool {
 	return sendHeadersPreferred
 }
 
+// WantsAddrV2 returns if the peer wants addresses relayed with addrv2 messages
+// instead of addr messages.
+//
+// This function is safe for concurrent access.
+func (p *Peer) WantsAddrV2() bool {
+	p.flagsMtx.Lock()
+	addrV2Preferred := p.addrV2Preferred
+	p.flagsMtx.Unlock()
+
+	return addrV2Preferred
+}
+
 // WantsCompactBlocks returns if the peer wants header cmpctblocks instead of
 // regular blocks.
 //
@@ -917,36 +965,50 @@ func (p *Peer) WantsDirectBlockRelay() bool {
 // addresses.  This function is useful over manually sending the message via
 // QueueMessage since it automatically limits the addresses to the maximum
 // number allowed by the message and randomizes the chosen addresses when there
-// are too many.  It returns the addresses that were actually sent and no
-// message will be sent if there are no entries in the provided addresses slice.
+// are too many.  An addrv2 message is sent instead when the peer asked for it,
+// otherwise addresses which can only be relayed with addrv2 are skipped.  It
+// returns the addresses that were actually sent and no message will be sent if
+// there are no entries in the provided addresses slice.
 //
 // This function is safe for concurrent access.
 func (p *Peer) PushAddrMsg(addresses []*wire.NetAddress) ([]*wire.NetAddress, error) {
-	addressCount := len(addresses)
+	addrV2 := p.WantsAddrV2()
+	addrList := make([]*wire.NetAddress, 0, len(addresses))
+	for _, na := range addresses {
+		if !addrV2 && na.NeedsAddrV2() {
+			continue
+		}
+		addrList = append(addrList, na)
+	}
+	addressCount := len(addrList)
 
 	// Nothing to send.
 	if addressCount == 0 {
 		return nil, nil
 	}
 
-	msg := wire.NewMsgAddr()
-	msg.AddrList = make([]*wire.NetAddress, addressCount)
-	copy(msg.AddrList, addresses)
-
 	// Randomize the addresses sent if there are more than the maximum allowed.
 	if addressCount > wire.MaxAddrPerMsg {
 		// Shuffle the address list.
 		for i := 0; i < wire.MaxAddrPerMsg; i++ {
 			j := i + rand.Intn(addressCount-i)
-			msg.AddrList[i], msg.AddrList[j] = msg.AddrList[j], msg.AddrList[i]
+			addrList[i], addrList[j] = addrList[j], addrList[i]
 		}
 
 		// Truncate it to the maximum size.
-		msg.AddrList = msg.AddrList[:wire.MaxAddrPerMsg]
+		addrList = addrList[:wire.MaxAddrPerMsg]
 	}
 
-	p.QueueMessage(msg, nil)
-	return msg.AddrList, nil
+	if addrV2 {
+		msg := wire.NewMsgAddrV2()
+		msg.AddrList = addrList
+		p.QueueMessage(msg, nil)
+	} else {
+		msg := wire.NewMsgAddr()
+		msg.AddrList = addrList
+		p.QueueMessage(msg, nil)
+	}
+	return addrList, nil
 }
 
 // PushGetBlocksMsg sends a getblocks message for the provided block locator
@@ -1112,6 +1174,7 @@ func (p *Peer) readMessage(encoding wire.MessageEncoding) (wire.Message, []byte,
 	if err != nil {
 		return nil, nil, err
 	}
+	p.msgStats.recordRecv(msg, n, time.Now())
 
 	// Use closures to log expensive operations so they are only run when
 	// the logging level requires it.
@@ -1172,6 +1235,9 @@ func (p *Peer) writeMessage(msg wire.Message, enc wire.MessageEncoding) error {
 	if p.cfg.Listeners.OnWrite != nil {
 		p.cfg.Listeners.OnWrite(p, n, msg, err)
 	}
+	if err == nil {
+		p.msgStats.recordSent(msg, n, time.Now())
+	}
 	return err
 }
 
@@ -1258,9 +1324,11 @@ func (p *Peer) maybeAddDeadline(pendingResponses map[string]time.Time, msgCmd st
 		pendingResponses[wire.CmdBlockTxns] = deadline
 
 	case wire.CmdGetData:
-		// Expects a block, cmpctblock, merkleblock, tx, or notfound message.
+		// Expects a block, cmpctblock, grblk, merkleblock, tx, or
+		// notfound message.
 		pendingResponses[wire.CmdBlock] = deadline
 		pendingResponses[wire.CmdCmpctBlock] = deadline
+		pendingResponses[wire.CmdGrapheneBlock] = deadline
 		pendingResponses[wire.CmdMerkleBlock] = deadline
 		pendingResponses[wire.CmdTx] = deadline
 		pendingResponses[wire.CmdNotFound] = deadline
@@ -1320,6 +1388,8 @@ out:
 					fallthrough
 				case wire.CmdCmpctBlock:
 					fallthrough
+				case wire.CmdGrapheneBlock:
+					fallthrough
 				case wire.CmdMerkleBlock:
 					fallthrough
 				case wire.CmdTx:
@@ -1327,6 +1397,7 @@ out:
 				case wire.CmdNotFound:
 					delete(pendingResponses, wire.CmdBlock)
 					delete(pendingResponses, wire.CmdCmpctBlock)
+					delete(pendingResponses, wire.CmdGrapheneBlock)
 					delete(pendingResponses, wire.CmdMerkleBlock)
 					delete(pendingResponses, wire.CmdTx)
 					delete(pendingResponses, wire.CmdNotFound)
@@ -1530,6 +1601,26 @@ out:
 				p.cfg.Listeners.OnAddr(p, msg)
 			}
 
+		case *wire.MsgAddrV2:
+			if p.cfg.Listeners.OnAddrV2 != nil {
+				p.cfg.Listeners.OnAddrV2(p, msg)
+			}
+
+		case *wire.MsgSendAddrV2:
+			// The sendaddrv2 message must be sent before verack.
+			if p.verAckReceived {
+				log.Infof("Received 'sendaddrv2' after 'verack' from "+
+					"peer %v -- disconnecting", p)
+				break out
+			}
+			p.flagsMtx.Lock()
+			p.addrV2Preferred = true
+			p.flagsMtx.Unlock()
+
+			if p.cfg.Listeners.OnSendAddrV2 != nil {
+				p.cfg.Listeners.OnSendAddrV2(p, msg)
+			}
+
 		case *wire.MsgPing:
 			p.handlePingMsg(msg)
 			if p.cfg.Listeners.OnPing != nil {
@@ -1672,6 +1763,11 @@ out:
 				p.cfg.Listeners.OnCmpctBlock(p, msg)
 			}
 
+		case *wire.MsgGrapheneBlock:
+			if p.cfg.Listeners.OnGrapheneBlock != nil {
+				p.cfg.Listeners.OnGrapheneBlock(p, msg)
+			}
+
 		case *wire.MsgGetBlockTxns:
 			if p.cfg.Listeners.OnGetBlockTxns != nil {
 				p.cfg.Listeners.OnGetBlockTxns(p, msg)
@@ -1682,6 +1778,21 @@ out:
 				p.cfg.Listeners.OnBlockTxns(p, msg)
 			}
 
+		case *wire.MsgReqRecon:
+			if p.cfg.Listeners.OnReqRecon != nil {
+				p.cfg.Listeners.OnReqRecon(p, msg)
+			}
+
+		case *wire.MsgSketch:
+			if p.cfg.Listeners.OnSketch != nil {
+				p.cfg.Listeners.OnSketch(p, msg)
+			}
+
+		case *wire.MsgReconcilDiff:
+			if p.cfg.Listeners.OnReconcilDiff != nil {
+				p.cfg.Listeners.OnReconcilDiff(p, msg)
+			}
+
 		default:
 			log.Debugf("Received unhandled message of type %v "+
 				"from %v", rmsg.Command(), p)
@@ -2251,6 +2362,12 @@ func (p *Peer) start() error {
 	go p.outHandler()
 	go p.pingHandler()
 
+	// Ask for addresses to be relayed with addrv2 messages before sending
+	// our verack message as required by BIP0155.
+	if p.ProtocolVersion() >= wire.AddrV2Version {
+		p.QueueMessage(wire.NewMsgSendAddrV2(), nil)
+	}
+
 	// Send our verack message now that the IO processing machinery has started.
 	p.QueueMessage(wire.NewMsgVerAck(), nil)
 	return nil
@@ -2328,6 +2445,7 @@ func newPeerBase(origCfg *Config, inbound bool) *Peer {
 		inbound:         inbound,
 		wireEncoding:    wire.BaseEncoding,
 		knownInventory:  newMruInventoryMap(cfg.MaxKnownInventory),
+		msgStats:        newMsgStats(),
 		stallControl:    make(chan stallControlMsg, 1), // nonblocking sync
 		outputQueue:     make(chan outMsg, outputBufferSize),
 		sendQueue:       make(chan outMsg, 1),   // nonblocking sync
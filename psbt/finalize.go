@@ -0,0 +1,360 @@
+package psbt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// defaultSighashType is the signature hash type used for inputs which do not
+// request one.
+const defaultSighashType = txscript.SigHashAll | txscript.SigHashForkID
+
+// SignScript returns the script the signatures of the input commit to, which
+// is the redeem script for pay-to-script-hash outputs and the public key
+// script of the spent output otherwise.
+func (p *Packet) SignScript(idx int) ([]byte, error) {
+	if idx < 0 || idx >= len(p.Inputs) {
+		return nil, fmt.Errorf("input %d out of range", idx)
+	}
+	pi := &p.Inputs[idx]
+	if pi.Utxo == nil {
+		return nil, fmt.Errorf("%w: input %d has no utxo",
+			ErrNotFinalizable, idx)
+	}
+	if !txscript.IsPayToScriptHash(pi.Utxo.PkScript) {
+		return pi.Utxo.PkScript, nil
+	}
+
+	if pi.RedeemScript == nil {
+		return nil, fmt.Errorf("%w: input %d has no redeem script",
+			ErrNotFinalizable, idx)
+	}
+	// The script hash is pushed between OP_HASH160 and OP_EQUAL.
+	if !bytes.Equal(czzutil.Hash160(pi.RedeemScript), pi.Utxo.PkScript[2:22]) {
+		return nil, fmt.Errorf("%w: redeem script of input %d does not "+
+			"match its utxo", ErrInvalidPacket, idx)
+	}
+	return pi.RedeemScript, nil
+}
+
+// SighashType returns the signature hash type of the input.
+func (p *Packet) SighashType(idx int) txscript.SigHashType {
+	if t := p.Inputs[idx].SighashType; t != 0 {
+		return t | txscript.SigHashForkID
+	}
+	return defaultSighashType
+}
+
+// SigHash returns the signature hash the signers of the input must sign.
+func (p *Packet) SigHash(idx int) ([]byte, error) {
+	script, err := p.SignScript(idx)
+	if err != nil {
+		return nil, err
+	}
+	sigHashes := txscript.NewTxSigHashes(p.UnsignedTx)
+	return txscript.CalcSignatureHash(script, sigHashes, p.SighashType(idx),
+		p.UnsignedTx, idx, p.Inputs[idx].Utxo.Value, true)
+}
+
+// AddPartialSig adds the signature of the input made by the private key of
+// pubKey.  The signature, which has the hash type appended, is verified before
+// it is added.
+func (p *Packet) AddPartialSig(idx int, pubKey, sig []byte) error {
+	hash, err := p.SigHash(idx)
+	if err != nil {
+		return err
+	}
+	if len(sig) < 1 || txscript.SigHashType(sig[len(sig)-1]) != p.SighashType(idx) {
+		return fmt.Errorf("signature of input %d has the wrong hash type", idx)
+	}
+	key, err := czzec.ParsePubKey(pubKey, czzec.S256())
+	if err != nil {
+		return err
+	}
+	var signature *czzec.Signature
+	if len(sig)-1 == 64 {
+		signature, err = czzec.ParseSchnorrSignature(sig[:len(sig)-1])
+	} else {
+		signature, err = czzec.ParseDERSignature(sig[:len(sig)-1], czzec.S256())
+	}
+	if err != nil {
+		return err
+	}
+	if !signature.Verify(hash, key) {
+		return fmt.Errorf("invalid signature for input %d", idx)
+	}
+
+	pi := &p.Inputs[idx]
+	if pi.FinalScriptSig != nil {
+		return fmt.Errorf("input %d is already finalized", idx)
+	}
+	for _, ps := range pi.PartialSigs {
+		if bytes.Equal(ps.PubKey, pubKey) {
+			ps.Signature = sig
+			return nil
+		}
+	}
+	pi.PartialSigs = append(pi.PartialSigs, &PartialSig{
+		PubKey:    pubKey,
+		Signature: sig,
+	})
+	pi.sortPartialSigs()
+	return nil
+}
+
+// SignInput signs the input with the passed private key and adds the
+// signature.  Multisig scripts only accept ECDSA signatures, so these are
+// made for them while Schnorr signatures are made for all other scripts.
+func (p *Packet) SignInput(idx int, key *czzec.PrivateKey) error {
+	script, err := p.SignScript(idx)
+	if err != nil {
+		return err
+	}
+	hash, err := p.SigHash(idx)
+	if err != nil {
+		return err
+	}
+
+	var sig *czzec.Signature
+	if txscript.GetScriptClass(script) == txscript.MultiSigTy {
+		sig, err = key.SignECDSA(hash)
+	} else {
+		sig, err = key.SignSchnorr(hash)
+	}
+	if err != nil {
+		return err
+	}
+	return p.AddPartialSig(idx, key.PubKey().SerializeCompressed(),
+		append(sig.Serialize(), byte(p.SighashType(idx))))
+}
+
+// partialSig returns the signature made by the passed public key.
+func (pi *PInput) partialSig(pubKey []byte) []byte {
+	for _, ps := range pi.PartialSigs {
+		if bytes.Equal(ps.PubKey, pubKey) {
+			return ps.Signature
+		}
+	}
+	return nil
+}
+
+// FinalizeInput builds the signature script of the input from its partial
+// signatures and verifies it.  Pay-to-pubkey, pay-to-pubkey-hash and multisig
+// scripts are supported, either bare or as redeem scripts.
+func (p *Packet) FinalizeInput(idx int) error {
+	pi := &p.Inputs[idx]
+	if pi.FinalScriptSig != nil {
+		return nil
+	}
+	script, err := p.SignScript(idx)
+	if err != nil {
+		return err
+	}
+
+	builder := txscript.NewScriptBuilder()
+	switch txscript.GetScriptClass(script) {
+	case txscript.PubKeyTy:
+		pushes, err := txscript.PushedData(script)
+		if err != nil {
+			return err
+		}
+		sig := pi.partialSig(pushes[0])
+		if sig == nil {
+			return fmt.Errorf("%w: input %d is not signed",
+				ErrNotFinalizable, idx)
+		}
+		builder.AddData(sig)
+
+	case txscript.PubKeyHashTy:
+		pushes, err := txscript.PushedData(script)
+		if err != nil {
+			return err
+		}
+		var found bool
+		for _, ps := range pi.PartialSigs {
+			if bytes.Equal(czzutil.Hash160(ps.PubKey), pushes[0]) {
+				builder.AddData(ps.Signature).AddData(ps.PubKey)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: input %d is not signed",
+				ErrNotFinalizable, idx)
+		}
+
+	case txscript.MultiSigTy:
+		_, nRequired, err := txscript.CalcMultiSigStats(script)
+		if err != nil {
+			return err
+		}
+		pubKeys, err := txscript.PushedData(script)
+		if err != nil {
+			return err
+		}
+
+		// The extra OP_FALSE is consumed by OP_CHECKMULTISIG and the
+		// signatures must be in the order of the public keys.
+		builder.AddOp(txscript.OP_FALSE)
+		signed := 0
+		for _, pubKey := range pubKeys {
+			if sig := pi.partialSig(pubKey); sig != nil {
+				builder.AddData(sig)
+				signed++
+				if signed == nRequired {
+					break
+				}
+			}
+		}
+		if signed < nRequired {
+			return fmt.Errorf("%w: input %d has %d of %d signatures",
+				ErrNotFinalizable, idx, signed, nRequired)
+		}
+
+	default:
+		return fmt.Errorf("%w: unsupported script of input %d",
+			ErrNotFinalizable, idx)
+	}
+	if txscript.IsPayToScriptHash(pi.Utxo.PkScript) {
+		builder.AddData(pi.RedeemScript)
+	}
+	sigScript, err := builder.Script()
+	if err != nil {
+		return err
+	}
+
+	// Execute the script to make sure the input is valid before the data
+	// needed to rebuild it is dropped.
+	tx := p.UnsignedTx.Copy()
+	tx.TxIn[idx].SignatureScript = sigScript
+	vm, err := txscript.NewEngine(pi.Utxo.PkScript, tx, idx,
+		txscript.StandardVerifyFlags, nil, nil, pi.Utxo.Value)
+	if err != nil {
+		return err
+	}
+	if err := vm.Execute(); err != nil {
+		return fmt.Errorf("input %d: %v", idx, err)
+	}
+
+	pi.FinalScriptSig = sigScript
+	pi.PartialSigs = nil
+	pi.SighashType = 0
+	pi.RedeemScript = nil
+	return nil
+}
+
+// Finalize finalizes all inputs which can be and returns whether or not the
+// packet is complete.
+func (p *Packet) Finalize() (bool, error) {
+	for idx := range p.Inputs {
+		err := p.FinalizeInput(idx)
+		if err != nil && !errors.Is(err, ErrNotFinalizable) {
+			return false, err
+		}
+	}
+	return p.IsComplete(), nil
+}
+
+// IsComplete returns whether or not all inputs of the packet are finalized.
+func (p *Packet) IsComplete() bool {
+	for i := range p.Inputs {
+		if p.Inputs[i].FinalScriptSig == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Extract returns the signed transaction of a complete packet.
+func (p *Packet) Extract() (*wire.MsgTx, error) {
+	if !p.IsComplete() {
+		return nil, ErrIncomplete
+	}
+	tx := p.UnsignedTx.Copy()
+	for i := range tx.TxIn {
+		tx.TxIn[i].SignatureScript = p.Inputs[i].FinalScriptSig
+	}
+	return tx, nil
+}
+
+// Combine returns a packet holding the data of all passed packets, which must
+// be for the same transaction.
+func Combine(packets ...*Packet) (*Packet, error) {
+	if len(packets) == 0 {
+		return nil, fmt.Errorf("no packets to combine")
+	}
+	txHash := packets[0].UnsignedTx.TxHash()
+	combined, err := New(packets[0].UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range packets {
+		if p.UnsignedTx.TxHash() != txHash {
+			return nil, fmt.Errorf("packets are for different " +
+				"transactions")
+		}
+		combined.Unknowns = mergeUnknowns(combined.Unknowns, p.Unknowns)
+
+		for i := range p.Inputs {
+			in, c := &p.Inputs[i], &combined.Inputs[i]
+			if c.Utxo == nil {
+				c.Utxo = in.Utxo
+			}
+			if c.SighashType == 0 {
+				c.SighashType = in.SighashType
+			}
+			if c.RedeemScript == nil {
+				c.RedeemScript = in.RedeemScript
+			}
+			if c.FinalScriptSig == nil {
+				c.FinalScriptSig = in.FinalScriptSig
+			}
+			for _, ps := range in.PartialSigs {
+				if c.partialSig(ps.PubKey) == nil {
+					c.PartialSigs = append(c.PartialSigs, ps)
+				}
+			}
+			c.Unknowns = mergeUnknowns(c.Unknowns, in.Unknowns)
+		}
+		for i := range p.Outputs {
+			out, c := &p.Outputs[i], &combined.Outputs[i]
+			if c.RedeemScript == nil {
+				c.RedeemScript = out.RedeemScript
+			}
+			c.Unknowns = mergeUnknowns(c.Unknowns, out.Unknowns)
+		}
+	}
+
+	// A finalized input does not need the signatures of the others.
+	for i := range combined.Inputs {
+		c := &combined.Inputs[i]
+		if c.FinalScriptSig != nil {
+			c.PartialSigs = nil
+			c.SighashType = 0
+			c.RedeemScript = nil
+		}
+		c.sortPartialSigs()
+	}
+	return combined, nil
+}
+
+// mergeUnknowns appends the unknown pairs of b whose keys are not in a.
+func mergeUnknowns(a, b []*Unknown) []*Unknown {
+next:
+	for _, u := range b {
+		for _, have := range a {
+			if bytes.Equal(have.Key, u.Key) {
+				continue next
+			}
+		}
+		a = append(a, u)
+	}
+	return a
+}
@@ -0,0 +1,498 @@
+// Package psbt implements a container for partially signed classzz
+// transactions modeled after BIP0174.  It carries the data needed to sign the
+// inputs of a transaction, which are the amounts and scripts of the spent
+// outputs along with redeem scripts, and collects the signatures of several
+// parties until the transaction can be finalized.
+//
+// The signature hashes of classzz commit to the amounts of the spent outputs,
+// so unlike BIP0174 the full previous transactions are never needed.
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+)
+
+// magic is the separator prefixing serialized packets.
+var magic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+const (
+	// maxKeyLength is the maximum length of the key of a serialized key
+	// value pair.
+	maxKeyLength = 10000
+
+	// maxValueLength is the maximum length of the value of a serialized key
+	// value pair.
+	maxValueLength = 4000000
+
+	// pver is the protocol version used to serialize the transaction and
+	// the compact sizes of a packet.
+	pver = 0
+)
+
+// Types of the global key value pairs.
+const (
+	globalUnsignedTx = 0x00
+)
+
+// Types of the key value pairs of the inputs.
+const (
+	inputUtxo           = 0x01
+	inputPartialSig     = 0x02
+	inputSighashType    = 0x03
+	inputRedeemScript   = 0x04
+	inputFinalScriptSig = 0x07
+)
+
+// Types of the key value pairs of the outputs.
+const (
+	outputRedeemScript = 0x00
+)
+
+var (
+	// ErrInvalidMagic is returned when a packet does not start with the
+	// magic bytes.
+	ErrInvalidMagic = errors.New("invalid packet magic")
+
+	// ErrDuplicateKey is returned when a key appears twice in a map.
+	ErrDuplicateKey = errors.New("duplicate key")
+
+	// ErrInvalidPacket is returned when a packet is malformed.
+	ErrInvalidPacket = errors.New("invalid packet")
+
+	// ErrNotFinalizable is returned when an input lacks the data or
+	// signatures to be finalized.
+	ErrNotFinalizable = errors.New("input cannot be finalized")
+
+	// ErrIncomplete is returned when a transaction is extracted before all
+	// its inputs are finalized.
+	ErrIncomplete = errors.New("packet is not complete")
+)
+
+// Unknown is a key value pair of a type this package does not know.  These
+// are preserved so packets can be passed through without losing data.
+type Unknown struct {
+	Key   []byte
+	Value []byte
+}
+
+// PartialSig is a signature of an input along with the public key which made
+// it.  The signature has the hash type appended.
+type PartialSig struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// PInput holds the data needed to sign and finalize an input.
+type PInput struct {
+	// Utxo is the output spent by the input.
+	Utxo *wire.TxOut
+
+	// PartialSigs are the signatures collected for the input, sorted by
+	// public key.
+	PartialSigs []*PartialSig
+
+	// SighashType is the signature hash type the signers must use, or zero
+	// when it is up to them.
+	SighashType txscript.SigHashType
+
+	// RedeemScript is the redeem script of a pay-to-script-hash output.
+	RedeemScript []byte
+
+	// FinalScriptSig is the signature script of the finalized input.
+	FinalScriptSig []byte
+
+	Unknowns []*Unknown
+}
+
+// POutput holds the data known about an output.
+type POutput struct {
+	// RedeemScript is the redeem script of a pay-to-script-hash output.
+	RedeemScript []byte
+
+	Unknowns []*Unknown
+}
+
+// Packet is a partially signed transaction.
+type Packet struct {
+	UnsignedTx *wire.MsgTx
+	Inputs     []PInput
+	Outputs    []POutput
+	Unknowns   []*Unknown
+}
+
+// New returns a new packet for the passed transaction, whose inputs must not
+// be signed.
+func New(tx *wire.MsgTx) (*Packet, error) {
+	for i, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 {
+			return nil, fmt.Errorf("input %d of the transaction is "+
+				"signed", i)
+		}
+	}
+	return &Packet{
+		UnsignedTx: tx.Copy(),
+		Inputs:     make([]PInput, len(tx.TxIn)),
+		Outputs:    make([]POutput, len(tx.TxOut)),
+	}, nil
+}
+
+// readKeyValue reads a key value pair from r.  A nil key is returned for the
+// separator terminating a map.
+func readKeyValue(r io.Reader) (key, value []byte, err error) {
+	key, err = wire.ReadVarBytes(r, pver, maxKeyLength, "psbt key")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(key) == 0 {
+		return nil, nil, nil
+	}
+	value, err = wire.ReadVarBytes(r, pver, maxValueLength, "psbt value")
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+// writeKeyValue writes the key value pair of the passed type to w.
+func writeKeyValue(w io.Writer, keyType byte, keyData, value []byte) error {
+	key := append([]byte{keyType}, keyData...)
+	if err := wire.WriteVarBytes(w, pver, key); err != nil {
+		return err
+	}
+	return wire.WriteVarBytes(w, pver, value)
+}
+
+// writeUnknowns writes the passed unknown key value pairs to w.
+func writeUnknowns(w io.Writer, unknowns []*Unknown) error {
+	for _, u := range unknowns {
+		if err := wire.WriteVarBytes(w, pver, u.Key); err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, pver, u.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serializeTxOut returns the serialization of the passed output, which is its
+// value as 8 byte little endian integer followed by its public key script.
+func serializeTxOut(txOut *wire.TxOut) ([]byte, error) {
+	var buf bytes.Buffer
+	var value [8]byte
+	binary.LittleEndian.PutUint64(value[:], uint64(txOut.Value))
+	buf.Write(value[:])
+	if err := wire.WriteVarBytes(&buf, pver, txOut.PkScript); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializeTxOut returns the output serialized by serializeTxOut.
+func deserializeTxOut(b []byte) (*wire.TxOut, error) {
+	r := bytes.NewReader(b)
+	var value [8]byte
+	if _, err := io.ReadFull(r, value[:]); err != nil {
+		return nil, err
+	}
+	pkScript, err := wire.ReadVarBytes(r, pver, maxValueLength, "pkscript")
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%w: trailing bytes after output",
+			ErrInvalidPacket)
+	}
+	return wire.NewTxOut(int64(binary.LittleEndian.Uint64(value[:])),
+		pkScript), nil
+}
+
+// keySet tracks the keys of a map to reject duplicates.
+type keySet map[string]struct{}
+
+// add adds the key to the set and returns ErrDuplicateKey when it was already
+// present.
+func (s keySet) add(key []byte) error {
+	if _, ok := s[string(key)]; ok {
+		return ErrDuplicateKey
+	}
+	s[string(key)] = struct{}{}
+	return nil
+}
+
+// NewFromRawBytes reads a packet from r, which holds its binary serialization
+// or its base64 encoding when b64 is set.
+func NewFromRawBytes(r io.Reader, b64 bool) (*Packet, error) {
+	if b64 {
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+
+	var m [5]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(m[:], magic) {
+		return nil, ErrInvalidMagic
+	}
+
+	p := &Packet{}
+	keys := make(keySet)
+	for {
+		key, value, err := readKeyValue(r)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			break
+		}
+		if err := keys.add(key); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case key[0] == globalUnsignedTx && len(key) == 1:
+			var tx wire.MsgTx
+			if err := tx.Deserialize(bytes.NewReader(value)); err != nil {
+				return nil, err
+			}
+			p.UnsignedTx = &tx
+		default:
+			p.Unknowns = append(p.Unknowns, &Unknown{key, value})
+		}
+	}
+	if p.UnsignedTx == nil {
+		return nil, fmt.Errorf("%w: missing unsigned transaction",
+			ErrInvalidPacket)
+	}
+
+	p.Inputs = make([]PInput, len(p.UnsignedTx.TxIn))
+	for i := range p.Inputs {
+		if err := p.Inputs[i].deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+	p.Outputs = make([]POutput, len(p.UnsignedTx.TxOut))
+	for i := range p.Outputs {
+		if err := p.Outputs[i].deserialize(r); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// deserialize reads the key value pairs of the input from r.
+func (pi *PInput) deserialize(r io.Reader) error {
+	keys := make(keySet)
+	for {
+		key, value, err := readKeyValue(r)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return nil
+		}
+		if err := keys.add(key); err != nil {
+			return err
+		}
+
+		switch {
+		case key[0] == inputUtxo && len(key) == 1:
+			txOut, err := deserializeTxOut(value)
+			if err != nil {
+				return err
+			}
+			pi.Utxo = txOut
+		case key[0] == inputPartialSig:
+			pi.PartialSigs = append(pi.PartialSigs, &PartialSig{
+				PubKey:    key[1:],
+				Signature: value,
+			})
+		case key[0] == inputSighashType && len(key) == 1:
+			if len(value) != 4 {
+				return fmt.Errorf("%w: invalid sighash type",
+					ErrInvalidPacket)
+			}
+			pi.SighashType = txscript.SigHashType(
+				binary.LittleEndian.Uint32(value))
+		case key[0] == inputRedeemScript && len(key) == 1:
+			pi.RedeemScript = value
+		case key[0] == inputFinalScriptSig && len(key) == 1:
+			pi.FinalScriptSig = value
+		default:
+			pi.Unknowns = append(pi.Unknowns, &Unknown{key, value})
+		}
+	}
+}
+
+// serialize writes the key value pairs of the input to w.
+func (pi *PInput) serialize(w io.Writer) error {
+	if pi.Utxo != nil {
+		value, err := serializeTxOut(pi.Utxo)
+		if err != nil {
+			return err
+		}
+		if err := writeKeyValue(w, inputUtxo, nil, value); err != nil {
+			return err
+		}
+	}
+	for _, sig := range pi.PartialSigs {
+		err := writeKeyValue(w, inputPartialSig, sig.PubKey, sig.Signature)
+		if err != nil {
+			return err
+		}
+	}
+	if pi.SighashType != 0 {
+		var value [4]byte
+		binary.LittleEndian.PutUint32(value[:], uint32(pi.SighashType))
+		if err := writeKeyValue(w, inputSighashType, nil, value[:]); err != nil {
+			return err
+		}
+	}
+	if pi.RedeemScript != nil {
+		err := writeKeyValue(w, inputRedeemScript, nil, pi.RedeemScript)
+		if err != nil {
+			return err
+		}
+	}
+	if pi.FinalScriptSig != nil {
+		err := writeKeyValue(w, inputFinalScriptSig, nil, pi.FinalScriptSig)
+		if err != nil {
+			return err
+		}
+	}
+	if err := writeUnknowns(w, pi.Unknowns); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// deserialize reads the key value pairs of the output from r.
+func (po *POutput) deserialize(r io.Reader) error {
+	keys := make(keySet)
+	for {
+		key, value, err := readKeyValue(r)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return nil
+		}
+		if err := keys.add(key); err != nil {
+			return err
+		}
+
+		switch {
+		case key[0] == outputRedeemScript && len(key) == 1:
+			po.RedeemScript = value
+		default:
+			po.Unknowns = append(po.Unknowns, &Unknown{key, value})
+		}
+	}
+}
+
+// serialize writes the key value pairs of the output to w.
+func (po *POutput) serialize(w io.Writer) error {
+	if po.RedeemScript != nil {
+		err := writeKeyValue(w, outputRedeemScript, nil, po.RedeemScript)
+		if err != nil {
+			return err
+		}
+	}
+	if err := writeUnknowns(w, po.Unknowns); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// Serialize writes the binary serialization of the packet to w.
+func (p *Packet) Serialize(w io.Writer) error {
+	if _, err := w.Write(magic); err != nil {
+		return err
+	}
+
+	var tx bytes.Buffer
+	if err := p.UnsignedTx.Serialize(&tx); err != nil {
+		return err
+	}
+	if err := writeKeyValue(w, globalUnsignedTx, nil, tx.Bytes()); err != nil {
+		return err
+	}
+	if err := writeUnknowns(w, p.Unknowns); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{0x00}); err != nil {
+		return err
+	}
+
+	for i := range p.Inputs {
+		if err := p.Inputs[i].serialize(w); err != nil {
+			return err
+		}
+	}
+	for i := range p.Outputs {
+		if err := p.Outputs[i].serialize(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// B64Encode returns the base64 encoding of the serialized packet.
+func (p *Packet) B64Encode() (string, error) {
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// SanityCheck ensures the packet is consistent, which is that the maps match
+// the inputs and outputs of its unsigned transaction and no input is both
+// finalized and carries partial signatures.
+func (p *Packet) SanityCheck() error {
+	if p.UnsignedTx == nil {
+		return fmt.Errorf("%w: missing unsigned transaction",
+			ErrInvalidPacket)
+	}
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) ||
+		len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+		return fmt.Errorf("%w: maps do not match the transaction",
+			ErrInvalidPacket)
+	}
+	for i, txIn := range p.UnsignedTx.TxIn {
+		if len(txIn.SignatureScript) != 0 {
+			return fmt.Errorf("%w: input %d of the transaction is "+
+				"signed", ErrInvalidPacket, i)
+		}
+		if p.Inputs[i].FinalScriptSig != nil &&
+			len(p.Inputs[i].PartialSigs) != 0 {
+			return fmt.Errorf("%w: finalized input %d has partial "+
+				"signatures", ErrInvalidPacket, i)
+		}
+	}
+	return nil
+}
+
+// sortPartialSigs sorts the partial signatures of the input by public key so
+// packets holding the same data serialize the same way.
+func (pi *PInput) sortPartialSigs() {
+	sort.Slice(pi.PartialSigs, func(i, j int) bool {
+		return bytes.Compare(pi.PartialSigs[i].PubKey,
+			pi.PartialSigs[j].PubKey) < 0
+	})
+}
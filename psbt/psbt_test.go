@@ -0,0 +1,191 @@
+package psbt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg"
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/czzec"
+	"github.com/classzz/classzz/txscript"
+	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
+)
+
+// testKey returns the private key made of 32 times b.
+func testKey(b byte) *czzec.PrivateKey {
+	key, _ := czzec.PrivKeyFromBytes(czzec.S256(), bytes.Repeat([]byte{b}, 32))
+	return key
+}
+
+// testTx returns an unsigned transaction spending one output.
+func testTx() *wire.MsgTx {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01}, 0), nil))
+	tx.AddTxOut(wire.NewTxOut(9e7, []byte{txscript.OP_TRUE}))
+	return tx
+}
+
+// roundTrip returns the packet after a base64 serialization round trip.
+func roundTrip(t *testing.T, p *Packet) *Packet {
+	b64, err := p.B64Encode()
+	if err != nil {
+		t.Fatalf("B64Encode: %v", err)
+	}
+	p2, err := NewFromRawBytes(strings.NewReader(b64), true)
+	if err != nil {
+		t.Fatalf("NewFromRawBytes: %v", err)
+	}
+	b64Again, err := p2.B64Encode()
+	if err != nil {
+		t.Fatalf("B64Encode: %v", err)
+	}
+	if b64Again != b64 {
+		t.Fatal("packet changed in serialization round trip")
+	}
+	return p2
+}
+
+// TestPayToPubKeyHash ensures a pay-to-pubkey-hash input is signed, survives
+// serialization and is finalized into a valid transaction.
+func TestPayToPubKeyHash(t *testing.T) {
+	key := testKey(0x01)
+	pkScript, err := txscript.PayToPubKeyHashScript(
+		czzutil.Hash160(key.PubKey().SerializeCompressed()))
+	if err != nil {
+		t.Fatalf("PayToPubKeyHashScript: %v", err)
+	}
+
+	p, err := New(testTx())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	p.Inputs[0].Utxo = wire.NewTxOut(1e8, pkScript)
+	p = roundTrip(t, p)
+
+	if _, err := p.Extract(); err != ErrIncomplete {
+		t.Fatalf("Extract of unsigned packet: %v", err)
+	}
+	if err := p.SignInput(0, key); err != nil {
+		t.Fatalf("SignInput: %v", err)
+	}
+	p = roundTrip(t, p)
+
+	complete, err := p.Finalize()
+	if err != nil || !complete {
+		t.Fatalf("Finalize: %v, %v", complete, err)
+	}
+	p = roundTrip(t, p)
+	tx, err := p.Extract()
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	if len(tx.TxIn[0].SignatureScript) == 0 {
+		t.Fatal("extracted transaction is not signed")
+	}
+}
+
+// TestMultiSigCombine ensures signatures of a 2-of-3 multisig redeem script
+// made by separate parties are combined and finalized.
+func TestMultiSigCombine(t *testing.T) {
+	params := &chaincfg.SimNetParams
+	keys := []*czzec.PrivateKey{testKey(0x01), testKey(0x02), testKey(0x03)}
+	var pubKeys []*czzutil.AddressPubKey
+	for _, key := range keys {
+		addr, err := czzutil.NewAddressPubKey(
+			key.PubKey().SerializeCompressed(), params)
+		if err != nil {
+			t.Fatalf("NewAddressPubKey: %v", err)
+		}
+		pubKeys = append(pubKeys, addr)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubKeys, 2)
+	if err != nil {
+		t.Fatalf("MultiSigScript: %v", err)
+	}
+	addr, err := czzutil.NewAddressScriptHash(redeemScript, params)
+	if err != nil {
+		t.Fatalf("NewAddressScriptHash: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %v", err)
+	}
+
+	p, err := New(testTx())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	p.Inputs[0].Utxo = wire.NewTxOut(1e8, pkScript)
+	p.Inputs[0].RedeemScript = redeemScript
+
+	// The first and the last key sign their own copies.
+	first, last := roundTrip(t, p), roundTrip(t, p)
+	if err := first.SignInput(0, keys[0]); err != nil {
+		t.Fatalf("SignInput: %v", err)
+	}
+	if complete, err := first.Finalize(); err != nil || complete {
+		t.Fatalf("Finalize with one signature: %v, %v", complete, err)
+	}
+	if err := last.SignInput(0, keys[2]); err != nil {
+		t.Fatalf("SignInput: %v", err)
+	}
+
+	combined, err := Combine(first, last)
+	if err != nil {
+		t.Fatalf("Combine: %v", err)
+	}
+	if n := len(combined.Inputs[0].PartialSigs); n != 2 {
+		t.Fatalf("combined packet has %d signatures, want 2", n)
+	}
+	complete, err := combined.Finalize()
+	if err != nil || !complete {
+		t.Fatalf("Finalize: %v, %v", complete, err)
+	}
+	if _, err := combined.Extract(); err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+}
+
+// TestInvalidPackets ensures malformed packets and signatures are rejected.
+func TestInvalidPackets(t *testing.T) {
+	p, err := New(testTx())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	serialized := buf.Bytes()
+
+	badMagic := append([]byte{0x00}, serialized[1:]...)
+	if _, err := NewFromRawBytes(bytes.NewReader(badMagic), false); err != ErrInvalidMagic {
+		t.Fatalf("unexpected error for bad magic: %v", err)
+	}
+
+	// Repeat the unsigned transaction in the global map.
+	txLen := len(serialized) - len(magic) - 1 - 2
+	global := serialized[len(magic) : len(magic)+txLen]
+	dup := append(append(append([]byte(nil), magic...), global...), serialized[len(magic):]...)
+	if _, err := NewFromRawBytes(bytes.NewReader(dup), false); err != ErrDuplicateKey {
+		t.Fatalf("unexpected error for duplicate key: %v", err)
+	}
+
+	// A signature by another key must not be accepted.
+	key := testKey(0x01)
+	pkScript, _ := txscript.PayToPubKeyHashScript(
+		czzutil.Hash160(key.PubKey().SerializeCompressed()))
+	p.Inputs[0].Utxo = wire.NewTxOut(1e8, pkScript)
+	hash, err := p.SigHash(0)
+	if err != nil {
+		t.Fatalf("SigHash: %v", err)
+	}
+	sig, _ := testKey(0x02).SignSchnorr(hash)
+	err = p.AddPartialSig(0, key.PubKey().SerializeCompressed(),
+		append(sig.Serialize(), byte(p.SighashType(0))))
+	if err == nil {
+		t.Fatal("AddPartialSig accepted a signature by another key")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/wire"
+	"github.com/classzz/czzutil"
 	"math/big"
 )
 
@@ -413,19 +414,19 @@ func (r FutureGetBurnTxInfoResult) Receive() ([]*BurnTxInfo, error) {
 	return btis, nil
 }
 
-// FutureGetBlockHashResult is a future promise to deliver the result of a
-// GetBlockHashAsync RPC invocation (or an applicable error).
+// FutureGetStateInfoResult is a future promise to deliver the result of a
+// GetStateInfoAsync RPC invocation (or an applicable error).
 type FutureGetStateInfoResult chan *response
 
-// Receive waits for the response promised by the future and returns the hash of
-// the block in the best block chain at the given height.
+// Receive waits for the response promised by the future and returns the state
+// of the registered beacons.
 func (r FutureGetStateInfoResult) Receive() ([]*btcjson.StateInfoChainResult, error) {
 	res, err := receiveFuture(r)
 	if err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the result as a string-encoded sha.
+	// Unmarshal the result as an array of beacon states.
 	var btis []*btcjson.StateInfoChainResult
 	err = json.Unmarshal(res, &btis)
 	if err != nil {
@@ -434,35 +435,35 @@ func (r FutureGetStateInfoResult) Receive() ([]*btcjson.StateInfoChainResult, er
 	return btis, nil
 }
 
-// GetBlockHashAsync returns an instance of a type that can be used to get the
+// GetStateInfoAsync returns an instance of a type that can be used to get the
 // result of the RPC at some future time by invoking the Receive function on the
 // returned instance.
 //
-// See GetBlockHash for the blocking version and more details.
+// See GetStateInfo for the blocking version and more details.
 func (c *Client) GetStateInfoAsync(BeaconID *uint64) FutureGetStateInfoResult {
 	cmd := btcjson.NewGetStateInfoCmd(BeaconID)
 	return c.sendCmd(cmd)
 }
 
-// GetBlockHash returns the hash of the block in the best block chain at the
-// given height.
+// GetStateInfo returns the state of all registered beacons, or only of the
+// beacon with the passed id when it is not nil.
 func (c *Client) GetStateInfo(BeaconID *uint64) ([]*btcjson.StateInfoChainResult, error) {
 	return c.GetStateInfoAsync(BeaconID).Receive()
 }
 
-// FutureGetBlockHashResult is a future promise to deliver the result of a
-// GetBlockHashAsync RPC invocation (or an applicable error).
+// FutureGetConvertItemsResult is a future promise to deliver the result of a
+// GetConvertItemsAsync RPC invocation (or an applicable error).
 type FutureGetConvertItemsResult chan *response
 
-// Receive waits for the response promised by the future and returns the hash of
-// the block in the best block chain at the given height.
+// Receive waits for the response promised by the future and returns the
+// pending convert items.
 func (r FutureGetConvertItemsResult) Receive() ([]*btcjson.ConvertItemsResult, error) {
 	res, err := receiveFuture(r)
 	if err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the result as a string-encoded sha.
+	// Unmarshal the result as an array of convert items.
 	var btis []*btcjson.ConvertItemsResult
 	err = json.Unmarshal(res, &btis)
 	if err != nil {
@@ -471,35 +472,35 @@ func (r FutureGetConvertItemsResult) Receive() ([]*btcjson.ConvertItemsResult, e
 	return btis, nil
 }
 
-// GetBlockHashAsync returns an instance of a type that can be used to get the
-// result of the RPC at some future time by invoking the Receive function on the
-// returned instance.
+// GetConvertItemsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
 //
-// See GetBlockHash for the blocking version and more details.
+// See GetConvertItems for the blocking version and more details.
 func (c *Client) GetConvertItemsAsync(AssetType *uint8, ConvertType *uint8) FutureGetConvertItemsResult {
 	cmd := btcjson.NewGetConvertItemsCmd(AssetType, ConvertType)
 	return c.sendCmd(cmd)
 }
 
-// GetBlockHash returns the hash of the block in the best block chain at the
-// given height.
+// GetConvertItems returns the convert items which are not confirmed yet,
+// optionally filtered by asset and convert type.
 func (c *Client) GetConvertItems(AssetType *uint8, ConvertType *uint8) ([]*btcjson.ConvertItemsResult, error) {
 	return c.GetConvertItemsAsync(AssetType, ConvertType).Receive()
 }
 
-// FutureGetBlockHashResult is a future promise to deliver the result of a
-// GetBlockHashAsync RPC invocation (or an applicable error).
+// FutureGetConvertConfirmItemsResult is a future promise to deliver the result
+// of a GetConvertConfirmItemsAsync RPC invocation (or an applicable error).
 type FutureGetConvertConfirmItemsResult chan *response
 
-// Receive waits for the response promised by the future and returns the hash of
-// the block in the best block chain at the given height.
+// Receive waits for the response promised by the future and returns the
+// confirmed convert items.
 func (r FutureGetConvertConfirmItemsResult) Receive() ([]*btcjson.ConvertItemsResult, error) {
 	res, err := receiveFuture(r)
 	if err != nil {
 		return nil, err
 	}
 
-	// Unmarshal the result as a string-encoded sha.
+	// Unmarshal the result as an array of convert items.
 	var btis []*btcjson.ConvertItemsResult
 	err = json.Unmarshal(res, &btis)
 	if err != nil {
@@ -508,17 +509,60 @@ func (r FutureGetConvertConfirmItemsResult) Receive() ([]*btcjson.ConvertItemsRe
 	return btis, nil
 }
 
-// GetBlockHash returns the hash of the block in the best block chain at the
-// given height.
+// GetConvertConfirmItemsAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetConvertConfirmItems for the blocking version and more details.
+func (c *Client) GetConvertConfirmItemsAsync(AssetType *uint8, ConvertType *uint8) FutureGetConvertConfirmItemsResult {
+	cmd := btcjson.NewGetConvertConfirmItemsCmd(AssetType, ConvertType)
+	return c.sendCmd(cmd)
+}
+
+// GetConvertConfirmItems returns the confirmed convert items, optionally
+// filtered by asset and convert type.
 func (c *Client) GetConvertConfirmItems(AssetType *uint8, ConvertType *uint8) ([]*btcjson.ConvertItemsResult, error) {
 	return c.GetConvertConfirmItemsAsync(AssetType, ConvertType).Receive()
 }
 
-func (c *Client) GetConvertConfirmItemsAsync(AssetType *uint8, ConvertType *uint8) FutureGetConvertConfirmItemsResult {
-	cmd := btcjson.NewGetConvertConfirmItemsCmd(AssetType, ConvertType)
+// FutureConversionAddressResult is a future promise to deliver the result of a
+// ConversionAddressAsync RPC invocation (or an applicable error).
+type FutureConversionAddressResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// addresses on the external chains which share the public key hash of the
+// requested classzz address.
+func (r FutureConversionAddressResult) Receive() (*btcjson.ConversionAddressResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as a conversionaddress result object.
+	var addrs btcjson.ConversionAddressResult
+	err = json.Unmarshal(res, &addrs)
+	if err != nil {
+		return nil, err
+	}
+	return &addrs, nil
+}
+
+// ConversionAddressAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See ConversionAddress for the blocking version and more details.
+func (c *Client) ConversionAddressAsync(address czzutil.Address) FutureConversionAddressResult {
+	cmd := btcjson.NewConversionAddressCmd(address.EncodeAddress())
 	return c.sendCmd(cmd)
 }
 
+// ConversionAddress returns the DOGE, LTC, BTC, BCH, BSV and USDT addresses
+// which share the public key hash of the passed classzz address.
+func (c *Client) ConversionAddress(address czzutil.Address) (*btcjson.ConversionAddressResult, error) {
+	return c.ConversionAddressAsync(address).Receive()
+}
+
 // FutureGetBlockHashResult is a future promise to deliver the result of a
 // GetBlockHashAsync RPC invocation (or an applicable error).
 type FutureGetBeaconBurnInfoResult chan *response
@@ -681,6 +725,192 @@ func (c *Client) GetMempoolEntry(txHash string) (*btcjson.GetMempoolEntryResult,
 	return c.GetMempoolEntryAsync(txHash).Receive()
 }
 
+// FutureGetMempoolAncestorsResult is a future promise to deliver the result of
+// a GetMempoolAncestorsAsync RPC invocation (or an applicable error).
+type FutureGetMempoolAncestorsResult chan *response
+
+// Receive waits for the response promised by the future and returns the hashes
+// of the ancestors of the transaction in the memory pool.
+func (r FutureGetMempoolAncestorsResult) Receive() ([]*chainhash.Hash, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as an array of strings.
+	var txHashStrs []string
+	err = json.Unmarshal(res, &txHashStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]*chainhash.Hash, 0, len(txHashStrs))
+	for _, hashStr := range txHashStrs {
+		txHash, err := chainhash.NewHashFromStr(hashStr)
+		if err != nil {
+			return nil, err
+		}
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes, nil
+}
+
+// GetMempoolAncestorsAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolAncestors for the blocking version and more details.
+func (c *Client) GetMempoolAncestorsAsync(txHash string) FutureGetMempoolAncestorsResult {
+	cmd := btcjson.NewGetMempoolAncestorsCmd(txHash, btcjson.Bool(false))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolAncestors returns the hashes of the in-mempool ancestors of the
+// transaction in the memory pool given its hash.
+//
+// See GetMempoolAncestorsVerbose to retrieve data structures with information
+// about the ancestors instead.
+func (c *Client) GetMempoolAncestors(txHash string) ([]*chainhash.Hash, error) {
+	return c.GetMempoolAncestorsAsync(txHash).Receive()
+}
+
+// FutureGetMempoolAncestorsVerboseResult is a future promise to deliver the
+// result of a GetMempoolAncestorsVerboseAsync RPC invocation (or an applicable
+// error).
+type FutureGetMempoolAncestorsVerboseResult chan *response
+
+// Receive waits for the response promised by the future and returns a map of
+// transaction hashes to an associated data structure with information about the
+// transaction for all ancestors of the transaction in the memory pool.
+func (r FutureGetMempoolAncestorsVerboseResult) Receive() (map[string]btcjson.GetMempoolEntryResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as a map of strings (tx shas) to their detailed
+	// results.
+	var mempoolItems map[string]btcjson.GetMempoolEntryResult
+	err = json.Unmarshal(res, &mempoolItems)
+	if err != nil {
+		return nil, err
+	}
+	return mempoolItems, nil
+}
+
+// GetMempoolAncestorsVerboseAsync returns an instance of a type that can be
+// used to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolAncestorsVerbose for the blocking version and more details.
+func (c *Client) GetMempoolAncestorsVerboseAsync(txHash string) FutureGetMempoolAncestorsVerboseResult {
+	cmd := btcjson.NewGetMempoolAncestorsCmd(txHash, btcjson.Bool(true))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolAncestorsVerbose returns a map of transaction hashes to an
+// associated data structure with information about the transaction for all
+// in-mempool ancestors of the transaction in the memory pool given its hash.
+//
+// See GetMempoolAncestors to retrieve only the transaction hashes instead.
+func (c *Client) GetMempoolAncestorsVerbose(txHash string) (map[string]btcjson.GetMempoolEntryResult, error) {
+	return c.GetMempoolAncestorsVerboseAsync(txHash).Receive()
+}
+
+// FutureGetMempoolDescendantsResult is a future promise to deliver the result
+// of a GetMempoolDescendantsAsync RPC invocation (or an applicable error).
+type FutureGetMempoolDescendantsResult chan *response
+
+// Receive waits for the response promised by the future and returns the hashes
+// of the descendants of the transaction in the memory pool.
+func (r FutureGetMempoolDescendantsResult) Receive() ([]*chainhash.Hash, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as an array of strings.
+	var txHashStrs []string
+	err = json.Unmarshal(res, &txHashStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]*chainhash.Hash, 0, len(txHashStrs))
+	for _, hashStr := range txHashStrs {
+		txHash, err := chainhash.NewHashFromStr(hashStr)
+		if err != nil {
+			return nil, err
+		}
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes, nil
+}
+
+// GetMempoolDescendantsAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolDescendants for the blocking version and more details.
+func (c *Client) GetMempoolDescendantsAsync(txHash string) FutureGetMempoolDescendantsResult {
+	cmd := btcjson.NewGetMempoolDescendantsCmd(txHash, btcjson.Bool(false))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolDescendants returns the hashes of the in-mempool descendants of the
+// transaction in the memory pool given its hash.
+//
+// See GetMempoolDescendantsVerbose to retrieve data structures with information
+// about the descendants instead.
+func (c *Client) GetMempoolDescendants(txHash string) ([]*chainhash.Hash, error) {
+	return c.GetMempoolDescendantsAsync(txHash).Receive()
+}
+
+// FutureGetMempoolDescendantsVerboseResult is a future promise to deliver the
+// result of a GetMempoolDescendantsVerboseAsync RPC invocation (or an
+// applicable error).
+type FutureGetMempoolDescendantsVerboseResult chan *response
+
+// Receive waits for the response promised by the future and returns a map of
+// transaction hashes to an associated data structure with information about the
+// transaction for all descendants of the transaction in the memory pool.
+func (r FutureGetMempoolDescendantsVerboseResult) Receive() (map[string]btcjson.GetMempoolEntryResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as a map of strings (tx shas) to their detailed
+	// results.
+	var mempoolItems map[string]btcjson.GetMempoolEntryResult
+	err = json.Unmarshal(res, &mempoolItems)
+	if err != nil {
+		return nil, err
+	}
+	return mempoolItems, nil
+}
+
+// GetMempoolDescendantsVerboseAsync returns an instance of a type that can be
+// used to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolDescendantsVerbose for the blocking version and more details.
+func (c *Client) GetMempoolDescendantsVerboseAsync(txHash string) FutureGetMempoolDescendantsVerboseResult {
+	cmd := btcjson.NewGetMempoolDescendantsCmd(txHash, btcjson.Bool(true))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolDescendantsVerbose returns a map of transaction hashes to an
+// associated data structure with information about the transaction for all
+// in-mempool descendants of the transaction in the memory pool given its hash.
+//
+// See GetMempoolDescendants to retrieve only the transaction hashes instead.
+func (c *Client) GetMempoolDescendantsVerbose(txHash string) (map[string]btcjson.GetMempoolEntryResult, error) {
+	return c.GetMempoolDescendantsVerboseAsync(txHash).Receive()
+}
+
 // FutureGetRawMempoolResult is a future promise to deliver the result of a
 // GetRawMempoolAsync RPC invocation (or an applicable error).
 type FutureGetRawMempoolResult chan *response
@@ -948,6 +1178,42 @@ func (c *Client) GetTxOut(txHash *chainhash.Hash, index uint32, mempool bool) (*
 	return c.GetTxOutAsync(txHash, index, mempool).Receive()
 }
 
+// FutureGetTxOutSetInfoResult is a future promise to deliver the result of a
+// GetTxOutSetInfoAsync RPC invocation (or an applicable error).
+type FutureGetTxOutSetInfoResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// statistics of the unspent transaction output set.
+func (r FutureGetTxOutSetInfoResult) Receive() (*btcjson.GetTxOutSetInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var info btcjson.GetTxOutSetInfoResult
+	err = json.Unmarshal(res, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GetTxOutSetInfoAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetTxOutSetInfo for the blocking version and more details.
+func (c *Client) GetTxOutSetInfoAsync() FutureGetTxOutSetInfoResult {
+	cmd := btcjson.NewGetTxOutSetInfoCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetTxOutSetInfo returns statistics about the unspent transaction output set
+// at the end of the main chain, including its ECMH commitment.
+func (c *Client) GetTxOutSetInfo() (*btcjson.GetTxOutSetInfoResult, error) {
+	return c.GetTxOutSetInfoAsync().Receive()
+}
+
 // FutureRescanBlocksResult is a future promise to deliver the result of a
 // RescanBlocksAsync RPC invocation (or an applicable error).
 //
@@ -1035,6 +1301,200 @@ func (c *Client) InvalidateBlock(blockHash *chainhash.Hash) error {
 	return c.InvalidateBlockAsync(blockHash).Receive()
 }
 
+// FutureReconsiderBlockResult is a future promise to deliver the result of a
+// ReconsiderBlockAsync RPC invocation (or an applicable error).
+type FutureReconsiderBlockResult chan *response
+
+// Receive waits for the response promised by the future and returns an error
+// if the block could not be reconsidered.
+func (r FutureReconsiderBlockResult) Receive() error {
+	_, err := receiveFuture(r)
+
+	return err
+}
+
+// ReconsiderBlockAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See ReconsiderBlock for the blocking version and more details.
+func (c *Client) ReconsiderBlockAsync(blockHash *chainhash.Hash) FutureReconsiderBlockResult {
+	hash := ""
+	if blockHash != nil {
+		hash = blockHash.String()
+	}
+
+	cmd := btcjson.NewReconsiderBlockCmd(hash)
+	return c.sendCmd(cmd)
+}
+
+// ReconsiderBlock removes the invalid status of a specific block and its
+// descendants.
+func (c *Client) ReconsiderBlock(blockHash *chainhash.Hash) error {
+	return c.ReconsiderBlockAsync(blockHash).Receive()
+}
+
+// FuturePreciousBlockResult is a future promise to deliver the result of a
+// PreciousBlockAsync RPC invocation (or an applicable error).
+type FuturePreciousBlockResult chan *response
+
+// Receive waits for the response promised by the future and returns an error
+// if the block could not be marked as precious.
+func (r FuturePreciousBlockResult) Receive() error {
+	_, err := receiveFuture(r)
+
+	return err
+}
+
+// PreciousBlockAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See PreciousBlock for the blocking version and more details.
+func (c *Client) PreciousBlockAsync(blockHash *chainhash.Hash) FuturePreciousBlockResult {
+	hash := ""
+	if blockHash != nil {
+		hash = blockHash.String()
+	}
+
+	cmd := btcjson.NewPreciousBlockCmd(hash)
+	return c.sendCmd(cmd)
+}
+
+// PreciousBlock treats a block as if it were received before others with the
+// same amount of work, making it the tip of the main chain when its branch has
+// as much work as the main chain.
+func (c *Client) PreciousBlock(blockHash *chainhash.Hash) error {
+	return c.PreciousBlockAsync(blockHash).Receive()
+}
+
+// FutureGetChainTipsResult is a future promise to deliver the result of a
+// GetChainTipsAsync RPC invocation (or an applicable error).
+type FutureGetChainTipsResult chan *response
+
+// Receive waits for the response promised by the future and returns the tips
+// of all known branches of the block tree.
+func (r FutureGetChainTipsResult) Receive() ([]btcjson.GetChainTipsResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var tips []btcjson.GetChainTipsResult
+	err = json.Unmarshal(res, &tips)
+	if err != nil {
+		return nil, err
+	}
+	return tips, nil
+}
+
+// GetChainTipsAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetChainTips for the blocking version and more details.
+func (c *Client) GetChainTipsAsync() FutureGetChainTipsResult {
+	cmd := btcjson.NewGetChainTipsCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetChainTips returns the tips of all known branches of the block tree,
+// including the tip of the main chain.
+func (c *Client) GetChainTips() ([]btcjson.GetChainTipsResult, error) {
+	return c.GetChainTipsAsync().Receive()
+}
+
+// FutureGetCommitteeResult is a future promise to deliver the result of a
+// GetCommitteeAsync or GetNextCommitteeAsync RPC invocation (or an
+// applicable error).
+type FutureGetCommitteeResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// committee, or nil when committees are not active.
+func (r FutureGetCommitteeResult) Receive() (*btcjson.GetCommitteeResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var committee *btcjson.GetCommitteeResult
+	err = json.Unmarshal(res, &committee)
+	if err != nil {
+		return nil, err
+	}
+	return committee, nil
+}
+
+// GetCommitteeAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetCommittee for the blocking version and more details.
+func (c *Client) GetCommitteeAsync() FutureGetCommitteeResult {
+	cmd := btcjson.NewGetCommitteeCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetCommittee returns the committee which must sign the ConvertConfirm
+// transactions of the next block, or nil when committees are not active for
+// it.
+func (c *Client) GetCommittee() (*btcjson.GetCommitteeResult, error) {
+	return c.GetCommitteeAsync().Receive()
+}
+
+// GetNextCommitteeAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetNextCommittee for the blocking version and more details.
+func (c *Client) GetNextCommitteeAsync() FutureGetCommitteeResult {
+	cmd := btcjson.NewGetNextCommitteeCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetNextCommittee returns the committee which would be elected for the
+// following epoch from the current pledges.
+func (c *Client) GetNextCommittee() (*btcjson.GetCommitteeResult, error) {
+	return c.GetNextCommitteeAsync().Receive()
+}
+
+// FutureGetPoolAccountingResult is a future promise to deliver the result of a
+// GetPoolAccountingAsync RPC invocation (or an applicable error).
+type FutureGetPoolAccountingResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// accounting of the pools and pledges.
+func (r FutureGetPoolAccountingResult) Receive() (*btcjson.GetPoolAccountingResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounting btcjson.GetPoolAccountingResult
+	err = json.Unmarshal(res, &accounting)
+	if err != nil {
+		return nil, err
+	}
+	return &accounting, nil
+}
+
+// GetPoolAccountingAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetPoolAccounting for the blocking version and more details.
+func (c *Client) GetPoolAccountingAsync(startHeight, endHeight *int32) FutureGetPoolAccountingResult {
+	cmd := btcjson.NewGetPoolAccountingCmd(startHeight, endHeight)
+	return c.sendCmd(cmd)
+}
+
+// GetPoolAccounting returns the flows of the cross-chain pools and the pledges
+// between the passed heights, which default to the Maui upgrade and the best
+// block when nil, along with a check of the pool balances against the flows.
+func (c *Client) GetPoolAccounting(startHeight, endHeight *int32) (*btcjson.GetPoolAccountingResult, error) {
+	return c.GetPoolAccountingAsync(startHeight, endHeight).Receive()
+}
+
 // FutureGetCFilterResult is a future promise to deliver the result of a
 // GetCFilterAsync RPC invocation (or an applicable error).
 type FutureGetCFilterResult chan *response
@@ -176,6 +176,12 @@ type Client struct {
 	ntfnStateLock sync.Mutex
 	ntfnState     *notificationState
 
+	// batch indicates whether or not the client queues the requests until
+	// they are sent together by Send.
+	batch     bool
+	batchLock sync.Mutex
+	batchList []*jsonRequest
+
 	// Networking infrastructure.
 	sendChan        chan []byte
 	sendPostChan    chan *sendPostDetails
@@ -276,6 +282,13 @@ func (c *Client) trackRegisteredNtfns(cmd interface{}) {
 
 		}
 
+	case *btcjson.NotifyCrossEventsCmd:
+		eventTypes := []string{}
+		if bcmd.EventTypes != nil {
+			eventTypes = *bcmd.EventTypes
+		}
+		c.ntfnState.notifyCrossEvents = &eventTypes
+
 	case *btcjson.NotifySpentCmd:
 		for _, op := range bcmd.OutPoints {
 			c.ntfnState.notifySpent[op] = struct{}{}
@@ -548,6 +561,15 @@ func (c *Client) reregisterNtfns() error {
 		}
 	}
 
+	// Reregister notifycrossevents if needed.
+	if stateCopy.notifyCrossEvents != nil {
+		log.Debugf("Reregistering [notifycrossevents]")
+		err := c.NotifyCrossEvents(*stateCopy.notifyCrossEvents)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Reregister the combination of all previously registered notifyspent
 	// outpoints in one command if needed.
 	nslen := len(stateCopy.notifySpent)
@@ -835,26 +857,133 @@ func receiveFuture(f chan *response) ([]byte, error) {
 // however, the underlying HTTP client might coalesce multiple commands
 // depending on several factors including the remote server configuration.
 func (c *Client) sendPost(jReq *jsonRequest) {
+	httpReq, err := c.newPostRequest(jReq.marshalledJSON)
+	if err != nil {
+		jReq.responseChan <- &response{result: nil, err: err}
+		return
+	}
+
+	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
+	c.sendPostRequest(httpReq, jReq)
+}
+
+// newPostRequest returns an HTTP POST request of the passed body to the
+// configured RPC server.
+func (c *Client) newPostRequest(body []byte) (*http.Request, error) {
 	// Generate a request to the configured RPC server.
 	protocol := "http"
 	if !c.config.DisableTLS {
 		protocol = "https"
 	}
 	url := protocol + "://" + c.config.Host
-	bodyReader := bytes.NewReader(jReq.marshalledJSON)
+	bodyReader := bytes.NewReader(body)
 	httpReq, err := http.NewRequest("POST", url, bodyReader)
 	if err != nil {
-		jReq.responseChan <- &response{result: nil, err: err}
-		return
+		return nil, err
 	}
 	httpReq.Close = true
 	httpReq.Header.Set("Content-Type", "application/json")
 
 	// Configure basic access authorization.
 	httpReq.SetBasicAuth(c.config.User, c.config.Pass)
+	return httpReq, nil
+}
 
-	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
-	c.sendPostRequest(httpReq, jReq)
+// batchResponse is a partially-unmarshaled JSON-RPC response of a batch, which
+// is matched to its request by ID.
+type batchResponse struct {
+	ID *uint64 `json:"id"`
+	rawResponse
+}
+
+// Send sends all requests queued by a client created with NewBatch to the
+// server in a single HTTP POST request and delivers the responses to their
+// futures.  The futures of the requests the server failed to process receive
+// the individual errors, while an error which prevents the whole batch from
+// being processed is both returned and delivered to all futures.
+func (c *Client) Send() error {
+	if !c.batch {
+		return errors.New("the client is not in batch mode")
+	}
+
+	c.batchLock.Lock()
+	requests := c.batchList
+	c.batchList = nil
+	c.batchLock.Unlock()
+	if len(requests) == 0 {
+		return nil
+	}
+
+	failAll := func(err error) error {
+		for _, jReq := range requests {
+			jReq.responseChan <- &response{err: err}
+		}
+		return err
+	}
+
+	// The batch is the JSON array of the marshalled requests.
+	var body bytes.Buffer
+	body.WriteByte('[')
+	for i, jReq := range requests {
+		if i > 0 {
+			body.WriteByte(',')
+		}
+		body.Write(jReq.marshalledJSON)
+	}
+	body.WriteByte(']')
+
+	httpReq, err := c.newPostRequest(body.Bytes())
+	if err != nil {
+		return failAll(err)
+	}
+	log.Tracef("Sending batch of %d commands", len(requests))
+	httpResponse, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return failAll(err)
+	}
+
+	// Read the raw bytes and close the response.
+	respBytes, err := ioutil.ReadAll(httpResponse.Body)
+	httpResponse.Body.Close()
+	if err != nil {
+		return failAll(fmt.Errorf("error reading json reply: %v", err))
+	}
+
+	// A server which could not process the batch replies with a single
+	// JSON-RPC response holding the error.
+	var responses []batchResponse
+	if err := json.Unmarshal(respBytes, &responses); err != nil {
+		var resp rawResponse
+		if json.Unmarshal(respBytes, &resp) == nil && resp.Error != nil {
+			return failAll(resp.Error)
+		}
+		return failAll(fmt.Errorf("status code: %d, response: %q",
+			httpResponse.StatusCode, string(respBytes)))
+	}
+
+	pending := make(map[uint64]*jsonRequest, len(requests))
+	for _, jReq := range requests {
+		pending[jReq.id] = jReq
+	}
+	for _, resp := range responses {
+		if resp.ID == nil {
+			continue
+		}
+		jReq, ok := pending[*resp.ID]
+		if !ok {
+			continue
+		}
+		delete(pending, *resp.ID)
+		res, err := resp.result()
+		jReq.responseChan <- &response{result: res, err: err}
+	}
+	for _, jReq := range pending {
+		jReq.responseChan <- &response{
+			err: fmt.Errorf("no response to command [%s] with id %d",
+				jReq.method, jReq.id),
+		}
+	}
+	return nil
 }
 
 // sendRequest sends the passed json request to the associated server using the
@@ -866,6 +995,12 @@ func (c *Client) sendRequest(jReq *jsonRequest) {
 	// POST mode, the command is issued via an HTTP client.  Otherwise,
 	// the command is issued via the asynchronous websocket channels.
 	if c.config.HTTPPostMode {
+		if c.batch {
+			c.batchLock.Lock()
+			c.batchList = append(c.batchList, jReq)
+			c.batchLock.Unlock()
+			return
+		}
 		c.sendPost(jReq)
 		return
 	}
@@ -1329,6 +1464,23 @@ func New(config *ConnConfig, ntfnHandlers *NotificationHandlers) (*Client, error
 	return client, nil
 }
 
+// NewBatch creates a new RPC client in batch mode based on the provided
+// connection configuration details, which must use HTTP POST mode.  The
+// requests of a client in batch mode are queued instead of being sent, and
+// their futures only receive the results once Send sends them all in a single
+// JSON-RPC batch.
+func NewBatch(config *ConnConfig) (*Client, error) {
+	if !config.HTTPPostMode {
+		return nil, errors.New("batch mode requires HTTP POST mode")
+	}
+	client, err := New(config, nil)
+	if err != nil {
+		return nil, err
+	}
+	client.batch = true
+	return client, nil
+}
+
 // Connect establishes the initial websocket connection.  This is necessary when
 // a client was created after setting the DisableConnectOnNew field of the
 // Config struct.
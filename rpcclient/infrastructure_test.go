@@ -0,0 +1,73 @@
+package rpcclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/classzz/classzz/btcjson"
+)
+
+// TestBatch ensures the requests of a batch client are sent in a single POST
+// and the responses, which may be out of order and hold errors, are delivered
+// to the matching futures.
+func TestBatch(t *testing.T) {
+	var posts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts++
+		var requests []btcjson.Request
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			t.Errorf("invalid batch: %v", err)
+			return
+		}
+
+		// Reply in reverse order and fail the getblockhash request.
+		var responses []*btcjson.Response
+		for i := len(requests) - 1; i >= 0; i-- {
+			var resp *btcjson.Response
+			switch requests[i].Method {
+			case "getblockcount":
+				resp, _ = btcjson.NewResponse(requests[i].ID, []byte("100"), nil)
+			case "getblockhash":
+				resp, _ = btcjson.NewResponse(requests[i].ID, []byte("null"),
+					btcjson.NewRPCError(btcjson.ErrRPCOutOfRange, "out of range"))
+			}
+			responses = append(responses, resp)
+		}
+		json.NewEncoder(w).Encode(responses)
+	}))
+	defer server.Close()
+
+	client, err := NewBatch(&ConnConfig{
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+		HTTPPostMode: true,
+		DisableTLS:   true,
+	})
+	if err != nil {
+		t.Fatalf("NewBatch: %v", err)
+	}
+	defer client.Shutdown()
+
+	countFuture := client.GetBlockCountAsync()
+	hashFuture := client.GetBlockHashAsync(1000)
+	if posts != 0 {
+		t.Fatal("requests sent before Send")
+	}
+	if err := client.Send(); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if posts != 1 {
+		t.Fatalf("batch sent in %d posts, want 1", posts)
+	}
+
+	count, err := countFuture.Receive()
+	if err != nil || count != 100 {
+		t.Fatalf("getblockcount: %v, %v", count, err)
+	}
+	_, err = hashFuture.Receive()
+	if rpcErr, ok := err.(*btcjson.RPCError); !ok || rpcErr.Code != btcjson.ErrRPCOutOfRange {
+		t.Fatalf("getblockhash: unexpected error %v", err)
+	}
+}
@@ -321,6 +321,45 @@ func (c *Client) GetWork() (*btcjson.GetWorkResult, error) {
 	return c.GetWorkAsync().Receive()
 }
 
+// FutureGetWorkTemplateResult is a future promise to deliver the result of a
+// GetWorkTemplateAsync RPC invocation (or an applicable error).
+type FutureGetWorkTemplateResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// header of the block template to work on.
+func (r FutureGetWorkTemplateResult) Receive() (*btcjson.GetWorkTemplateResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getworktemplate result object.
+	var result btcjson.GetWorkTemplateResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetWorkTemplateAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetWorkTemplate for the blocking version and more details.
+func (c *Client) GetWorkTemplateAsync() FutureGetWorkTemplateResult {
+	cmd := btcjson.NewGetWorkTemplateCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetWorkTemplate returns the header of a new block template to work on.
+//
+// See SubmitWork to submit the found nonce.
+func (c *Client) GetWorkTemplate() (*btcjson.GetWorkTemplateResult, error) {
+	return c.GetWorkTemplateAsync().Receive()
+}
+
 // FutureGetWorkSubmit is a future promise to deliver the result of a
 // GetWorkSubmitAsync RPC invocation (or an applicable error).
 type FutureGetWorkSubmit chan *response
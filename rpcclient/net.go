@@ -391,3 +391,43 @@ func (c *Client) GetNetTotalsAsync() FutureGetNetTotalsResult {
 func (c *Client) GetNetTotals() (*btcjson.GetNetTotalsResult, error) {
 	return c.GetNetTotalsAsync().Receive()
 }
+
+// FutureGetNetMsgStatsResult is a future promise to deliver the result of a
+// GetNetMsgStatsAsync RPC invocation (or an applicable error).
+type FutureGetNetMsgStatsResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// per-message traffic and relay latency statistics of the connected peers.
+func (r FutureGetNetMsgStatsResult) Receive() (*btcjson.GetNetMsgStatsResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getnetmsgstats result object.
+	var stats btcjson.GetNetMsgStatsResult
+	err = json.Unmarshal(res, &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// GetNetMsgStatsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetNetMsgStats for the blocking version and more details.
+func (c *Client) GetNetMsgStatsAsync(peerID *int32) FutureGetNetMsgStatsResult {
+	cmd := btcjson.NewGetNetMsgStatsCmd(peerID)
+	return c.sendCmd(cmd)
+}
+
+// GetNetMsgStats returns the number and size of the messages exchanged with
+// the connected peers by command along with their block and transaction relay
+// latencies.  Only the statistics of the peer with the passed id are returned
+// when it is not nil.
+func (c *Client) GetNetMsgStats(peerID *int32) (*btcjson.GetNetMsgStatsResult, error) {
+	return c.GetNetMsgStatsAsync(peerID).Receive()
+}
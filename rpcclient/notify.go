@@ -35,6 +35,7 @@ type notificationState struct {
 	notifyBlocks       bool
 	notifyNewTx        bool
 	notifyNewTxVerbose bool
+	notifyCrossEvents  *[]string
 	notifyReceived     map[string]struct{}
 	notifySpent        map[btcjson.OutPoint]struct{}
 }
@@ -45,6 +46,10 @@ func (s *notificationState) Copy() *notificationState {
 	stateCopy.notifyBlocks = s.notifyBlocks
 	stateCopy.notifyNewTx = s.notifyNewTx
 	stateCopy.notifyNewTxVerbose = s.notifyNewTxVerbose
+	if s.notifyCrossEvents != nil {
+		eventTypes := append([]string(nil), *s.notifyCrossEvents...)
+		stateCopy.notifyCrossEvents = &eventTypes
+	}
 	stateCopy.notifyReceived = make(map[string]struct{})
 	for addr := range s.notifyReceived {
 		stateCopy.notifyReceived[addr] = struct{}{}
@@ -179,6 +184,14 @@ type NotificationHandlers struct {
 	// made to register for the notification and the function is non-nil.
 	OnTxAcceptedVerbose func(txDetails *btcjson.TxRawResult)
 
+	// OnCrossEvent is invoked when a block connected to or disconnected
+	// from the main chain changed the committee state.  It will only be
+	// invoked if a preceding call to NotifyCrossEvents has been made to
+	// register for the notification and the function is non-nil.  The
+	// removed flag is set for the events of a disconnected block.
+	OnCrossEvent func(hash *chainhash.Hash, height int32, removed bool,
+		event *btcjson.CrossEventResult)
+
 	// OnBchdConnected is invoked when a wallet connects or disconnects from
 	// classzz.
 	//
@@ -409,6 +422,23 @@ func (c *Client) handleNotification(ntfn *rawNotification) {
 
 		c.ntfnHandlers.OnTxAcceptedVerbose(rawTx)
 
+	// OnCrossEvent
+	case btcjson.CrossEventNtfnMethod:
+		// Ignore the notification if the client is not interested in
+		// it.
+		if c.ntfnHandlers.OnCrossEvent == nil {
+			return
+		}
+
+		hash, height, removed, event, err := parseCrossEventNtfnParams(ntfn.Params)
+		if err != nil {
+			log.Warnf("Received invalid cross event "+
+				"notification: %v", err)
+			return
+		}
+
+		c.ntfnHandlers.OnCrossEvent(hash, height, removed, event)
+
 	// OnBchdConnected
 	case btcjson.BtcdConnectedNtfnMethod:
 		// Ignore the notification if the client is not interested in
@@ -775,6 +805,53 @@ func parseTxAcceptedVerboseNtfnParams(params []json.RawMessage) (*btcjson.TxRawR
 	return &rawTx, nil
 }
 
+// parseCrossEventNtfnParams parses out the block hash and height, the removed
+// flag and the committee state change from the parameters of a crossevent
+// notification.
+func parseCrossEventNtfnParams(params []json.RawMessage) (*chainhash.Hash,
+	int32, bool, *btcjson.CrossEventResult, error) {
+
+	if len(params) != 4 {
+		return nil, 0, false, nil, wrongNumParams(len(params))
+	}
+
+	// Unmarshal first parameter as a string.
+	var blockHashStr string
+	err := json.Unmarshal(params[0], &blockHashStr)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+
+	// Unmarshal second parameter as an integer.
+	var blockHeight int32
+	err = json.Unmarshal(params[1], &blockHeight)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+
+	// Unmarshal third parameter as a boolean.
+	var removed bool
+	err = json.Unmarshal(params[2], &removed)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+
+	// Unmarshal fourth parameter as a cross event result object.
+	var event btcjson.CrossEventResult
+	err = json.Unmarshal(params[3], &event)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+
+	// Create hash from block hash string.
+	blockHash, err := chainhash.NewHashFromStr(blockHashStr)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+
+	return blockHash, blockHeight, removed, &event, nil
+}
+
 // parseBchdConnectedNtfnParams parses out the connection status of classzz
 // and czzwallet from the parameters of a czzdconnected notification.
 func parseBchdConnectedNtfnParams(params []json.RawMessage) (bool, error) {
@@ -902,6 +979,60 @@ func (c *Client) NotifyBlocks() error {
 	return c.NotifyBlocksAsync().Receive()
 }
 
+// FutureNotifyCrossEventsResult is a future promise to deliver the result of a
+// NotifyCrossEventsAsync RPC invocation (or an applicable error).
+type FutureNotifyCrossEventsResult chan *response
+
+// Receive waits for the response promised by the future and returns an error
+// if the registration was not successful.
+func (r FutureNotifyCrossEventsResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// NotifyCrossEventsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See NotifyCrossEvents for the blocking version and more details.
+//
+// NOTE: This is a classzz extension and requires a websocket connection.
+func (c *Client) NotifyCrossEventsAsync(eventTypes []string) FutureNotifyCrossEventsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Ignore the notification if the client is not interested in
+	// notifications.
+	if c.ntfnHandlers == nil {
+		return newNilFutureResult()
+	}
+
+	var types *[]string
+	if len(eventTypes) != 0 {
+		types = &eventTypes
+	}
+	cmd := btcjson.NewNotifyCrossEventsCmd(types)
+	return c.sendCmd(cmd)
+}
+
+// NotifyCrossEvents registers the client to receive notifications when blocks
+// connected to or disconnected from the main chain change the committee state.
+// Only the passed event types are notified, or all of them when none are
+// passed.  The notifications are delivered to the notification handlers
+// associated with the client.  Calling this function has no effect if there
+// are no notification handlers and will result in an error if the client is
+// configured to run in HTTP POST mode.
+//
+// The notifications delivered as a result of this call will be via
+// OnCrossEvent.
+//
+// NOTE: This is a classzz extension and requires a websocket connection.
+func (c *Client) NotifyCrossEvents(eventTypes []string) error {
+	return c.NotifyCrossEventsAsync(eventTypes).Receive()
+}
+
 // FutureNotifySpentResult is a future promise to deliver the result of a
 // NotifySpentAsync RPC invocation (or an applicable error).
 //
@@ -9,9 +9,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/psbt"
 	"github.com/classzz/classzz/wire"
 	"github.com/classzz/czzutil"
 )
@@ -907,3 +909,138 @@ func (c *Client) DecodeScriptAsync(serializedScript []byte) FutureDecodeScriptRe
 func (c *Client) DecodeScript(serializedScript []byte) (*btcjson.DecodeScriptResult, error) {
 	return c.DecodeScriptAsync(serializedScript).Receive()
 }
+
+// FutureCreatePsbtResult is a future promise to deliver the result of a
+// CreatePsbtAsync RPC invocation (or an applicable error).
+type FutureCreatePsbtResult chan *response
+
+// Receive waits for the response promised by the future and returns a new
+// partially signed transaction spending the provided inputs and sending to
+// the provided addresses.
+func (r FutureCreatePsbtResult) Receive() (*psbt.Packet, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a string.
+	var b64 string
+	err = json.Unmarshal(res, &b64)
+	if err != nil {
+		return nil, err
+	}
+
+	return psbt.NewFromRawBytes(strings.NewReader(b64), true)
+}
+
+// CreatePsbtAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See CreatePsbt for the blocking version and more details.
+func (c *Client) CreatePsbtAsync(inputs []btcjson.TransactionInput,
+	amounts map[czzutil.Address]czzutil.Amount, lockTime *int64) FutureCreatePsbtResult {
+
+	convertedAmts := make(map[string]float64, len(amounts))
+	for addr, amount := range amounts {
+		convertedAmts[addr.String()] = amount.ToCZZ()
+	}
+	cmd := btcjson.NewCreatePsbtCmd(inputs, convertedAmts, lockTime)
+	return c.sendCmd(cmd)
+}
+
+// CreatePsbt returns a new partially signed transaction spending the provided
+// inputs and sending to the provided addresses.  The outputs spent by the
+// inputs are included when they are known to the server.
+func (c *Client) CreatePsbt(inputs []btcjson.TransactionInput,
+	amounts map[czzutil.Address]czzutil.Amount, lockTime *int64) (*psbt.Packet, error) {
+	return c.CreatePsbtAsync(inputs, amounts, lockTime).Receive()
+}
+
+// FutureDecodePsbtResult is a future promise to deliver the result of a
+// DecodePsbtAsync RPC invocation (or an applicable error).
+type FutureDecodePsbtResult chan *response
+
+// Receive waits for the response promised by the future and returns
+// information about a partially signed transaction.
+func (r FutureDecodePsbtResult) Receive() (*btcjson.DecodePsbtResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a decodepsbt result object.
+	var decodePsbtResult btcjson.DecodePsbtResult
+	err = json.Unmarshal(res, &decodePsbtResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decodePsbtResult, nil
+}
+
+// DecodePsbtAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See DecodePsbt for the blocking version and more details.
+func (c *Client) DecodePsbtAsync(packet *psbt.Packet) FutureDecodePsbtResult {
+	b64, err := packet.B64Encode()
+	if err != nil {
+		return newFutureError(err)
+	}
+
+	cmd := btcjson.NewDecodePsbtCmd(b64)
+	return c.sendCmd(cmd)
+}
+
+// DecodePsbt returns information about a partially signed transaction.
+func (c *Client) DecodePsbt(packet *psbt.Packet) (*btcjson.DecodePsbtResult, error) {
+	return c.DecodePsbtAsync(packet).Receive()
+}
+
+// FutureFinalizePsbtResult is a future promise to deliver the result of a
+// FinalizePsbtAsync RPC invocation (or an applicable error).
+type FutureFinalizePsbtResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// finalized partially signed transaction or, once it is complete and extracted,
+// the signed transaction.
+func (r FutureFinalizePsbtResult) Receive() (*btcjson.FinalizePsbtResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a finalizepsbt result object.
+	var finalizePsbtResult btcjson.FinalizePsbtResult
+	err = json.Unmarshal(res, &finalizePsbtResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return &finalizePsbtResult, nil
+}
+
+// FinalizePsbtAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See FinalizePsbt for the blocking version and more details.
+func (c *Client) FinalizePsbtAsync(packet *psbt.Packet, extract *bool) FutureFinalizePsbtResult {
+	b64, err := packet.B64Encode()
+	if err != nil {
+		return newFutureError(err)
+	}
+
+	cmd := btcjson.NewFinalizePsbtCmd(b64, extract)
+	return c.sendCmd(cmd)
+}
+
+// FinalizePsbt builds the signature scripts of the inputs of a partially
+// signed transaction which have enough signatures.  The signed transaction is
+// returned instead of the partially signed one when it is complete, unless
+// extract is false.
+func (c *Client) FinalizePsbt(packet *psbt.Packet, extract *bool) (*btcjson.FinalizePsbtResult, error) {
+	return c.FinalizePsbtAsync(packet, extract).Receive()
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/classzz/classzz/mining"
 	"github.com/classzz/classzz/mining/cpuminer"
 	"github.com/classzz/classzz/peer"
+	"github.com/classzz/classzz/psbt"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/version"
 	"github.com/classzz/classzz/wire"
@@ -125,6 +126,14 @@ var (
 		Code:    btcjson.ErrRPCNoWallet,
 		Message: "This implementation does not implement wallet commands",
 	}
+
+	// ErrRPCHeadersOnly is an error returned to RPC clients when the
+	// provided command requires full blocks or the UTXO set, neither of
+	// which is available when the node runs in headers-only mode.
+	ErrRPCHeadersOnly = &btcjson.RPCError{
+		Code:    btcjson.ErrRPCMisc,
+		Message: "Command unavailable in headers-only mode",
+	}
 )
 
 type commandHandler func(*rpcServer, interface{}, <-chan struct{}) (interface{}, error)
@@ -135,6 +144,7 @@ type commandHandler func(*rpcServer, interface{}, <-chan struct{}) (interface{},
 var rpcHandlers map[string]commandHandler
 var rpcHandlersBeforeInit = map[string]commandHandler{
 	"addnode":              handleAddNode,
+	"createpsbt":           handleCreatePsbt,
 	"createrawtransaction": handleCreateRawTransaction,
 	//"beaconregistration":     handleBeaconRegistration,
 	//"addbeaconpledge":        handleAddBeaconPledge,
@@ -146,9 +156,11 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"convertconfirm":         handleConvertConfirm,
 	"conversionaddress":      handleConversionAddress,
 	"debuglevel":             handleDebugLevel,
+	"decodepsbt":             handleDecodePsbt,
 	"decoderawtransaction":   handleDecodeRawTransaction,
 	"decodescript":           handleDecodeScript,
 	"estimatefee":            handleEstimateFee,
+	"finalizepsbt":           handleFinalizePsbt,
 	"generate":               handleGenerate,
 	"getaddednodeinfo":       handleGetAddedNodeInfo,
 	"getbestblock":           handleGetBestBlock,
@@ -159,6 +171,8 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"getblockhash":           handleGetBlockHash,
 	"getblockheader":         handleGetBlockHeader,
 	"getblocktemplate":       handleGetBlockTemplate,
+	"getchaintips":           handleGetChainTips,
+	"getcommittee":           handleGetCommittee,
 	"getcfilter":             handleGetCFilter,
 	"getcfilterheader":       handleGetCFilterHeader,
 	"getconnectioncount":     handleGetConnectionCount,
@@ -173,19 +187,27 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"getconvertconfirmitems": handleGetConvertConfirmItems,
 	"getwork":                handleGetWork,
 	"getworktemplate":        handleGetWorkTemplate,
+	"getmempoolancestors":    handleGetMempoolAncestors,
+	"getmempooldescendants":  handleGetMempoolDescendants,
+	"getmempoolentry":        handleGetMempoolEntry,
 	"getmempoolinfo":         handleGetMempoolInfo,
 	"getmininginfo":          handleGetMiningInfo,
+	"getnetmsgstats":         handleGetNetMsgStats,
 	"getnettotals":           handleGetNetTotals,
+	"getnextcommittee":       handleGetNextCommittee,
 	"getnetworkhashps":       handleGetNetworkHashPS,
 	"getpeerinfo":            handleGetPeerInfo,
+	"getpoolaccounting":      handleGetPoolAccounting,
 	"getrawmempool":          handleGetRawMempool,
 	"getrawtransaction":      handleGetRawTransaction,
 	"gettxout":               handleGetTxOut,
 	"gettxoutproof":          handleGetTxOutProof,
+	"gettxoutsetinfo":        handleGetTxOutSetInfo,
 	"help":                   handleHelp,
 	"invalidateblock":        handleInvalidateBlock,
 	"node":                   handleNode,
 	"ping":                   handlePing,
+	"preciousblock":          handlePreciousBlock,
 	"reconsiderblock":        handleReconsiderBlock,
 	"searchrawtransactions":  handleSearchRawTransactions,
 	"sendrawtransaction":     handleSendRawTransaction,
@@ -221,7 +243,6 @@ var rpcAskWallet = map[string]struct{}{
 	"getreceivedbyaccount":   {},
 	"getreceivedbyaddress":   {},
 	"gettransaction":         {},
-	"gettxoutsetinfo":        {},
 	"getunconfirmedbalance":  {},
 	"getwalletinfo":          {},
 	"importprivkey":          {},
@@ -252,10 +273,33 @@ var rpcAskWallet = map[string]struct{}{
 // Commands that are currently unimplemented, but should ultimately be.
 var rpcUnimplemented = map[string]struct{}{
 	"estimatepriority": {},
-	"getchaintips":     {},
-	"getmempoolentry":  {},
 	"getnetworkinfo":   {},
-	"preciousblock":    {},
+}
+
+// Commands that rely on full blocks, the UTXO set or the mempool and are
+// therefore unavailable when running in headers-only mode.
+var rpcHeadersOnlyUnavailable = map[string]struct{}{
+	"casting":                {},
+	"convert":                {},
+	"convertconfirm":         {},
+	"generate":               {},
+	"getblocktemplate":       {},
+	"getcommittee":           {},
+	"getconvertconfirmitems": {},
+	"getconvertitems":        {},
+	"getnextcommittee":       {},
+	"getpoolaccounting":      {},
+	"getstateinfo":           {},
+	"gettxout":               {},
+	"gettxoutsetinfo":        {},
+	"getwork":                {},
+	"getworktemplate":        {},
+	"sendrawtransaction":     {},
+	"setgenerate":            {},
+	"submitblock":            {},
+	"submitwork":             {},
+	"preciousblock":          {},
+	"verifychain":            {},
 }
 
 // Commands that are available to a limited user
@@ -274,12 +318,15 @@ var rpcLimited = map[string]struct{}{
 	"help": {},
 
 	// HTTP/S-only commands
+	"createpsbt":                   {},
 	"createrawtransaction":         {},
 	"createrawentangletransaction": {},
 	"beaconregistration":           {},
+	"decodepsbt":                   {},
 	"decoderawtransaction":         {},
 	"decodescript":                 {},
 	"estimatefee":                  {},
+	"finalizepsbt":                 {},
 	"getbestblock":                 {},
 	"getbestblockhash":             {},
 	"getblock":                     {},
@@ -287,6 +334,8 @@ var rpcLimited = map[string]struct{}{
 	"getblockhash":                 {},
 	"getblockheader":               {},
 	"getburntxinfo":                {},
+	"getchaintips":                 {},
+	"getcommittee":                 {},
 	"getcfilter":                   {},
 	"getcfilterheader":             {},
 	"getcurrentnet":                {},
@@ -297,11 +346,17 @@ var rpcLimited = map[string]struct{}{
 	"getworktemplate":              {},
 	"getentangleinfo":              {},
 	"getnettotals":                 {},
+	"getnextcommittee":             {},
 	"getnetworkhashps":             {},
+	"getmempoolancestors":          {},
+	"getmempooldescendants":        {},
+	"getmempoolentry":              {},
+	"getpoolaccounting":            {},
 	"getrawmempool":                {},
 	"getrawtransaction":            {},
 	"gettxout":                     {},
 	"gettxoutproof":                {},
+	"gettxoutsetinfo":              {},
 	"searchrawtransactions":        {},
 	"sendrawtransaction":           {},
 	"submitblock":                  {},
@@ -542,13 +597,15 @@ func messageToHex(msg wire.Message) (string, error) {
 	return hex.EncodeToString(buf.Bytes()), nil
 }
 
-// handleCreateRawTransaction handles createrawtransaction commands.
-func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
-	c := cmd.(*btcjson.CreateRawTransactionCmd)
+// createRawTransaction returns the unsigned transaction spending the passed
+// inputs and paying the passed amounts, as used by the createrawtransaction and
+// createpsbt commands.
+func createRawTransaction(s *rpcServer, inputs []btcjson.TransactionInput,
+	amounts map[string]float64, lockTime *int64) (*wire.MsgTx, error) {
 
 	// Validate the locktime, if given.
-	if c.LockTime != nil &&
-		(*c.LockTime < 0 || *c.LockTime > int64(wire.MaxTxInSequenceNum)) {
+	if lockTime != nil &&
+		(*lockTime < 0 || *lockTime > int64(wire.MaxTxInSequenceNum)) {
 		return nil, &btcjson.RPCError{
 			Code:    btcjson.ErrRPCInvalidParameter,
 			Message: "Locktime out of range",
@@ -558,7 +615,7 @@ func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan
 	// Add all transaction inputs to a new transaction after performing
 	// some validity checks.
 	mtx := wire.NewMsgTx(wire.TxVersion)
-	for _, input := range c.Inputs {
+	for _, input := range inputs {
 		txHash, err := chainhash.NewHashFromStr(input.Txid)
 		if err != nil {
 			return nil, rpcDecodeHexError(input.Txid)
@@ -566,7 +623,7 @@ func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan
 
 		prevOut := wire.NewOutPoint(txHash, input.Vout)
 		txIn := wire.NewTxIn(prevOut, []byte{})
-		if c.LockTime != nil && *c.LockTime != 0 {
+		if lockTime != nil && *lockTime != 0 {
 			txIn.Sequence = wire.MaxTxInSequenceNum - 1
 		}
 		mtx.AddTxIn(txIn)
@@ -575,7 +632,7 @@ func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan
 	// Add all transaction outputs to the transaction after performing
 	// some validity checks.
 	params := s.cfg.ChainParams
-	for encodedAddr, amount := range c.Amounts {
+	for encodedAddr, amount := range amounts {
 		// Ensure amount is in the valid range for monetary amounts.
 		if amount <= 0 || amount > czzutil.MaxSatoshi {
 			return nil, &btcjson.RPCError{
@@ -633,8 +690,68 @@ func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan
 	}
 
 	// Set the Locktime, if given.
-	if c.LockTime != nil {
-		mtx.LockTime = uint32(*c.LockTime)
+	if lockTime != nil {
+		mtx.LockTime = uint32(*lockTime)
+	}
+
+	return mtx, nil
+}
+
+// handleCreatePsbt handles createpsbt commands.
+func handleCreatePsbt(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.CreatePsbtCmd)
+
+	mtx, err := createRawTransaction(s, c.Inputs, c.Amounts, c.LockTime)
+	if err != nil {
+		return nil, err
+	}
+	packet, err := psbt.New(mtx)
+	if err != nil {
+		context := "Failed to create partially signed transaction"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	// Add the outputs spent by the inputs which are known to the mempool or
+	// the utxo set, since signers need their amounts to sign.  The others
+	// must be added by the parties spending them.
+	for i, txIn := range mtx.TxIn {
+		packet.Inputs[i].Utxo = fetchUnspentOutput(s, &txIn.PreviousOutPoint)
+	}
+
+	b64, err := packet.B64Encode()
+	if err != nil {
+		context := "Failed to encode partially signed transaction"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	return b64, nil
+}
+
+// fetchUnspentOutput returns the unspent output referenced by the passed
+// outpoint from the mempool or the utxo set, or nil when it is unknown or
+// spent.
+func fetchUnspentOutput(s *rpcServer, outpoint *wire.OutPoint) *wire.TxOut {
+	if tx, err := s.cfg.TxMemPool.FetchTransaction(&outpoint.Hash); err == nil {
+		mtx := tx.MsgTx()
+		if outpoint.Index >= uint32(len(mtx.TxOut)) {
+			return nil
+		}
+		return mtx.TxOut[outpoint.Index]
+	}
+
+	entry, err := s.cfg.Chain.FetchUtxoEntry(*outpoint)
+	if err != nil || entry == nil || entry.IsSpent() {
+		return nil
+	}
+	return wire.NewTxOut(entry.Amount(), entry.PkScript())
+}
+
+// handleCreateRawTransaction handles createrawtransaction commands.
+func handleCreateRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.CreateRawTransactionCmd)
+
+	mtx, err := createRawTransaction(s, c.Inputs, c.Amounts, c.LockTime)
+	if err != nil {
+		return nil, err
 	}
 
 	// Return the serialized and hex-encoded transaction.  Note that this
@@ -1579,6 +1696,13 @@ func handleConvertConfirm(s *rpcServer, cmd interface{}, closeChan <-chan struct
 			Index:       convert.Index,
 			Amount:      big.NewInt(int64(satoshi)),
 		}
+		for _, sig := range convert.Signatures {
+			sigBytes, err := hex.DecodeString(sig)
+			if err != nil {
+				return nil, rpcDecodeHexError(sig)
+			}
+			ct.Signatures = append(ct.Signatures, sigBytes)
+		}
 		ctByte, err := rlp.EncodeToBytes(ct)
 		scriptInfo, err := txscript.ConvertConfirmScript(ctByte)
 		if err != nil {
@@ -1970,6 +2094,140 @@ func createTxRawResult(chainParams *chaincfg.Params, mtx *wire.MsgTx,
 	return txReply, nil
 }
 
+// decodePsbt returns the partially signed transaction encoded in the passed
+// base64 string.
+func decodePsbt(b64 string) (*psbt.Packet, error) {
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(b64), true)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCDeserialization,
+			Message: "PSBT decode failed: " + err.Error(),
+		}
+	}
+	return packet, nil
+}
+
+// unknownsToMap returns the unknown key-value pairs of a partially signed
+// transaction as hex strings.
+func unknownsToMap(unknowns []*psbt.Unknown) map[string]string {
+	if len(unknowns) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(unknowns))
+	for _, u := range unknowns {
+		m[hex.EncodeToString(u.Key)] = hex.EncodeToString(u.Value)
+	}
+	return m
+}
+
+// sigHashTypeString returns the name of the passed signature hash type, such
+// as ALL|FORKID.
+func sigHashTypeString(hashType txscript.SigHashType) string {
+	var name string
+	switch hashType &^ (txscript.SigHashForkID | txscript.SigHashAnyOneCanPay) {
+	case txscript.SigHashAll:
+		name = "ALL"
+	case txscript.SigHashNone:
+		name = "NONE"
+	case txscript.SigHashSingle:
+		name = "SINGLE"
+	default:
+		return fmt.Sprintf("%#x", uint32(hashType))
+	}
+	if hashType&txscript.SigHashForkID != 0 {
+		name += "|FORKID"
+	}
+	if hashType&txscript.SigHashAnyOneCanPay != 0 {
+		name += "|ANYONECANPAY"
+	}
+	return name
+}
+
+// handleDecodePsbt handles decodepsbt commands.
+func handleDecodePsbt(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.DecodePsbtCmd)
+
+	packet, err := decodePsbt(c.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	mtx := packet.UnsignedTx
+	reply := btcjson.DecodePsbtResult{
+		Tx: btcjson.TxRawDecodeResult{
+			Txid:     mtx.TxHash().String(),
+			Version:  mtx.Version,
+			Locktime: mtx.LockTime,
+			Vin:      createVinList(mtx),
+			Vout:     createVoutList(mtx, s.cfg.ChainParams, nil),
+		},
+		Unknown: unknownsToMap(packet.Unknowns),
+		Inputs:  make([]btcjson.PsbtInputResult, len(packet.Inputs)),
+		Outputs: make([]btcjson.PsbtOutputResult, len(packet.Outputs)),
+	}
+	if reply.Unknown == nil {
+		reply.Unknown = make(map[string]string)
+	}
+
+	// The fee is only known when the amounts of all inputs are.
+	var inputTotal int64
+	feeKnown := true
+	for i := range packet.Inputs {
+		pi := &packet.Inputs[i]
+		input := &reply.Inputs[i]
+		if pi.Utxo != nil {
+			inputTotal += pi.Utxo.Value
+			input.Utxo = &btcjson.PsbtUtxoResult{
+				Amount:       czzutil.Amount(pi.Utxo.Value).ToCZZ(),
+				ScriptPubKey: scriptPubKeyResult(pi.Utxo.PkScript, s.cfg.ChainParams),
+			}
+		} else {
+			feeKnown = false
+		}
+		if len(pi.PartialSigs) > 0 {
+			input.PartialSignatures = make(map[string]string, len(pi.PartialSigs))
+			for _, ps := range pi.PartialSigs {
+				input.PartialSignatures[hex.EncodeToString(ps.PubKey)] =
+					hex.EncodeToString(ps.Signature)
+			}
+		}
+		if pi.SighashType != 0 {
+			input.SighashType = sigHashTypeString(pi.SighashType)
+		}
+		if pi.RedeemScript != nil {
+			result := decodeScript(pi.RedeemScript, s.cfg.ChainParams)
+			input.RedeemScript = &result
+		}
+		if pi.FinalScriptSig != nil {
+			disbuf, _ := txscript.DisasmString(pi.FinalScriptSig)
+			input.FinalScriptSig = &btcjson.ScriptSig{
+				Asm: disbuf,
+				Hex: hex.EncodeToString(pi.FinalScriptSig),
+			}
+		}
+		input.Unknown = unknownsToMap(pi.Unknowns)
+	}
+	for i := range packet.Outputs {
+		po := &packet.Outputs[i]
+		output := &reply.Outputs[i]
+		if po.RedeemScript != nil {
+			result := decodeScript(po.RedeemScript, s.cfg.ChainParams)
+			output.RedeemScript = &result
+		}
+		output.Unknown = unknownsToMap(po.Unknowns)
+	}
+
+	if feeKnown {
+		var outputTotal int64
+		for _, txOut := range mtx.TxOut {
+			outputTotal += txOut.Value
+		}
+		fee := czzutil.Amount(inputTotal - outputTotal).ToCZZ()
+		reply.Fee = &fee
+	}
+	return reply, nil
+}
+
 // handleDecodeRawTransaction handles decoderawtransaction commands.
 func handleDecodeRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.DecodeRawTransactionCmd)
@@ -2017,6 +2275,24 @@ func handleDecodeScript(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 		return nil, rpcDecodeHexError(hexStr)
 	}
 
+	// Convert the script itself to a pay-to-script-hash address.
+	p2sh, err := czzutil.NewAddressScriptHash(script, s.cfg.ChainParams)
+	if err != nil {
+		context := "Failed to convert script to pay-to-script-hash"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	// Generate and return the reply.
+	reply := decodeScript(script, s.cfg.ChainParams)
+	if reply.Type != txscript.ScriptHashTy.String() {
+		reply.P2sh = p2sh.EncodeAddress()
+	}
+	return reply, nil
+}
+
+// decodeScript returns the disassembly, type and addresses of the passed
+// script.
+func decodeScript(script []byte, params *chaincfg.Params) btcjson.DecodeScriptResult {
 	// The disassembled string will contain [error] inline if the script
 	// doesn't fully parse, so ignore the error here.
 	disbuf, _ := txscript.DisasmString(script)
@@ -2025,30 +2301,30 @@ func handleDecodeScript(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	// Ignore the error here since an error means the script couldn't parse
 	// and there is no additinal information about it anyways.
 	scriptClass, addrs, reqSigs, _ := txscript.ExtractPkScriptAddrs(script,
-		s.cfg.ChainParams)
+		params)
 	addresses := make([]string, len(addrs))
 	for i, addr := range addrs {
 		addresses[i] = addr.EncodeAddress()
 	}
 
-	// Convert the script itself to a pay-to-script-hash address.
-	p2sh, err := czzutil.NewAddressScriptHash(script, s.cfg.ChainParams)
-	if err != nil {
-		context := "Failed to convert script to pay-to-script-hash"
-		return nil, internalRPCError(err.Error(), context)
-	}
-
-	// Generate and return the reply.
-	reply := btcjson.DecodeScriptResult{
+	return btcjson.DecodeScriptResult{
 		Asm:       disbuf,
 		ReqSigs:   int32(reqSigs),
 		Type:      scriptClass.String(),
 		Addresses: addresses,
 	}
-	if scriptClass != txscript.ScriptHashTy {
-		reply.P2sh = p2sh.EncodeAddress()
+}
+
+// scriptPubKeyResult returns the description of the passed public key script.
+func scriptPubKeyResult(pkScript []byte, params *chaincfg.Params) btcjson.ScriptPubKeyResult {
+	decoded := decodeScript(pkScript, params)
+	return btcjson.ScriptPubKeyResult{
+		Asm:       decoded.Asm,
+		Hex:       hex.EncodeToString(pkScript),
+		ReqSigs:   decoded.ReqSigs,
+		Type:      decoded.Type,
+		Addresses: decoded.Addresses,
 	}
-	return reply, nil
 }
 
 // handleEstimateFee handles estimatefee commands.
@@ -2073,6 +2349,44 @@ func handleEstimateFee(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 	return float64(feeRate), nil
 }
 
+// handleFinalizePsbt handles finalizepsbt commands.
+func handleFinalizePsbt(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.FinalizePsbtCmd)
+
+	packet, err := decodePsbt(c.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	complete, err := packet.Finalize()
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCVerify,
+			Message: "PSBT finalization failed: " + err.Error(),
+		}
+	}
+
+	reply := btcjson.FinalizePsbtResult{Complete: complete}
+	if complete && (c.Extract == nil || *c.Extract) {
+		mtx, err := packet.Extract()
+		if err != nil {
+			context := "Failed to extract transaction"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		reply.Hex, err = messageToHex(mtx)
+		if err != nil {
+			return nil, err
+		}
+		return reply, nil
+	}
+
+	reply.Psbt, err = packet.B64Encode()
+	if err != nil {
+		context := "Failed to encode partially signed transaction"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	return reply, nil
+}
+
 // handleGenerate handles generate commands.
 func handleGenerate(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	// Respond with an error if there are no addresses to pay the
@@ -3357,6 +3671,80 @@ func handleGetBlockTemplate(s *rpcServer, cmd interface{}, closeChan <-chan stru
 	}
 }
 
+// handleGetChainTips implements the getchaintips command.
+func handleGetChainTips(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	tips := s.cfg.Chain.ChainTips()
+	results := make([]btcjson.GetChainTipsResult, 0, len(tips))
+	for _, tip := range tips {
+		results = append(results, btcjson.GetChainTipsResult{
+			Height:    tip.Height,
+			Hash:      tip.Hash.String(),
+			BranchLen: tip.BranchLen,
+			Status:    tip.Status.String(),
+		})
+	}
+	return results, nil
+}
+
+// committeeResult converts the passed committee to its RPC representation.
+// The staking amounts of the members are those of their pledges in the passed
+// committee state.
+func committeeResult(params *chaincfg.Params, cState *cross.CommitteeState, committee *cross.CommitteeInfo) *btcjson.GetCommitteeResult {
+	members := func(list []*cross.CommitteeMember) []btcjson.CommitteeMemberResult {
+		results := make([]btcjson.CommitteeMemberResult, 0, len(list))
+		for _, member := range list {
+			result := btcjson.CommitteeMemberResult{
+				Address:  member.CommitteeBase,
+				Coinbase: member.Coinbase,
+				PubKey:   hex.EncodeToString(member.Publickey),
+			}
+			pledge := cState.GetPledgeInfoByAddress(member.CommitteeBase)
+			if pledge != nil && pledge.StakingAmount != nil {
+				result.StakingAmount = pledge.StakingAmount.Int64()
+			}
+			results = append(results, result)
+		}
+		return results
+	}
+
+	return &btcjson.GetCommitteeResult{
+		Epoch:       committee.Id.Int64(),
+		StartHeight: committee.StartHeight.Int64(),
+		EndHeight:   committee.EndHeight.Int64(),
+		Threshold:   int32(committee.RequiredApprovals(params)),
+		Members:     members(committee.Members),
+		BackMembers: members(committee.BackMembers),
+	}
+}
+
+// handleGetCommittee implements the getcommittee command.
+func handleGetCommittee(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	params := s.cfg.ChainParams
+	height := s.cfg.Chain.BestSnapshot().Height
+	if !params.IsActive(chaincfg.UpgradeCommittee, height+1) {
+		return nil, nil
+	}
+
+	cState := s.cfg.Chain.CurrentCstate()
+	return committeeResult(params, cState,
+		cState.CurrentCommittee(params, height+1)), nil
+}
+
+// handleGetNextCommittee implements the getnextcommittee command.
+func handleGetNextCommittee(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	params := s.cfg.ChainParams
+	height := s.cfg.Chain.BestSnapshot().Height
+	if _, ok := params.Upgrades[chaincfg.UpgradeCommittee]; !ok ||
+		!params.IsActive(chaincfg.UpgradeMaui, height) {
+
+		return nil, nil
+	}
+
+	cState := s.cfg.Chain.CurrentCstate()
+	return committeeResult(params, cState,
+		cState.NextCommittee(params, height+1)), nil
+}
+
 // handleGetCFilter implements the getcfilter command.
 func handleGetCFilter(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	if s.cfg.CfIndex == nil {
@@ -3528,7 +3916,7 @@ func handleGetStateInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	c := cmd.(*btcjson.GetStateInfoCmd)
 	estate := s.cfg.Chain.CurrentCstate()
 	infos := make([]*btcjson.StateInfoChainResult, 0)
-	if s.cfg.Chain.BestSnapshot().Height < s.cfg.ChainParams.BeaconHeight {
+	if !s.cfg.ChainParams.IsActive(chaincfg.UpgradeBeacon, s.cfg.Chain.BestSnapshot().Height) {
 		return infos, nil
 	}
 
@@ -3848,7 +4236,7 @@ func handleConversionAddress(s *rpcServer, cmd interface{}, closeChan <-chan str
 		return nil, err
 	}
 
-	addrs := make(map[string]string)
+	addrs := &btcjson.ConversionAddressResult{}
 
 	dogeparams := &chaincfg.Params{
 		LegacyPubKeyHashAddrID: 0x1e,
@@ -3859,7 +4247,7 @@ func handleConversionAddress(s *rpcServer, cmd interface{}, closeChan <-chan str
 		e := fmt.Sprintf("doge addr err")
 		return nil, errors.New(e)
 	}
-	addrs["DOGE"] = addr1.String()
+	addrs.DOGE = addr1.String()
 
 	ltcparams := &chaincfg.Params{
 		LegacyPubKeyHashAddrID: 0x30,
@@ -3870,35 +4258,35 @@ func handleConversionAddress(s *rpcServer, cmd interface{}, closeChan <-chan str
 		e := fmt.Sprintf("LTC addr err")
 		return nil, errors.New(e)
 	}
-	addrs["LTC"] = addr2.String()
+	addrs.LTC = addr2.String()
 
 	addr3, err := czzutil.NewLegacyAddressPubKeyHash(czzaddr.ScriptAddress(), s.cfg.ChainParams)
 	if err != nil {
 		e := fmt.Sprintf("BTC addr err")
 		return nil, errors.New(e)
 	}
-	addrs["BTC"] = addr3.String()
+	addrs.BTC = addr3.String()
 
 	addr4, err := czzutil.NewLegacyAddressPubKeyHash(czzaddr.ScriptAddress(), s.cfg.ChainParams)
 	if err != nil {
 		e := fmt.Sprintf("BCH addr err")
 		return nil, errors.New(e)
 	}
-	addrs["BCH"] = addr4.String()
+	addrs.BCH = addr4.String()
 
 	addr5, err := czzutil.NewLegacyAddressPubKeyHash(czzaddr.ScriptAddress(), s.cfg.ChainParams)
 	if err != nil {
 		e := fmt.Sprintf("BSV addr err")
 		return nil, errors.New(e)
 	}
-	addrs["BSV"] = addr5.String()
+	addrs.BSV = addr5.String()
 
 	addr6, err := czzutil.NewLegacyAddressPubKeyHash(czzaddr.ScriptAddress(), s.cfg.ChainParams)
 	if err != nil {
 		e := fmt.Sprintf("USDT addr err")
 		return nil, errors.New(e)
 	}
-	addrs["USDT"] = addr6.String()
+	addrs.USDT = addr6.String()
 
 	return addrs, nil
 }
@@ -3918,7 +4306,7 @@ func handleGetWork(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (in
 	}
 
 	targetN := blockchain.CompactToBig(blockTemplate.Block.Header.Bits)
-	if blockTemplate.Height > s.cfg.ChainParams.BeaconHeight {
+	if s.cfg.ChainParams.IsActive(chaincfg.UpgradeBeacon, blockTemplate.Height-1) {
 		rsState := s.cfg.Chain.GetCommitteeVerify().Cache.LoadEntangleState(blockTemplate.Height-1, blockTemplate.Block.Header.PrevBlock)
 		script := blockTemplate.Block.Transactions[0].TxOut[0].PkScript
 		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(script, s.cfg.ChainParams)
@@ -3966,6 +4354,100 @@ func handleGetWorkTemplate(s *rpcServer, cmd interface{}, closeChan <-chan struc
 	return baseBlockReply, nil
 }
 
+// mempoolEntryResult converts a mempool entry to the result returned by the
+// getmempoolentry command.
+func mempoolEntryResult(entry *mempool.TxEntry) *btcjson.GetMempoolEntryResult {
+	fee := czzutil.Amount(entry.Fee).ToCZZ()
+	result := &btcjson.GetMempoolEntryResult{
+		Size:             int32(entry.Size),
+		Fee:              fee,
+		ModifiedFee:      fee,
+		Time:             entry.Added.Unix(),
+		Height:           int64(entry.Height),
+		StartingPriority: entry.StartingPriority,
+		CurrentPriority:  entry.CurrentPriority,
+		DescendantCount:  entry.DescendantCount,
+		DescendantSize:   entry.DescendantSize,
+		DescendantFees:   czzutil.Amount(entry.DescendantFees).ToCZZ(),
+		AncestorCount:    entry.AncestorCount,
+		AncestorSize:     entry.AncestorSize,
+		AncestorFees:     czzutil.Amount(entry.AncestorFees).ToCZZ(),
+		Depends:          make([]string, 0, len(entry.Depends)),
+		SpentBy:          make([]string, 0, len(entry.SpentBy)),
+		Type:             entry.Type.String(),
+	}
+	for _, hash := range entry.Depends {
+		result.Depends = append(result.Depends, hash.String())
+	}
+	for _, hash := range entry.SpentBy {
+		result.SpentBy = append(result.SpentBy, hash.String())
+	}
+	return result
+}
+
+// mempoolRelativesResult returns the hashes of the passed mempool entries, or
+// their full results keyed by hash when verbose is set.
+func mempoolRelativesResult(entries []*mempool.TxEntry, verbose *bool) interface{} {
+	if verbose != nil && *verbose {
+		result := make(map[string]*btcjson.GetMempoolEntryResult, len(entries))
+		for _, entry := range entries {
+			result[entry.Tx.Hash().String()] = mempoolEntryResult(entry)
+		}
+		return result
+	}
+
+	hashStrings := make([]string, len(entries))
+	for i, entry := range entries {
+		hashStrings[i] = entry.Tx.Hash().String()
+	}
+	return hashStrings
+}
+
+// handleGetMempoolAncestors implements the getmempoolancestors command.
+func handleGetMempoolAncestors(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetMempoolAncestorsCmd)
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+
+	entries, err := s.cfg.TxMemPool.TxAncestors(txHash)
+	if err != nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	return mempoolRelativesResult(entries, c.Verbose), nil
+}
+
+// handleGetMempoolDescendants implements the getmempooldescendants command.
+func handleGetMempoolDescendants(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetMempoolDescendantsCmd)
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+
+	entries, err := s.cfg.TxMemPool.TxDescendants(txHash)
+	if err != nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	return mempoolRelativesResult(entries, c.Verbose), nil
+}
+
+// handleGetMempoolEntry implements the getmempoolentry command.
+func handleGetMempoolEntry(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetMempoolEntryCmd)
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+
+	entry, err := s.cfg.TxMemPool.TxEntry(txHash)
+	if err != nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	return mempoolEntryResult(entry), nil
+}
+
 // handleGetMempoolInfo implements the getmempoolinfo command.
 func handleGetMempoolInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	mempoolTxns := s.cfg.TxMemPool.TxDescs()
@@ -4029,6 +4511,86 @@ func handleGetNetTotals(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	return reply, nil
 }
 
+// msgStatsResult converts per-command message counters to their JSON
+// representation and adds them to the passed totals.
+func msgStatsResult(stats map[string]peer.MsgStats, totals map[string]btcjson.NetMsgStatsResult) map[string]btcjson.NetMsgStatsResult {
+	result := make(map[string]btcjson.NetMsgStatsResult, len(stats))
+	for command, s := range stats {
+		result[command] = btcjson.NetMsgStatsResult{
+			Count: s.Count,
+			Bytes: s.Bytes,
+		}
+		total := totals[command]
+		total.Count += s.Count
+		total.Bytes += s.Bytes
+		totals[command] = total
+	}
+	return result
+}
+
+// latencyHistogramResult converts a relay latency histogram to its JSON
+// representation.
+func latencyHistogramResult(h *peer.LatencyHistogram) btcjson.LatencyHistogramResult {
+	result := btcjson.LatencyHistogramResult{
+		Count:        h.Count,
+		MeanMillis:   float64(h.Mean()) / float64(time.Millisecond),
+		BoundsMillis: make([]float64, 0, len(peer.LatencyBuckets)),
+		Counts:       h.Buckets,
+	}
+	for _, bound := range peer.LatencyBuckets {
+		result.BoundsMillis = append(result.BoundsMillis,
+			float64(bound)/float64(time.Millisecond))
+	}
+	return result
+}
+
+// handleGetNetMsgStats implements the getnetmsgstats command.
+func handleGetNetMsgStats(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetNetMsgStatsCmd)
+
+	reply := &btcjson.GetNetMsgStatsResult{
+		Sent:  make(map[string]btcjson.NetMsgStatsResult),
+		Recv:  make(map[string]btcjson.NetMsgStatsResult),
+		Peers: make([]btcjson.PeerNetMsgStatsResult, 0),
+	}
+	for _, p := range s.cfg.ConnMgr.ConnectedPeers() {
+		statsSnap := p.ToPeer().StatsSnapshot()
+		if c.PeerID != nil && *c.PeerID != statsSnap.ID {
+			continue
+		}
+		reply.Peers = append(reply.Peers, btcjson.PeerNetMsgStatsResult{
+			ID:           statsSnap.ID,
+			Addr:         statsSnap.Addr,
+			Inbound:      statsSnap.Inbound,
+			Sent:         msgStatsResult(statsSnap.MsgsSent, reply.Sent),
+			Recv:         msgStatsResult(statsSnap.MsgsRecv, reply.Recv),
+			BlockLatency: latencyHistogramResult(&statsSnap.BlockLatency),
+			TxLatency:    latencyHistogramResult(&statsSnap.TxLatency),
+		})
+	}
+	if c.PeerID != nil && len(reply.Peers) == 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("No connected peer with id %d", *c.PeerID),
+		}
+	}
+	return reply, nil
+}
+
+// blocksPerRetarget returns the number of blocks per retarget interval of the
+// passed chain parameters.  Networks without a retarget interval adjust the
+// difficulty with every block, so the interval is a single block for them.
+func blocksPerRetarget(params *chaincfg.Params) int32 {
+	if params.TargetTimePerBlock <= 0 {
+		return 1
+	}
+	blocks := int32(params.TargetTimespan / params.TargetTimePerBlock)
+	if blocks < 1 {
+		return 1
+	}
+	return blocks
+}
+
 // handleGetNetworkHashPS implements the getnetworkhashps command.
 func handleGetNetworkHashPS(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	// Note: All valid error return paths should return an int64.
@@ -4055,8 +4617,7 @@ func handleGetNetworkHashPS(s *rpcServer, cmd interface{}, closeChan <-chan stru
 
 	// Calculate the number of blocks per retarget interval based on the
 	// chain parameters.
-	blocksPerRetarget := int32(s.cfg.ChainParams.TargetTimespan /
-		s.cfg.ChainParams.TargetTimePerBlock)
+	blocksPerRetarget := blocksPerRetarget(s.cfg.ChainParams)
 
 	// Calculate the starting block height based on the passed number of
 	// blocks.  When the passed value is negative, use the last block the
@@ -4154,6 +4715,14 @@ func handleGetPeerInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 			FeeFilter:      p.FeeFilter(),
 			SyncNode:       statsSnap.ID == syncPeerID,
 		}
+		info.BytesSentPerMsg = make(map[string]uint64, len(statsSnap.MsgsSent))
+		for command, stats := range statsSnap.MsgsSent {
+			info.BytesSentPerMsg[command] = stats.Bytes
+		}
+		info.BytesRecvPerMsg = make(map[string]uint64, len(statsSnap.MsgsRecv))
+		for command, stats := range statsSnap.MsgsRecv {
+			info.BytesRecvPerMsg[command] = stats.Bytes
+		}
 		if p.ToPeer().LastPingNonce() != 0 {
 			wait := float64(time.Since(statsSnap.LastPingTime).Nanoseconds())
 			// We actually want microseconds.
@@ -4164,6 +4733,99 @@ func handleGetPeerInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 	return infos, nil
 }
 
+// handleGetPoolAccounting implements the getpoolaccounting command.
+func handleGetPoolAccounting(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetPoolAccountingCmd)
+	params := s.cfg.ChainParams
+	best := s.cfg.Chain.BestSnapshot().Height
+
+	// The range defaults to the blocks since the Maui upgrade.
+	maui := params.UpgradeHeight(chaincfg.UpgradeMaui)
+	start, end := maui, best
+	if c.StartHeight != nil {
+		start = *c.StartHeight
+	}
+	if c.EndHeight != nil {
+		end = *c.EndHeight
+	}
+	if start < maui || start > end || end > best {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCOutOfRange,
+			Message: fmt.Sprintf("Block range from %d to %d is not "+
+				"within %d and %d", start, end, maui, best),
+		}
+	}
+
+	// The flows of the range are those between the state before its first
+	// block and the one after its last block.
+	fetchState := func(height int32) (*cross.CommitteeState, error) {
+		hash, err := s.cfg.Chain.BlockHashByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		return s.cfg.Chain.FetchCommitteeState(hash, height)
+	}
+	var prev *cross.CommitteeState
+	if start > maui {
+		var err error
+		prev, err = fetchState(start - 1)
+		if err != nil {
+			return nil, internalRPCError(err.Error(), "Could not fetch "+
+				"committee state")
+		}
+	}
+	cur, err := fetchState(end)
+	if err != nil {
+		return nil, internalRPCError(err.Error(), "Could not fetch "+
+			"committee state")
+	}
+	pools, err := cross.PoolAccounting(params, prev, cur)
+	if err != nil {
+		return nil, internalRPCError(err.Error(), "Could not account pools")
+	}
+
+	result := &btcjson.GetPoolAccountingResult{
+		StartHeight: start,
+		EndHeight:   end,
+		Consistent:  true,
+		Pools:       make([]btcjson.PoolAccountingResult, 0, len(pools)),
+	}
+	for _, a := range pools {
+		mismatches := a.Mismatches()
+		if len(mismatches) != 0 {
+			result.Consistent = false
+		}
+		result.Pools = append(result.Pools, btcjson.PoolAccountingResult{
+			AssetType:       a.AssetType,
+			Address:         a.Address,
+			BurnedIn:        a.BurnedIn.Int64(),
+			PaidOut:         a.PaidOut.Int64(),
+			Fees:            a.Fees.Int64(),
+			CastIn:          a.CastIn.Int64(),
+			ConvertedIn:     a.ConvertedIn.Int64(),
+			MintedOut:       a.MintedOut.Int64(),
+			PendingItems:    a.PendingItems,
+			PendingAmount:   a.PendingAmount.Int64(),
+			StartBalance:    a.StartBalance.Int64(),
+			Balance:         a.Balance.Int64(),
+			ExpectedBalance: a.ExpectedBalance.Int64(),
+			Mismatches:      mismatches,
+		})
+	}
+	pledges := cross.PledgeAccounting(prev, cur)
+	result.Pledges = make([]btcjson.PledgeAccountingResult, 0, len(pledges))
+	for _, a := range pledges {
+		result.Pledges = append(result.Pledges, btcjson.PledgeAccountingResult{
+			ID:                 a.ID.Int64(),
+			Address:            a.Address,
+			StartStakingAmount: a.StartStakingAmount.Int64(),
+			StakingAmount:      a.StakingAmount.Int64(),
+			Locked:             a.Locked.Int64(),
+		})
+	}
+	return result, nil
+}
+
 // handleGetRawMempool implements the getrawmempool command.
 func handleGetRawMempool(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.GetRawMempoolCmd)
@@ -4409,6 +5071,27 @@ func handleGetTxOut(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (i
 	return txOutReply, nil
 }
 
+// handleGetTxOutSetInfo implements the gettxoutsetinfo command.
+func handleGetTxOutSetInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	info, err := s.cfg.Chain.UtxoSetInfo()
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: err.Error(),
+		}
+	}
+
+	return &btcjson.GetTxOutSetInfoResult{
+		Height:       info.Height,
+		BestBlock:    info.Hash.String(),
+		Transactions: info.TotalTxns,
+		TxOuts:       info.NumOutputs,
+		UtxoSetSize:  info.Size,
+		UtxoSetHash:  info.UtxoSetHash.String(),
+		TotalAmount:  czzutil.Amount(info.TotalAmount).ToCZZ(),
+	}, nil
+}
+
 // handleGetTxOutProof implements the gettxoutproof command.
 func handleGetTxOutProof(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.GetTxOutProofCmd)
@@ -4662,6 +5345,24 @@ func handleReconsiderBlock(s *rpcServer, cmd interface{}, closeChan <-chan struc
 	return nil, s.cfg.Chain.ReconsiderBlock(hash)
 }
 
+// handlePreciousBlock implements the preciousblock command.
+func handlePreciousBlock(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.PreciousBlockCmd)
+
+	hash, err := chainhash.NewHashFromStr(c.BlockHash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.BlockHash)
+	}
+
+	if err := s.cfg.Chain.PreciousBlock(hash); err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCDatabase,
+			Message: err.Error(),
+		}
+	}
+	return nil, nil
+}
+
 // handleHelp implements the help command.
 func handleHelp(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.HelpCmd)
@@ -5365,7 +6066,7 @@ func handleSubmitWork(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 
 	result := consensus.CZZhashFull(BlockHash[:], c.Nonce)
 	targetN := blockchain.CompactToBig(template.Block.Header.Bits)
-	if template.Height > s.cfg.ChainParams.BeaconHeight {
+	if s.cfg.ChainParams.IsActive(chaincfg.UpgradeBeacon, template.Height-1) {
 		rsState := s.cfg.Chain.GetCommitteeVerify().Cache.LoadEntangleState(template.Height-1, template.Block.Header.PrevBlock)
 		script := template.Block.Transactions[0].TxOut[0].PkScript
 		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(script, s.cfg.ChainParams)
@@ -5792,6 +6493,11 @@ func (s *rpcServer) standardCmdResult(cmd *parsedRPCCmd, closeChan <-chan struct
 	}
 	return nil, btcjson.ErrRPCMethodNotFound
 handled:
+	if s.cfg.Chain.HeadersOnly() {
+		if _, ok := rpcHeadersOnlyUnavailable[cmd.method]; ok {
+			return nil, ErrRPCHeadersOnly
+		}
+	}
 
 	return handler(s, cmd.cmd, closeChan)
 }
@@ -5884,83 +6590,43 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 	defer buf.Flush()
 	conn.SetReadDeadline(timeZeroVal)
 
-	// Attempt to parse the raw body into a JSON-RPC request.
-	var responseID interface{}
-	var jsonErr error
-	var result interface{}
-	var request btcjson.Request
-	if err := json.Unmarshal(body, &request); err != nil {
-		jsonErr = &btcjson.RPCError{
-			Code:    btcjson.ErrRPCParse.Code,
-			Message: "Failed to parse request: " + err.Error(),
-		}
-	}
-	if jsonErr == nil {
-		// The JSON-RPC 1.0 spec defines that notifications must have their "id"
-		// set to null and states that notifications do not have a response.
-		//
-		// A JSON-RPC 2.0 notification is a request with "json-rpc":"2.0", and
-		// without an "id" member. The specification states that notifications
-		// must not be responded to. JSON-RPC 2.0 permits the null value as a
-		// valid request id, therefore such requests are not notifications.
-		//
-		// Bitcoin Core serves requests with "id":null or even an absent "id",
-		// and responds to such requests with "id":null in the response.
-		//
-		// Classzz does not respond to any request without and "id" or "id":null,
-		// regardless the indicated JSON-RPC protocol version unless RPC quirks
-		// are enabled. With RPC quirks enabled, such requests will be responded
-		// to if the reqeust does not indicate JSON-RPC version.
-		//
-		// RPC quirks can be enabled by the user to avoid compatibility issues
-		// with software relying on Core's behavior.
-		if request.ID == nil && !(cfg.RPCQuirks && request.Jsonrpc == "") {
-			return
-		}
-
-		// The parse was at least successful enough to have an ID so
-		// set it for the response.
-		responseID = request.ID
-
-		// Setup a close notifier.  Since the connection is hijacked,
-		// the CloseNotifer on the ResponseWriter is not available.
-		closeChan := make(chan struct{}, 1)
-		go func() {
-			_, err := conn.Read(make([]byte, 1))
-			if err != nil {
-				close(closeChan)
-			}
-		}()
-
-		// Check if the user is limited and set error if method unauthorized
-		if !isAdmin {
-			if _, ok := rpcLimited[request.Method]; !ok {
-				jsonErr = &btcjson.RPCError{
-					Code:    btcjson.ErrRPCInvalidParams.Code,
-					Message: "limited user not authorized for this method",
-				}
-			}
+	// Setup a close notifier.  Since the connection is hijacked,
+	// the CloseNotifer on the ResponseWriter is not available.
+	closeChan := make(chan struct{}, 1)
+	go func() {
+		_, err := conn.Read(make([]byte, 1))
+		if err != nil {
+			close(closeChan)
 		}
+	}()
 
-		if jsonErr == nil {
-			// Attempt to parse the JSON-RPC request into a known concrete
-			// command.
-			parsedCmd := parseCmd(&request)
-			if parsedCmd.err != nil {
-				jsonErr = parsedCmd.err
-			} else {
-				result, jsonErr = s.standardCmdResult(parsedCmd, closeChan)
+	// A body holding a JSON array is a batch of requests, while anything
+	// else is handled as a single request.
+	var msg []byte
+	if trimmed := bytes.TrimLeft(body, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '[' {
+		msg, err = s.processBatch(body, isAdmin, closeChan)
+	} else {
+		var request btcjson.Request
+		if parseErr := json.Unmarshal(body, &request); parseErr != nil {
+			jsonErr := &btcjson.RPCError{
+				Code:    btcjson.ErrRPCParse.Code,
+				Message: "Failed to parse request: " + parseErr.Error(),
 			}
+			msg, err = createMarshalledReply(nil, nil, jsonErr)
+		} else {
+			msg, err = s.processRequest(&request, isAdmin, closeChan)
 		}
 	}
-
-	// Marshal the response.
-	msg, err := createMarshalledReply(responseID, result, jsonErr)
 	if err != nil {
 		rpcsLog.Errorf("Failed to marshal reply: %v", err)
 		return
 	}
 
+	// Notifications are not responded to.
+	if msg == nil {
+		return
+	}
+
 	// Write the response.
 	err = s.writeHTTPResponseHeaders(r, w.Header(), http.StatusOK, buf)
 	if err != nil {
@@ -5977,6 +6643,127 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 	}
 }
 
+// processRequest runs the passed JSON-RPC request and returns the marshalled
+// reply, which is nil for notifications.
+func (s *rpcServer) processRequest(request *btcjson.Request, isAdmin bool, closeChan <-chan struct{}) ([]byte, error) {
+	// The JSON-RPC 1.0 spec defines that notifications must have their "id"
+	// set to null and states that notifications do not have a response.
+	//
+	// A JSON-RPC 2.0 notification is a request with "json-rpc":"2.0", and
+	// without an "id" member. The specification states that notifications
+	// must not be responded to. JSON-RPC 2.0 permits the null value as a
+	// valid request id, therefore such requests are not notifications.
+	//
+	// Bitcoin Core serves requests with "id":null or even an absent "id",
+	// and responds to such requests with "id":null in the response.
+	//
+	// Classzz does not respond to any request without and "id" or "id":null,
+	// regardless the indicated JSON-RPC protocol version unless RPC quirks
+	// are enabled. With RPC quirks enabled, such requests will be responded
+	// to if the reqeust does not indicate JSON-RPC version.
+	//
+	// RPC quirks can be enabled by the user to avoid compatibility issues
+	// with software relying on Core's behavior.
+	if request.ID == nil && !(cfg.RPCQuirks && request.Jsonrpc == "") {
+		return nil, nil
+	}
+
+	// Check if the user is limited and set error if method unauthorized
+	var jsonErr error
+	var result interface{}
+	if !isAdmin {
+		if _, ok := rpcLimited[request.Method]; !ok {
+			jsonErr = &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidParams.Code,
+				Message: "limited user not authorized for this method",
+			}
+		}
+	}
+
+	if jsonErr == nil {
+		// Attempt to parse the JSON-RPC request into a known concrete
+		// command.
+		parsedCmd := parseCmd(request)
+		if parsedCmd.err != nil {
+			jsonErr = parsedCmd.err
+		} else {
+			result, jsonErr = s.standardCmdResult(parsedCmd, closeChan)
+		}
+	}
+
+	// Marshal the response.
+	return createMarshalledReply(request.ID, result, jsonErr)
+}
+
+// processBatch runs the requests of the passed JSON-RPC batch and returns the
+// marshalled array of their replies, in the order of the requests.  The
+// requests run concurrently, up to the maximum number of concurrent requests
+// allowed to a client, and each of them may fail independently of the others.
+// The reply is nil when all requests are notifications.
+func (s *rpcServer) processBatch(body []byte, isAdmin bool, closeChan <-chan struct{}) ([]byte, error) {
+	var batch []json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		jsonErr := &btcjson.RPCError{
+			Code:    btcjson.ErrRPCParse.Code,
+			Message: "Failed to parse batch: " + err.Error(),
+		}
+		return createMarshalledReply(nil, nil, jsonErr)
+	}
+	if len(batch) == 0 {
+		jsonErr := &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidRequest.Code,
+			Message: "Empty batch",
+		}
+		return createMarshalledReply(nil, nil, jsonErr)
+	}
+
+	replies := make([][]byte, len(batch))
+	errs := make([]error, len(batch))
+	sem := makeSemaphore(cfg.RPCMaxConcurrentReqs)
+	var wg sync.WaitGroup
+	for i := range batch {
+		var request btcjson.Request
+		if err := json.Unmarshal(batch[i], &request); err != nil {
+			jsonErr := &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidRequest.Code,
+				Message: "Failed to parse request: " + err.Error(),
+			}
+			replies[i], errs[i] = createMarshalledReply(nil, nil, jsonErr)
+			continue
+		}
+
+		sem.acquire()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer sem.release()
+			replies[i], errs[i] = s.processRequest(&request, isAdmin,
+				closeChan)
+		}(i)
+	}
+	wg.Wait()
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, reply := range replies {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if reply == nil {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(reply)
+	}
+	if buf.Len() == 1 {
+		return nil, nil
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
 // jsonAuthFail sends a message back to the client if the http auth is rejected.
 func jsonAuthFail(w http.ResponseWriter) {
 	w.Header().Add("WWW-Authenticate", `Basic realm="classzz RPC"`)
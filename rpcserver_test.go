@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg"
+)
+
+// TestBlocksPerRetarget ensures the retarget interval getnetworkhashps uses
+// for the blocks since the last difficulty change is counted in blocks of the
+// target time per block.
+func TestBlocksPerRetarget(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *chaincfg.Params
+		want   int32
+	}{
+		{"mainnet", &chaincfg.MainNetParams, 1},
+		{"regtest", &chaincfg.RegressionNetParams, 1},
+		{"testnet", &chaincfg.TestNetParams, 40320},
+		{"simnet", &chaincfg.SimNetParams, 40320},
+		{"no target time", &chaincfg.Params{
+			TargetTimespan: time.Hour,
+		}, 1},
+	}
+	for _, test := range tests {
+		if got := blocksPerRetarget(test.params); got != test.want {
+			t.Errorf("%s: got %d blocks, want %d", test.name, got,
+				test.want)
+		}
+	}
+}
@@ -44,6 +44,17 @@ var helpDescsEnUS = map[string]string{
 	"transactioninput-txid": "The hash of the input transaction",
 	"transactioninput-vout": "The specific output of the input transaction to redeem",
 
+	// CreatePsbtCmd help.
+	"createpsbt--synopsis": "Returns a new partially signed transaction spending the provided inputs and sending to the provided addresses.\n" +
+		"The spent outputs known to the mempool or the utxo set are added to the inputs so they can be signed offline.",
+	"createpsbt-inputs":         "The inputs to the transaction",
+	"createpsbt-amounts":        "JSON object with the destination addresses as keys and amounts as values",
+	"createpsbt-amounts--key":   "address",
+	"createpsbt-amounts--value": "n.nnn",
+	"createpsbt-amounts--desc":  "The destination address as the key and the amount in CZZ as the value",
+	"createpsbt-locktime":       "Locktime value; a non-zero value will also locktime-activate the inputs",
+	"createpsbt--result0":       "Base64-encoded partially signed transaction",
+
 	// CreateRawTransactionCmd help.
 	"createrawtransaction--synopsis": "Returns a new transaction spending the provided inputs and sending to the provided addresses.\n" +
 		"The transaction inputs are not signed in the created transaction.\n" +
@@ -136,7 +147,17 @@ var helpDescsEnUS = map[string]string{
 	"burnreportwhitelist-locktime":       "Locktime value; a non-zero value will also locktime-activate the inputs",
 	"burnreportwhitelist--result0":       "Hex-encoded bytes of the serialized transaction",
 
-	"conversionaddress--synopsis": "",
+	// ConversionAddressCmd help.
+	"conversionaddress--synopsis":      "Returns the addresses of the external chains which share the public key hash of a classzz address.",
+	"conversionaddress-classzzaddress": "The classzz address to convert",
+
+	// ConversionAddressResult help.
+	"conversionaddressresult-DOGE": "The Dogecoin address",
+	"conversionaddressresult-LTC":  "The Litecoin address",
+	"conversionaddressresult-BTC":  "The Bitcoin address",
+	"conversionaddressresult-BCH":  "The Bitcoin Cash address",
+	"conversionaddressresult-BSV":  "The Bitcoin SV address",
+	"conversionaddressresult-USDT": "The Omni USDT address",
 
 	// ScriptSig help.
 	"scriptsig-asm": "Disassembly of the script",
@@ -182,6 +203,46 @@ var helpDescsEnUS = map[string]string{
 	"txrawdecoderesult-vin":      "The transaction inputs as JSON objects",
 	"txrawdecoderesult-vout":     "The transaction outputs as JSON objects",
 
+	// PsbtUtxoResult help.
+	"psbtutxoresult-amount":       "The amount of the spent output in CZZ",
+	"psbtutxoresult-scriptPubKey": "The public key script of the spent output as a JSON object",
+
+	// PsbtInputResult help.
+	"psbtinputresult-utxo":            "The output spent by the input, if known",
+	"psbtinputresult-sighash":         "The signature hash type the signers must use, if not the default",
+	"psbtinputresult-redeem_script":   "The redeem script of a pay-to-script-hash input",
+	"psbtinputresult-final_scriptSig": "The signature script of a finalized input",
+
+	"psbtinputresult-partial_signatures":        "The signatures of the input",
+	"psbtinputresult-partial_signatures--key":   "pubkey",
+	"psbtinputresult-partial_signatures--value": "signature",
+	"psbtinputresult-partial_signatures--desc":  "The hex-encoded public key as the key and its hex-encoded signature as the value",
+	"psbtinputresult-unknown":                   "Unknown key-value pairs of the input",
+	"psbtinputresult-unknown--key":              "key",
+	"psbtinputresult-unknown--value":            "value",
+	"psbtinputresult-unknown--desc":             "The hex-encoded key as the key and the hex-encoded value as the value",
+
+	// PsbtOutputResult help.
+	"psbtoutputresult-redeem_script":  "The redeem script of a pay-to-script-hash output",
+	"psbtoutputresult-unknown":        "Unknown key-value pairs of the output",
+	"psbtoutputresult-unknown--key":   "key",
+	"psbtoutputresult-unknown--value": "value",
+	"psbtoutputresult-unknown--desc":  "The hex-encoded key as the key and the hex-encoded value as the value",
+
+	// DecodePsbtResult help.
+	"decodepsbtresult-tx":             "The unsigned transaction as a JSON object",
+	"decodepsbtresult-inputs":         "The data of the inputs",
+	"decodepsbtresult-outputs":        "The data of the outputs",
+	"decodepsbtresult-fee":            "The fee paid by the transaction in CZZ (only present if the outputs spent by all inputs are known)",
+	"decodepsbtresult-unknown":        "Unknown global key-value pairs",
+	"decodepsbtresult-unknown--key":   "key",
+	"decodepsbtresult-unknown--value": "value",
+	"decodepsbtresult-unknown--desc":  "The hex-encoded key as the key and the hex-encoded value as the value",
+
+	// DecodePsbtCmd help.
+	"decodepsbt--synopsis": "Returns a JSON object representing the provided base64-encoded partially signed transaction.",
+	"decodepsbt-psbt":      "Base64-encoded partially signed transaction",
+
 	// DecodeRawTransactionCmd help.
 	"decoderawtransaction--synopsis": "Returns a JSON object representing the provided serialized, hex-encoded transaction.",
 	"decoderawtransaction-hextx":     "Serialized, hex-encoded transaction",
@@ -206,6 +267,16 @@ var helpDescsEnUS = map[string]string{
 	"estimatefee--result0": "Estimated fee per kilobyte in satoshis for a block to " +
 		"be mined in the next NumBlocks blocks.",
 
+	// FinalizePsbtResult help.
+	"finalizepsbtresult-psbt":     "The base64-encoded partially signed transaction (only present if it is not extracted)",
+	"finalizepsbtresult-hex":      "The hex-encoded signed transaction (only present if it is complete and extracted)",
+	"finalizepsbtresult-complete": "Whether or not all inputs are finalized",
+
+	// FinalizePsbtCmd help.
+	"finalizepsbt--synopsis": "Builds the signature scripts of the inputs of a partially signed transaction which have enough signatures.",
+	"finalizepsbt-psbt":      "Base64-encoded partially signed transaction",
+	"finalizepsbt-extract":   "Return the signed transaction instead of the partially signed one when it is complete",
+
 	// GenerateCmd help
 	"generate--synopsis": "Generates a set number of blocks (simnet or regtest only) and returns a JSON\n" +
 		" array of their hashes.",
@@ -418,6 +489,36 @@ var helpDescsEnUS = map[string]string{
 	"getblocktemplate--condition2": "mode=proposal, accepted",
 	"getblocktemplate--result1":    "An error string which represents why the proposal was rejected or nothing if accepted",
 
+	// GetChainTipsResult help.
+	"getchaintipsresult-height":    "Height of the chain tip",
+	"getchaintipsresult-hash":      "Hex-encoded hash of the chain tip",
+	"getchaintipsresult-branchlen": "Number of blocks between the chain tip and the main chain (0 for the main chain)",
+	"getchaintipsresult-status":    "Status of the branch ending at the tip (active, valid-fork, valid-headers, headers-only or invalid)",
+
+	// GetChainTipsCmd help.
+	"getchaintips--synopsis": "Returns information about the tips of all known branches of the block tree, including the main chain.",
+
+	// CommitteeMemberResult help.
+	"committeememberresult-address":       "Address of the pledge of the member",
+	"committeememberresult-coinbase":      "First coinbase address of the pledge",
+	"committeememberresult-pubkey":        "Hex-encoded public key whose pay-to-pubkey-hash outputs the member signs ConvertConfirm transactions with",
+	"committeememberresult-stakingamount": "Current staking amount of the pledge in the smallest unit",
+
+	// GetCommitteeResult help.
+	"getcommitteeresult-epoch":       "Number of the committee epoch",
+	"getcommitteeresult-startheight": "Height of the first block of the epoch",
+	"getcommitteeresult-endheight":   "Height of the last block of the epoch",
+	"getcommitteeresult-threshold":   "Number of members which must sign a ConvertConfirm transaction",
+	"getcommitteeresult-members":     "Members of the committee ranked by stake",
+	"getcommitteeresult-backmembers": "Backup members ranked after the members, which don't sign",
+
+	// GetCommitteeCmd help.
+	"getcommittee--synopsis": "Returns the committee which must sign the ConvertConfirm transactions of the next block, or null when committees are not active for it.",
+
+	// GetNextCommitteeCmd help.
+	"getnextcommittee--synopsis": "Returns the committee the current pledges would elect for the epoch after the one of the next block, or for the first epoch before committees activate. " +
+		"The pledges can still change until the epoch starts.  Returns null when committees are not scheduled or before the Maui upgrade.",
+
 	// GetCFilterCmd help.
 	"getcfilter--synopsis":  "Returns a block's committed filter given its hash.",
 	"getcfilter-filtertype": "The type of filter to return (0=regular)",
@@ -503,6 +604,44 @@ var helpDescsEnUS = map[string]string{
 	// GetEntangleInfoCmd help.
 	"getentangleinfo--synopsis": "Returns a JSON object containing various Entangle info.",
 
+	// GetMempoolAncestorsCmd help.
+	"getmempoolancestors--synopsis":   "Returns the in-mempool ancestors of a transaction in the memory pool.",
+	"getmempoolancestors-txid":        "The hash of the transaction",
+	"getmempoolancestors-verbose":     "Returns JSON objects keyed by transaction hash when true or an array of transaction hashes when false",
+	"getmempoolancestors--condition0": "verbose=false",
+	"getmempoolancestors--condition1": "verbose=true",
+	"getmempoolancestors--result0":    "Array of transaction hashes",
+
+	// GetMempoolDescendantsCmd help.
+	"getmempooldescendants--synopsis":   "Returns the in-mempool descendants of a transaction in the memory pool.",
+	"getmempooldescendants-txid":        "The hash of the transaction",
+	"getmempooldescendants-verbose":     "Returns JSON objects keyed by transaction hash when true or an array of transaction hashes when false",
+	"getmempooldescendants--condition0": "verbose=false",
+	"getmempooldescendants--condition1": "verbose=true",
+	"getmempooldescendants--result0":    "Array of transaction hashes",
+
+	// GetMempoolEntryCmd help.
+	"getmempoolentry--synopsis": "Returns information about a transaction in the memory pool.",
+	"getmempoolentry-txid":      "The hash of the transaction",
+
+	// GetMempoolEntryResult help.
+	"getmempoolentryresult-size":             "Transaction size in bytes",
+	"getmempoolentryresult-fee":              "Transaction fee in bitcoins",
+	"getmempoolentryresult-modifiedfee":      "Transaction fee used for mining priority in bitcoins",
+	"getmempoolentryresult-time":             "Local time transaction entered pool in seconds since 1 Jan 1970 GMT",
+	"getmempoolentryresult-height":           "Block height when transaction entered the pool",
+	"getmempoolentryresult-startingpriority": "Priority when transaction entered the pool",
+	"getmempoolentryresult-currentpriority":  "Current priority",
+	"getmempoolentryresult-descendantcount":  "Number of in-mempool descendant transactions, including this one",
+	"getmempoolentryresult-descendantsize":   "Size in bytes of in-mempool descendants, including this one",
+	"getmempoolentryresult-descendantfees":   "Fees of in-mempool descendants in bitcoins, including this one",
+	"getmempoolentryresult-ancestorcount":    "Number of in-mempool ancestor transactions, including this one",
+	"getmempoolentryresult-ancestorsize":     "Size in bytes of in-mempool ancestors, including this one",
+	"getmempoolentryresult-ancestorfees":     "Fees of in-mempool ancestors in bitcoins, including this one",
+	"getmempoolentryresult-depends":          "Unconfirmed transactions used as inputs for this transaction",
+	"getmempoolentryresult-spentby":          "Unconfirmed transactions spending outputs of this transaction",
+	"getmempoolentryresult-type":             "The kind of the transaction (standard, convert, casting or mortgage)",
+
 	// GetMempoolInfoCmd help.
 	"getmempoolinfo--synopsis": "Returns memory pool information",
 
@@ -575,9 +714,90 @@ var helpDescsEnUS = map[string]string{
 	"getpeerinforesult-feefilter":      "The requested minimum fee a transaction must have to be announced to the peer",
 	"getpeerinforesult-syncnode":       "Whether or not the peer is the sync peer",
 
+	"getpeerinforesult-bytessent_per_msg":        "Total bytes sent by message command",
+	"getpeerinforesult-bytessent_per_msg--key":   "command",
+	"getpeerinforesult-bytessent_per_msg--value": "n",
+	"getpeerinforesult-bytessent_per_msg--desc":  "The message command as the key and the bytes sent as the value",
+	"getpeerinforesult-bytesrecv_per_msg":        "Total bytes received by message command",
+	"getpeerinforesult-bytesrecv_per_msg--key":   "command",
+	"getpeerinforesult-bytesrecv_per_msg--value": "n",
+	"getpeerinforesult-bytesrecv_per_msg--desc":  "The message command as the key and the bytes received as the value",
+
 	// GetPeerInfoCmd help.
 	"getpeerinfo--synopsis": "Returns data about each connected network peer as an array of json objects.",
 
+	// GetNetMsgStatsCmd help.
+	"getnetmsgstats--synopsis": "Returns the number and size of the messages sent and received by command as well as the block and transaction relay latencies of the connected peers.",
+	"getnetmsgstats-peerid":    "Only return the statistics of the peer with this id",
+
+	// GetNetMsgStatsResult help.
+	"getnetmsgstatsresult-sent":        "Messages sent to all returned peers by command",
+	"getnetmsgstatsresult-sent--key":   "command",
+	"getnetmsgstatsresult-sent--value": "{\"count\": n, \"bytes\": n}",
+	"getnetmsgstatsresult-sent--desc":  "The message command as the key and the number and total size of the messages as the value",
+	"getnetmsgstatsresult-recv":        "Messages received from all returned peers by command",
+	"getnetmsgstatsresult-recv--key":   "command",
+	"getnetmsgstatsresult-recv--value": "{\"count\": n, \"bytes\": n}",
+	"getnetmsgstatsresult-recv--desc":  "The message command as the key and the number and total size of the messages as the value",
+	"getnetmsgstatsresult-peers":       "The statistics of the individual peers",
+
+	// PeerNetMsgStatsResult help.
+	"peernetmsgstatsresult-id":           "A unique node ID",
+	"peernetmsgstatsresult-addr":         "The ip address and port of the peer",
+	"peernetmsgstatsresult-inbound":      "Whether or not the peer is an inbound connection",
+	"peernetmsgstatsresult-sent":         "Messages sent to the peer by command",
+	"peernetmsgstatsresult-sent--key":    "command",
+	"peernetmsgstatsresult-sent--value":  "{\"count\": n, \"bytes\": n}",
+	"peernetmsgstatsresult-sent--desc":   "The message command as the key and the number and total size of the messages as the value",
+	"peernetmsgstatsresult-recv":         "Messages received from the peer by command",
+	"peernetmsgstatsresult-recv--key":    "command",
+	"peernetmsgstatsresult-recv--value":  "{\"count\": n, \"bytes\": n}",
+	"peernetmsgstatsresult-recv--desc":   "The message command as the key and the number and total size of the messages as the value",
+	"peernetmsgstatsresult-blocklatency": "The latencies between requesting blocks from the peer and receiving them",
+	"peernetmsgstatsresult-txlatency":    "The latencies between requesting transactions from the peer and receiving them",
+
+	// LatencyHistogramResult help.
+	"latencyhistogramresult-count":    "The number of measured latencies",
+	"latencyhistogramresult-meanms":   "The mean latency in milliseconds",
+	"latencyhistogramresult-boundsms": "The inclusive upper bounds of the buckets in milliseconds",
+	"latencyhistogramresult-counts":   "The number of latencies per bucket followed by the number of latencies beyond the last bound",
+
+	// PoolAccountingResult help.
+	"poolaccountingresult-assettype":       "Asset type of the pool",
+	"poolaccountingresult-address":         "Address of the pool",
+	"poolaccountingresult-burnedin":        "Amount of the conversions from the asset type, whose tokens were burned on its external chain",
+	"poolaccountingresult-paidout":         "Part of the burned in amount converted to czz and paid out of the pool",
+	"poolaccountingresult-fees":            "Fees of the conversions from the asset type",
+	"poolaccountingresult-castin":          "Amount of czz cast into the pool",
+	"poolaccountingresult-convertedin":     "Amount of the conversions from other asset types credited to the pool after their fees",
+	"poolaccountingresult-mintedout":       "Amount of the conversions to the asset type confirmed to be minted on its external chain",
+	"poolaccountingresult-pendingitems":    "Number of conversions to the asset type waiting to be confirmed at the end of the range",
+	"poolaccountingresult-pendingamount":   "Amount of the conversions waiting to be confirmed",
+	"poolaccountingresult-startbalance":    "Balance of the pool before the first block of the range",
+	"poolaccountingresult-balance":         "Balance of the pool after the last block of the range",
+	"poolaccountingresult-expectedbalance": "Start balance plus the amounts cast and converted in minus the amount burned in",
+	"poolaccountingresult-mismatches":      "Inconsistencies of the pool, omitted when there are none",
+
+	// PledgeAccountingResult help.
+	"pledgeaccountingresult-id":                 "ID of the pledge",
+	"pledgeaccountingresult-address":            "Address of the pledge",
+	"pledgeaccountingresult-startstakingamount": "Staking amount before the first block of the range",
+	"pledgeaccountingresult-stakingamount":      "Staking amount after the last block of the range",
+	"pledgeaccountingresult-locked":             "Amount of the utxos locked for the pledge",
+
+	// GetPoolAccountingResult help.
+	"getpoolaccountingresult-startheight": "Height of the first block of the range",
+	"getpoolaccountingresult-endheight":   "Height of the last block of the range",
+	"getpoolaccountingresult-consistent":  "Whether the balances of all pools match their flows",
+	"getpoolaccountingresult-pools":       "Accounting of the pools ordered by asset type",
+	"getpoolaccountingresult-pledges":     "Accounting of the pledges ordered by ID",
+
+	// GetPoolAccountingCmd help.
+	"getpoolaccounting--synopsis": "Returns the flows of the cross-chain pools and the pledges over a range of blocks, along with a check of the pool balances against the flows. " +
+		"Amounts are in the smallest unit.",
+	"getpoolaccounting-startheight": "Height of the first block of the range, the Maui upgrade by default",
+	"getpoolaccounting-endheight":   "Height of the last block of the range, the best block by default",
+
 	// GetRawMempoolVerboseResult help.
 	"getrawmempoolverboseresult-size":             "Transaction size in bytes",
 	"getrawmempoolverboseresult-fee":              "Transaction fee in bitcoins",
@@ -617,6 +837,18 @@ var helpDescsEnUS = map[string]string{
 	"gettxout-vout":           "The index of the output",
 	"gettxout-includemempool": "Include the mempool when true",
 
+	// GetTxOutSetInfoResult help.
+	"gettxoutsetinforesult-height":       "The height of the best block",
+	"gettxoutsetinforesult-bestblock":    "The hash of the best block",
+	"gettxoutsetinforesult-transactions": "The number of transactions in the main chain",
+	"gettxoutsetinforesult-txouts":       "The number of unspent transaction outputs",
+	"gettxoutsetinforesult-utxosetsize":  "The size in bytes of the UTXO set serialized in the fast sync format",
+	"gettxoutsetinforesult-utxosethash":  "The ECMH of the UTXO set serialized in the fast sync format, as used by checkpoints",
+	"gettxoutsetinforesult-total_amount": "The total amount of the unspent transaction outputs in BTC",
+
+	// GetTxOutSetInfoCmd help.
+	"gettxoutsetinfo--synopsis": "Returns statistics about the unspent transaction output set and its rolling commitment.",
+
 	// GetTxOutProofCmd help.
 	"gettxoutproof--synopsis": "Returns hex encoded merkle proof for a given transaction set",
 	"gettxoutproof-txids":     "A list of transaction hashes to generate proof for",
@@ -667,6 +899,10 @@ var helpDescsEnUS = map[string]string{
 	"reconsiderblock--synopsis": "Reconsider a block for validation.",
 	"reconsiderblock-blockhash": "Hash of the block you want to reconsider",
 
+	// PreciousBlockCmd
+	"preciousblock--synopsis": "Treat a block as if it were received before others with the same work, making it the tip of the main chain when its branch has as much work as the main chain.",
+	"preciousblock-blockhash": "Hash of the block you want to mark as precious",
+
 	// InvalidateBlockCmd
 	"invalidateblock--synopsis": "Invalidate a block.",
 	"invalidateblock-blockhash": "Hash of the block you want to invalidate",
@@ -735,6 +971,14 @@ var helpDescsEnUS = map[string]string{
 	// StopNotifyNewTransactionsCmd help.
 	"stopnotifynewtransactions--synopsis": "Stop sending either a txaccepted or a txacceptedverbose notification when a new transaction is accepted into the mempool.",
 
+	// NotifyCrossEventsCmd help.
+	"notifycrossevents--synopsis": "Send a crossevent notification for every change of the committee state made by a block connected to or disconnected from the main (best) chain.\n" +
+		"The events of a disconnected block are sent from the last to the first with the removed flag set.",
+	"notifycrossevents-eventtypes": "The event types to be notified about (ConvertItemCreated, ConvertItemConfirmed, CastingCreated, PledgeChanged or PoolBalanceChanged), all of them when omitted",
+
+	// StopNotifyCrossEventsCmd help.
+	"stopnotifycrossevents--synopsis": "Stop sending crossevent notifications.",
+
 	// NotifyReceivedCmd help.
 	"notifyreceived--synopsis": "Send a recvtx notification when a transaction added to mempool or appears in a newly-attached block contains a txout pkScript sending to any of the passed addresses.\n" +
 		"Matching outpoints are automatically registered for redeemingtx notifications.",
@@ -805,6 +1049,7 @@ var helpDescsEnUS = map[string]string{
 // pointer to the type (or nil to indicate no return value).
 var rpcResultTypes = map[string][]interface{}{
 	"addnode":               nil,
+	"createpsbt":            {(*string)(nil)},
 	"createrawtransaction":  {(*string)(nil)},
 	"beaconregistration":    {(*string)(nil)},
 	"addbeaconpledge":       {(*string)(nil)},
@@ -814,9 +1059,11 @@ var rpcResultTypes = map[string][]interface{}{
 	"convert":               {(*string)(nil)},
 	"casting":               {(*string)(nil)},
 	"debuglevel":            {(*string)(nil), (*string)(nil)},
+	"decodepsbt":            {(*btcjson.DecodePsbtResult)(nil)},
 	"decoderawtransaction":  {(*btcjson.TxRawDecodeResult)(nil)},
 	"decodescript":          {(*btcjson.DecodeScriptResult)(nil)},
 	"estimatefee":           {(*float64)(nil)},
+	"finalizepsbt":          {(*btcjson.FinalizePsbtResult)(nil)},
 	"generate":              {(*[]string)(nil)},
 	"getaddednodeinfo":      {(*[]string)(nil), (*[]btcjson.GetAddedNodeInfoResult)(nil)},
 	"getbestblock":          {(*btcjson.GetBestBlockResult)(nil)},
@@ -827,6 +1074,8 @@ var rpcResultTypes = map[string][]interface{}{
 	"getblockheader":        {(*string)(nil), (*btcjson.GetBlockHeaderVerboseResult)(nil)},
 	"getblocktemplate":      {(*btcjson.GetBlockTemplateResult)(nil), (*string)(nil), nil},
 	"getblockchaininfo":     {(*btcjson.GetBlockChainInfoResult)(nil)},
+	"getchaintips":          {(*[]btcjson.GetChainTipsResult)(nil)},
+	"getcommittee":          {(*btcjson.GetCommitteeResult)(nil)},
 	"getcfilter":            {(*string)(nil)},
 	"getcfilterheader":      {(*string)(nil)},
 	"getconnectioncount":    {(*int32)(nil)},
@@ -838,19 +1087,28 @@ var rpcResultTypes = map[string][]interface{}{
 	"getinfo":               {(*btcjson.InfoChainResult)(nil)},
 	"getstateinfo":          {(*map[string]btcjson.BeaconAddressInfo)(nil)},
 	"getconvertitems":       {(*[]*btcjson.ConvertItemsResult)(nil)},
+	"getnextcommittee":      {(*btcjson.GetCommitteeResult)(nil)},
+	"conversionaddress":     {(*btcjson.ConversionAddressResult)(nil)},
+	"getmempoolancestors":   {(*[]string)(nil), (*btcjson.GetMempoolEntryResult)(nil)},
+	"getmempooldescendants": {(*[]string)(nil), (*btcjson.GetMempoolEntryResult)(nil)},
+	"getmempoolentry":       {(*btcjson.GetMempoolEntryResult)(nil)},
 	"getmempoolinfo":        {(*btcjson.GetMempoolInfoResult)(nil)},
 	"getmininginfo":         {(*btcjson.GetMiningInfoResult)(nil)},
+	"getnetmsgstats":        {(*btcjson.GetNetMsgStatsResult)(nil)},
 	"getnettotals":          {(*btcjson.GetNetTotalsResult)(nil)},
 	"getnetworkhashps":      {(*float64)(nil)},
 	"getpeerinfo":           {(*[]btcjson.GetPeerInfoResult)(nil)},
+	"getpoolaccounting":     {(*btcjson.GetPoolAccountingResult)(nil)},
 	"getrawmempool":         {(*[]string)(nil), (*btcjson.GetRawMempoolVerboseResult)(nil)},
 	"getrawtransaction":     {(*string)(nil), (*btcjson.TxRawResult)(nil)},
 	"gettxout":              {(*btcjson.GetTxOutResult)(nil)},
 	"gettxoutproof":         {(*string)(nil)},
+	"gettxoutsetinfo":       {(*btcjson.GetTxOutSetInfoResult)(nil)},
 	"node":                  nil,
 	"help":                  {(*string)(nil), (*string)(nil)},
 	"invalidateblock":       nil,
 	"ping":                  nil,
+	"preciousblock":         nil,
 	"reconsiderblock":       nil,
 	"searchrawtransactions": {(*string)(nil), (*[]btcjson.SearchRawTransactionsResult)(nil)},
 	"sendrawtransaction":    {(*string)(nil)},
@@ -871,6 +1129,8 @@ var rpcResultTypes = map[string][]interface{}{
 	"stopnotifyblocks":          nil,
 	"notifynewtransactions":     nil,
 	"stopnotifynewtransactions": nil,
+	"notifycrossevents":         nil,
+	"stopnotifycrossevents":     nil,
 	"notifyreceived":            nil,
 	"stopnotifyreceived":        nil,
 	"notifyspent":               nil,
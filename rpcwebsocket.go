@@ -27,6 +27,7 @@ import (
 	"github.com/classzz/classzz/btcjson"
 	"github.com/classzz/classzz/chaincfg"
 	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/cross"
 	"github.com/classzz/classzz/database"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/wire"
@@ -67,11 +68,13 @@ var wsHandlersBeforeInit = map[string]wsCommandHandler{
 	"loadtxfilter":              handleLoadTxFilter,
 	"help":                      handleWebsocketHelp,
 	"notifyblocks":              handleNotifyBlocks,
+	"notifycrossevents":         handleNotifyCrossEvents,
 	"notifynewtransactions":     handleNotifyNewTransactions,
 	"notifyreceived":            handleNotifyReceived,
 	"notifyspent":               handleNotifySpent,
 	"session":                   handleSession,
 	"stopnotifyblocks":          handleStopNotifyBlocks,
+	"stopnotifycrossevents":     handleStopNotifyCrossEvents,
 	"stopnotifynewtransactions": handleStopNotifyNewTransactions,
 	"stopnotifyspent":           handleStopNotifySpent,
 	"stopnotifyreceived":        handleStopNotifyReceived,
@@ -460,6 +463,11 @@ type notificationRegisterBlocks wsClient
 type notificationUnregisterBlocks wsClient
 type notificationRegisterNewMempoolTxs wsClient
 type notificationUnregisterNewMempoolTxs wsClient
+type notificationRegisterCrossEvents struct {
+	wsc        *wsClient
+	eventTypes map[string]struct{}
+}
+type notificationUnregisterCrossEvents wsClient
 type notificationRegisterSpent struct {
 	wsc *wsClient
 	ops []*wire.OutPoint
@@ -492,6 +500,7 @@ func (m *wsNotificationManager) notificationHandler() {
 	// since it is quite a bit more efficient than using the entire struct.
 	blockNotifications := make(map[chan struct{}]*wsClient)
 	txNotifications := make(map[chan struct{}]*wsClient)
+	crossNotifications := make(map[chan struct{}]*wsClient)
 	watchedOutPoints := make(map[wire.OutPoint]map[chan struct{}]*wsClient)
 	watchedAddrs := make(map[string]map[chan struct{}]*wsClient)
 
@@ -523,6 +532,11 @@ out:
 						block)
 				}
 
+				if len(crossNotifications) != 0 {
+					m.notifyCrossEvents(crossNotifications, block,
+						false)
+				}
+
 			case *notificationBlockDisconnected:
 				block := (*czzutil.Block)(n)
 
@@ -533,6 +547,11 @@ out:
 						block)
 				}
 
+				if len(crossNotifications) != 0 {
+					m.notifyCrossEvents(crossNotifications, block,
+						true)
+				}
+
 			case *notificationTxAcceptedByMempool:
 				if n.isNew && len(txNotifications) != 0 {
 					m.notifyForNewTx(txNotifications, n.tx)
@@ -558,6 +577,7 @@ out:
 				// the client itself.
 				delete(blockNotifications, wsc.quit)
 				delete(txNotifications, wsc.quit)
+				delete(crossNotifications, wsc.quit)
 				for k := range wsc.spentRequests {
 					op := k
 					m.removeSpentRequest(watchedOutPoints, wsc, &op)
@@ -587,6 +607,14 @@ out:
 				wsc := (*wsClient)(n)
 				delete(txNotifications, wsc.quit)
 
+			case *notificationRegisterCrossEvents:
+				n.wsc.crossEventTypes = n.eventTypes
+				crossNotifications[n.wsc.quit] = n.wsc
+
+			case *notificationUnregisterCrossEvents:
+				wsc := (*wsClient)(n)
+				delete(crossNotifications, wsc.quit)
+
 			default:
 				rpcsLog.Warn("Unhandled notification type")
 			}
@@ -820,6 +848,95 @@ func (m *wsNotificationManager) UnregisterNewMempoolTxsUpdates(wsc *wsClient) {
 	m.queueNotification <- (*notificationUnregisterNewMempoolTxs)(wsc)
 }
 
+// RegisterCrossEvents requests notifications to the passed websocket client
+// when connected or disconnected blocks change the committee state.  Only the
+// passed event types are notified, or all of them when it is empty.
+func (m *wsNotificationManager) RegisterCrossEvents(wsc *wsClient, eventTypes map[string]struct{}) {
+	m.queueNotification <- &notificationRegisterCrossEvents{
+		wsc:        wsc,
+		eventTypes: eventTypes,
+	}
+}
+
+// UnregisterCrossEvents removes committee state change notifications for the
+// passed websocket client.
+func (m *wsNotificationManager) UnregisterCrossEvents(wsc *wsClient) {
+	m.queueNotification <- (*notificationUnregisterCrossEvents)(wsc)
+}
+
+// crossEventResult converts a committee state change to its JSON-RPC result.
+func crossEventResult(event *cross.CommitteeEvent) btcjson.CrossEventResult {
+	result := btcjson.CrossEventResult{Type: event.Type.String()}
+	switch {
+	case event.Item != nil:
+		result.ConvertItem = &btcjson.ConvertItemsResult{
+			MID:              event.Item.ID,
+			AssetType:        event.AssetType,
+			ConvertType:      event.ConvertType,
+			PubKey:           event.Item.PubKey,
+			TxHash:           event.Item.TxHash,
+			ExtTxHash:        event.Item.ExtTxHash,
+			ConfirmExtTxHash: event.Item.ConfirmExtTxHash,
+			Amount:           event.Item.Amount,
+			FeeAmount:        event.Item.FeeAmount,
+			ToToken:          event.Item.ToToken,
+		}
+	case event.Pledge != nil:
+		result.Pledge = &btcjson.StateInfoChainResult{
+			ID:              event.Pledge.ID,
+			Address:         event.Pledge.Address,
+			ToAddress:       hex.EncodeToString(event.Pledge.ToAddress),
+			PubKey:          event.Pledge.PubKey,
+			StakingAmount:   event.Pledge.StakingAmount,
+			CoinBaseAddress: event.Pledge.CoinBaseAddress,
+		}
+	default:
+		result.PoolBalance = &btcjson.CrossPoolBalanceResult{
+			Address: event.Address,
+			Balance: czzutil.Amount(event.Balance.Int64()).ToCZZ(),
+		}
+	}
+	return result
+}
+
+// notifyCrossEvents notifies websocket clients that have registered for
+// committee state changes when a block is connected to or disconnected from
+// the main chain.  The events of a disconnected block are notified from the
+// last to the first with the removed flag set.
+func (m *wsNotificationManager) notifyCrossEvents(clients map[chan struct{}]*wsClient,
+	block *czzutil.Block, removed bool) {
+
+	events, err := m.server.cfg.Chain.CommitteeEvents(block)
+	if err != nil {
+		rpcsLog.Errorf("Failed to compute committee events of block "+
+			"%v: %v", block.Hash(), err)
+		return
+	}
+
+	for i := range events {
+		event := events[i]
+		if removed {
+			event = events[len(events)-1-i]
+		}
+		ntfn := btcjson.NewCrossEventNtfn(block.Hash().String(),
+			block.Height(), removed, crossEventResult(event))
+		marshalledJSON, err := btcjson.MarshalCmd(nil, ntfn)
+		if err != nil {
+			rpcsLog.Errorf("Failed to marshal cross event "+
+				"notification: %v", err)
+			return
+		}
+		for _, wsc := range clients {
+			if len(wsc.crossEventTypes) != 0 {
+				if _, ok := wsc.crossEventTypes[ntfn.Event.Type]; !ok {
+					continue
+				}
+			}
+			wsc.QueueNotification(marshalledJSON)
+		}
+	}
+}
+
 // notifyForNewTx notifies websocket clients that have registered for updates
 // when a new transaction is added to the memory pool.
 func (m *wsNotificationManager) notifyForNewTx(clients map[chan struct{}]*wsClient, tx *czzutil.Tx) {
@@ -1278,6 +1395,11 @@ type wsClient struct {
 	// information about all new transactions.
 	verboseTxUpdates bool
 
+	// crossEventTypes is the set of committee event types the client has
+	// requested to be notified about, or empty for all of them.  Owned by
+	// the notification manager.
+	crossEventTypes map[string]struct{}
+
 	// addrRequests is a set of addresses the caller has requested to be
 	// notified about.  It is maintained here so all requests can be removed
 	// when a wallet disconnects.  Owned by the notification manager.
@@ -1833,6 +1955,49 @@ func handleNotifyBlocks(wsc *wsClient, icmd interface{}) (interface{}, error) {
 	return nil, nil
 }
 
+// handleNotifyCrossEvents implements the notifycrossevents command extension
+// for websocket connections.
+func handleNotifyCrossEvents(wsc *wsClient, icmd interface{}) (interface{}, error) {
+	cmd, ok := icmd.(*btcjson.NotifyCrossEventsCmd)
+	if !ok {
+		return nil, btcjson.ErrRPCInternal
+	}
+
+	eventTypes := make(map[string]struct{})
+	if cmd.EventTypes != nil {
+		for _, eventType := range *cmd.EventTypes {
+			if !isCrossEventType(eventType) {
+				return nil, &btcjson.RPCError{
+					Code:    btcjson.ErrRPCInvalidParameter,
+					Message: "Unknown cross event type: " + eventType,
+				}
+			}
+			eventTypes[eventType] = struct{}{}
+		}
+	}
+
+	wsc.server.ntfnMgr.RegisterCrossEvents(wsc, eventTypes)
+	return nil, nil
+}
+
+// isCrossEventType returns whether the passed string names a committee event
+// type.
+func isCrossEventType(eventType string) bool {
+	for t := cross.EventConvertItemCreated; t <= cross.EventPoolBalanceChanged; t++ {
+		if t.String() == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// handleStopNotifyCrossEvents implements the stopnotifycrossevents command
+// extension for websocket connections.
+func handleStopNotifyCrossEvents(wsc *wsClient, icmd interface{}) (interface{}, error) {
+	wsc.server.ntfnMgr.UnregisterCrossEvents(wsc)
+	return nil, nil
+}
+
 // handleSession implements the session command extension for websocket
 // connections.
 func handleSession(wsc *wsClient, icmd interface{}) (interface{}, error) {
@@ -34,11 +34,13 @@ import (
 	"github.com/classzz/classzz/chaincfg/chainhash"
 	"github.com/classzz/classzz/connmgr"
 	"github.com/classzz/classzz/database"
+	"github.com/classzz/classzz/graphene"
 	"github.com/classzz/classzz/mempool"
 	"github.com/classzz/classzz/mining"
 	"github.com/classzz/classzz/mining/cpuminer"
 	"github.com/classzz/classzz/netsync"
 	"github.com/classzz/classzz/peer"
+	"github.com/classzz/classzz/txrecon"
 	"github.com/classzz/classzz/txscript"
 	"github.com/classzz/classzz/version"
 	"github.com/classzz/classzz/wire"
@@ -49,7 +51,8 @@ import (
 const (
 	// defaultServices describes the default services that are supported by
 	// the server.
-	defaultServices = wire.SFNodeNetwork | wire.SFNodeBloom | wire.SFNodeCF
+	defaultServices = wire.SFNodeNetwork | wire.SFNodeBloom | wire.SFNodeCF |
+		wire.SFNodeXthin | wire.SFNodeTxRecon
 
 	// defaultRequiredServices describes the default services that are
 	// required to be supported by outbound peers.
@@ -67,6 +70,16 @@ const (
 	// than necessary. For this reason we cap the number of peers we
 	// allow to send us blocks directly at three.
 	maxDirectRelayPeers = 3
+
+	// maxTxFloodPeers is the maximum number of outbound peers transactions
+	// are still flooded to when they support transaction reconciliation.
+	// Flooding to a few peers keeps transactions propagating quickly while
+	// all other peers learn about them in the reconciliation rounds.
+	maxTxFloodPeers = 2
+
+	// txReconInterval is the interval at which reconciliation rounds are
+	// initiated with outbound peers.
+	txReconInterval = 8 * time.Second
 )
 
 var (
@@ -178,6 +191,7 @@ type peerState struct {
 	outboundPeers    map[int32]*serverPeer
 	persistentPeers  map[int32]*serverPeer
 	directRelayPeers map[int32]*serverPeer
+	txFloodPeers     map[int32]struct{}
 	banned           map[string]time.Time
 	outboundGroups   map[string]int
 	connectionCount  map[string]int
@@ -194,6 +208,23 @@ func (ps *peerState) CountIP(host string) int {
 	return ps.connectionCount[host]
 }
 
+// isTxFloodPeer returns whether or not transactions are flooded to the passed
+// peer that supports transaction reconciliation.  The first maxTxFloodPeers
+// outbound peers asking are selected as flood peers.
+func (ps *peerState) isTxFloodPeer(sp *serverPeer) bool {
+	if sp.Inbound() {
+		return false
+	}
+	if _, ok := ps.txFloodPeers[sp.ID()]; ok {
+		return true
+	}
+	if len(ps.txFloodPeers) < maxTxFloodPeers {
+		ps.txFloodPeers[sp.ID()] = struct{}{}
+		return true
+	}
+	return false
+}
+
 // forAllOutboundPeers is a helper function that runs closure on all outbound
 // peers known to peerState.
 func (ps *peerState) forAllOutboundPeers(closure func(sp *serverPeer)) {
@@ -308,6 +339,8 @@ type serverPeer struct {
 	relayMtx        sync.Mutex
 	processBlockMtx sync.Mutex
 	disableRelayTx  bool
+	txRecon         *txrecon.Reconciler
+	txReconSalt     uint64
 	sentAddrs       bool
 	isWhitelisted   bool
 	filter          *bloom.Filter
@@ -326,6 +359,7 @@ type serverPeer struct {
 // newServerPeer returns a new serverPeer instance. The peer needs to be set by
 // the caller.
 func newServerPeer(s *server, isPersistent bool) *serverPeer {
+	txReconSalt, _ := wire.RandomUint64()
 	return &serverPeer{
 		server:          s,
 		persistent:      isPersistent,
@@ -335,6 +369,7 @@ func newServerPeer(s *server, isPersistent bool) *serverPeer {
 		txProcessed:     make(chan struct{}, 1),
 		blockProcessed:  make(chan struct{}, 1),
 		recvSubscribers: make(map[spMsgSubscription]struct{}),
+		txReconSalt:     txReconSalt,
 	}
 }
 
@@ -382,6 +417,25 @@ func (sp *serverPeer) relayTxDisabled() bool {
 	return isDisabled
 }
 
+// setTxReconciler sets the transaction reconciler used for the given peer.
+// It is safe for concurrent access.
+func (sp *serverPeer) setTxReconciler(r *txrecon.Reconciler) {
+	sp.relayMtx.Lock()
+	sp.txRecon = r
+	sp.relayMtx.Unlock()
+}
+
+// txReconciler returns the transaction reconciler used for the given peer or
+// nil when transactions are not reconciled with it.
+// It is safe for concurrent access.
+func (sp *serverPeer) txReconciler() *txrecon.Reconciler {
+	sp.relayMtx.Lock()
+	r := sp.txRecon
+	sp.relayMtx.Unlock()
+
+	return r
+}
+
 // pushAddrMsg sends an addr message to the connected peer using the provided
 // addresses.
 func (sp *serverPeer) pushAddrMsg(addresses []*wire.NetAddress) {
@@ -558,14 +612,158 @@ func (sp *serverPeer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) *wire.MsgRej
 	sendCmpctMessage := wire.NewMsgSendCmpct(announce, wire.CompactBlocksProtocolVersion)
 	sp.Peer.QueueMessage(sendCmpctMessage, nil)
 
+	// Offer transaction reconciliation when both sides support it and
+	// relay transactions.
+	if sp.wantsTxRecon(msg.Services) && !msg.DisableRelayTx {
+		xVersion := wire.NewMsgXVersion()
+		xVersion.XVersionMap[wire.XVersionKeyTxRecon] =
+			encodeTxReconXVersion(txrecon.ProtocolVersion, sp.txReconSalt)
+		sp.QueueMessage(xVersion, nil)
+	}
+
 	return nil
 }
 
-// OnXVersion is invoked when a peer receives an xversion message.
+// wantsTxRecon returns whether or not transactions should be reconciled with
+// a peer advertising the passed services.
+func (sp *serverPeer) wantsTxRecon(services wire.ServiceFlag) bool {
+	return sp.server.services&wire.SFNodeTxRecon == wire.SFNodeTxRecon &&
+		hasServices(services, wire.SFNodeTxRecon)
+}
+
+// encodeTxReconXVersion returns the value of the transaction reconciliation
+// xversion entry made up of the protocol version and the salt for the short
+// IDs.
+func encodeTxReconXVersion(version uint32, salt uint64) []byte {
+	value := make([]byte, 12)
+	binary.LittleEndian.PutUint32(value[:4], version)
+	binary.LittleEndian.PutUint64(value[4:], salt)
+	return value
+}
+
+// OnXVersion is invoked when a peer receives an xversion message.  It sets up
+// transaction reconciliation with the peer when both sides offered it.
 func (sp *serverPeer) OnXVersion(_ *peer.Peer, msg *wire.MsgXVersion) {
+	value, ok := msg.XVersionMap[wire.XVersionKeyTxRecon]
+	if ok && len(value) >= 12 && sp.wantsTxRecon(sp.Services()) &&
+		!sp.relayTxDisabled() && sp.txReconciler() == nil {
+
+		version := binary.LittleEndian.Uint32(value[:4])
+		remoteSalt := binary.LittleEndian.Uint64(value[4:12])
+		if version >= txrecon.ProtocolVersion {
+			r := txrecon.NewReconciler(sp.txReconSalt, remoteSalt,
+				!sp.Inbound())
+			sp.setTxReconciler(r)
+			if r.IsInitiator() {
+				go sp.txReconHandler(r)
+			}
+			peerLog.Debugf("Reconciling transactions with peer %v", sp)
+		}
+	}
+
 	sp.Peer.QueueMessage(wire.NewMsgXVerAck(), nil)
 }
 
+// txReconHandler periodically initiates transaction reconciliation rounds with
+// the peer until it disconnects.  It must be run as a goroutine.
+func (sp *serverPeer) txReconHandler(r *txrecon.Reconciler) {
+	ticker := time.NewTicker(txReconInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if msg := r.RequestReconciliation(); msg != nil {
+				sp.QueueMessage(msg, nil)
+			}
+
+		case <-sp.quit:
+			return
+		}
+	}
+}
+
+// announceReconciledTxs queues inventory for the passed transactions which
+// the peer lacks according to a reconciliation round.  Transactions that left
+// the mempool in the meantime are skipped.
+func (sp *serverPeer) announceReconciledTxs(txHashes []chainhash.Hash) {
+	for i := range txHashes {
+		if !sp.server.txMemPool.HaveTransaction(&txHashes[i]) {
+			continue
+		}
+		sp.QueueInventory(wire.NewInvVect(wire.InvTypeTx, &txHashes[i]))
+	}
+}
+
+// OnReqRecon is invoked when a peer receives a reqrecon bitcoin message.  It
+// replies with a sketch of the transactions queued for the peer.
+func (sp *serverPeer) OnReqRecon(_ *peer.Peer, msg *wire.MsgReqRecon) {
+	r := sp.txReconciler()
+	if r == nil {
+		peerLog.Debugf("Peer %v sent unexpected reqrecon -- "+
+			"disconnecting", sp)
+		sp.Disconnect()
+		return
+	}
+
+	sketch, err := r.HandleReqRecon(msg)
+	if err != nil {
+		peerLog.Debugf("Unable to handle reqrecon from %v: %v -- "+
+			"disconnecting", sp, err)
+		sp.Disconnect()
+		return
+	}
+	sp.QueueMessage(sketch, nil)
+}
+
+// OnSketch is invoked when a peer receives a sketch bitcoin message.  It
+// concludes the reconciliation round by announcing the transactions the peer
+// lacks and asking for the ones it has beyond the local set.
+func (sp *serverPeer) OnSketch(_ *peer.Peer, msg *wire.MsgSketch) {
+	r := sp.txReconciler()
+	if r == nil {
+		peerLog.Debugf("Peer %v sent unexpected sketch -- "+
+			"disconnecting", sp)
+		sp.Disconnect()
+		return
+	}
+
+	announce, diff, err := r.HandleSketch(msg)
+	if err != nil {
+		peerLog.Debugf("Unable to handle sketch from %v: %v -- "+
+			"disconnecting", sp, err)
+		sp.Disconnect()
+		return
+	}
+	if !diff.Success {
+		peerLog.Debugf("Failed to reconcile transactions with %v, "+
+			"announcing %d transactions", sp, len(announce))
+	}
+	sp.QueueMessage(diff, nil)
+	sp.announceReconciledTxs(announce)
+}
+
+// OnReconcilDiff is invoked when a peer receives a reconcildiff bitcoin
+// message.  It announces the transactions the peer asked for.
+func (sp *serverPeer) OnReconcilDiff(_ *peer.Peer, msg *wire.MsgReconcilDiff) {
+	r := sp.txReconciler()
+	if r == nil {
+		peerLog.Debugf("Peer %v sent unexpected reconcildiff -- "+
+			"disconnecting", sp)
+		sp.Disconnect()
+		return
+	}
+
+	announce, err := r.HandleReconcilDiff(msg)
+	if err != nil {
+		peerLog.Debugf("Unable to handle reconcildiff from %v: %v -- "+
+			"disconnecting", sp, err)
+		sp.Disconnect()
+		return
+	}
+	sp.announceReconciledTxs(announce)
+}
+
 // OnMemPool is invoked when a peer receives a mempool bitcoin message.
 // It creates and sends an inventory message with the contents of the memory
 // pool up to the maximum inventory allowed per message.  When the peer has a
@@ -719,6 +917,11 @@ func (sp *serverPeer) OnCmpctBlock(_ *peer.Peer, msg *wire.MsgCmpctBlock) {
 	go sp.processComapactBlock(msg)
 }
 
+// OnGrapheneBlock is invoked when a peer receives a grblk bitcoin message.
+func (sp *serverPeer) OnGrapheneBlock(_ *peer.Peer, msg *wire.MsgGrapheneBlock) {
+	go sp.processGrapheneBlock(msg)
+}
+
 // processComapactBlock attempts to reconstruct a full wire.MsgBlock from
 // a wire.MsgCmpctBlock. This may require making another round trip to the
 // peer to retrieve any missing transactions. Thus you can expect this
@@ -739,77 +942,145 @@ func (sp *serverPeer) processComapactBlock(msg *wire.MsgCmpctBlock) {
 		sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
 		return
 	}
-	script := msgBlock.Transactions[0].TxOut[0].PkScript
-	_, addrs, _, _ := txscript.ExtractPkScriptAddrs(script, sp.server.chainParams)
-	//(b *BlockChain) CheckBlockHeaderContext(header *wire.BlockHeader, height int32, addr czzutil.Address) error
-	if err := sp.server.chain.CheckBlockHeaderContext(&msg.Header, addrs[0]); err != nil { //传下  block
-		peerLog.Debugf("Ignoring cmpctblock %v from %v -- "+
-			"invalid header: %v", msg.Header.BlockHash(), sp, err)
+	if !sp.checkDecodedBlockHeader(msgBlock, "cmpctblock") {
+		return
+	}
+	if !sp.fetchBlockTxns(msgBlock, "cmpctblock") {
+		return
+	}
+
+	// Relay the block to peers which want direct relay.
+	sp.server.relayCmpctBlock <- msg
+
+	sp.queueDecodedBlock(msgBlock)
+}
+
+// processGrapheneBlock attempts to reconstruct a full wire.MsgBlock from a
+// wire.MsgGrapheneBlock.  Like for compact blocks this may require another
+// round trip to the peer to retrieve the transactions that could not be
+// recovered from the mempool, so it should be run in a separate goroutine.
+func (sp *serverPeer) processGrapheneBlock(msg *wire.MsgGrapheneBlock) {
+	targetHash := msg.BlockHash()
+	msgBlock, err := sp.server.txMemPool.DecodeCompressedBlock(msg)
+	if err != nil {
+		peerLog.Debugf("Error decoding grapheneblock %v from %v: %v",
+			targetHash, sp, err)
 		sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
 		return
 	}
-	msgGetBlockTxns := wire.NewMsgGetBlockTxnsFromBlock(msgBlock)
+	if !sp.checkDecodedBlockHeader(msgBlock, "grapheneblock") {
+		return
+	}
+	if !sp.fetchBlockTxns(msgBlock, "grapheneblock") {
+		return
+	}
+	sp.queueDecodedBlock(msgBlock)
+}
 
-	if len(msgGetBlockTxns.Indexes) > 0 {
-		quitChan := make(chan struct{})
-		msgChan := make(chan spMsg)
-		subscription := spMsgSubscription{
-			command:  wire.CmdBlockTxns,
-			quitChan: quitChan,
-			msgChan:  msgChan,
-		}
-		sp.subscribeRecvMsg(subscription)
-		sp.QueueMessage(msgGetBlockTxns, nil)
-		timeout := time.After(time.Second * 30)
-		select {
-		case <-timeout:
-			sp.unsubscribeRecvMsgs(subscription)
-			close(quitChan)
-			peerLog.Debugf("Peer %v timed out waiting for blocktxns for cmpctblock %v",
-				sp, msg.Header.BlockHash())
+// checkDecodedBlockHeader checks the header of a block decoded from a
+// compressed block message against the coinbase of the block.  The coinbase
+// is always sent along with the compressed block.  The returned bool is false
+// when the block must be ignored.
+func (sp *serverPeer) checkDecodedBlockHeader(msgBlock *wire.MsgBlock, kind string) bool {
+	targetHash := msgBlock.BlockHash()
+	coinbase := msgBlock.Transactions[0]
+	if coinbase == nil || len(coinbase.TxOut) == 0 {
+		peerLog.Debugf("Ignoring %s %v from %v -- missing coinbase",
+			kind, targetHash, sp)
+		sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+		return false
+	}
+	script := coinbase.TxOut[0].PkScript
+	_, addrs, _, _ := txscript.ExtractPkScriptAddrs(script, sp.server.chainParams)
+	if len(addrs) == 0 {
+		peerLog.Debugf("Ignoring %s %v from %v -- no coinbase address",
+			kind, targetHash, sp)
+		sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+		return false
+	}
+	if err := sp.server.chain.CheckBlockHeaderContext(&msgBlock.Header, addrs[0]); err != nil {
+		peerLog.Debugf("Ignoring %s %v from %v -- "+
+			"invalid header: %v", kind, targetHash, sp, err)
+		sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+		return false
+	}
+	return true
+}
+
+// fetchBlockTxns requests the transactions missing from a block decoded from
+// a compressed block message from the peer with a getblocktxn message and
+// fills them in.  The returned bool is false when the transactions could not
+// be retrieved.
+func (sp *serverPeer) fetchBlockTxns(msgBlock *wire.MsgBlock, kind string) bool {
+	targetHash := msgBlock.BlockHash()
+	msgGetBlockTxns := wire.NewMsgGetBlockTxnsFromBlock(msgBlock)
+	if len(msgGetBlockTxns.Indexes) == 0 {
+		return true
+	}
+
+	quitChan := make(chan struct{})
+	msgChan := make(chan spMsg)
+	subscription := spMsgSubscription{
+		command:  wire.CmdBlockTxns,
+		quitChan: quitChan,
+		msgChan:  msgChan,
+	}
+	sp.subscribeRecvMsg(subscription)
+	sp.QueueMessage(msgGetBlockTxns, nil)
+	timeout := time.After(time.Second * 30)
+	select {
+	case <-timeout:
+		sp.unsubscribeRecvMsgs(subscription)
+		close(quitChan)
+		peerLog.Debugf("Peer %v timed out waiting for blocktxns for %s %v",
+			sp, kind, targetHash)
+		sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+		return false
+	case resp := <-msgChan:
+		sp.unsubscribeRecvMsgs(subscription)
+		blockTxns, ok := resp.msg.(*wire.MsgBlockTxns)
+		if !ok {
+			peerLog.Debugf("Unable to decode blocktxns for %s %v from peer %v",
+				kind, targetHash, sp)
 			sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
-			return
-		case resp := <-msgChan:
-			sp.unsubscribeRecvMsgs(subscription)
-			blockTxns, ok := resp.msg.(*wire.MsgBlockTxns)
-			if !ok {
-				peerLog.Debugf("Unable to decode blocktxns for cmpctblock %v from peer %v",
-					msg.Header.BlockHash(), sp)
-				sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
-				return
-			}
-			if !blockTxns.BlockHash.IsEqual(&targetHash) {
-				peerLog.Debugf("blocktxns response for cmpctblock %v from peer %v "+
-					"contained incorrect hash", msg.Header.BlockHash(), sp)
-				sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
-				return
-			}
-			indexMap, err := blockTxns.AbsoluteIndexes(msgGetBlockTxns.Indexes)
-			if err != nil {
-				peerLog.Debugf("blocktxns response for cmpctblock %v from peer %v "+
-					"contained incorrect number of txs", msg.Header.BlockHash(), sp)
-				sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
-				return
-			}
-			for i, tx := range indexMap {
-				if i > uint32(len(msgBlock.Transactions)-1) {
-					peerLog.Debugf("blocktxns response for cmpctblock %v from peer %v "+
-						"contained incorrect index", msg.Header.BlockHash(), sp)
-					sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
-					return
-				}
-				msgBlock.Transactions[i] = tx
-			}
-			newBlockHash := msgBlock.BlockHash()
-			if !newBlockHash.IsEqual(&targetHash) {
-				peerLog.Debugf("decoded cmpctblock hash %v doesn't match original message"+
-					" from peer %v ", msg.Header.BlockHash(), sp)
+			return false
+		}
+		if !blockTxns.BlockHash.IsEqual(&targetHash) {
+			peerLog.Debugf("blocktxns response for %s %v from peer %v "+
+				"contained incorrect hash", kind, targetHash, sp)
+			sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+			return false
+		}
+		indexMap, err := blockTxns.AbsoluteIndexes(msgGetBlockTxns.Indexes)
+		if err != nil {
+			peerLog.Debugf("blocktxns response for %s %v from peer %v "+
+				"contained incorrect number of txs", kind, targetHash, sp)
+			sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+			return false
+		}
+		for i, tx := range indexMap {
+			if i > uint32(len(msgBlock.Transactions)-1) {
+				peerLog.Debugf("blocktxns response for %s %v from peer %v "+
+					"contained incorrect index", kind, targetHash, sp)
 				sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
-				return
+				return false
 			}
+			msgBlock.Transactions[i] = tx
+		}
+		newBlockHash := msgBlock.BlockHash()
+		if !newBlockHash.IsEqual(&targetHash) {
+			peerLog.Debugf("decoded %s hash %v doesn't match original message"+
+				" from peer %v ", kind, targetHash, sp)
+			sp.server.syncManager.QueueBlockError(&targetHash, sp.Peer)
+			return false
 		}
 	}
+	return true
+}
 
+// queueDecodedBlock hands a block decoded from a compressed block message to
+// the sync manager and waits for it to be processed.
+func (sp *serverPeer) queueDecodedBlock(msgBlock *wire.MsgBlock) {
 	// Convert the raw MsgBlock to a czzutil.Block which provides some
 	// convenience methods and things such as hash caching.
 	block := czzutil.NewBlock(msgBlock)
@@ -818,9 +1089,6 @@ func (sp *serverPeer) processComapactBlock(msg *wire.MsgCmpctBlock) {
 	iv := wire.NewInvVect(wire.InvTypeBlock, block.Hash())
 	sp.AddKnownInventory(iv)
 
-	// Relay the block to peers which want direct relay.
-	sp.server.relayCmpctBlock <- msg
-
 	// Queue the block up to be handled by the block
 	// manager and intentionally block further receives
 	// until the bitcoin block is fully processed and known
@@ -897,6 +1165,16 @@ func (sp *serverPeer) OnGetBlockTxns(_ *peer.Peer, msg *wire.MsgGetBlockTxns) {
 // accordingly.  We pass the message down to blockmanager which will call
 // QueueMessage with any appropriate responses.
 func (sp *serverPeer) OnInv(_ *peer.Peer, msg *wire.MsgInv) {
+	// Transactions the peer announced itself don't need to be reconciled
+	// with it anymore.
+	if r := sp.txReconciler(); r != nil {
+		for _, invVect := range msg.InvList {
+			if invVect.Type == wire.InvTypeTx {
+				r.Remove(&invVect.Hash)
+			}
+		}
+	}
+
 	if !cfg.BlocksOnly {
 		if len(msg.InvList) > 0 {
 			sp.server.syncManager.QueueInv(msg, sp.Peer)
@@ -935,6 +1213,26 @@ func (sp *serverPeer) OnHeaders(_ *peer.Peer, msg *wire.MsgHeaders) {
 	sp.server.syncManager.QueueHeaders(msg, sp.Peer)
 }
 
+// OnCFilter is invoked when a peer receives a cfilter bitcoin message.  The
+// filters are only requested by headers-only nodes, so they are passed to the
+// sync manager which stores them and fetches the matching blocks.
+func (sp *serverPeer) OnCFilter(_ *peer.Peer, msg *wire.MsgCFilter) {
+	if !cfg.HeadersOnly {
+		return
+	}
+	sp.server.syncManager.QueueCFilter(msg, sp.Peer)
+}
+
+// OnCFHeaders is invoked when a peer receives a cfheaders bitcoin message.
+// The filter headers are only requested by headers-only nodes, so they are
+// passed to the sync manager which checks the filters it fetches against them.
+func (sp *serverPeer) OnCFHeaders(_ *peer.Peer, msg *wire.MsgCFHeaders) {
+	if !cfg.HeadersOnly {
+		return
+	}
+	sp.server.syncManager.QueueCFHeaders(msg, sp.Peer)
+}
+
 // handleGetData is invoked when a peer receives a getdata bitcoin message and
 // is used to deliver block and transaction information.
 func (sp *serverPeer) OnGetData(_ *peer.Peer, msg *wire.MsgGetData) {
@@ -975,6 +1273,8 @@ func (sp *serverPeer) OnGetData(_ *peer.Peer, msg *wire.MsgGetData) {
 			err = sp.server.pushBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
 		case wire.InvTypeCmpctBlock:
 			err = sp.server.pushCmpctBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
+		case wire.InvTypeGrapheneBlock:
+			err = sp.server.pushGrapheneBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
 		case wire.InvTypeFilteredBlock:
 			err = sp.server.pushMerkleBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
 		default:
@@ -1575,6 +1875,19 @@ func (sp *serverPeer) OnGetAddr(_ *peer.Peer, msg *wire.MsgGetAddr) {
 // OnAddr is invoked when a peer receives an addr bitcoin message and is
 // used to notify the server about advertised addresses.
 func (sp *serverPeer) OnAddr(_ *peer.Peer, msg *wire.MsgAddr) {
+	sp.handleAddrList(msg, msg.AddrList)
+}
+
+// OnAddrV2 is invoked when a peer receives an addrv2 bitcoin message and is
+// used to notify the server about advertised addresses, including those of
+// networks which can't be relayed with addr messages.
+func (sp *serverPeer) OnAddrV2(_ *peer.Peer, msg *wire.MsgAddrV2) {
+	sp.handleAddrList(msg, msg.AddrList)
+}
+
+// handleAddrList adds the addresses of the passed addr or addrv2 message to
+// the known addresses of the peer and the address manager.
+func (sp *serverPeer) handleAddrList(msg wire.Message, addrList []*wire.NetAddress) {
 	// Ignore addresses when running on the simulation test network.  This
 	// helps prevent the network from becoming another public test network
 	// since it will not be able to learn about other peers that have not
@@ -1583,14 +1896,14 @@ func (sp *serverPeer) OnAddr(_ *peer.Peer, msg *wire.MsgAddr) {
 		return
 	}
 	// A message that has no addresses is invalid.
-	if len(msg.AddrList) == 0 {
+	if len(addrList) == 0 {
 		peerLog.Errorf("Command [%s] from %s does not contain any addresses",
 			msg.Command(), sp.Peer)
 		sp.Disconnect()
 		return
 	}
 
-	for _, na := range msg.AddrList {
+	for _, na := range addrList {
 		// Don't add more address if we're disconnecting.
 		if !sp.Connected() {
 			return
@@ -1613,7 +1926,7 @@ func (sp *serverPeer) OnAddr(_ *peer.Peer, msg *wire.MsgAddr) {
 	// addresses, and last seen updates.
 	// XXX bitcoind gives a 2 hour time penalty here, do we want to do the
 	// same?
-	sp.server.addrManager.AddAddresses(msg.AddrList, sp.NA())
+	sp.server.addrManager.AddAddresses(addrList, sp.NA())
 }
 
 // OnReject logs all reject messages received from the remote peer.
@@ -1725,6 +2038,21 @@ func (s *server) AnnounceNewTransactions(txns []*mempool.TxDesc) {
 	}
 }
 
+// PeerStats returns a snapshot of the statistics of all connected peers.
+//
+// This function is safe for concurrent access.
+func (s *server) PeerStats() []*peer.StatsSnap {
+	replyChan := make(chan []*serverPeer)
+	s.query <- getPeersMsg{reply: replyChan}
+	serverPeers := <-replyChan
+
+	stats := make([]*peer.StatsSnap, 0, len(serverPeers))
+	for _, sp := range serverPeers {
+		stats = append(stats, sp.StatsSnapshot())
+	}
+	return stats
+}
+
 // Transaction has one confirmation on the main chain. Now we can mark it as no
 // longer needing rebroadcasting.
 func (s *server) TransactionConfirmed(tx *czzutil.Tx) {
@@ -1887,6 +2215,71 @@ func (s *server) pushCmpctBlockMsg(sp *serverPeer, hash *chainhash.Hash, doneCha
 	return nil
 }
 
+// pushGrapheneBlockMsg sends a grblk message for the provided block hash to the
+// connected peer.  A cmpctblock message is sent instead when the block can't be
+// encoded as a graphene block.  An error is returned if the block hash is not
+// known.
+func (s *server) pushGrapheneBlockMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
+	waitChan <-chan struct{}, encoding wire.MessageEncoding) error {
+
+	// Fetch the raw block bytes from the database.
+	var blockBytes []byte
+	err := sp.server.db.View(func(dbTx database.Tx) error {
+		var err error
+		blockBytes, err = dbTx.FetchBlock(hash)
+		return err
+	})
+	if err != nil {
+		peerLog.Tracef("Unable to fetch requested block hash %v: %v",
+			hash, err)
+
+		if doneChan != nil {
+			doneChan <- struct{}{}
+		}
+		return err
+	}
+
+	// Deserialize the block.
+	var msgBlock wire.MsgBlock
+	err = msgBlock.Deserialize(bytes.NewReader(blockBytes))
+	if err != nil {
+		peerLog.Tracef("Unable to deserialize requested block hash "+
+			"%v: %v", hash, err)
+
+		if doneChan != nil {
+			doneChan <- struct{}{}
+		}
+		return err
+	}
+
+	var msg wire.Message
+	knownInventory := sp.GetKnownTxInventory()
+	msg, err = graphene.NewMsgGrapheneBlockFromBlock(&msgBlock, knownInventory,
+		s.txMemPool.Count())
+	if err == graphene.ErrShortIDCollision {
+		peerLog.Debugf("Sending cmpctblock for block %v to %v: %v", hash,
+			sp, err)
+		msg, err = wire.NewMsgCmpctBlockFromBlock(&msgBlock, knownInventory)
+	}
+	if err != nil {
+		peerLog.Tracef("Unable to build requested grapheneblock hash "+
+			"%v: %v", hash, err)
+
+		if doneChan != nil {
+			doneChan <- struct{}{}
+		}
+		return err
+	}
+
+	// Once we have fetched data wait for any previous operation to finish.
+	if waitChan != nil {
+		<-waitChan
+	}
+
+	sp.QueueMessageWithEncoding(msg, doneChan, encoding)
+	return nil
+}
+
 // pushMerkleBlockMsg sends a merkleblock message for the provided block hash to
 // the connected peer.  Since a merkle block requires the peer to have a filter
 // loaded, this call will simply be ignored if there is no filter loaded.  An
@@ -2101,6 +2494,7 @@ func (s *server) handleDonePeerMsg(state *peerState, sp *serverPeer) {
 	if _, ok := state.directRelayPeers[sp.ID()]; ok {
 		delete(state.directRelayPeers, sp.ID())
 	}
+	delete(state.txFloodPeers, sp.ID())
 
 	// If we get here it means that either we didn't know about the peer
 	// or we purposefully deleted it.
@@ -2215,6 +2609,19 @@ func (s *server) handleRelayInvMsg(state *peerState, msg relayMsg) {
 					return
 				}
 			}
+
+			// Queue the transaction for the next reconciliation
+			// round unless the peer is one of the few outbound peers
+			// transactions are still flooded to.  It is flooded as
+			// well when the reconciliation set is full.
+			r := sp.txReconciler()
+			if r != nil && !state.isTxFloodPeer(sp) {
+				if sp.HasKnownInventory(msg.invVect) ||
+					r.Add(&msg.invVect.Hash) {
+
+					return
+				}
+			}
 		}
 
 		// Queue the inventory to be relayed with the next batch.
@@ -2429,32 +2836,39 @@ func disconnectPeer(peerList map[int32]*serverPeer, compareFunc func(*serverPeer
 func newPeerConfig(sp *serverPeer) *peer.Config {
 	return &peer.Config{
 		Listeners: peer.MessageListeners{
-			OnVersion:      sp.OnVersion,
-			OnXVersion:     sp.OnXVersion,
-			OnMemPool:      sp.OnMemPool,
-			OnTx:           sp.OnTx,
-			OnBlock:        sp.OnBlock,
-			OnCmpctBlock:   sp.OnCmpctBlock,
-			OnGetBlockTxns: sp.OnGetBlockTxns,
-			OnInv:          sp.OnInv,
-			OnHeaders:      sp.OnHeaders,
-			OnGetData:      sp.OnGetData,
-			OnGetBlocks:    sp.OnGetBlocks,
-			OnGetHeaders:   sp.OnGetHeaders,
-			OnGetCFilters:  sp.OnGetCFilters,
-			OnGetCFHeaders: sp.OnGetCFHeaders,
-			OnGetCFCheckpt: sp.OnGetCFCheckpt,
-			OnGetCFMempool: sp.OnGetCFMemPool,
-			OnFeeFilter:    sp.OnFeeFilter,
-			OnFilterAdd:    sp.OnFilterAdd,
-			OnFilterClear:  sp.OnFilterClear,
-			OnFilterLoad:   sp.OnFilterLoad,
-			OnGetAddr:      sp.OnGetAddr,
-			OnAddr:         sp.OnAddr,
-			OnRead:         sp.OnRead,
-			OnWrite:        sp.OnWrite,
-			OnReject:       sp.OnReject,
-			OnNotFound:     sp.OnNotFound,
+			OnVersion:       sp.OnVersion,
+			OnXVersion:      sp.OnXVersion,
+			OnMemPool:       sp.OnMemPool,
+			OnTx:            sp.OnTx,
+			OnBlock:         sp.OnBlock,
+			OnCmpctBlock:    sp.OnCmpctBlock,
+			OnGrapheneBlock: sp.OnGrapheneBlock,
+			OnGetBlockTxns:  sp.OnGetBlockTxns,
+			OnReqRecon:      sp.OnReqRecon,
+			OnSketch:        sp.OnSketch,
+			OnReconcilDiff:  sp.OnReconcilDiff,
+			OnInv:           sp.OnInv,
+			OnHeaders:       sp.OnHeaders,
+			OnGetData:       sp.OnGetData,
+			OnGetBlocks:     sp.OnGetBlocks,
+			OnGetHeaders:    sp.OnGetHeaders,
+			OnCFilter:       sp.OnCFilter,
+			OnCFHeaders:     sp.OnCFHeaders,
+			OnGetCFilters:   sp.OnGetCFilters,
+			OnGetCFHeaders:  sp.OnGetCFHeaders,
+			OnGetCFCheckpt:  sp.OnGetCFCheckpt,
+			OnGetCFMempool:  sp.OnGetCFMemPool,
+			OnFeeFilter:     sp.OnFeeFilter,
+			OnFilterAdd:     sp.OnFilterAdd,
+			OnFilterClear:   sp.OnFilterClear,
+			OnFilterLoad:    sp.OnFilterLoad,
+			OnGetAddr:       sp.OnGetAddr,
+			OnAddr:          sp.OnAddr,
+			OnAddrV2:        sp.OnAddrV2,
+			OnRead:          sp.OnRead,
+			OnWrite:         sp.OnWrite,
+			OnReject:        sp.OnReject,
+			OnNotFound:      sp.OnNotFound,
 		},
 		AddrMe:            addrMe,
 		NewestBlock:       sp.newestBlock,
@@ -2544,6 +2958,7 @@ func (s *server) peerHandler() {
 		persistentPeers:  make(map[int32]*serverPeer),
 		outboundPeers:    make(map[int32]*serverPeer),
 		directRelayPeers: make(map[int32]*serverPeer),
+		txFloodPeers:     make(map[int32]struct{}),
 		banned:           make(map[string]time.Time),
 		outboundGroups:   make(map[string]int),
 		connectionCount:  make(map[string]int),
@@ -3044,6 +3459,17 @@ func newServer(listenAddrs []string, db database.DB, chainParams *chaincfg.Param
 	if cfg.NoCFilters {
 		services &^= wire.SFNodeCF
 	}
+	if cfg.NoGraphene {
+		services &^= wire.SFNodeXthin
+	}
+	if cfg.NoTxRecon || cfg.BlocksOnly {
+		services &^= wire.SFNodeTxRecon
+	}
+	if cfg.HeadersOnly {
+		// Headers-only nodes can neither serve full blocks nor filter
+		// transactions with bloom filters.
+		services &^= wire.SFNodeNetwork | wire.SFNodeBloom | wire.SFNodeXthin
+	}
 
 	amgr := addrmgr.New(cfg.DataDir, czzdLookup)
 
@@ -3114,7 +3540,17 @@ func newServer(listenAddrs []string, db database.DB, chainParams *chaincfg.Param
 	if !cfg.NoCFilters {
 		indxLog.Info("Committed filter index is enabled")
 		s.cfIndex = indexers.NewCfIndex(db, chainParams)
-		indexes = append(indexes, s.cfIndex)
+
+		// Headers-only nodes fetch filters from their peers rather than
+		// building them from blocks, so the index is not managed by the
+		// index manager in that case.
+		if cfg.HeadersOnly {
+			if err := s.cfIndex.InitHeadersOnly(); err != nil {
+				return nil, err
+			}
+		} else {
+			indexes = append(indexes, s.cfIndex)
+		}
 	}
 
 	// Create an index manager if any of the optional indexes are enabled.
@@ -3147,6 +3583,7 @@ func newServer(listenAddrs []string, db database.DB, chainParams *chaincfg.Param
 		ReIndexChainState:  cfg.ReIndexChainState,
 		FastSync:           cfg.FastSync,
 		FastSyncDataDir:    cfg.DataDir,
+		HeadersOnly:        cfg.HeadersOnly,
 		Proxy:              cfg.Proxy,
 
 		EthRPC:  cfg.EthRPC,
@@ -3237,6 +3674,10 @@ func newServer(listenAddrs []string, db database.DB, chainParams *chaincfg.Param
 		FeeEstimator:            s.feeEstimator,
 		MinSyncPeerNetworkSpeed: cfg.MinSyncPeerNetworkSpeed,
 		FastSyncMode:            cfg.FastSync,
+		HeadersOnlyMode:         cfg.HeadersOnly,
+		GrapheneBlocks:          s.services&wire.SFNodeXthin == wire.SFNodeXthin,
+		WatchedScripts:          cfg.watchedScripts,
+		CfIndex:                 s.cfIndex,
 	})
 	if err != nil {
 		return nil, err
@@ -3252,6 +3693,9 @@ func newServer(listenAddrs []string, db database.DB, chainParams *chaincfg.Param
 		BlockMaxSize:      cfg.BlockMaxSize,
 		BlockPrioritySize: cfg.BlockPrioritySize,
 		TxMinFreeFee:      cfg.minRelayTxFee,
+
+		BlockMaxConvertItems: cfg.BlockMaxConvertItems,
+		BlockMaxConvertValue: cfg.blockMaxConvertValue,
 	}
 	blockTemplateGenerator := mining.NewBlkTmplGenerator(&policy,
 		s.chainParams, s.txMemPool, s.chain, s.timeSource,
@@ -3291,6 +3735,15 @@ func newServer(listenAddrs []string, db database.DB, chainParams *chaincfg.Param
 					continue
 				}
 
+				// I2P and CJDNS addresses are only relayed since
+				// they can't be dialed, and onion addresses can
+				// only be dialed through tor.
+				na := addr.NetAddress()
+				if addrmgr.IsI2P(na) || addrmgr.IsCJDNS(na) ||
+					(cfg.NoOnion && addrmgr.IsOnion(na)) {
+					continue
+				}
+
 				// only allow recent nodes (10mins) after we failed 30
 				// times
 				if tries < 30 && time.Since(addr.LastAttempt()) < 10*time.Minute {
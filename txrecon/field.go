@@ -0,0 +1,39 @@
+package txrecon
+
+// fieldPoly is the reduction polynomial x^32 + x^7 + x^3 + x^2 + 1 of the
+// field GF(2^32) the sketches operate on, without its leading term.
+const fieldPoly = 0x8d
+
+// gfMul returns the product of a and b in GF(2^32).
+func gfMul(a, b uint32) uint32 {
+	var r uint32
+	for b != 0 {
+		if b&1 != 0 {
+			r ^= a
+		}
+		b >>= 1
+		carry := a & 0x80000000
+		a <<= 1
+		if carry != 0 {
+			a ^= fieldPoly
+		}
+	}
+	return r
+}
+
+// gfSqr returns the square of a in GF(2^32).
+func gfSqr(a uint32) uint32 {
+	return gfMul(a, a)
+}
+
+// gfInv returns the multiplicative inverse of a in GF(2^32), which is
+// a^(2^32-2).  The inverse of zero is zero.
+func gfInv(a uint32) uint32 {
+	// 2^32-2 is 31 one bits followed by a zero bit, so square and
+	// multiply for each of the one bits and square once more.
+	r := uint32(1)
+	for i := 0; i < 31; i++ {
+		r = gfMul(gfSqr(r), a)
+	}
+	return gfSqr(r)
+}
@@ -0,0 +1,189 @@
+package txrecon
+
+// Polynomials over GF(2^32) are represented by their coefficients in
+// ascending order without trailing zero coefficients.
+
+// polyTrim removes the trailing zero coefficients of the passed polynomial.
+func polyTrim(p []uint32) []uint32 {
+	for len(p) > 0 && p[len(p)-1] == 0 {
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// polyMod returns the remainder of the division of a by the non-zero
+// polynomial m.
+func polyMod(a, m []uint32) []uint32 {
+	r := polyTrim(append([]uint32(nil), a...))
+	degree := len(m) - 1
+	leadInv := gfInv(m[degree])
+	for len(r)-1 >= degree {
+		factor := gfMul(r[len(r)-1], leadInv)
+		shift := len(r) - 1 - degree
+		for i, coef := range m {
+			r[i+shift] ^= gfMul(factor, coef)
+		}
+		r = polyTrim(r)
+	}
+	return r
+}
+
+// polyDiv returns the quotient of the division of a by the non-zero
+// polynomial m.
+func polyDiv(a, m []uint32) []uint32 {
+	r := polyTrim(append([]uint32(nil), a...))
+	degree := len(m) - 1
+	if len(r)-1 < degree {
+		return nil
+	}
+	q := make([]uint32, len(r)-degree)
+	leadInv := gfInv(m[degree])
+	for len(r)-1 >= degree {
+		factor := gfMul(r[len(r)-1], leadInv)
+		shift := len(r) - 1 - degree
+		q[shift] = factor
+		for i, coef := range m {
+			r[i+shift] ^= gfMul(factor, coef)
+		}
+		r = polyTrim(r)
+	}
+	return polyTrim(q)
+}
+
+// polyMulMod returns the product of a and b modulo m.
+func polyMulMod(a, b, m []uint32) []uint32 {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	product := make([]uint32, len(a)+len(b)-1)
+	for i, x := range a {
+		if x == 0 {
+			continue
+		}
+		for j, y := range b {
+			product[i+j] ^= gfMul(x, y)
+		}
+	}
+	return polyMod(product, m)
+}
+
+// polyMonic scales the passed non-zero polynomial so its leading coefficient
+// is one.
+func polyMonic(p []uint32) []uint32 {
+	leadInv := gfInv(p[len(p)-1])
+	monic := make([]uint32, len(p))
+	for i, coef := range p {
+		monic[i] = gfMul(coef, leadInv)
+	}
+	return monic
+}
+
+// polyGCD returns the monic greatest common divisor of a and b.
+func polyGCD(a, b []uint32) []uint32 {
+	a = polyTrim(a)
+	b = polyTrim(b)
+	for len(b) > 0 {
+		a, b = b, polyMod(a, b)
+	}
+	if len(a) == 0 {
+		return nil
+	}
+	return polyMonic(a)
+}
+
+// polyEqual returns whether or not both polynomials are equal.
+func polyEqual(a, b []uint32) bool {
+	a = polyTrim(a)
+	b = polyTrim(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// maxSplitAttempts is the number of trace maps tried to split a polynomial
+// before giving up.  Each attempt succeeds with a probability of about one
+// half.
+const maxSplitAttempts = 64
+
+// findRoots returns the roots of the passed monic polynomial.  The returned
+// bool is false when the polynomial does not split into distinct linear
+// factors.
+func findRoots(f []uint32) ([]uint32, bool) {
+	f = polyTrim(f)
+	if len(f) < 2 {
+		return nil, true
+	}
+
+	// All roots lie in GF(2^32) and are distinct exactly when f divides
+	// x^(2^32) - x.
+	x := polyMod([]uint32{0, 1}, f)
+	t := x
+	for i := 0; i < 32; i++ {
+		t = polyMulMod(t, t, f)
+	}
+	if !polyEqual(t, x) {
+		return nil, false
+	}
+
+	var roots []uint32
+	if !splitRoots(f, 1, &roots) {
+		return nil, false
+	}
+	return roots, true
+}
+
+// splitRoots appends the roots of the passed monic polynomial, which is known
+// to split into distinct linear factors, to roots.  The polynomial is split
+// with the gcd of itself and the trace map Tr(beta*x), which holds about half
+// of its roots, until only linear factors remain.
+func splitRoots(f []uint32, seed uint32, roots *[]uint32) bool {
+	switch len(f) - 1 {
+	case 0:
+		return true
+	case 1:
+		// x + a has the root a in characteristic two.
+		*roots = append(*roots, f[0])
+		return true
+	}
+
+	beta := seed
+	for attempt := 0; attempt < maxSplitAttempts; attempt++ {
+		// Derive a new non-zero beta for every attempt.
+		beta = beta*0x9e3779b1 + 0x7f4a7c15
+		if beta == 0 {
+			continue
+		}
+
+		t := polyMod([]uint32{0, beta}, f)
+		trace := t
+		for i := 1; i < 32; i++ {
+			t = polyMulMod(t, t, f)
+			trace = addPoly(trace, t)
+		}
+		g := polyGCD(f, trace)
+		if len(g) < 2 || len(g) == len(f) {
+			continue
+		}
+		return splitRoots(g, beta, roots) &&
+			splitRoots(polyMonic(polyDiv(f, g)), beta, roots)
+	}
+	return false
+}
+
+// addPoly returns the sum of a and b.
+func addPoly(a, b []uint32) []uint32 {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	sum := append([]uint32(nil), a...)
+	for i, coef := range b {
+		sum[i] ^= coef
+	}
+	return polyTrim(sum)
+}
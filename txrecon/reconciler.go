@@ -0,0 +1,310 @@
+package txrecon
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+	"github.com/classzz/classzz/wire"
+	"github.com/dchest/siphash"
+)
+
+const (
+	// ProtocolVersion is the version of the reconciliation protocol
+	// announced in the xversion message.
+	ProtocolVersion = 1
+
+	// MaxSetSize is the maximum number of transactions queued for
+	// reconciliation with a single peer.  Transactions beyond it are
+	// flooded instead.
+	MaxSetSize = 3000
+
+	// DefaultQ is the initial estimate of the fraction of the smaller set
+	// that is not part of the other set.
+	DefaultQ = 0.25
+
+	// qPrecision scales q for transmission in the reqrecon message.
+	qPrecision = 1<<15 - 1
+
+	// RoundTimeout is the time after which a reconciliation round the peer
+	// did not complete is abandoned and its transactions are queued for
+	// the next round again.
+	RoundTimeout = time.Minute
+)
+
+var (
+	// ErrUnexpectedMessage is returned when a peer sends a reconciliation
+	// message that does not fit the role or state of the reconciliation.
+	ErrUnexpectedMessage = errors.New("unexpected reconciliation message")
+
+	// ErrInvalidSketch is returned when a peer sends a malformed sketch.
+	ErrInvalidSketch = errors.New("invalid reconciliation sketch")
+)
+
+// Reconciler tracks the transactions queued for announcement to a single
+// peer and reconciles them with the peer's set in rounds.  The initiator,
+// which is the side that opened the connection, periodically requests a
+// sketch from the responder, decodes the difference against its own set and
+// tells the responder which of its transactions it lacks.  Both sides then
+// announce the transactions the other side is missing with inv messages.
+//
+// A Reconciler is safe for concurrent access.
+type Reconciler struct {
+	mtx       sync.Mutex
+	k0, k1    uint64
+	initiator bool
+	q         float64
+
+	// set holds the transactions queued for the next round keyed by
+	// their short IDs.  snapshot holds the transactions of the round in
+	// progress, if any.
+	set        map[uint32]chainhash.Hash
+	snapshot   map[uint32]chainhash.Hash
+	roundStart time.Time
+}
+
+// NewReconciler returns a new reconciler for a peer using the passed salts
+// exchanged in the xversion messages.  initiator must be true for outbound
+// peers.
+func NewReconciler(localSalt, remoteSalt uint64, initiator bool) *Reconciler {
+	// Order the salts so both sides derive the same keys.
+	k0, k1 := localSalt, remoteSalt
+	if k0 > k1 {
+		k0, k1 = k1, k0
+	}
+	return &Reconciler{
+		k0:        k0,
+		k1:        k1,
+		initiator: initiator,
+		q:         DefaultQ,
+		set:       make(map[uint32]chainhash.Hash),
+	}
+}
+
+// IsInitiator returns whether or not the local side initiates the
+// reconciliation rounds.
+func (r *Reconciler) IsInitiator() bool {
+	return r.initiator
+}
+
+// ShortID returns the 32-bit short ID of the passed transaction hash used in
+// the sketches.  Since sketches can't hold zero, it is mapped to one.
+func (r *Reconciler) ShortID(txHash *chainhash.Hash) uint32 {
+	id := uint32(siphash.Hash(r.k0, r.k1, txHash[:]))
+	if id == 0 {
+		id = 1
+	}
+	return id
+}
+
+// Add queues the passed transaction for the next reconciliation round.  It
+// returns false when the transaction can't be queued because the set is full
+// or its short ID collides with another queued transaction, in which case the
+// caller should announce it right away.
+func (r *Reconciler) Add(txHash *chainhash.Hash) bool {
+	id := r.ShortID(txHash)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if queued, ok := r.set[id]; ok {
+		return queued == *txHash
+	}
+	if len(r.set) >= MaxSetSize {
+		return false
+	}
+	r.set[id] = *txHash
+	return true
+}
+
+// Remove removes the passed transaction from the next reconciliation round.
+// It is used when the peer announced the transaction itself.
+func (r *Reconciler) Remove(txHash *chainhash.Hash) {
+	id := r.ShortID(txHash)
+
+	r.mtx.Lock()
+	if queued, ok := r.set[id]; ok && queued == *txHash {
+		delete(r.set, id)
+	}
+	r.mtx.Unlock()
+}
+
+// SetSize returns the number of transactions queued for the next round.
+func (r *Reconciler) SetSize() int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return len(r.set)
+}
+
+// takeSnapshot moves the queued transactions to the snapshot of a new round.
+// The transactions of an abandoned round are queued again first.
+//
+// This function MUST be called with the mutex held.
+func (r *Reconciler) takeSnapshot() {
+	for id, txHash := range r.snapshot {
+		if _, ok := r.set[id]; !ok {
+			r.set[id] = txHash
+		}
+	}
+	r.snapshot = r.set
+	r.set = make(map[uint32]chainhash.Hash)
+	r.roundStart = time.Now()
+}
+
+// snapshotHashes returns the hashes of the transactions in the snapshot.
+//
+// This function MUST be called with the mutex held.
+func (r *Reconciler) snapshotHashes() []chainhash.Hash {
+	hashes := make([]chainhash.Hash, 0, len(r.snapshot))
+	for _, txHash := range r.snapshot {
+		hashes = append(hashes, txHash)
+	}
+	return hashes
+}
+
+// sketch returns a sketch of the passed capacity over the snapshot.
+//
+// This function MUST be called with the mutex held.
+func (r *Reconciler) sketch(capacity int) *Sketch {
+	s := NewSketch(capacity)
+	for id := range r.snapshot {
+		s.Add(id)
+	}
+	return s
+}
+
+// RequestReconciliation starts a new reconciliation round and returns the
+// reqrecon message to send to the peer.  It returns nil when the local side
+// is not the initiator or the previous round is still in progress.
+func (r *Reconciler) RequestReconciliation() *wire.MsgReqRecon {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !r.initiator {
+		return nil
+	}
+	if r.snapshot != nil && time.Since(r.roundStart) < RoundTimeout {
+		return nil
+	}
+	r.takeSnapshot()
+	return wire.NewMsgReqRecon(uint32(len(r.snapshot)),
+		uint16(r.q*qPrecision))
+}
+
+// estimateCapacity returns the sketch capacity for sets of the passed sizes
+// given the estimated fraction q of the smaller set the other set lacks.
+func estimateCapacity(localSize, remoteSize int, q float64) int {
+	diff := localSize - remoteSize
+	if diff < 0 {
+		diff = -diff
+	}
+	minSize := localSize
+	if remoteSize < minSize {
+		minSize = remoteSize
+	}
+	capacity := diff + int(q*float64(minSize)) + 1
+	if capacity > wire.MaxTxReconSketchCapacity {
+		capacity = wire.MaxTxReconSketchCapacity
+	}
+	return capacity
+}
+
+// HandleReqRecon handles a reqrecon message from the initiator and returns
+// the sketch message to reply with.
+func (r *Reconciler) HandleReqRecon(msg *wire.MsgReqRecon) (*wire.MsgSketch, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.initiator {
+		return nil, ErrUnexpectedMessage
+	}
+	r.takeSnapshot()
+	q := float64(msg.Q) / qPrecision
+	capacity := estimateCapacity(len(r.snapshot), int(msg.SetSize), q)
+	return wire.NewMsgSketch(r.sketch(capacity).Serialize()), nil
+}
+
+// HandleSketch handles the sketch message the responder replied to the
+// reqrecon message with.  It returns the transactions to announce to the peer
+// and the reconcildiff message to conclude the round with.  When the
+// difference can't be decoded all transactions of the round are returned.
+func (r *Reconciler) HandleSketch(msg *wire.MsgSketch) ([]chainhash.Hash, *wire.MsgReconcilDiff, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !r.initiator || r.snapshot == nil {
+		return nil, nil, ErrUnexpectedMessage
+	}
+	remote, err := DeserializeSketch(msg.Sketch)
+	if err != nil || remote.Capacity() == 0 {
+		return nil, nil, ErrInvalidSketch
+	}
+
+	local := r.sketch(remote.Capacity())
+	local.Merge(remote)
+	diff, err := local.Decode()
+	if err != nil {
+		// Fall back to announcing everything and start over with the
+		// default estimate.
+		announce := r.snapshotHashes()
+		r.snapshot = nil
+		r.q = DefaultQ
+		return announce, wire.NewMsgReconcilDiff(false, nil), nil
+	}
+
+	var announce []chainhash.Hash
+	askShortIDs := make([]uint32, 0, len(diff))
+	for _, id := range diff {
+		if txHash, ok := r.snapshot[id]; ok {
+			announce = append(announce, txHash)
+		} else {
+			askShortIDs = append(askShortIDs, id)
+		}
+	}
+
+	// Update the estimate of q with the outcome of the round.
+	localSize := len(r.snapshot)
+	remoteSize := localSize - len(announce) + len(askShortIDs)
+	minSize := localSize
+	if remoteSize < minSize {
+		minSize = remoteSize
+	}
+	if minSize > 0 {
+		sizeDiff := localSize - remoteSize
+		if sizeDiff < 0 {
+			sizeDiff = -sizeDiff
+		}
+		r.q = float64(len(diff)-sizeDiff) / float64(minSize)
+		if r.q > 1 {
+			r.q = 1
+		}
+	}
+
+	r.snapshot = nil
+	return announce, wire.NewMsgReconcilDiff(true, askShortIDs), nil
+}
+
+// HandleReconcilDiff handles the reconcildiff message that concludes a round
+// and returns the transactions to announce to the peer.
+func (r *Reconciler) HandleReconcilDiff(msg *wire.MsgReconcilDiff) ([]chainhash.Hash, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.initiator || r.snapshot == nil {
+		return nil, ErrUnexpectedMessage
+	}
+
+	var announce []chainhash.Hash
+	if !msg.Success {
+		announce = r.snapshotHashes()
+	} else {
+		for _, id := range msg.AskShortIDs {
+			if txHash, ok := r.snapshot[id]; ok {
+				announce = append(announce, txHash)
+			}
+		}
+	}
+	r.snapshot = nil
+	return announce, nil
+}
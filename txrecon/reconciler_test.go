@@ -0,0 +1,180 @@
+package txrecon
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+)
+
+// randomHashes returns n random transaction hashes.
+func randomHashes(rng *rand.Rand, n int) []chainhash.Hash {
+	hashes := make([]chainhash.Hash, n)
+	for i := range hashes {
+		rng.Read(hashes[i][:])
+	}
+	return hashes
+}
+
+// sortedHashes returns the passed hashes sorted by their string form.
+func sortedHashes(hashes []chainhash.Hash) []string {
+	sorted := make([]string, len(hashes))
+	for i := range hashes {
+		sorted[i] = hashes[i].String()
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// equalHashes returns whether or not both lists hold the same hashes in any
+// order.
+func equalHashes(a, b []chainhash.Hash) bool {
+	sa, sb := sortedHashes(a), sortedHashes(b)
+	if len(sa) != len(sb) {
+		return false
+	}
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestReconcilerRound simulates reconciliation rounds between an initiator and
+// a responder and ensures each side ends up announcing exactly the
+// transactions the other side lacks.
+func TestReconcilerRound(t *testing.T) {
+	tests := []struct {
+		name        string
+		common      int
+		onlyInit    int
+		onlyResp    int
+		wantSuccess bool
+	}{
+		{name: "empty", wantSuccess: true},
+		{name: "equal sets", common: 50, wantSuccess: true},
+		{name: "small difference", common: 100, onlyInit: 5, onlyResp: 8, wantSuccess: true},
+		{name: "initiator only", onlyInit: 20, wantSuccess: true},
+		{name: "responder only", onlyResp: 20, wantSuccess: true},
+		{name: "underestimated q", common: 200, onlyInit: 80, onlyResp: 80, wantSuccess: false},
+	}
+
+	rng := rand.New(rand.NewSource(3))
+	for _, test := range tests {
+		initiator := NewReconciler(1, 2, true)
+		responder := NewReconciler(2, 1, false)
+
+		common := randomHashes(rng, test.common)
+		onlyInit := randomHashes(rng, test.onlyInit)
+		onlyResp := randomHashes(rng, test.onlyResp)
+		for i := range common {
+			initiator.Add(&common[i])
+			responder.Add(&common[i])
+		}
+		for i := range onlyInit {
+			initiator.Add(&onlyInit[i])
+		}
+		for i := range onlyResp {
+			responder.Add(&onlyResp[i])
+		}
+
+		reqRecon := initiator.RequestReconciliation()
+		if reqRecon == nil {
+			t.Fatalf("%s: no reqrecon message", test.name)
+		}
+		if initiator.RequestReconciliation() != nil {
+			t.Fatalf("%s: started a round while another is in "+
+				"progress", test.name)
+		}
+		sketch, err := responder.HandleReqRecon(reqRecon)
+		if err != nil {
+			t.Fatalf("%s: HandleReqRecon: %v", test.name, err)
+		}
+		initAnnounce, diff, err := initiator.HandleSketch(sketch)
+		if err != nil {
+			t.Fatalf("%s: HandleSketch: %v", test.name, err)
+		}
+		respAnnounce, err := responder.HandleReconcilDiff(diff)
+		if err != nil {
+			t.Fatalf("%s: HandleReconcilDiff: %v", test.name, err)
+		}
+
+		if diff.Success != test.wantSuccess {
+			t.Fatalf("%s: got success %v, want %v", test.name,
+				diff.Success, test.wantSuccess)
+		}
+		if !diff.Success {
+			// Both sides fall back to announcing their whole set.
+			onlyInit = append(onlyInit, common...)
+			onlyResp = append(onlyResp, common...)
+		}
+		if !equalHashes(initAnnounce, onlyInit) {
+			t.Errorf("%s: initiator announces %d txs, want %d",
+				test.name, len(initAnnounce), len(onlyInit))
+		}
+		if !equalHashes(respAnnounce, onlyResp) {
+			t.Errorf("%s: responder announces %d txs, want %d",
+				test.name, len(respAnnounce), len(onlyResp))
+		}
+
+		// The sets must be empty for the next round.
+		if initiator.SetSize() != 0 || responder.SetSize() != 0 {
+			t.Errorf("%s: sets not reset after round", test.name)
+		}
+		if initiator.RequestReconciliation() == nil {
+			t.Errorf("%s: can't start next round", test.name)
+		}
+	}
+}
+
+// TestReconcilerRoles ensures messages that do not fit the role of the local
+// side are rejected.
+func TestReconcilerRoles(t *testing.T) {
+	initiator := NewReconciler(1, 2, true)
+	responder := NewReconciler(2, 1, false)
+
+	if responder.RequestReconciliation() != nil {
+		t.Error("responder started a round")
+	}
+	reqRecon := initiator.RequestReconciliation()
+	if _, err := initiator.HandleReqRecon(reqRecon); err != ErrUnexpectedMessage {
+		t.Errorf("initiator handled reqrecon: %v", err)
+	}
+	sketch, err := responder.HandleReqRecon(reqRecon)
+	if err != nil {
+		t.Fatalf("HandleReqRecon: %v", err)
+	}
+	if _, _, err := responder.HandleSketch(sketch); err != ErrUnexpectedMessage {
+		t.Errorf("responder handled sketch: %v", err)
+	}
+	_, diff, err := initiator.HandleSketch(sketch)
+	if err != nil {
+		t.Fatalf("HandleSketch: %v", err)
+	}
+	if _, err := initiator.HandleReconcilDiff(diff); err != ErrUnexpectedMessage {
+		t.Errorf("initiator handled reconcildiff: %v", err)
+	}
+	if _, _, err := initiator.HandleSketch(sketch); err != ErrUnexpectedMessage {
+		t.Errorf("initiator handled sketch without round: %v", err)
+	}
+}
+
+// TestReconcilerShortIDs ensures both sides derive the same short IDs and a
+// transaction is only queued once.
+func TestReconcilerShortIDs(t *testing.T) {
+	a := NewReconciler(10, 20, true)
+	b := NewReconciler(20, 10, false)
+	hash := chainhash.Hash{0x01}
+	if a.ShortID(&hash) != b.ShortID(&hash) {
+		t.Fatal("short IDs differ between peers")
+	}
+	if !a.Add(&hash) || !a.Add(&hash) || a.SetSize() != 1 {
+		t.Fatal("transaction queued more than once")
+	}
+	a.Remove(&hash)
+	if a.SetSize() != 0 {
+		t.Fatal("transaction not removed")
+	}
+}
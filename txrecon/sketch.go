@@ -0,0 +1,179 @@
+package txrecon
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrSketchDecode is returned when a sketch holds more differences than its
+// capacity and therefore can't be decoded.
+var ErrSketchDecode = errors.New("sketch capacity exceeded")
+
+// Sketch is a PinSketch set sketch over non-zero 32-bit elements as used by
+// minisketch.  A sketch of capacity c consists of the odd power sums
+// s_1, s_3, ..., s_(2c-1) of its elements in GF(2^32).  Since adding an element
+// twice cancels it out, merging the sketches of two sets yields the sketch of
+// their symmetric difference, which can be decoded as long as it holds at most
+// c elements.
+type Sketch struct {
+	syndromes []uint32
+}
+
+// NewSketch returns an empty sketch with the passed capacity.
+func NewSketch(capacity int) *Sketch {
+	return &Sketch{syndromes: make([]uint32, capacity)}
+}
+
+// Capacity returns the maximum number of differences the sketch can decode.
+func (s *Sketch) Capacity() int {
+	return len(s.syndromes)
+}
+
+// Add adds the passed element to the sketch, or removes it when it was added
+// before.  Zero elements are ignored.
+func (s *Sketch) Add(element uint32) {
+	if element == 0 {
+		return
+	}
+	sqr := gfSqr(element)
+	power := element
+	for i := range s.syndromes {
+		s.syndromes[i] ^= power
+		power = gfMul(power, sqr)
+	}
+}
+
+// Merge adds the elements of the passed sketch to the sketch.  Only the
+// common capacity of both sketches remains usable.
+func (s *Sketch) Merge(other *Sketch) {
+	if len(other.syndromes) < len(s.syndromes) {
+		s.syndromes = s.syndromes[:len(other.syndromes)]
+	}
+	for i := range s.syndromes {
+		s.syndromes[i] ^= other.syndromes[i]
+	}
+}
+
+// Serialize returns the serialized sketch.
+func (s *Sketch) Serialize() []byte {
+	serialized := make([]byte, 4*len(s.syndromes))
+	for i, syndrome := range s.syndromes {
+		binary.LittleEndian.PutUint32(serialized[4*i:], syndrome)
+	}
+	return serialized
+}
+
+// DeserializeSketch parses a serialized sketch.
+func DeserializeSketch(serialized []byte) (*Sketch, error) {
+	if len(serialized)%4 != 0 {
+		return nil, errors.New("invalid sketch size")
+	}
+	s := NewSketch(len(serialized) / 4)
+	for i := range s.syndromes {
+		s.syndromes[i] = binary.LittleEndian.Uint32(serialized[4*i:])
+	}
+	return s, nil
+}
+
+// Decode returns the elements of the sketch.  ErrSketchDecode is returned
+// when the sketch holds more elements than its capacity.
+func (s *Sketch) Decode() ([]uint32, error) {
+	c := len(s.syndromes)
+
+	// Derive the even power sums from the odd ones since
+	// s_(2i) = s_i^2 in a field of characteristic two.
+	sums := make([]uint32, 2*c)
+	for i := 0; i < c; i++ {
+		sums[2*i] = s.syndromes[i]
+	}
+	for i := 1; i < c+1; i++ {
+		sums[2*i-1] = gfSqr(sums[i-1])
+	}
+
+	locator := berlekampMassey(sums)
+	degree := len(locator) - 1
+	if degree == 0 {
+		for _, syndrome := range s.syndromes {
+			if syndrome != 0 {
+				return nil, ErrSketchDecode
+			}
+		}
+		return nil, nil
+	}
+	if degree > c {
+		return nil, ErrSketchDecode
+	}
+
+	// The elements are the inverses of the roots of the locator
+	// polynomial and therefore the roots of the reversed polynomial.
+	reversed := make([]uint32, degree+1)
+	for i := range reversed {
+		reversed[i] = locator[degree-i]
+	}
+	roots, ok := findRoots(reversed)
+	if !ok || len(roots) != degree {
+		return nil, ErrSketchDecode
+	}
+
+	// Make sure the elements reproduce the sketch since a sketch beyond
+	// its capacity may still yield a polynomial that fully splits.
+	check := NewSketch(c)
+	for _, root := range roots {
+		check.Add(root)
+	}
+	for i := range check.syndromes {
+		if check.syndromes[i] != s.syndromes[i] {
+			return nil, ErrSketchDecode
+		}
+	}
+	return roots, nil
+}
+
+// berlekampMassey returns the shortest linear feedback shift register, as
+// its connection polynomial with the coefficients in ascending order, that
+// generates the passed sequence.
+func berlekampMassey(sums []uint32) []uint32 {
+	current := []uint32{1}
+	prev := []uint32{1}
+	length := 0
+	shift := 1
+	prevDiscrepancy := uint32(1)
+	for n := range sums {
+		discrepancy := sums[n]
+		for i := 1; i <= length && i < len(current); i++ {
+			discrepancy ^= gfMul(current[i], sums[n-i])
+		}
+		if discrepancy == 0 {
+			shift++
+			continue
+		}
+
+		factor := gfMul(discrepancy, gfInv(prevDiscrepancy))
+		next := make([]uint32, maxInt(len(current), len(prev)+shift))
+		copy(next, current)
+		for i, coef := range prev {
+			next[i+shift] ^= gfMul(factor, coef)
+		}
+		if 2*length <= n {
+			length = n + 1 - length
+			prev = current
+			prevDiscrepancy = discrepancy
+			shift = 1
+		} else {
+			shift++
+		}
+		current = next
+	}
+
+	locator := make([]uint32, length+1)
+	copy(locator, current)
+	return locator
+}
+
+// maxInt returns the larger of the passed integers.
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
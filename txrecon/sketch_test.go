@@ -0,0 +1,124 @@
+package txrecon
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// TestFieldArithmetic ensures the field multiplication and inversion are
+// consistent, which also confirms the reduction polynomial is irreducible.
+func TestFieldArithmetic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a := rng.Uint32() | 1
+		b := rng.Uint32()
+		if got := gfMul(a, gfInv(a)); got != 1 {
+			t.Fatalf("a * a^-1 = %x, want 1 (a = %x)", got, a)
+		}
+		if gfMul(a, b) != gfMul(b, a) {
+			t.Fatalf("multiplication is not commutative for %x, %x", a, b)
+		}
+	}
+}
+
+// sortedElements returns the passed elements sorted in ascending order.
+func sortedElements(elements []uint32) []uint32 {
+	sorted := append([]uint32(nil), elements...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// TestSketchReconcile ensures the symmetric difference of two sets is
+// recovered from their merged sketches as long as it fits the capacity.
+func TestSketchReconcile(t *testing.T) {
+	tests := []struct {
+		name     string
+		common   int
+		onlyA    int
+		onlyB    int
+		capacity int
+		success  bool
+	}{
+		{name: "equal sets", common: 100, capacity: 10, success: true},
+		{name: "single difference", common: 100, onlyA: 1, capacity: 1, success: true},
+		{name: "both sides", common: 500, onlyA: 10, onlyB: 15, capacity: 30, success: true},
+		{name: "exact capacity", common: 50, onlyA: 20, onlyB: 20, capacity: 40, success: true},
+		{name: "over capacity", common: 50, onlyA: 20, onlyB: 21, capacity: 40, success: false},
+		{name: "far over capacity", common: 0, onlyA: 200, capacity: 20, success: false},
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	for _, test := range tests {
+		a := NewSketch(test.capacity)
+		b := NewSketch(test.capacity)
+		var want []uint32
+		for i := 0; i < test.common; i++ {
+			element := rng.Uint32() | 1
+			a.Add(element)
+			b.Add(element)
+		}
+		for i := 0; i < test.onlyA; i++ {
+			element := rng.Uint32() | 1
+			a.Add(element)
+			want = append(want, element)
+		}
+		for i := 0; i < test.onlyB; i++ {
+			element := rng.Uint32() | 1
+			b.Add(element)
+			want = append(want, element)
+		}
+
+		// Exchange the sketch of b in serialized form.
+		received, err := DeserializeSketch(b.Serialize())
+		if err != nil {
+			t.Fatalf("%s: DeserializeSketch: %v", test.name, err)
+		}
+		a.Merge(received)
+		got, err := a.Decode()
+		if !test.success {
+			if err != ErrSketchDecode {
+				t.Errorf("%s: unexpected result %v, %v", test.name,
+					got, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: Decode: %v", test.name, err)
+		}
+		got, want = sortedElements(got), sortedElements(want)
+		if len(got) != len(want) {
+			t.Fatalf("%s: got %d elements, want %d", test.name,
+				len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("%s: got element %x, want %x", test.name,
+					got[i], want[i])
+			}
+		}
+	}
+}
+
+// TestSketchSerialize ensures sketches survive a serialization round trip and
+// malformed sketches are rejected.
+func TestSketchSerialize(t *testing.T) {
+	s := NewSketch(3)
+	s.Add(0x12345678)
+	s.Add(0x9abcdef0)
+	serialized := s.Serialize()
+	if len(serialized) != 12 {
+		t.Fatalf("unexpected serialized size %d", len(serialized))
+	}
+	s2, err := DeserializeSketch(serialized)
+	if err != nil {
+		t.Fatalf("DeserializeSketch: %v", err)
+	}
+	if !bytes.Equal(s2.Serialize(), serialized) {
+		t.Fatal("sketch changed in round trip")
+	}
+	if _, err := DeserializeSketch(serialized[:5]); err == nil {
+		t.Fatal("DeserializeSketch accepted a truncated sketch")
+	}
+}
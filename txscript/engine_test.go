@@ -686,7 +686,7 @@ func Test_isSegwitScript(t *testing.T) {
 func TestSegwitExemption(t *testing.T) {
 	redeemScript := hexToBytes("0014fcf9969ce1c98a135ed293719721fb69f0b686cb")
 
-	addr, err := czzutil.NewAddressScriptHash(redeemScript, &chaincfg.TestNet3Params)
+	addr, err := czzutil.NewAddressScriptHash(redeemScript, &chaincfg.TestNetParams)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -79,7 +79,7 @@ func signAndCheck(msg string, tx *wire.MsgTx, idx int, inputAmt int64, pkScript
 	hashType SigHashType, kdb KeyDB, sdb ScriptDB,
 	previousScript []byte) error {
 
-	sigScript, err := SignTxOutput(&chaincfg.TestNet3Params, tx, idx,
+	sigScript, err := SignTxOutput(&chaincfg.TestNetParams, tx, idx,
 		inputAmt, pkScript, hashType, kdb, sdb, nil)
 	if err != nil {
 		return fmt.Errorf("failed to sign output %s: %v", msg, err)
@@ -226,7 +226,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -263,7 +263,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -276,7 +276,7 @@ func TestSignTxOutput(t *testing.T) {
 					"for %s: %v", msg, err)
 			}
 
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -289,7 +289,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err = SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -324,7 +324,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -363,7 +363,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -376,7 +376,7 @@ func TestSignTxOutput(t *testing.T) {
 					"for %s: %v", msg, err)
 			}
 
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -389,7 +389,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err = SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -425,7 +425,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -464,7 +464,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -477,7 +477,7 @@ func TestSignTxOutput(t *testing.T) {
 					"for %s: %v", msg, err)
 			}
 
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -490,7 +490,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err = SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -525,7 +525,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -564,7 +564,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -577,7 +577,7 @@ func TestSignTxOutput(t *testing.T) {
 					"for %s: %v", msg, err)
 			}
 
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -590,7 +590,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err = SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], pkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -626,7 +626,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -641,7 +641,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -683,7 +683,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -698,7 +698,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -713,7 +713,7 @@ func TestSignTxOutput(t *testing.T) {
 				break
 			}
 
-			_, err = SignTxOutput(&chaincfg.TestNet3Params,
+			_, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -728,7 +728,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -766,7 +766,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -780,7 +780,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -823,7 +823,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKeyHash(
-				czzutil.Hash160(pk), &chaincfg.TestNet3Params)
+				czzutil.Hash160(pk), &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -837,7 +837,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -852,7 +852,7 @@ func TestSignTxOutput(t *testing.T) {
 				break
 			}
 
-			_, err = SignTxOutput(&chaincfg.TestNet3Params,
+			_, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -867,7 +867,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -905,7 +905,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -919,7 +919,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -962,7 +962,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeUncompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -976,7 +976,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -990,7 +990,7 @@ func TestSignTxOutput(t *testing.T) {
 				break
 			}
 
-			_, err = SignTxOutput(&chaincfg.TestNet3Params,
+			_, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -1005,7 +1005,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, false},
@@ -1043,7 +1043,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -1057,7 +1057,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -1099,7 +1099,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk := (*czzec.PublicKey)(&key.PublicKey).
 				SerializeCompressed()
 			address, err := czzutil.NewAddressPubKey(pk,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -1113,7 +1113,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -1127,7 +1127,7 @@ func TestSignTxOutput(t *testing.T) {
 				break
 			}
 
-			_, err = SignTxOutput(&chaincfg.TestNet3Params,
+			_, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -1142,7 +1142,7 @@ func TestSignTxOutput(t *testing.T) {
 
 			// by the above loop, this should be valid, now sign
 			// again and merge.
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address.EncodeAddress(): {key, true},
@@ -1180,7 +1180,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk1 := (*czzec.PublicKey)(&key1.PublicKey).
 				SerializeCompressed()
 			address1, err := czzutil.NewAddressPubKey(pk1,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -1197,7 +1197,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk2 := (*czzec.PublicKey)(&key2.PublicKey).
 				SerializeCompressed()
 			address2, err := czzutil.NewAddressPubKey(pk2,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address 2 for %s: %v",
 					msg, err)
@@ -1213,7 +1213,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -1256,7 +1256,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk1 := (*czzec.PublicKey)(&key1.PublicKey).
 				SerializeCompressed()
 			address1, err := czzutil.NewAddressPubKey(pk1,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -1273,7 +1273,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk2 := (*czzec.PublicKey)(&key2.PublicKey).
 				SerializeCompressed()
 			address2, err := czzutil.NewAddressPubKey(pk2,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address 2 for %s: %v",
 					msg, err)
@@ -1289,7 +1289,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -1303,7 +1303,7 @@ func TestSignTxOutput(t *testing.T) {
 				break
 			}
 
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address1.EncodeAddress(): {key1, true},
@@ -1324,7 +1324,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			// Sign with the other key and merge
-			sigScript, err = SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address2.EncodeAddress(): {key2, true},
@@ -1362,7 +1362,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk1 := (*czzec.PublicKey)(&key1.PublicKey).
 				SerializeCompressed()
 			address1, err := czzutil.NewAddressPubKey(pk1,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address for %s: %v",
 					msg, err)
@@ -1379,7 +1379,7 @@ func TestSignTxOutput(t *testing.T) {
 			pk2 := (*czzec.PublicKey)(&key2.PublicKey).
 				SerializeCompressed()
 			address2, err := czzutil.NewAddressPubKey(pk2,
-				&chaincfg.TestNet3Params)
+				&chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make address 2 for %s: %v",
 					msg, err)
@@ -1395,7 +1395,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			scriptAddr, err := czzutil.NewAddressScriptHash(
-				pkScript, &chaincfg.TestNet3Params)
+				pkScript, &chaincfg.TestNetParams)
 			if err != nil {
 				t.Errorf("failed to make p2sh addr for %s: %v",
 					msg, err)
@@ -1409,7 +1409,7 @@ func TestSignTxOutput(t *testing.T) {
 				break
 			}
 
-			sigScript, err := SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err := SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address1.EncodeAddress(): {key1, true},
@@ -1430,7 +1430,7 @@ func TestSignTxOutput(t *testing.T) {
 			}
 
 			// Sign with the other key and merge
-			sigScript, err = SignTxOutput(&chaincfg.TestNet3Params,
+			sigScript, err = SignTxOutput(&chaincfg.TestNetParams,
 				tx, i, inputAmounts[i], scriptPkScript, hashType,
 				mkGetKey(map[string]addressToKey{
 					address1.EncodeAddress(): {key1, true},
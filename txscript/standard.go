@@ -17,6 +17,11 @@ const (
 	// data to be considered a nulldata transaction
 	MaxDataCarrierSize = 223
 
+	// MaxPoolDataCarrierSize is the maximum number of bytes allowed in the
+	// data of ConvertConfirm and PoolKeyRotation scripts, which carry the
+	// signatures of the keys controlling a pool.
+	MaxPoolDataCarrierSize = 4096
+
 	// StandardVerifyFlags are the script flags which are used when
 	// executing transaction scripts to enforce additional checks which
 	// are required for the script to be considered standard.  These checks
@@ -65,6 +70,7 @@ const (
 	ConvertTy
 	CastingTy
 	ConvertConfirmTy
+	PoolKeyRotationTy
 )
 
 // scriptClassToName houses the human-readable strings which describe each
@@ -85,6 +91,7 @@ var scriptClassToName = []string{
 	ConvertTy:            "convert",
 	CastingTy:            "casting",
 	ConvertConfirmTy:     "convertconfirm",
+	PoolKeyRotationTy:    "poolkeyrotation",
 }
 
 // String implements the Stringer interface by returning the name of
@@ -219,7 +226,7 @@ func isBeaconTy(pops []parsedOpcode) bool {
 
 func isAddBeaconPledgeTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 3 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN197 &&
 		pops[2].opcode.value == OP_1
@@ -227,7 +234,7 @@ func isAddBeaconPledgeTy(pops []parsedOpcode) bool {
 
 func isMortgageTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 2 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN198 &&
 		pops[2].opcode.value == OP_1
@@ -235,7 +242,7 @@ func isMortgageTy(pops []parsedOpcode) bool {
 
 func isAddMortgageTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 2 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN198 &&
 		pops[2].opcode.value == OP_2
@@ -243,7 +250,7 @@ func isAddMortgageTy(pops []parsedOpcode) bool {
 
 func isUpdateCoinbaseAllTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 2 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN198 &&
 		pops[2].opcode.value == OP_3
@@ -251,7 +258,7 @@ func isUpdateCoinbaseAllTy(pops []parsedOpcode) bool {
 
 func isConvertTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 2 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN198 &&
 		pops[2].opcode.value == OP_4
@@ -259,7 +266,7 @@ func isConvertTy(pops []parsedOpcode) bool {
 
 func isCastingTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 2 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN198 &&
 		pops[2].opcode.value == OP_5
@@ -267,12 +274,20 @@ func isCastingTy(pops []parsedOpcode) bool {
 
 func isConvertConfirmTy(pops []parsedOpcode) bool {
 	// simple judge
-	return len(pops) >= 2 &&
+	return len(pops) >= 4 &&
 		pops[0].opcode.value == OP_RETURN &&
 		pops[1].opcode.value == OP_UNKNOWN198 &&
 		pops[2].opcode.value == OP_6
 }
 
+func isPoolKeyRotationTy(pops []parsedOpcode) bool {
+	// simple judge
+	return len(pops) >= 4 &&
+		pops[0].opcode.value == OP_RETURN &&
+		pops[1].opcode.value == OP_UNKNOWN198 &&
+		pops[2].opcode.value == OP_7
+}
+
 // scriptType returns the type of the script being inspected from the known
 // standard types.
 func typeOfScript(pops []parsedOpcode) ScriptClass {
@@ -304,6 +319,8 @@ func typeOfScript(pops []parsedOpcode) ScriptClass {
 		return ConvertConfirmTy
 	} else if isCastingTy(pops) {
 		return CastingTy
+	} else if isPoolKeyRotationTy(pops) {
+		return PoolKeyRotationTy
 	}
 
 	return NonStandardTy
@@ -383,6 +400,14 @@ func IsConvertConfirmTy(script []byte) bool {
 	return isConvertConfirmTy(pops)
 }
 
+func IsPoolKeyRotationTy(script []byte) bool {
+	pops, err := parseScript(script)
+	if err != nil {
+		return false
+	}
+	return isPoolKeyRotationTy(pops)
+}
+
 // expectedInputs returns the number of arguments required by a script.
 // If the script is of unknown type such that the number can not be determined
 // then -1 is returned. We are an internal function and thus assume that class
@@ -426,6 +451,8 @@ func expectedInputs(pops []parsedOpcode, class ScriptClass) int {
 		fallthrough
 	case ConvertConfirmTy:
 		fallthrough
+	case PoolKeyRotationTy:
+		fallthrough
 	default:
 		return -1
 	}
@@ -691,14 +718,27 @@ func CastingScript(data []byte) ([]byte, error) {
 	return NewScriptBuilder().AddOp(OP_RETURN).AddOp(OP_UNKNOWN198).AddOp(OP_5).AddData(data).Script()
 }
 
-// ConvertConfirmScript impl in
+// ConvertConfirmScript impl in.  The data may exceed the maximum script
+// element size since the script is never executed.
 func ConvertConfirmScript(data []byte) ([]byte, error) {
-	if len(data) > MaxDataCarrierSize {
+	if len(data) > MaxPoolDataCarrierSize {
 		str := fmt.Sprintf("data size %d is larger than max "+
-			"allowed size %d", len(data), MaxDataCarrierSize)
+			"allowed size %d", len(data), MaxPoolDataCarrierSize)
+		return nil, scriptError(ErrTooMuchNullData, str)
+	}
+	return NewScriptBuilder().AddOp(OP_RETURN).AddOp(OP_UNKNOWN198).AddOp(OP_6).AddFullData(data).Script()
+}
+
+// PoolKeyRotationScript returns a script carrying the new definition of a
+// pool along with the signatures of its current keys.  Like with
+// ConvertConfirmScript, the data may exceed the maximum script element size.
+func PoolKeyRotationScript(data []byte) ([]byte, error) {
+	if len(data) > MaxPoolDataCarrierSize {
+		str := fmt.Sprintf("data size %d is larger than max "+
+			"allowed size %d", len(data), MaxPoolDataCarrierSize)
 		return nil, scriptError(ErrTooMuchNullData, str)
 	}
-	return NewScriptBuilder().AddOp(OP_RETURN).AddOp(OP_UNKNOWN198).AddOp(OP_6).AddData(data).Script()
+	return NewScriptBuilder().AddOp(OP_RETURN).AddOp(OP_UNKNOWN198).AddOp(OP_7).AddFullData(data).Script()
 }
 
 // KeepedAmountScript impl in
@@ -738,7 +778,7 @@ func GetKeepedAmountData(script []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	if !isKeepedAmountInfo(pops) {
+	if !isKeepedAmountInfo(pops) || len(pops) < 3 {
 		return nil, errors.New("not keepedAmount info type")
 	}
 	return pops[2].data, nil
@@ -749,7 +789,7 @@ func GetBeaconRegistrationData(script []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	if !isBeaconRegistrationTy(pops) {
+	if !isBeaconRegistrationTy(pops) || len(pops) < 3 {
 		return nil, errors.New("not BeaconRegistration type")
 	}
 	return pops[2].data, nil
@@ -832,6 +872,17 @@ func GetConvertConfirmInfoData(script []byte) ([]byte, error) {
 	return pops[3].data, nil
 }
 
+func GetPoolKeyRotationData(script []byte) ([]byte, error) {
+	pops, err := parseScript(script)
+	if err != nil {
+		return nil, err
+	}
+	if !isPoolKeyRotationTy(pops) {
+		return nil, errors.New("not PoolKeyRotation type")
+	}
+	return pops[3].data, nil
+}
+
 // PushedData returns an array of byte slices containing any pushed data found
 // in the passed script.  This includes OP_0, but not OP_1 - OP_16.
 func PushedData(script []byte) ([][]byte, error) {
@@ -935,6 +986,7 @@ func ExtractPkScriptAddrs(pkScript []byte, chainParams *chaincfg.Params) (Script
 	case ConvertTy:
 	case CastingTy:
 	case ConvertConfirmTy:
+	case PoolKeyRotationTy:
 
 	}
 
@@ -997,6 +997,45 @@ func TestScriptClass(t *testing.T) {
 	}
 }
 
+// TestTruncatedCrossScripts ensures truncated scripts of the cross-chain
+// transaction types are neither classified as those types nor crash their
+// data accessors.
+func TestTruncatedCrossScripts(t *testing.T) {
+	t.Parallel()
+
+	getters := []func([]byte) ([]byte, error){
+		GetKeepedAmountData, GetBeaconRegistrationData,
+		GetAddBeaconPledgeData, GetMortgageData, GetAddMortgageData,
+		GetUpdateCoinbaseAllData, GetConvertInfoData,
+		GetCastingInfoData, GetConvertConfirmInfoData,
+		GetPoolKeyRotationData,
+	}
+	prefixes := []byte{OP_UNKNOWN194, OP_UNKNOWN195, OP_UNKNOWN197,
+		OP_UNKNOWN198}
+	for _, prefix := range prefixes {
+		scripts := [][]byte{{OP_RETURN, prefix}}
+		for op := byte(OP_1); op <= OP_7; op++ {
+			scripts = append(scripts, []byte{OP_RETURN, prefix, op})
+		}
+		for _, script := range scripts {
+			class := GetScriptClass(script)
+			if prefix == OP_UNKNOWN198 && class != NonStandardTy {
+				t.Errorf("script %x: expected %s got %s", script,
+					NonStandardTy, class)
+			}
+			// None of the types carry their data in the
+			// third opcode of a script.
+			for i, get := range getters {
+				data, err := get(script)
+				if err == nil && data != nil {
+					t.Errorf("script %x: getter %d returned "+
+						"data %x", script, i, data)
+				}
+			}
+		}
+	}
+}
+
 // TestStringifyClass ensures the script class string returns the expected
 // string for each script class.
 func TestStringifyClass(t *testing.T) {
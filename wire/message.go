@@ -39,41 +39,47 @@ func maxMessagePayload() uint32 {
 
 // Commands used in bitcoin message headers which describe the type of message.
 const (
-	CmdVersion      = "version"
-	CmdXVersion     = "xversion"
-	CmdVerAck       = "verack"
-	CmdXVerAck      = "xverack"
-	CmdGetAddr      = "getaddr"
-	CmdAddr         = "addr"
-	CmdGetBlocks    = "getblocks"
-	CmdInv          = "inv"
-	CmdGetData      = "getdata"
-	CmdNotFound     = "notfound"
-	CmdBlock        = "block"
-	CmdTx           = "tx"
-	CmdGetHeaders   = "getheaders"
-	CmdHeaders      = "headers"
-	CmdPing         = "ping"
-	CmdPong         = "pong"
-	CmdMemPool      = "mempool"
-	CmdFilterAdd    = "filteradd"
-	CmdFilterClear  = "filterclear"
-	CmdFilterLoad   = "filterload"
-	CmdMerkleBlock  = "merkleblock"
-	CmdReject       = "reject"
-	CmdSendHeaders  = "sendheaders"
-	CmdFeeFilter    = "feefilter"
-	CmdGetCFilters  = "getcfilters"
-	CmdGetCFHeaders = "getcfheaders"
-	CmdGetCFCheckpt = "getcfcheckpt"
-	CmdGetCFMempool = "getcfmempool"
-	CmdCFilter      = "cfilter"
-	CmdCFHeaders    = "cfheaders"
-	CmdCFCheckpt    = "cfcheckpt"
-	CmdSendCmpct    = "sendcmpct"
-	CmdCmpctBlock   = "cmpctblock"
-	CmdGetBlockTxns = "getblocktxn"
-	CmdBlockTxns    = "blocktxn"
+	CmdVersion       = "version"
+	CmdXVersion      = "xversion"
+	CmdVerAck        = "verack"
+	CmdXVerAck       = "xverack"
+	CmdGetAddr       = "getaddr"
+	CmdAddr          = "addr"
+	CmdGetBlocks     = "getblocks"
+	CmdInv           = "inv"
+	CmdGetData       = "getdata"
+	CmdNotFound      = "notfound"
+	CmdBlock         = "block"
+	CmdTx            = "tx"
+	CmdGetHeaders    = "getheaders"
+	CmdHeaders       = "headers"
+	CmdPing          = "ping"
+	CmdPong          = "pong"
+	CmdMemPool       = "mempool"
+	CmdFilterAdd     = "filteradd"
+	CmdFilterClear   = "filterclear"
+	CmdFilterLoad    = "filterload"
+	CmdMerkleBlock   = "merkleblock"
+	CmdReject        = "reject"
+	CmdSendHeaders   = "sendheaders"
+	CmdFeeFilter     = "feefilter"
+	CmdGetCFilters   = "getcfilters"
+	CmdGetCFHeaders  = "getcfheaders"
+	CmdGetCFCheckpt  = "getcfcheckpt"
+	CmdGetCFMempool  = "getcfmempool"
+	CmdCFilter       = "cfilter"
+	CmdCFHeaders     = "cfheaders"
+	CmdCFCheckpt     = "cfcheckpt"
+	CmdSendCmpct     = "sendcmpct"
+	CmdCmpctBlock    = "cmpctblock"
+	CmdGetBlockTxns  = "getblocktxn"
+	CmdBlockTxns     = "blocktxn"
+	CmdGrapheneBlock = "grblk"
+	CmdReqRecon      = "reqrecon"
+	CmdSketch        = "sketch"
+	CmdReconcilDiff  = "reconcildiff"
+	CmdAddrV2        = "addrv2"
+	CmdSendAddrV2    = "sendaddrv2"
 )
 
 // MessageEncoding represents the wire message encoding format to be used.
@@ -212,6 +218,24 @@ func makeEmptyMessage(command string) (Message, error) {
 	case CmdBlockTxns:
 		msg = &MsgBlockTxns{}
 
+	case CmdGrapheneBlock:
+		msg = &MsgGrapheneBlock{}
+
+	case CmdReqRecon:
+		msg = &MsgReqRecon{}
+
+	case CmdSketch:
+		msg = &MsgSketch{}
+
+	case CmdReconcilDiff:
+		msg = &MsgReconcilDiff{}
+
+	case CmdAddrV2:
+		msg = &MsgAddrV2{}
+
+	case CmdSendAddrV2:
+		msg = &MsgSendAddrV2{}
+
 	default:
 		return nil, fmt.Errorf("unhandled command [%s]", command)
 	}
@@ -245,7 +269,7 @@ func readMessageHeader(r io.Reader) (int, *messageHeader, error) {
 	readElements(hr, &hdr.magic, &command, &hdr.length, &hdr.checksum)
 
 	// Strip trailing zeros from command string.
-	hdr.command = string(bytes.TrimRight(command[:], string(0)))
+	hdr.command = string(bytes.TrimRight(command[:], string(rune(0))))
 
 	return n, &hdr, nil
 }
@@ -199,7 +199,7 @@ func TestReadMessageWireErrors(t *testing.T) {
 	}
 
 	// Wire encoded bytes for main and testnet3 networks magic identifiers.
-	testNet3Bytes := makeHeader(TestNet3, "", 0, 0)
+	testNet3Bytes := makeHeader(TestNet, "", 0, 0)
 
 	// Wire encoded bytes for a message that exceeds max overall message
 	// length.
@@ -257,7 +257,7 @@ func TestReadMessageWireErrors(t *testing.T) {
 			0,
 		},
 
-		// Wrong network.  Want MainNet, but giving TestNet3.
+		// Wrong network.  Want MainNet, but giving TestNet.
 		{
 			testNet3Bytes,
 			pver,
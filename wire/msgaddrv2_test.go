@@ -0,0 +1,210 @@
+package wire
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestAddrV2Wire tests the MsgAddrV2 wire encode and decode for the address
+// types of all networks known by BIP0155.
+func TestAddrV2Wire(t *testing.T) {
+	pver := ProtocolVersion
+	ts := time.Unix(0x495fab29, 0)
+	torV3 := bytes.Repeat([]byte{0x53}, 32)
+	i2p := bytes.Repeat([]byte{0xa2}, 32)
+	cjdns := net.ParseIP("fc00:1:2:3:4:5:6:7")
+
+	tests := []struct {
+		name string
+		na   *NetAddress
+		buf  []byte
+	}{
+		{
+			name: "ipv4",
+			na:   NewNetAddressTimestamp(ts, SFNodeNetwork, net.ParseIP("1.2.3.4"), 8333),
+			buf: []byte{
+				0x29, 0xab, 0x5f, 0x49, // Timestamp
+				0x01,                         // Services
+				0x01,                         // Network
+				0x04, 0x01, 0x02, 0x03, 0x04, // Address
+				0x20, 0x8d, // Port 8333 in big-endian
+			},
+		},
+		{
+			name: "ipv6",
+			na:   NewNetAddressTimestamp(ts, SFNodeNetwork|SFNodeTxRecon, net.ParseIP("2001:db8::1"), 8333),
+			buf: []byte{
+				0x29, 0xab, 0x5f, 0x49,
+				0xfd, 0x01, 0x08, // Services
+				0x02,
+				0x10, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				0x20, 0x8d,
+			},
+		},
+		{
+			name: "tor v2",
+			na: NewNetAddressTimestamp(ts, SFNodeNetwork, net.IP{
+				0xfd, 0x87, 0xd8, 0x7e, 0xeb, 0x43, 0xf1, 0xf2,
+				0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8, 0xf9, 0xfa}, 8333),
+			buf: []byte{
+				0x29, 0xab, 0x5f, 0x49,
+				0x01,
+				0x03,
+				0x0a, 0xf1, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8,
+				0xf9, 0xfa,
+				0x20, 0x8d,
+			},
+		},
+		{
+			name: "tor v3",
+			na:   newNetworkAddress(ts, NetworkTorV3, torV3),
+			buf: append(append([]byte{
+				0x29, 0xab, 0x5f, 0x49,
+				0x01,
+				0x04,
+				0x20}, torV3...),
+				0x20, 0x8d),
+		},
+		{
+			name: "i2p",
+			na:   newNetworkAddress(ts, NetworkI2P, i2p),
+			buf: append(append([]byte{
+				0x29, 0xab, 0x5f, 0x49,
+				0x01,
+				0x05,
+				0x20}, i2p...),
+				0x20, 0x8d),
+		},
+		{
+			name: "cjdns",
+			na:   newNetworkAddress(ts, NetworkCJDNS, cjdns),
+			buf: append(append([]byte{
+				0x29, 0xab, 0x5f, 0x49,
+				0x01,
+				0x06,
+				0x10}, cjdns...),
+				0x20, 0x8d),
+		},
+		{
+			name: "unknown network",
+			na:   newNetworkAddress(ts, NetworkID(0xaa), []byte{0x01, 0x02, 0x03}),
+			buf: []byte{
+				0x29, 0xab, 0x5f, 0x49,
+				0x01,
+				0xaa,
+				0x03, 0x01, 0x02, 0x03,
+				0x20, 0x8d,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgAddrV2()
+		msg.AddAddress(test.na)
+
+		var buf bytes.Buffer
+		if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+			t.Errorf("%s: CzzEncode: %v", test.name, err)
+			continue
+		}
+		want := append([]byte{0x01}, test.buf...)
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("%s: CzzEncode: wrong bytes - got %v, want %v",
+				test.name, spew.Sdump(buf.Bytes()), spew.Sdump(want))
+			continue
+		}
+
+		var readMsg MsgAddrV2
+		if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err != nil {
+			t.Errorf("%s: CzzDecode: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(&readMsg, msg) {
+			t.Errorf("%s: CzzDecode: wrong message - got %v, want %v",
+				test.name, spew.Sdump(&readMsg), spew.Sdump(msg))
+		}
+	}
+}
+
+// newNetworkAddress returns a NetAddress of the passed network using the
+// port and services shared by the addrv2 tests.
+func newNetworkAddress(ts time.Time, network NetworkID, addr []byte) *NetAddress {
+	na := NewNetAddressNetwork(network, addr, 8333, SFNodeNetwork)
+	na.Timestamp = ts
+	return na
+}
+
+// TestAddrV2WireErrors ensures malformed addrv2 messages are rejected.
+func TestAddrV2WireErrors(t *testing.T) {
+	pver := ProtocolVersion
+
+	tests := []struct {
+		name string
+		buf  []byte
+	}{
+		{
+			name: "ipv4 address too long",
+			buf: []byte{
+				0x01, 0x29, 0xab, 0x5f, 0x49, 0x01, 0x01,
+				0x05, 0x01, 0x02, 0x03, 0x04, 0x05,
+				0x20, 0x8d,
+			},
+		},
+		{
+			name: "tor v3 address too short",
+			buf: []byte{
+				0x01, 0x29, 0xab, 0x5f, 0x49, 0x01, 0x04,
+				0x02, 0x01, 0x02,
+				0x20, 0x8d,
+			},
+		},
+		{
+			name: "ipv4 mapped ipv6 address",
+			buf: []byte{
+				0x01, 0x29, 0xab, 0x5f, 0x49, 0x01, 0x02,
+				0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0xff, 0xff, 0x01, 0x02, 0x03, 0x04,
+				0x20, 0x8d,
+			},
+		},
+		{
+			name: "address beyond max size",
+			buf: []byte{
+				0x01, 0x29, 0xab, 0x5f, 0x49, 0x01, 0xaa,
+				0xfd, 0x01, 0x02,
+			},
+		},
+		{
+			name: "too many addresses",
+			buf:  []byte{0xfd, 0xe9, 0x03},
+		},
+	}
+
+	for _, test := range tests {
+		var msg MsgAddrV2
+		err := msg.CzzDecode(bytes.NewReader(test.buf), pver, BaseEncoding)
+		if err == nil {
+			t.Errorf("%s: CzzDecode did not fail", test.name)
+		}
+	}
+}
+
+// TestAddrV2NeedsAddrV2 ensures only addresses not held in an IP require the
+// addrv2 message.
+func TestAddrV2NeedsAddrV2(t *testing.T) {
+	na := NewNetAddressIPPort(net.ParseIP("1.2.3.4"), 8333, SFNodeNetwork)
+	if na.NeedsAddrV2() {
+		t.Error("ipv4 address needs addrv2")
+	}
+	na = NewNetAddressNetwork(NetworkTorV3, make([]byte, 32), 8333,
+		SFNodeNetwork)
+	if !na.NeedsAddrV2() {
+		t.Error("tor v3 address does not need addrv2")
+	}
+}
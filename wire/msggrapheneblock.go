@@ -0,0 +1,172 @@
+package wire
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/classzz/classzz/chaincfg/chainhash"
+)
+
+// MsgGrapheneBlock implements the Message interface and represents a graphene
+// block message.  Rather than short IDs for every transaction, a graphene block
+// carries a Bloom filter and an invertible Bloom lookup table (IBLT) over the
+// short IDs of the block's transactions which the receiver reconciles with its
+// mempool.  The filter, IBLT and order are kept in their serialized form here
+// and interpreted by the graphene package.
+//
+// Order holds the position of each non-prefilled transaction within the set of
+// short IDs sorted in ascending order.  It is empty when the transactions
+// following the coinbase are in canonical (lexicographical) order, in which
+// case the receiver can restore the order from the transaction hashes alone.
+type MsgGrapheneBlock struct {
+	Header          BlockHeader
+	Nonce           uint64
+	TxCount         uint32
+	FilterHashFuncs uint32
+	Filter          []byte
+	IBLT            []byte
+	Order           []byte
+	PrefilledTxs    []*PrefilledTx
+}
+
+// CzzDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgGrapheneBlock) CzzDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	if err := readBlockHeader(r, pver, &msg.Header); err != nil {
+		return err
+	}
+	if err := readElement(r, &msg.Nonce); err != nil {
+		return err
+	}
+
+	txCount, err := ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+
+	// Prevent more transactions than could possibly fit into a block since
+	// the receiver allocates the transactions of the block up front.
+	if txCount > uint64(maxTxPerBlock()) {
+		str := fmt.Sprintf("too many transactions to fit into a block "+
+			"[count %d, max %d]", txCount, maxTxPerBlock())
+		return messageError("MsgGrapheneBlock.CzzDecode", str)
+	}
+	msg.TxCount = uint32(txCount)
+
+	hashFuncs, err := ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+	msg.FilterHashFuncs = uint32(hashFuncs)
+
+	msg.Filter, err = ReadVarBytes(r, pver, maxMessagePayload(),
+		"graphene filter")
+	if err != nil {
+		return err
+	}
+	msg.IBLT, err = ReadVarBytes(r, pver, maxMessagePayload(),
+		"graphene iblt")
+	if err != nil {
+		return err
+	}
+	msg.Order, err = ReadVarBytes(r, pver, maxMessagePayload(),
+		"graphene order")
+	if err != nil {
+		return err
+	}
+
+	prefilledTxCount, err := ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+	if prefilledTxCount > txCount {
+		str := "too many prefilled transactions for graphene block"
+		return messageError("MsgGrapheneBlock.CzzDecode", str)
+	}
+
+	for i := uint64(0); i < prefilledTxCount; i++ {
+		index, err := ReadVarInt(r, pver)
+		if err != nil {
+			return err
+		}
+		tx := MsgTx{}
+		err = tx.CzzDecode(r, pver, enc)
+		if err != nil {
+			return err
+		}
+		ptx := &PrefilledTx{
+			Index: uint32(index),
+			Tx:    &tx,
+		}
+		msg.PrefilledTxs = append(msg.PrefilledTxs, ptx)
+	}
+	return nil
+}
+
+// CzzEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgGrapheneBlock) CzzEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	if err := writeBlockHeader(w, pver, &msg.Header); err != nil {
+		return err
+	}
+	if err := writeElement(w, msg.Nonce); err != nil {
+		return err
+	}
+	if err := WriteVarInt(w, pver, uint64(msg.TxCount)); err != nil {
+		return err
+	}
+	if err := WriteVarInt(w, pver, uint64(msg.FilterHashFuncs)); err != nil {
+		return err
+	}
+	if err := WriteVarBytes(w, pver, msg.Filter); err != nil {
+		return err
+	}
+	if err := WriteVarBytes(w, pver, msg.IBLT); err != nil {
+		return err
+	}
+	if err := WriteVarBytes(w, pver, msg.Order); err != nil {
+		return err
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(msg.PrefilledTxs))); err != nil {
+		return err
+	}
+	for _, ptx := range msg.PrefilledTxs {
+		if err := WriteVarInt(w, pver, uint64(ptx.Index)); err != nil {
+			return err
+		}
+		if err := ptx.Tx.CzzEncode(w, pver, enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgGrapheneBlock) Command() string {
+	return CmdGrapheneBlock
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgGrapheneBlock) MaxPayloadLength(pver uint32) uint32 {
+	// This can take up to the max payload. The derived block
+	// cannot be larger than the excessive block size.
+	return maxMessagePayload()
+}
+
+// BlockHash computes the block identifier hash for this block.
+func (msg *MsgGrapheneBlock) BlockHash() chainhash.Hash {
+	return msg.Header.BlockHash()
+}
+
+// NewMsgGrapheneBlock returns a new graphene block message that conforms to
+// the Message interface using the passed parameters and defaults for the
+// remaining fields.
+func NewMsgGrapheneBlock(blockHeader *BlockHeader) *MsgGrapheneBlock {
+	return &MsgGrapheneBlock{
+		Header:       *blockHeader,
+		PrefilledTxs: make([]*PrefilledTx, 0, defaultTransactionAlloc),
+	}
+}
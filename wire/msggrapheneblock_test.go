@@ -0,0 +1,96 @@
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestGrapheneBlock tests the MsgGrapheneBlock API.
+func TestGrapheneBlock(t *testing.T) {
+	pver := ProtocolVersion
+
+	// Block 1 header.
+	prevHash := &blockOne.Header.PrevBlock
+	merkleHash := &blockOne.Header.MerkleRoot
+	bits := blockOne.Header.Bits
+	nonce := blockOne.Header.Nonce
+	bh := NewBlockHeader(1, prevHash, merkleHash, &EmptyCIDRoot, bits, nonce)
+
+	// Ensure the command is expected value.
+	wantCmd := "grblk"
+	msg := NewMsgGrapheneBlock(bh)
+	if cmd := msg.Command(); cmd != wantCmd {
+		t.Errorf("NewMsgGrapheneBlock: wrong command - got %v want %v",
+			cmd, wantCmd)
+	}
+
+	// Ensure max payload is expected value for latest protocol version.
+	wantPayload := maxMessagePayload()
+	maxPayload := msg.MaxPayloadLength(pver)
+	if maxPayload != wantPayload {
+		t.Errorf("MaxPayloadLength: wrong max payload length for "+
+			"protocol version %d - got %v, want %v", pver,
+			maxPayload, wantPayload)
+	}
+
+	// Ensure we get the same block header data back out.
+	if !reflect.DeepEqual(&msg.Header, bh) {
+		t.Errorf("NewMsgGrapheneBlock: wrong block header - got %v, want %v",
+			spew.Sdump(&msg.Header), spew.Sdump(bh))
+	}
+}
+
+// TestGrapheneBlockWire tests the MsgGrapheneBlock wire encode and decode.
+func TestGrapheneBlockWire(t *testing.T) {
+	pver := ProtocolVersion
+
+	msg := NewMsgGrapheneBlock(&blockOne.Header)
+	msg.Nonce = 0x0123456789abcdef
+	msg.TxCount = 3
+	msg.FilterHashFuncs = 7
+	msg.Filter = []byte{0x01, 0x02, 0x03}
+	msg.IBLT = []byte{0x04, 0x05}
+	msg.Order = []byte{0x06}
+	msg.PrefilledTxs = append(msg.PrefilledTxs, &PrefilledTx{
+		Index: 0,
+		Tx:    blockOne.Transactions[0],
+	})
+
+	var buf bytes.Buffer
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+
+	var readMsg MsgGrapheneBlock
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzDecode: %v", err)
+	}
+	if !reflect.DeepEqual(&readMsg, msg) {
+		t.Errorf("CzzDecode: wrong message - got %v, want %v",
+			spew.Sdump(&readMsg), spew.Sdump(msg))
+	}
+
+	// Ensure more prefilled transactions than transactions are rejected.
+	msg.TxCount = 0
+	buf.Reset()
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("CzzDecode: did not reject too many prefilled " +
+			"transactions")
+	}
+
+	// Ensure more transactions than fit into a block are rejected.
+	msg.TxCount = maxTxPerBlock() + 1
+	buf.Reset()
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("CzzDecode: did not reject too many transactions")
+	}
+}
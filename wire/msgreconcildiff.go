@@ -0,0 +1,93 @@
+package wire
+
+import (
+	"fmt"
+	"io"
+)
+
+// MsgReconcilDiff implements the Message interface and represents a bitcoin
+// reconcildiff message.  It concludes a transaction reconciliation round and
+// is sent by the initiator once it decoded the difference between the sketch
+// of the responder and its own.
+//
+// On success AskShortIDs lists the short IDs of the announcements of the
+// responder the initiator does not know about, which the responder then
+// announces with an inv message.  When the difference could not be decoded
+// Success is false and both sides announce all of their queued transactions
+// instead.
+type MsgReconcilDiff struct {
+	Success     bool
+	AskShortIDs []uint32
+}
+
+// CzzDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgReconcilDiff) CzzDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	if err := readElement(r, &msg.Success); err != nil {
+		return err
+	}
+
+	count, err := ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+	if count > MaxTxReconSketchCapacity {
+		str := fmt.Sprintf("too many short IDs for message "+
+			"[count %v, max %v]", count, MaxTxReconSketchCapacity)
+		return messageError("MsgReconcilDiff.CzzDecode", str)
+	}
+
+	msg.AskShortIDs = make([]uint32, count)
+	for i := range msg.AskShortIDs {
+		if err := readElement(r, &msg.AskShortIDs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CzzEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgReconcilDiff) CzzEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	count := len(msg.AskShortIDs)
+	if count > MaxTxReconSketchCapacity {
+		str := fmt.Sprintf("too many short IDs for message "+
+			"[count %v, max %v]", count, MaxTxReconSketchCapacity)
+		return messageError("MsgReconcilDiff.CzzEncode", str)
+	}
+
+	if err := writeElement(w, msg.Success); err != nil {
+		return err
+	}
+	if err := WriteVarInt(w, pver, uint64(count)); err != nil {
+		return err
+	}
+	for _, shortID := range msg.AskShortIDs {
+		if err := writeElement(w, shortID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgReconcilDiff) Command() string {
+	return CmdReconcilDiff
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgReconcilDiff) MaxPayloadLength(pver uint32) uint32 {
+	// Success flag 1 byte + var int count + 4 bytes per short ID.
+	return 1 + MaxVarIntPayload + 4*MaxTxReconSketchCapacity
+}
+
+// NewMsgReconcilDiff returns a new bitcoin reconcildiff message that conforms
+// to the Message interface using the passed parameters.
+func NewMsgReconcilDiff(success bool, askShortIDs []uint32) *MsgReconcilDiff {
+	return &MsgReconcilDiff{
+		Success:     success,
+		AskShortIDs: askShortIDs,
+	}
+}
@@ -0,0 +1,52 @@
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestReconcilDiffWire tests the MsgReconcilDiff wire encode and decode.
+func TestReconcilDiffWire(t *testing.T) {
+	pver := ProtocolVersion
+
+	tests := []*MsgReconcilDiff{
+		NewMsgReconcilDiff(false, []uint32{}),
+		NewMsgReconcilDiff(true, []uint32{0x01020304, 0xfffffffe}),
+	}
+	for i, msg := range tests {
+		if cmd := msg.Command(); cmd != "reconcildiff" {
+			t.Errorf("NewMsgReconcilDiff: wrong command - got %v "+
+				"want reconcildiff", cmd)
+		}
+
+		var buf bytes.Buffer
+		if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+			t.Fatalf("CzzEncode #%d: %v", i, err)
+		}
+		var readMsg MsgReconcilDiff
+		if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err != nil {
+			t.Fatalf("CzzDecode #%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(&readMsg, msg) {
+			t.Errorf("CzzDecode #%d: wrong message - got %v, want %v",
+				i, spew.Sdump(&readMsg), spew.Sdump(msg))
+		}
+	}
+
+	// Ensure too many short IDs are rejected.
+	msg := NewMsgReconcilDiff(true,
+		make([]uint32, MaxTxReconSketchCapacity+1))
+	var buf bytes.Buffer
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("CzzEncode: did not reject too many short IDs")
+	}
+	buf.Reset()
+	buf.Write([]byte{0x01, 0xfd, 0xf5, 0x01})
+	var readMsg MsgReconcilDiff
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("CzzDecode: did not reject too many short IDs")
+	}
+}
@@ -0,0 +1,58 @@
+package wire
+
+import (
+	"io"
+)
+
+// MaxTxReconSketchCapacity is the maximum capacity of a transaction
+// reconciliation sketch and thereby the maximum number of differences that can
+// be reconciled in a single round.
+const MaxTxReconSketchCapacity = 500
+
+// MsgReqRecon implements the Message interface and represents a bitcoin
+// reqrecon message.  It is sent by the initiator of a transaction
+// reconciliation round to request a sketch of the announcements the responder
+// has queued for it.
+//
+// SetSize is the number of announcements the initiator has queued for the
+// responder and Q is the estimated fraction of announcements, scaled by
+// 2^15-1, that one of the sets holds beyond the other.  Both are used by the
+// responder to size the sketch.
+type MsgReqRecon struct {
+	SetSize uint32
+	Q       uint16
+}
+
+// CzzDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgReqRecon) CzzDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	return readElements(r, &msg.SetSize, &msg.Q)
+}
+
+// CzzEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgReqRecon) CzzEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	return writeElements(w, msg.SetSize, msg.Q)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgReqRecon) Command() string {
+	return CmdReqRecon
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgReqRecon) MaxPayloadLength(pver uint32) uint32 {
+	// Set size 4 bytes + q 2 bytes.
+	return 6
+}
+
+// NewMsgReqRecon returns a new bitcoin reqrecon message that conforms to the
+// Message interface using the passed parameters.
+func NewMsgReqRecon(setSize uint32, q uint16) *MsgReqRecon {
+	return &MsgReqRecon{
+		SetSize: setSize,
+		Q:       q,
+	}
+}
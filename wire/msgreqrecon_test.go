@@ -0,0 +1,43 @@
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestReqReconWire tests the MsgReqRecon wire encode and decode.
+func TestReqReconWire(t *testing.T) {
+	pver := ProtocolVersion
+
+	msg := NewMsgReqRecon(0x01020304, 0x0506)
+	if cmd := msg.Command(); cmd != "reqrecon" {
+		t.Errorf("NewMsgReqRecon: wrong command - got %v want reqrecon",
+			cmd)
+	}
+
+	var buf bytes.Buffer
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	want := []byte{0x04, 0x03, 0x02, 0x01, 0x06, 0x05}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("CzzEncode: wrong bytes - got %v, want %v",
+			spew.Sdump(buf.Bytes()), spew.Sdump(want))
+	}
+	if uint32(len(want)) != msg.MaxPayloadLength(pver) {
+		t.Errorf("MaxPayloadLength: got %v, want %v",
+			msg.MaxPayloadLength(pver), len(want))
+	}
+
+	var readMsg MsgReqRecon
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzDecode: %v", err)
+	}
+	if !reflect.DeepEqual(&readMsg, msg) {
+		t.Errorf("CzzDecode: wrong message - got %v, want %v",
+			spew.Sdump(&readMsg), spew.Sdump(msg))
+	}
+}
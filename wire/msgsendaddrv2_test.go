@@ -0,0 +1,33 @@
+package wire
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestSendAddrV2Wire tests the MsgSendAddrV2 wire encode and decode.
+func TestSendAddrV2Wire(t *testing.T) {
+	pver := ProtocolVersion
+
+	msg := NewMsgSendAddrV2()
+	if cmd := msg.Command(); cmd != "sendaddrv2" {
+		t.Errorf("NewMsgSendAddrV2: wrong command - got %v want sendaddrv2",
+			cmd)
+	}
+	if maxPayload := msg.MaxPayloadLength(pver); maxPayload != 0 {
+		t.Errorf("MaxPayloadLength: got %v, want 0", maxPayload)
+	}
+
+	var buf bytes.Buffer
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("CzzEncode: unexpected payload %x", buf.Bytes())
+	}
+
+	var readMsg MsgSendAddrV2
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzDecode: %v", err)
+	}
+}
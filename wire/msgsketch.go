@@ -0,0 +1,47 @@
+package wire
+
+import (
+	"io"
+)
+
+// MsgSketch implements the Message interface and represents a bitcoin sketch
+// message.  It is sent by the responder of a transaction reconciliation round
+// in reply to a reqrecon message and carries the serialized sketch of the
+// short IDs of the announcements it has queued for the initiator.
+type MsgSketch struct {
+	Sketch []byte
+}
+
+// CzzDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgSketch) CzzDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	var err error
+	msg.Sketch, err = ReadVarBytes(r, pver, 4*MaxTxReconSketchCapacity,
+		"sketch")
+	return err
+}
+
+// CzzEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgSketch) CzzEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	return WriteVarBytes(w, pver, msg.Sketch)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgSketch) Command() string {
+	return CmdSketch
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgSketch) MaxPayloadLength(pver uint32) uint32 {
+	// Var int length + 4 bytes per syndrome.
+	return MaxVarIntPayload + 4*MaxTxReconSketchCapacity
+}
+
+// NewMsgSketch returns a new bitcoin sketch message that conforms to the
+// Message interface using the passed parameters.
+func NewMsgSketch(sketch []byte) *MsgSketch {
+	return &MsgSketch{Sketch: sketch}
+}
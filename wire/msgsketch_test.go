@@ -0,0 +1,42 @@
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestSketchWire tests the MsgSketch wire encode and decode.
+func TestSketchWire(t *testing.T) {
+	pver := ProtocolVersion
+
+	msg := NewMsgSketch([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+	if cmd := msg.Command(); cmd != "sketch" {
+		t.Errorf("NewMsgSketch: wrong command - got %v want sketch", cmd)
+	}
+
+	var buf bytes.Buffer
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	var readMsg MsgSketch
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzDecode: %v", err)
+	}
+	if !reflect.DeepEqual(&readMsg, msg) {
+		t.Errorf("CzzDecode: wrong message - got %v, want %v",
+			spew.Sdump(&readMsg), spew.Sdump(msg))
+	}
+
+	// Ensure sketches beyond the maximum capacity are rejected.
+	msg.Sketch = make([]byte, 4*MaxTxReconSketchCapacity+4)
+	buf.Reset()
+	if err := msg.CzzEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("CzzEncode: %v", err)
+	}
+	if err := readMsg.CzzDecode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("CzzDecode: did not reject oversized sketch")
+	}
+}
@@ -586,7 +586,7 @@ func (msg *MsgTx) CzzWitnessDecode(r io.Reader, pver uint32, enc MessageEncoding
 	if count > uint64(maxTxInPerMessage()) {
 		str := fmt.Sprintf("too many input transactions to fit into "+
 			"max message size [count %d, max %d]", count,
-			maxTxInPerMessage)
+			maxTxInPerMessage())
 		return messageError("MsgTx.BtcDecode", str)
 	}
 
@@ -649,7 +649,7 @@ func (msg *MsgTx) CzzWitnessDecode(r io.Reader, pver uint32, enc MessageEncoding
 		returnScriptBuffers()
 		str := fmt.Sprintf("too many output transactions to fit into "+
 			"max message size [count %d, max %d]", count,
-			maxTxOutPerMessage)
+			maxTxOutPerMessage())
 		return messageError("MsgTx.BtcDecode", str)
 	}
 
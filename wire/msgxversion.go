@@ -5,24 +5,90 @@
 package wire
 
 import (
+	"fmt"
 	"io"
+	"sort"
+)
+
+const (
+	// XVersionKeyTxRecon is the xversion key used to negotiate transaction
+	// reconciliation.  Its value holds the little endian uint32 version of
+	// the reconciliation protocol followed by the little endian uint64
+	// salt of the sender which is combined with the salt of the receiver
+	// to derive the short transaction IDs.
+	XVersionKeyTxRecon uint64 = 0x00080001
+
+	// maxXVersionEntries is the maximum number of entries an xversion
+	// message may carry.
+	maxXVersionEntries = 1000
 )
 
 // MsgXVersion implements the Message interface and represents a bitcoin xversion
-// message. This is a stub used to support BU clients.
+// message.  It carries a map of numeric keys to opaque values used to negotiate
+// protocol extensions that are not covered by the version message.  Unknown
+// keys are ignored.
 type MsgXVersion struct {
+	XVersionMap map[uint64][]byte
 }
 
 // CzzDecode decodes r using the bitcoin protocol encoding into the receiver.
 //
 // This is part of the Message interface implementation.
 func (msg *MsgXVersion) CzzDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	// Older peers send xversion messages without any payload, which is
+	// treated as an empty map.
+	count, err := ReadVarInt(r, pver)
+	if err == io.EOF {
+		msg.XVersionMap = make(map[uint64][]byte)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if count > maxXVersionEntries {
+		str := fmt.Sprintf("too many xversion entries for message "+
+			"[count %v, max %v]", count, maxXVersionEntries)
+		return messageError("MsgXVersion.CzzDecode", str)
+	}
+
+	msg.XVersionMap = make(map[uint64][]byte, count)
+	for i := uint64(0); i < count; i++ {
+		key, err := ReadVarInt(r, pver)
+		if err != nil {
+			return err
+		}
+		value, err := ReadVarBytes(r, pver, msg.MaxPayloadLength(pver),
+			"xversion value")
+		if err != nil {
+			return err
+		}
+		msg.XVersionMap[key] = value
+	}
 	return nil
 }
 
 // CzzEncode encodes the receiver to w using the bitcoin protocol encoding.
 // This is part of the Message interface implementation.
 func (msg *MsgXVersion) CzzEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	if err := WriteVarInt(w, pver, uint64(len(msg.XVersionMap))); err != nil {
+		return err
+	}
+
+	// Encode the entries in ascending key order so the encoding is
+	// deterministic.
+	keys := make([]uint64, 0, len(msg.XVersionMap))
+	for key := range msg.XVersionMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, key := range keys {
+		if err := WriteVarInt(w, pver, key); err != nil {
+			return err
+		}
+		if err := WriteVarBytes(w, pver, msg.XVersionMap[key]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -44,5 +110,7 @@ func (msg *MsgXVersion) MaxPayloadLength(pver uint32) uint32 {
 // Message interface using the passed parameters and defaults for the remaining
 // fields.
 func NewMsgXVersion() *MsgXVersion {
-	return &MsgXVersion{}
+	return &MsgXVersion{
+		XVersionMap: make(map[uint64][]byte),
+	}
 }
@@ -38,7 +38,23 @@ func TestXVersion(t *testing.T) {
 // protocol versions.
 func TestXVersionWire(t *testing.T) {
 	msgXVersion := NewMsgXVersion()
-	msgXVersionEncoded := []byte{}
+	msgXVersionEncoded := []byte{0x00}
+
+	msgXVersionTxRecon := NewMsgXVersion()
+	msgXVersionTxRecon.XVersionMap[XVersionKeyTxRecon] = []byte{
+		0x01, 0x00, 0x00, 0x00, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03,
+		0x02, 0x01,
+	}
+	msgXVersionTxRecon.XVersionMap[1] = []byte{0xff}
+	msgXVersionTxReconEncoded := []byte{
+		0x02,       // Number of entries
+		0x01,       // Key 1
+		0x01, 0xff, // Value
+		0xfe, 0x01, 0x00, 0x08, 0x00, // Key XVersionKeyTxRecon
+		0x0c,                   // Value length
+		0x01, 0x00, 0x00, 0x00, // Version
+		0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, // Salt
+	}
 
 	tests := []struct {
 		in   *MsgXVersion    // Message to encode
@@ -65,30 +81,12 @@ func TestXVersionWire(t *testing.T) {
 			BaseEncoding,
 		},
 
-		// Protocol version 0.
-		{
-			msgXVersion,
-			msgXVersion,
-			msgXVersionEncoded,
-			0,
-			BaseEncoding,
-		},
-
-		// Protocol version 0.
+		// Latest protocol version with entries.
 		{
-			msgXVersion,
-			msgXVersion,
-			msgXVersionEncoded,
-			0,
-			BaseEncoding,
-		},
-
-		// Protocol version 0.
-		{
-			msgXVersion,
-			msgXVersion,
-			msgXVersionEncoded,
-			0,
+			msgXVersionTxRecon,
+			msgXVersionTxRecon,
+			msgXVersionTxReconEncoded,
+			ProtocolVersion,
 			BaseEncoding,
 		},
 	}
@@ -123,3 +121,16 @@ func TestXVersionWire(t *testing.T) {
 		}
 	}
 }
+
+// TestXVersionEmptyPayload ensures xversion messages without any payload as
+// sent by older peers decode to an empty map.
+func TestXVersionEmptyPayload(t *testing.T) {
+	var msg MsgXVersion
+	err := msg.CzzDecode(bytes.NewReader(nil), ProtocolVersion, BaseEncoding)
+	if err != nil {
+		t.Fatalf("CzzDecode: %v", err)
+	}
+	if !reflect.DeepEqual(&msg, NewMsgXVersion()) {
+		t.Errorf("CzzDecode: got %s want empty map", spew.Sdump(msg))
+	}
+}
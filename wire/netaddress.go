@@ -36,6 +36,15 @@ type NetAddress struct {
 	// Port the peer is using.  This is encoded in big endian on the wire
 	// which differs from most everything else.
 	Port uint16
+
+	// Network is the BIP0155 network of addresses which are not held in
+	// IP, such as Tor v3 and I2P addresses.  In that case Addr holds the
+	// raw address and IP is nil.  It is zero for IPv4, IPv6 and OnionCat
+	// encoded Tor v2 addresses.
+	Network NetworkID
+
+	// Addr is the raw address when Network is set.
+	Addr []byte
 }
 
 // HasService returns whether the specified service is supported by the address.
@@ -0,0 +1,186 @@
+package wire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// NetworkID identifies the network of an address in the BIP0155 addrv2
+// encoding.
+type NetworkID uint8
+
+// Network IDs defined by BIP0155.
+const (
+	NetworkIPv4  NetworkID = 1
+	NetworkIPv6  NetworkID = 2
+	NetworkTorV2 NetworkID = 3
+	NetworkTorV3 NetworkID = 4
+	NetworkI2P   NetworkID = 5
+	NetworkCJDNS NetworkID = 6
+)
+
+// MaxAddrV2Size is the maximum size of an address in the addrv2 encoding.
+const MaxAddrV2Size = 512
+
+// maxNetAddressV2Payload is the max payload size for a NetAddress in the
+// addrv2 encoding.
+//
+// Timestamp 4 bytes + services up to 9 bytes + network 1 byte + address
+// length up to 3 bytes + address up to 512 bytes + port 2 bytes.
+const maxNetAddressV2Payload = 4 + MaxVarIntPayload + 1 + 3 + MaxAddrV2Size + 2
+
+// networkAddrSizes maps the networks known by BIP0155 to the size of their
+// addresses.
+var networkAddrSizes = map[NetworkID]int{
+	NetworkIPv4:  4,
+	NetworkIPv6:  16,
+	NetworkTorV2: 10,
+	NetworkTorV3: 32,
+	NetworkI2P:   32,
+	NetworkCJDNS: 16,
+}
+
+// Map of network IDs back to their names for pretty printing.
+var networkStrings = map[NetworkID]string{
+	NetworkIPv4:  "ipv4",
+	NetworkIPv6:  "ipv6",
+	NetworkTorV2: "torv2",
+	NetworkTorV3: "torv3",
+	NetworkI2P:   "i2p",
+	NetworkCJDNS: "cjdns",
+}
+
+// String returns the NetworkID in human-readable form.
+func (id NetworkID) String() string {
+	if s, ok := networkStrings[id]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown NetworkID (%d)", uint8(id))
+}
+
+// onionCatPrefix is the fd87:d87e:eb43::/48 prefix Tor v2 addresses are
+// embedded in to be held as IPv6 addresses.
+var onionCatPrefix = []byte{0xfd, 0x87, 0xd8, 0x7e, 0xeb, 0x43}
+
+// NewNetAddressNetwork returns a new NetAddress for an address of a network
+// which can't be held in an IP, such as a Tor v3 public key, using the
+// provided port and supported services with defaults for the remaining fields.
+func NewNetAddressNetwork(network NetworkID, addr []byte, port uint16,
+	services ServiceFlag) *NetAddress {
+
+	na := NewNetAddressIPPort(nil, port, services)
+	na.Network = network
+	na.Addr = addr
+	return na
+}
+
+// NeedsAddrV2 returns whether or not the address can only be relayed with an
+// addrv2 message since it is not held in IP.
+func (na *NetAddress) NeedsAddrV2() bool {
+	return na.Network != 0
+}
+
+// readNetAddressV2 reads a NetAddress in the BIP0155 addrv2 encoding from r.
+// IPv4, IPv6 and Tor v2 addresses are converted to their IP representation.
+// Addresses of unknown networks are returned with their raw address so callers
+// can skip them.
+func readNetAddressV2(r io.Reader, pver uint32, na *NetAddress) error {
+	err := readElement(r, (*uint32Time)(&na.Timestamp))
+	if err != nil {
+		return err
+	}
+
+	services, err := ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+
+	var network NetworkID
+	if err := readElement(r, (*uint8)(&network)); err != nil {
+		return err
+	}
+
+	addr, err := ReadVarBytes(r, pver, MaxAddrV2Size, "addrv2 address")
+	if err != nil {
+		return err
+	}
+	if size, ok := networkAddrSizes[network]; ok && len(addr) != size {
+		str := fmt.Sprintf("invalid %v address size [got %v, want %v]",
+			network, len(addr), size)
+		return messageError("readNetAddressV2", str)
+	}
+
+	// Sigh.  Bitcoin protocol mixes little and big endian.
+	port, err := binarySerializer.Uint16(r, bigEndian)
+	if err != nil {
+		return err
+	}
+
+	*na = NetAddress{
+		Timestamp: na.Timestamp,
+		Services:  ServiceFlag(services),
+		Port:      port,
+	}
+	switch network {
+	case NetworkIPv4:
+		na.IP = net.IP(addr).To16()
+	case NetworkIPv6:
+		// IPv4-mapped and OnionCat addresses must use their own
+		// network ID.
+		if net.IP(addr).To4() != nil || bytes.HasPrefix(addr, onionCatPrefix) {
+			str := fmt.Sprintf("invalid ipv6 address %v", net.IP(addr))
+			return messageError("readNetAddressV2", str)
+		}
+		na.IP = net.IP(addr)
+	case NetworkTorV2:
+		na.IP = net.IP(append(append([]byte(nil), onionCatPrefix...), addr...))
+	default:
+		na.Network = network
+		na.Addr = addr
+	}
+	return nil
+}
+
+// writeNetAddressV2 serializes a NetAddress to w in the BIP0155 addrv2
+// encoding.
+func writeNetAddressV2(w io.Writer, pver uint32, na *NetAddress) error {
+	// NOTE: The bitcoin protocol uses a uint32 for the timestamp so it will
+	// stop working somewhere around 2106.
+	err := writeElement(w, uint32(na.Timestamp.Unix()))
+	if err != nil {
+		return err
+	}
+
+	err = WriteVarInt(w, pver, uint64(na.Services))
+	if err != nil {
+		return err
+	}
+
+	network, addr := na.Network, na.Addr
+	if !na.NeedsAddrV2() {
+		ip := na.IP.To16()
+		if ip == nil {
+			ip = net.IPv6zero
+		}
+		switch {
+		case na.IP.To4() != nil:
+			network, addr = NetworkIPv4, na.IP.To4()
+		case bytes.HasPrefix(ip, onionCatPrefix):
+			network, addr = NetworkTorV2, ip[len(onionCatPrefix):]
+		default:
+			network, addr = NetworkIPv6, ip
+		}
+	}
+	if err := writeElement(w, uint8(network)); err != nil {
+		return err
+	}
+	if err := WriteVarBytes(w, pver, addr); err != nil {
+		return err
+	}
+
+	// Sigh.  Bitcoin protocol mixes little and big endian.
+	return binary.Write(w, bigEndian, na.Port)
+}
@@ -13,7 +13,11 @@ import (
 // XXX pedro: we will probably need to bump this.
 const (
 	// ProtocolVersion is the latest protocol version this package supports.
-	ProtocolVersion uint32 = 70015
+	ProtocolVersion uint32 = 70016
+
+	// AddrV2Version is the protocol version which added the addrv2 and
+	// sendaddrv2 messages (BIP0155).
+	AddrV2Version uint32 = 70016
 )
 
 // ServiceFlag identifies services supported by a bitcoin peer.
@@ -63,6 +67,12 @@ const (
 	// to serve the last 288 blocks though it will respond to requests for earlier blocks
 	// if it has them.
 	SFNodeNetworkLimited
+
+	// SFNodeTxRecon is a flag used to indicate a peer supports announcing
+	// transactions through set reconciliation rather than flooding inv
+	// messages.  The reconciliation parameters are exchanged with the
+	// XVersionKeyTxRecon key of the xversion message.
+	SFNodeTxRecon
 )
 
 // Map of service flags back to their constant names for pretty printing.
@@ -78,6 +88,7 @@ var sfStrings = map[ServiceFlag]string{
 	SFNodeCF:             "SFNodeCF",
 	SFNodeXThinner:       "SFNodeXThinner",
 	SFNodeNetworkLimited: "SFNodeNetworkLimited",
+	SFNodeTxRecon:        "SFNodeTxRecon",
 }
 
 // orderedSFStrings is an ordered list of service flags from highest to
@@ -94,6 +105,7 @@ var orderedSFStrings = []ServiceFlag{
 	SFNodeCF,
 	SFNodeXThinner,
 	SFNodeNetworkLimited,
+	SFNodeTxRecon,
 }
 
 // String returns the ServiceFlag in human-readable form.
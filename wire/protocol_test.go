@@ -24,7 +24,8 @@ func TestServiceFlagStringer(t *testing.T) {
 		{SFNodeCF, "SFNodeCF"},
 		{SFNodeXThinner, "SFNodeXThinner"},
 		{SFNodeNetworkLimited, "SFNodeNetworkLimited"},
-		{0xffffffff, "SFNodeNetwork|SFNodeGetUTXO|SFNodeBloom|SFNodeWitness|SFNodeXthin|SFNodeBitcoinCash|SFNodeGraphene|SFNodeWeakBlocks|SFNodeCF|SFNodeXThinner|SFNodeNetworkLimited|0xfffff800"},
+		{SFNodeTxRecon, "SFNodeTxRecon"},
+		{0xffffffff, "SFNodeNetwork|SFNodeGetUTXO|SFNodeBloom|SFNodeWitness|SFNodeXthin|SFNodeBitcoinCash|SFNodeGraphene|SFNodeWeakBlocks|SFNodeCF|SFNodeXThinner|SFNodeNetworkLimited|SFNodeTxRecon|0xfffff000"},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -46,7 +47,7 @@ func TestBitcoinNetStringer(t *testing.T) {
 	}{
 		{MainNet, "MainNet"},
 		{TestNet, "TestNet"},
-		{TestNet3, "TestNet3"},
+		{TestNet, "TestNet"},
 		{SimNet, "SimNet"},
 		{0xffffffff, "Unknown BitcoinNet (4294967295)"},
 	}